@@ -30,6 +30,7 @@ import (
 	denommetadatamoduleclient "github.com/dymensionxyz/dymension/v3/x/denommetadata/client"
 
 	v5 "github.com/dymensionxyz/dymension/v3/app/upgrades/v5"
+	v6 "github.com/dymensionxyz/dymension/v3/app/upgrades/v6"
 
 	"cosmossdk.io/log"
 	dbm "github.com/cosmos/cosmos-db"
@@ -82,7 +83,7 @@ var (
 	DefaultNodeHome string
 
 	// Upgrades contains the upgrade handlers for the application
-	Upgrades = []upgrades.Upgrade{v5.Upgrade}
+	Upgrades = []upgrades.Upgrade{v5.Upgrade, v6.Upgrade}
 )
 
 func init() {
@@ -18,6 +18,8 @@ const (
 func GenerateTestPacketData(t *testing.T) []byte {
 	t.Helper()
 	data := &transfertypes.FungibleTokenPacketData{
+		Denom:    "adym",
+		Amount:   "100",
 		Receiver: TestPacketReceiver,
 		Sender:   TestPacketSender,
 	}
@@ -387,6 +387,7 @@ func (a *AppKeepers) InitKeepers(
 	)
 
 	a.SequencerKeeper.SetUnbondBlockers(a.RollappKeeper, a.LightClientKeeper)
+	a.SequencerKeeper.SetPriceSource(a.GAMMKeeper)
 	a.SequencerKeeper.SetHooks(sequencermoduletypes.MultiHooks{rollappmodulekeeper.SequencerHooks{Keeper: a.RollappKeeper}})
 
 	a.RollappKeeper.SetSequencerKeeper(a.SequencerKeeper)
@@ -471,10 +472,14 @@ func (a *AppKeepers) InitKeepers(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		a.BankKeeper,
 		a.IBCKeeper.ChannelKeeper,
-		genesisbridge.NewICS4Wrapper(
-			denommetadatamodule.NewICS4Wrapper(a.IBCKeeper.ChannelKeeper, a.RollappKeeper, a.BankKeeper),
+		delayedackmodule.NewICS4Wrapper(
+			genesisbridge.NewICS4Wrapper(
+				denommetadatamodule.NewICS4Wrapper(a.IBCKeeper.ChannelKeeper, a.RollappKeeper, a.BankKeeper),
+				a.RollappKeeper,
+				a.IBCKeeper.ChannelKeeper,
+			),
+			&a.DelayedAckKeeper,
 			a.RollappKeeper,
-			a.IBCKeeper.ChannelKeeper,
 		), // ICS4Wrapper
 	)
 
@@ -629,6 +634,8 @@ func (a *AppKeepers) SetupHooks() {
 			a.IncentivesKeeper.EpochHooks(),
 			a.TxFeesKeeper.Hooks(),
 			a.DelayedAckKeeper.GetEpochHooks(),
+			a.EIBCKeeper.GetEpochHooks(),
+			a.SequencerKeeper.EpochHooks(),
 		),
 	)
 
@@ -176,6 +176,8 @@ func ModuleAccountAddrs() map[string]bool {
 	modAccAddrs[authtypes.NewModuleAddress(streamermoduletypes.ModuleName).String()] = false
 	modAccAddrs[authtypes.NewModuleAddress(txfeestypes.ModuleName).String()] = false
 	modAccAddrs[authtypes.NewModuleAddress(irotypes.ModuleName).String()] = false
+	// exclude eibc as it receives the transfers of partially fulfilled demand orders
+	modAccAddrs[authtypes.NewModuleAddress(eibcmoduletypes.ModuleName).String()] = false
 
 	return modAccAddrs
 }
@@ -206,6 +208,7 @@ var maccPerms = map[string][]string{
 	hyperwarptypes.ModuleName:                          {authtypes.Minter, authtypes.Burner},
 	kastypes.ModuleName:                                nil,
 	ratelimittypes.ModuleName:                          nil,
+	eibcmoduletypes.ModuleName:                         nil,
 }
 
 var PreBlockers = []string{
@@ -0,0 +1,17 @@
+package v6
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/upgrades"
+)
+
+const (
+	UpgradeName = "v6"
+)
+
+var Upgrade = upgrades.Upgrade{
+	Name:          UpgradeName,
+	CreateHandler: CreateUpgradeHandler,
+	StoreUpgrades: storetypes.StoreUpgrades{},
+}
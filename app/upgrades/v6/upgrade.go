@@ -0,0 +1,27 @@
+package v6
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/dymensionxyz/dymension/v3/app/upgrades"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v6
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	_ *upgrades.UpgradeKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(goCtx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(goCtx)
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+
+		// The new params of x/rollapp, x/sequencer, x/eibc and x/delayedack are set by their migrations.
+		logger.Debug("running module migrations ...")
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
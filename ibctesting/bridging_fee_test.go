@@ -105,7 +105,7 @@ func (s *bridgingFeeSuite) TestBridgingFee() {
 	s.finalizeRollappPacketsByAddress(s.hubChain().SenderAccount.GetAddress().String())
 
 	// check balance after finalization
-	expectedFee := s.hubApp().DelayedAckKeeper.BridgingFeeFromAmt(s.hubCtx(), transferredCoins.Amount)
+	expectedFee := s.hubApp().DelayedAckKeeper.BridgingFeeFromAmt(s.hubCtx(), rollappChainID(), denom, transferredCoins.Amount)
 	expectedBalance := initialBalance.Add(transferredCoins).Sub(sdk.NewCoin(denom, expectedFee))
 	finalBalance := s.hubApp().BankKeeper.SpendableCoins(s.hubCtx(), recipient)
 	s.Equal(expectedBalance, finalBalance)
@@ -197,7 +197,7 @@ func (s *bridgingFeeSuite) TestBridgingFeeReturnTokens() {
 	s.finalizeRollappPacketsByAddress(s.hubChain().SenderAccount.GetAddress().String())
 
 	// Check balance after finalization
-	expectedFee := s.hubApp().DelayedAckKeeper.BridgingFeeFromAmt(s.hubCtx(), rollappReceivedCoin.Amount)
+	expectedFee := s.hubApp().DelayedAckKeeper.BridgingFeeFromAmt(s.hubCtx(), rollappChainID(), hubDenom, rollappReceivedCoin.Amount)
 	expectedBalance := initialHubBalance.Add(sdk.NewCoin(hubDenom, rollappReceivedCoin.Amount)).Sub(sdk.NewCoin(hubDenom, expectedFee))
 	finalBalance := s.hubApp().BankKeeper.SpendableCoins(s.hubCtx(), recipient)
 	s.Equal(expectedBalance, finalBalance)
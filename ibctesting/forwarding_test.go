@@ -163,8 +163,8 @@ func (s *eibcForwardSuite) runFinalizeFwdTC(tc FinalizeFwdTC) {
 		s.Require().False(ok)
 		// recipient still has funds
 		extra, _ := math.NewIntFromString(tc.ibcAmt)
-		extra = extra.Sub(s.dackK().BridgingFeeFromAmt(s.hubCtx(), extra))
 		ibcDenom := "ibc/C053D637CCA2A2BA030E2C5EE1B28A16F71CCB0E45E8BE52766DC1B241B77878" // found in debugger :/
+		extra = extra.Sub(s.dackK().BridgingFeeFromAmt(s.hubCtx(), rollappChainID(), ibcDenom, extra))
 		extraCoin := sdk.NewCoin(ibcDenom, extra)
 		s.Require().Equal(ibcRecipientBalBefore.Add(extraCoin), ibcRecipientBalAfter)
 	}
@@ -0,0 +1,167 @@
+package ibctesting_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/suite"
+
+	delayedackkeeper "github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
+	delayedacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+)
+
+type rateLimitSuite struct {
+	ibcTestingSuite
+}
+
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(rateLimitSuite))
+}
+
+func (s *rateLimitSuite) SetupTest() {
+	s.ibcTestingSuite.SetupTest()
+	s.hubApp().LightClientKeeper.SetEnabled(false) // disable state validation against light client
+}
+
+func (s *rateLimitSuite) TestRateLimitAndPause() {
+	path := s.newTransferPath(s.hubChain(), s.rollappChain())
+	s.coordinator.Setup(path)
+	s.createRollappWithFinishedGenesis(path.EndpointA.ChannelID)
+	s.registerSequencer()
+	s.setRollappLightClientID(s.rollappCtx().ChainID(), path.EndpointA.ClientID)
+	s.updateRollappState(uint64(s.rollappCtx().BlockHeight())) //nolint:gosec
+
+	hubEndpoint := path.EndpointA
+	rollappEndpoint := path.EndpointB
+	hubAddr := s.hubChain().SenderAccount.GetAddress()
+	rollappAddr := s.rollappChain().SenderAccount.GetAddress()
+	timeoutHeight := clienttypes.NewHeight(100, 110)
+	dk := s.hubApp().DelayedAckKeeper
+
+	// the rollapp denom as credited on the hub
+	inDenom := types.ParseDenomTrace(types.GetPrefixedDenom(hubEndpoint.ChannelConfig.PortID, hubEndpoint.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	err := dk.SetRateLimit(s.hubCtx(), delayedacktypes.RateLimit{
+		RollappId:  rollappChainID(),
+		Denom:      inDenom,
+		MaxInflow:  math.NewInt(100),
+		MaxOutflow: math.ZeroInt(),
+		Window:     time.Hour,
+	})
+	s.Require().NoError(err)
+	err = dk.SetRateLimit(s.hubCtx(), delayedacktypes.RateLimit{
+		Denom:      sdk.DefaultBondDenom,
+		MaxInflow:  math.ZeroInt(),
+		MaxOutflow: math.NewInt(50),
+		Window:     time.Hour,
+	})
+	s.Require().NoError(err)
+
+	sendFromRollapp := func(amt int64) error {
+		msg := types.NewMsgTransfer(rollappEndpoint.ChannelConfig.PortID, rollappEndpoint.ChannelID,
+			sdk.NewInt64Coin(sdk.DefaultBondDenom, amt), rollappAddr.String(), hubAddr.String(), timeoutHeight, 0, "")
+		res, err := s.rollappChain().SendMsgs(msg)
+		s.Require().NoError(err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		s.Require().NoError(err)
+		// an error means that the ack is delayed
+		return path.RelayPacket(packet)
+	}
+	sendFromHub := func(amt int64) error {
+		msg := types.NewMsgTransfer(hubEndpoint.ChannelConfig.PortID, hubEndpoint.ChannelID,
+			sdk.NewInt64Coin(sdk.DefaultBondDenom, amt), hubAddr.String(), rollappAddr.String(), timeoutHeight, 0, "")
+		_, err := s.hubChain().SendMsgs(msg)
+		return err
+	}
+	quota := func() *delayedacktypes.QueryRateLimitQuotaResponse {
+		res, err := delayedackkeeper.NewQuerier(dk).RateLimitQuota(s.hubCtx(), &delayedacktypes.QueryRateLimitQuotaRequest{
+			RollappId: rollappChainID(),
+			Denom:     inDenom,
+		})
+		s.Require().NoError(err)
+		return res
+	}
+
+	// within the inflow limit, the ack is delayed
+	s.Require().Error(sendFromRollapp(60))
+	s.Require().Equal(math.NewInt(40), *quota().RemainingInflow)
+	s.Require().Nil(quota().RemainingOutflow)
+
+	// over the inflow limit, the packet gets an error ack right away
+	s.Require().NoError(sendFromRollapp(50))
+	s.Require().Equal(math.NewInt(40), *quota().RemainingInflow)
+	s.Require().Error(sendFromRollapp(40))
+	s.Require().True(quota().RemainingInflow.IsZero())
+
+	// the outflow limit applies to each rollapp
+	err = sendFromHub(60)
+	s.Require().ErrorContains(err, delayedacktypes.ErrRateLimitExceeded.Error())
+	s.Require().NoError(sendFromHub(50))
+
+	// the pause halts transfers both ways
+	err = dk.PauseBridge(s.hubCtx(), delayedacktypes.BridgePause{RollappId: rollappChainID(), Governance: true})
+	s.Require().NoError(err)
+	s.Require().NotNil(quota().Pause)
+	err = dk.RemoveRateLimit(s.hubCtx(), "", sdk.DefaultBondDenom)
+	s.Require().NoError(err)
+	err = sendFromHub(1)
+	s.Require().ErrorContains(err, delayedacktypes.ErrBridgePaused.Error())
+	s.Require().NoError(dk.RemoveRateLimit(s.hubCtx(), rollappChainID(), inDenom))
+	s.Require().NoError(sendFromRollapp(1))
+
+	s.Require().NoError(dk.ResumeBridge(s.hubCtx(), rollappChainID()))
+	s.Require().NoError(sendFromHub(1))
+	s.Require().Error(sendFromRollapp(1))
+}
+
+func (s *rateLimitSuite) TestInflowGivenBackOnErrorAck() {
+	path := s.newTransferPath(s.hubChain(), s.rollappChain())
+	s.coordinator.Setup(path)
+	s.createRollappWithFinishedGenesis(path.EndpointA.ChannelID)
+	s.registerSequencer()
+	s.setRollappLightClientID(s.rollappCtx().ChainID(), path.EndpointA.ClientID)
+	s.updateRollappState(uint64(s.rollappCtx().BlockHeight())) //nolint:gosec
+
+	hubEndpoint := path.EndpointA
+	rollappEndpoint := path.EndpointB
+	hubAddr := s.hubChain().SenderAccount.GetAddress()
+	dk := s.hubApp().DelayedAckKeeper
+
+	inDenom := types.ParseDenomTrace(types.GetPrefixedDenom(hubEndpoint.ChannelConfig.PortID, hubEndpoint.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	err := dk.SetRateLimit(s.hubCtx(), delayedacktypes.RateLimit{
+		RollappId:  rollappChainID(),
+		Denom:      inDenom,
+		MaxInflow:  math.NewInt(100),
+		MaxOutflow: math.ZeroInt(),
+		Window:     time.Hour,
+	})
+	s.Require().NoError(err)
+	remainingInflow := func() math.Int {
+		_, inflow, _, err := dk.GetRateLimitQuota(s.hubCtx(), rollappChainID(), inDenom)
+		s.Require().NoError(err)
+		return *inflow
+	}
+
+	msg := types.NewMsgTransfer(rollappEndpoint.ChannelConfig.PortID, rollappEndpoint.ChannelID,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 60), s.rollappChain().SenderAccount.GetAddress().String(), hubAddr.String(),
+		clienttypes.NewHeight(100, 110), 0, "")
+	res, err := s.rollappChain().SendMsgs(msg)
+	s.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	s.Require().Error(path.RelayPacket(packet)) // the ack is delayed
+	s.Require().Equal(math.NewInt(40), remainingInflow())
+
+	// the transfer fails on finalization and is refunded on the rollapp with an error ack
+	s.hubApp().TransferKeeper.SetParams(s.hubCtx(), types.Params{SendEnabled: true, ReceiveEnabled: false})
+	_, err = s.finalizeRollappState(1, uint64(s.rollappCtx().BlockHeight())) //nolint:gosec
+	s.Require().NoError(err)
+	s.finalizeRollappPacketsByAddress(hubAddr.String())
+
+	s.Require().True(s.hubApp().GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(s.hubCtx(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	s.Require().Equal(math.NewInt(100), remainingInflow())
+}
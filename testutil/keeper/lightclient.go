@@ -228,6 +228,10 @@ func (m *MockRollappKeeper) GetStateInfo(ctx sdk.Context, rollappId string, inde
 func (m *MockRollappKeeper) SetRollapp(ctx sdk.Context, rollapp rollapptypes.Rollapp) {
 }
 
+func (m *MockRollappKeeper) GetBlockDescriptor(ctx sdk.Context, stateInfo *rollapptypes.StateInfo, height uint64) (rollapptypes.BlockDescriptor, bool) {
+	return stateInfo.GetBlockDescriptor(height)
+}
+
 func (m *MockRollappKeeper) HardFork(ctx sdk.Context, rollappID string, fraudHeight uint64) error {
 	return nil
 }
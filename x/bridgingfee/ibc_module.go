@@ -90,8 +90,8 @@ func (w IBCModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, rel
 	}
 	receiver := sdk.MustAccAddressFromBech32(transfer.Receiver)
 
-	feeAmt := w.delayedAckKeeper.BridgingFeeFromAmt(ctx, transfer.MustAmountInt())
 	denom := denomutils.GetIncomingTransferDenom(packet, transfer.FungibleTokenPacketData)
+	feeAmt := w.delayedAckKeeper.PacketBridgingFeeFromAmt(ctx, packet, transfer.Rollapp.RollappId, denom, transfer.MustAmountInt())
 	feeCoin := sdk.NewCoin(denom, feeAmt)
 
 	// since transfer worked, then receiver should have enough balance to pay
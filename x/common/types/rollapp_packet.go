@@ -123,6 +123,19 @@ func (r RollappPacket) RestoreOriginalTransferTarget() RollappPacket {
 	return r
 }
 
+// TransferTarget returns the account which gets the funds when the packet is finalized: the receiver of
+// an incoming transfer, or the sender to refund for an ack or a timeout.
+func (r RollappPacket) TransferTarget() (string, error) {
+	transferPacketData, err := r.GetTransferPacketData()
+	if err != nil {
+		return "", err
+	}
+	if r.Type == RollappPacket_ON_RECV {
+		return transferPacketData.Receiver, nil
+	}
+	return transferPacketData.Sender, nil
+}
+
 func PacketHubPortChan(packetType RollappPacket_Type, packet channeltypes.Packet) (string, string) {
 	var port string
 	var channel string
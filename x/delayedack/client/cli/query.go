@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -30,6 +31,12 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetPacketsByStatus())
 	cmd.AddCommand(CmdGetPacketsByType())
 	cmd.AddCommand(CmdGetPendingPacketsByAddress())
+	cmd.AddCommand(CmdQueryEffectiveBridgingFee())
+	cmd.AddCommand(CmdQueryBridgingFeeOverrides())
+	cmd.AddCommand(CmdQueryRateLimitQuota())
+	cmd.AddCommand(CmdQueryRateLimits())
+	cmd.AddCommand(CmdQuerySettledPacketReceipt())
+	cmd.AddCommand(CmdQuerySettledPacketReceipts())
 
 	return cmd
 }
@@ -254,3 +261,217 @@ func CmdGetPendingPacketsByAddress() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryEffectiveBridgingFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bridging-fee [rollapp-id] [amount]",
+		Short:   "Get the bridging fee charged on a transfer from the rollapp",
+		Example: "dymd query delayedack bridging-fee rollapp_1234-1 1000ibc/ABCD",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EffectiveBridgingFee(cmd.Context(), &types.QueryEffectiveBridgingFeeRequest{
+				RollappId: args[0],
+				Denom:     amount.Denom,
+				Amount:    amount.Amount,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryBridgingFeeOverrides() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridging-fee-overrides [rollapp-id]",
+		Short: "List the overrides of the bridging fee, optionally for a rollapp only",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryBridgingFeeOverridesRequest{Pagination: pageReq}
+			if len(args) == 1 {
+				req.RollappId = args[0]
+			}
+
+			res, err := queryClient.BridgingFeeOverrides(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "bridging-fee-overrides")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryRateLimitQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limit-quota [rollapp-id] [denom]",
+		Short:   "Get the amounts of the denom which can still flow in from and out to the rollapp",
+		Example: "dymd query delayedack rate-limit-quota rollapp_1234-1 ibc/ABCD",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimitQuota(cmd.Context(), &types.QueryRateLimitQuotaRequest{
+				RollappId: args[0],
+				Denom:     args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits [rollapp-id]",
+		Short: "List the rate limits, optionally for a rollapp only",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryRateLimitsRequest{Pagination: pageReq}
+			if len(args) == 1 {
+				req.RollappId = args[0]
+			}
+
+			res, err := queryClient.RateLimits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "rate-limits")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySettledPacketReceipt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "settled-packet [rollapp-id] [type] [src-channel] [sequence]",
+		Short:   "Get how a rollapp packet was settled, after it is deleted",
+		Example: "dymd query delayedack settled-packet rollapp_1234-1 ON_RECV channel-0 42",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			packetType, ok := commontypes.RollappPacket_Type_value[strings.ToUpper(args[1])]
+			if !ok {
+				return fmt.Errorf("invalid packet type: %s", args[1])
+			}
+			sequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("sequence: %w", err)
+			}
+
+			res, err := queryClient.SettledPacketReceipt(cmd.Context(), &types.QuerySettledPacketReceiptRequest{
+				RollappId:  args[0],
+				Type:       commontypes.RollappPacket_Type(packetType),
+				SrcChannel: args[2],
+				Sequence:   sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySettledPacketReceipts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settled-packets [rollapp-id]",
+		Short: "List the receipts of the settled packets of the rollapp",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SettledPacketReceipts(cmd.Context(), &types.QuerySettledPacketReceiptsRequest{
+				RollappId:  args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "settled-packets")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,7 +3,10 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -24,6 +27,13 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdFinalizePacket())
+	cmd.AddCommand(CmdFinalizePackets())
+	cmd.AddCommand(CmdSetBridgingFeeOverride())
+	cmd.AddCommand(CmdRemoveBridgingFeeOverride())
+	cmd.AddCommand(CmdSetRateLimit())
+	cmd.AddCommand(CmdRemoveRateLimit())
+	cmd.AddCommand(CmdPauseBridge())
+	cmd.AddCommand(CmdResumeBridge())
 
 	return cmd
 }
@@ -73,6 +83,305 @@ func CmdFinalizePacket() *cobra.Command {
 	return cmd
 }
 
+const (
+	FlagDenom      = "denom"
+	FlagTiers      = "tiers"
+	FlagPacketKeys = "packet-keys"
+	FlagLimit      = "limit"
+	FlagReason     = "reason"
+)
+
+func CmdFinalizePackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-packets [rollapp-id] [min-proof-height] [max-proof-height] --from <sender>",
+		Short: "Finalize a batch of packets",
+		Long: `Finalize the pending packets of the rollapp in the proof height range, capped at the latest finalized height.
+A max height of 0 means the latest finalized height. With --packet-keys, the given packets are finalized instead.`,
+		Example: `dymd tx delayedack finalize-packets rollapp_1234-1 0 0 --from <sender>
+dymd tx delayedack finalize-packets --packet-keys <key1>,<key2> --from <sender>`,
+		Args: cobra.RangeArgs(0, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			keys, err := cmd.Flags().GetStringSlice(FlagPacketKeys)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint32(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgFinalizePackets{
+				Sender:     clientCtx.GetFromAddress().String(),
+				PacketKeys: keys,
+				Limit:      limit,
+			}
+			if len(keys) == 0 {
+				if len(args) != 3 {
+					return fmt.Errorf("rollapp id and proof height range are required without packet keys")
+				}
+				msg.RollappId = args[0]
+				if msg.MinProofHeight, err = strconv.ParseUint(args[1], 10, 64); err != nil {
+					return err
+				}
+				if msg.MaxProofHeight, err = strconv.ParseUint(args[2], 10, 64); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagPacketKeys, nil, "Comma separated base64 encoded packet keys")
+	cmd.Flags().Uint32(FlagLimit, 0, fmt.Sprintf("Maximum number of packets to finalize, %d if 0", types.MaxFinalizePacketsBatch))
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetBridgingFeeOverride() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-bridging-fee-override [rollapp-id] [fee] --from <rollapp-owner>",
+		Short:   "Override the bridging fee of the rollapp, optionally for a denom only",
+		Long:    "Override the bridging fee of the rollapp. Tiers are min-amount:fee pairs by increasing min amount.",
+		Example: "dymd tx delayedack set-bridging-fee-override rollapp_1234-1 0.001 --denom ibc/ABCD --tiers 1000000:0.0005,10000000:0.0002",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fee, err := math.LegacyNewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("fee: %w", err)
+			}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			tiersStr, err := cmd.Flags().GetString(FlagTiers)
+			if err != nil {
+				return err
+			}
+			tiers, err := parseBridgingFeeTiers(tiersStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetBridgingFeeOverride{
+				Sender: clientCtx.GetFromAddress().String(),
+				Override: types.BridgingFeeOverride{
+					RollappId: args[0],
+					Denom:     denom,
+					Fee:       fee,
+					Tiers:     tiers,
+				},
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "Hub denom the override applies to, all denoms if empty")
+	cmd.Flags().String(FlagTiers, "", "Comma separated min-amount:fee pairs")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveBridgingFeeOverride() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-bridging-fee-override [rollapp-id] --from <rollapp-owner>",
+		Short: "Remove an override of the bridging fee of the rollapp",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRemoveBridgingFeeOverride{
+				Sender:    clientCtx.GetFromAddress().String(),
+				RollappId: args[0],
+				Denom:     denom,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "Hub denom of the override, all denoms if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-rate-limit [rollapp-id] [denom] [max-inflow] [max-outflow] [window] --from <rollapp-owner>",
+		Short:   "Limit the flow of a denom to and from the rollapp over a sliding window",
+		Long:    "Limit the flow of a denom to and from the rollapp over a sliding window. A max of zero means no limit in that direction.",
+		Example: "dymd tx delayedack set-rate-limit rollapp_1234-1 ibc/ABCD 1000000000 0 24h",
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxInflow, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("max inflow: %s", args[2])
+			}
+			maxOutflow, ok := math.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("max outflow: %s", args[3])
+			}
+			window, err := time.ParseDuration(args[4])
+			if err != nil {
+				return fmt.Errorf("window: %w", err)
+			}
+
+			msg := types.MsgSetRateLimit{
+				Sender: clientCtx.GetFromAddress().String(),
+				RateLimit: types.RateLimit{
+					RollappId:  args[0],
+					Denom:      args[1],
+					MaxInflow:  maxInflow,
+					MaxOutflow: maxOutflow,
+					Window:     window,
+				},
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-limit [rollapp-id] [denom] --from <rollapp-owner>",
+		Short: "Remove the limit on the flow of a denom to and from the rollapp",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRemoveRateLimit{
+				Sender:    clientCtx.GetFromAddress().String(),
+				RollappId: args[0],
+				Denom:     args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPauseBridge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pause-bridge [rollapp-id] --from <rollapp-owner>",
+		Short:   "Halt all transfers between the hub and the rollapp",
+		Example: "dymd tx delayedack pause-bridge rollapp_1234-1 --reason \"incident\"",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetBridgePaused{
+				Sender:    clientCtx.GetFromAddress().String(),
+				RollappId: args[0],
+				Paused:    true,
+				Reason:    reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "Note on the incident")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdResumeBridge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-bridge [rollapp-id] --from <rollapp-owner>",
+		Short: "Resume the transfers between the hub and the rollapp",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetBridgePaused{
+				Sender:    clientCtx.GetFromAddress().String(),
+				RollappId: args[0],
+				Paused:    false,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseBridgingFeeTiers(s string) ([]types.BridgingFeeTier, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var tiers []types.BridgingFeeTier
+	for _, pair := range strings.Split(s, ",") {
+		minAmt, feeStr, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("tier must be min-amount:fee: %s", pair)
+		}
+		amt, ok := math.NewIntFromString(minAmt)
+		if !ok {
+			return nil, fmt.Errorf("tier min amount: %s", minAmt)
+		}
+		fee, err := math.LegacyNewDecFromStr(feeStr)
+		if err != nil {
+			return nil, fmt.Errorf("tier fee: %w", err)
+		}
+		tiers = append(tiers, types.BridgingFeeTier{MinAmount: amt, Fee: fee})
+	}
+	return tiers, nil
+}
+
 func parsePacketType(packetType string) (commontypes.RollappPacket_Type, error) {
 	switch packetType {
 	case commontypes.RollappPacket_ON_RECV.String():
@@ -23,12 +23,52 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 		k.SetRollappPacket(ctx, packet)
 	}
+	for _, o := range genState.BridgingFeeOverrides {
+		if err := k.SetBridgingFeeOverride(ctx, o); err != nil {
+			panic(err)
+		}
+	}
+	for _, l := range genState.RateLimits {
+		if err := k.SetRateLimit(ctx, l); err != nil {
+			panic(err)
+		}
+	}
+	for _, p := range genState.BridgePauses {
+		if err := k.PauseBridge(ctx, p); err != nil {
+			panic(err)
+		}
+	}
+	for _, r := range genState.SettledPacketReceipts {
+		if err := k.SetSettledPacketReceipt(ctx, r); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	overrides, err := k.GetAllBridgingFeeOverrides(ctx)
+	if err != nil {
+		panic(err)
+	}
+	limits, err := k.GetAllRateLimits(ctx)
+	if err != nil {
+		panic(err)
+	}
+	pauses, err := k.GetAllBridgePauses(ctx)
+	if err != nil {
+		panic(err)
+	}
+	receipts, err := k.GetAllSettledPacketReceipts(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return &types.GenesisState{
-		Params:         k.GetParams(ctx),
-		RollappPackets: k.GetAllRollappPackets(ctx),
+		Params:                k.GetParams(ctx),
+		RollappPackets:        k.GetAllRollappPackets(ctx),
+		BridgingFeeOverrides:  overrides,
+		RateLimits:            limits,
+		BridgePauses:          pauses,
+		SettledPacketReceipts: receipts,
 	}
 }
@@ -11,7 +11,11 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var defBridgingFee = types.DefaultParams().BridgingFee
+var (
+	defBridgingFee         = types.DefaultParams().BridgingFee
+	defMinOwnerBridgingFee = types.DefaultParams().MinOwnerBridgingFee
+	defMaxOwnerBridgingFee = types.DefaultParams().MaxOwnerBridgingFee
+)
 
 func TestInitGenesis(t *testing.T) {
 	tests := []struct {
@@ -23,8 +27,10 @@ func TestInitGenesis(t *testing.T) {
 		{
 			name: "only params - success",
 			params: types.Params{
-				EpochIdentifier: "week",
-				BridgingFee:     defBridgingFee,
+				EpochIdentifier:     "week",
+				BridgingFee:         defBridgingFee,
+				MinOwnerBridgingFee: defMinOwnerBridgingFee,
+				MaxOwnerBridgingFee: defMaxOwnerBridgingFee,
 			},
 			rollappPackets: []commontypes.RollappPacket{},
 			expPanic:       false,
@@ -32,8 +38,10 @@ func TestInitGenesis(t *testing.T) {
 		{
 			name: "params and rollapp packets - panic",
 			params: types.Params{
-				EpochIdentifier: "week",
-				BridgingFee:     defBridgingFee,
+				EpochIdentifier:     "week",
+				BridgingFee:         defBridgingFee,
+				MinOwnerBridgingFee: defMinOwnerBridgingFee,
+				MaxOwnerBridgingFee: defMaxOwnerBridgingFee,
 			},
 			rollappPackets: []commontypes.RollappPacket{{RollappId: "0"}},
 			expPanic:       true,
@@ -61,8 +69,10 @@ func TestExportGenesis(t *testing.T) {
 	k, ctx := keepertest.DelayedackKeeper(t)
 	// Set params
 	params := types.Params{
-		EpochIdentifier: "week",
-		BridgingFee:     defBridgingFee,
+		EpochIdentifier:     "week",
+		BridgingFee:         defBridgingFee,
+		MinOwnerBridgingFee: defMinOwnerBridgingFee,
+		MaxOwnerBridgingFee: defMaxOwnerBridgingFee,
 	}
 	k.SetParams(ctx, params)
 	// Set some demand orders
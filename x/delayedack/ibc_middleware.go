@@ -6,11 +6,16 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
+	denomutils "github.com/dymensionxyz/dymension/v3/utils/denom"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
@@ -94,6 +99,15 @@ func (w IBCMiddleware) OnRecvPacket(
 		return uevent.NewErrorAcknowledgement(ctx, errorsmod.Wrap(err, "delayed ack: get valid transfer with finalization info"))
 	}
 
+	if transfer.IsRollapp() {
+		// if the packet is rejected later on, the error ack reverts the tracked inflow
+		err = w.checkInflow(ctx, packet, transfer)
+		if err != nil {
+			l.Info("Inflow rejected.", "rollapp", transfer.Rollapp.RollappId, "err", err)
+			return uevent.NewErrorAcknowledgement(ctx, errorsmod.Wrap(err, "delayed ack: check inflow"))
+		}
+	}
+
 	if !transfer.IsRollapp() || transfer.Finalized {
 		return w.IBCModule.OnRecvPacket(ctx, packet, relayer)
 	}
@@ -110,6 +124,13 @@ func (w IBCMiddleware) OnRecvPacket(
 		return ack
 	}
 
+	// the eIBC order is priced, and the packet charged on finalization, with the fee in effect now
+	denom := denomutils.GetIncomingTransferDenom(packet, transfer.FungibleTokenPacketData)
+	err = w.RecordPacketBridgingFee(ctx, packet, transfer.Rollapp.RollappId, denom, transfer.MustAmountInt())
+	if err != nil {
+		return uevent.NewErrorAcknowledgement(ctx, errorsmod.Wrap(err, "delayed ack: record bridging fee"))
+	}
+
 	rollappPacket := w.savePacket(ctx, packet, transfer, relayer, commontypes.RollappPacket_ON_RECV, nil)
 
 	err = w.EIBCDemandOrderHandler(ctx, rollappPacket, transfer.FungibleTokenPacketData)
@@ -120,6 +141,15 @@ func (w IBCMiddleware) OnRecvPacket(
 	return nil
 }
 
+// checkInflow rejects the transfer if the bridge of the rollapp is paused or if it goes over the inflow limit
+func (w IBCMiddleware) checkInflow(ctx sdk.Context, packet channeltypes.Packet, transfer types.TransferDataWithFinalization) error {
+	if err := w.CheckBridgeNotPaused(ctx, transfer.Rollapp.RollappId); err != nil {
+		return err
+	}
+	denom := denomutils.GetIncomingTransferDenom(packet, transfer.FungibleTokenPacketData)
+	return w.TrackInflow(ctx, transfer.Rollapp.RollappId, denom, transfer.MustAmountInt())
+}
+
 // OnAcknowledgementPacket implements the IBCMiddleware interface
 func (w IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
@@ -141,6 +171,13 @@ func (w IBCMiddleware) OnAcknowledgementPacket(
 		return err
 	}
 
+	// an ack for a height which is not finalized yet can still be reverted, the outflow is reversed on finalization
+	if transfer.IsRollapp() && transfer.Finalized && !ack.Success() {
+		if err := w.reverseOutflow(ctx, transfer); err != nil {
+			return errorsmod.Wrap(err, "reverse outflow")
+		}
+	}
+
 	if !transfer.IsRollapp() || transfer.Finalized {
 		return w.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 	}
@@ -180,6 +217,13 @@ func (w IBCMiddleware) OnTimeoutPacket(
 		return err
 	}
 
+	// a timeout for a height which is not finalized yet can still be reverted, the outflow is reversed on finalization
+	if transfer.IsRollapp() && transfer.Finalized {
+		if err := w.reverseOutflow(ctx, transfer); err != nil {
+			return errorsmod.Wrap(err, "reverse outflow")
+		}
+	}
+
 	if !transfer.IsRollapp() || transfer.Finalized {
 		return w.IBCModule.OnTimeoutPacket(ctx, packet, relayer)
 	}
@@ -199,6 +243,13 @@ func (w IBCMiddleware) OnTimeoutPacket(
 	return w.EIBCDemandOrderHandler(ctx, rollappPacket, transfer.FungibleTokenPacketData)
 }
 
+// reverseOutflow takes the refunded transfer to the rollapp back from the outflow tracked when it was sent
+func (w IBCMiddleware) reverseOutflow(ctx sdk.Context, transfer types.TransferDataWithFinalization) error {
+	// the packet denom is the full trace of the hub denom, as in SendPacket
+	denom := transfertypes.ParseDenomTrace(transfer.Denom).IBCDenom()
+	return w.ReverseOutflow(ctx, transfer.Rollapp.RollappId, denom, transfer.MustAmountInt())
+}
+
 // savePacket the packet to the store for later processing and returns it
 func (w IBCMiddleware) savePacket(ctx sdk.Context, packet channeltypes.Packet, transfer types.TransferDataWithFinalization, relayer sdk.AccAddress, packetType commontypes.RollappPacket_Type, ack []byte) commontypes.RollappPacket {
 	p := commontypes.RollappPacket{
@@ -224,3 +275,58 @@ func (w IBCMiddleware) savePacket(ctx sdk.Context, packet channeltypes.Packet, t
 
 	return p
 }
+
+// ICS4Wrapper rejects the transfers sent to a rollapp whose bridge is paused, or which go over the outflow limit.
+type ICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+
+	// the keeper is created after the wrapper
+	k             *keeper.Keeper
+	rollappKeeper types.RollappKeeper
+}
+
+// NewICS4Wrapper creates a new ICS4Wrapper
+func NewICS4Wrapper(
+	ics porttypes.ICS4Wrapper,
+	k *keeper.Keeper,
+	rollappKeeper types.RollappKeeper,
+) *ICS4Wrapper {
+	return &ICS4Wrapper{
+		ICS4Wrapper:   ics,
+		k:             k,
+		rollappKeeper: rollappKeeper,
+	}
+}
+
+// SendPacket wraps IBC ChannelKeeper's SendPacket function
+func (w *ICS4Wrapper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (sequence uint64, err error) {
+	transfer, err := w.rollappKeeper.GetValidTransfer(ctx, data, sourcePort, sourceChannel)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "get valid transfer")
+	}
+
+	if transfer.IsRollapp() {
+		// withdrawals from paused or retired rollapps are still allowed, but not deposits
+		if !transfer.Rollapp.IsActive() {
+			return 0, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "rollapp is not active: %s", transfer.Rollapp.LifecycleState)
+		}
+		if err := w.k.CheckBridgeNotPaused(ctx, transfer.Rollapp.RollappId); err != nil {
+			return 0, err
+		}
+		// the packet denom is the full trace of the hub denom
+		denom := transfertypes.ParseDenomTrace(transfer.Denom).IBCDenom()
+		if err := w.k.TrackOutflow(ctx, transfer.Rollapp.RollappId, denom, transfer.MustAmountInt()); err != nil {
+			return 0, err
+		}
+	}
+
+	return w.ICS4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
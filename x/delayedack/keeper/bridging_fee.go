@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+)
+
+// SetBridgingFeeOverride sets the override, replacing any existing one for the same rollapp and denom.
+func (k Keeper) SetBridgingFeeOverride(ctx sdk.Context, o types.BridgingFeeOverride) error {
+	return k.bridgingFeeOverrides.Set(ctx, collections.Join(o.RollappId, o.Denom), o)
+}
+
+// GetBridgingFeeOverride returns the override of the rollapp and denom, either of which may be empty.
+func (k Keeper) GetBridgingFeeOverride(ctx sdk.Context, rollappID, denom string) (types.BridgingFeeOverride, bool, error) {
+	o, err := k.bridgingFeeOverrides.Get(ctx, collections.Join(rollappID, denom))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.BridgingFeeOverride{}, false, nil
+	}
+	if err != nil {
+		return types.BridgingFeeOverride{}, false, err
+	}
+	return o, true, nil
+}
+
+func (k Keeper) RemoveBridgingFeeOverride(ctx sdk.Context, rollappID, denom string) error {
+	return k.bridgingFeeOverrides.Remove(ctx, collections.Join(rollappID, denom))
+}
+
+func (k Keeper) GetAllBridgingFeeOverrides(ctx sdk.Context) ([]types.BridgingFeeOverride, error) {
+	iter, err := k.bridgingFeeOverrides.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// checkGovOrRollappOwner returns an error unless the sender is governance or the owner of the rollapp.
+// Only governance may act on all rollapps, i.e. an empty rollapp. It returns true if the sender is governance.
+func (k Keeper) checkGovOrRollappOwner(ctx sdk.Context, sender, rollappID string) (bool, error) {
+	if sender == k.authority {
+		return true, nil
+	}
+	if rollappID == "" {
+		return false, errorsmod.Wrap(gerrc.ErrPermissionDenied, "only governance can act on all rollapps")
+	}
+	ra, ok := k.rollappKeeper.GetRollapp(ctx, rollappID)
+	if !ok {
+		return false, errorsmod.Wrapf(gerrc.ErrNotFound, "rollapp: %s", rollappID)
+	}
+	if ra.Owner != sender {
+		return false, errorsmod.Wrap(gerrc.ErrPermissionDenied, "not the rollapp owner")
+	}
+	return false, nil
+}
+
+// checkBridgingFeeOverrideSender returns an error unless the sender may change the override of the rollapp
+// and denom. Governance may change any override. The owner of a rollapp may change the overrides of its
+// rollapp, unless they were set by governance. It returns true if the sender is governance.
+func (k Keeper) checkBridgingFeeOverrideSender(ctx sdk.Context, sender, rollappID, denom string) (bool, error) {
+	gov, err := k.checkGovOrRollappOwner(ctx, sender, rollappID)
+	if err != nil || gov {
+		return gov, err
+	}
+	existing, found, err := k.GetBridgingFeeOverride(ctx, rollappID, denom)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "get bridging fee override")
+	}
+	if found && existing.Governance {
+		return false, errorsmod.Wrap(gerrc.ErrPermissionDenied, "override set by governance")
+	}
+	return false, nil
+}
+
+// bridgingFeeOverride returns the most specific override for a transfer of denom from the rollapp: the one
+// for the rollapp and denom, then the one for the rollapp, then the one for the denom.
+func (k Keeper) bridgingFeeOverride(ctx sdk.Context, rollappID, denom string) (*types.BridgingFeeOverride, error) {
+	for _, key := range [][2]string{{rollappID, denom}, {rollappID, ""}, {"", denom}} {
+		if key[0] == "" && key[1] == "" {
+			continue
+		}
+		o, ok, err := k.GetBridgingFeeOverride(ctx, key[0], key[1])
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "get bridging fee override")
+		}
+		if ok {
+			return &o, nil
+		}
+	}
+	return nil, nil
+}
+
+// EffectiveBridgingFee returns the fee multiplier for a transfer of the amount of denom from the rollapp.
+// Without an override, it is the bridging_fee param.
+func (k Keeper) EffectiveBridgingFee(ctx sdk.Context, rollappID, denom string, amt math.Int) math.LegacyDec {
+	o, err := k.bridgingFeeOverride(ctx, rollappID, denom)
+	if err != nil {
+		// the store is corrupted, charging the default fee is the safest option
+		k.Logger(ctx).Error("Bridging fee override.", "rollapp", rollappID, "denom", denom, "err", err)
+	}
+	if o == nil {
+		return k.BridgingFee(ctx)
+	}
+	return o.FeeFor(amt)
+}
+
+func packetBridgingFeeKey(packet channeltypes.Packet) collections.Triple[string, string, uint64] {
+	return collections.Join3(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+}
+
+// RecordPacketBridgingFee records the fee multiplier in effect for the transfer of the amount of denom from the
+// rollapp, received in the packet. The price of its eIBC order and the fee charged on finalization then agree,
+// whatever happens to the overrides in the meantime.
+func (k Keeper) RecordPacketBridgingFee(ctx sdk.Context, packet channeltypes.Packet, rollappID, denom string, amt math.Int) error {
+	return k.packetBridgingFees.Set(ctx, packetBridgingFeeKey(packet), k.EffectiveBridgingFee(ctx, rollappID, denom, amt))
+}
+
+// PacketBridgingFee returns the fee multiplier recorded for the received packet, or the one in effect if none
+// was recorded, e.g. for a transfer from a finalized height.
+func (k Keeper) PacketBridgingFee(ctx sdk.Context, packet channeltypes.Packet, rollappID, denom string, amt math.Int) math.LegacyDec {
+	fee, err := k.packetBridgingFees.Get(ctx, packetBridgingFeeKey(packet))
+	if err == nil {
+		return fee
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		k.Logger(ctx).Error("Packet bridging fee.", "rollapp", rollappID, "sequence", packet.GetSequence(), "err", err)
+	}
+	return k.EffectiveBridgingFee(ctx, rollappID, denom, amt)
+}
+
+func (k Keeper) deletePacketBridgingFee(ctx sdk.Context, packet channeltypes.Packet) error {
+	return k.packetBridgingFees.Remove(ctx, packetBridgingFeeKey(packet))
+}
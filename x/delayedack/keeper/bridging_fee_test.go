@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+)
+
+func (suite *DelayedAckTestSuite) TestBridgingFeeOverrides() {
+	k := suite.App.DelayedAckKeeper
+	msgServer := keeper.NewMsgServer(k, nil)
+	querier := keeper.NewQuerier(k)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	other := apptesting.CreateRandomAccounts(1)[0].String()
+	rollappID := suite.CreateDefaultRollapp()
+	denom := "ibc/ABCD"
+
+	dec := math.LegacyMustNewDecFromStr
+	effective := func(rollappID, denom string, amt int64) math.LegacyDec {
+		res, err := querier.EffectiveBridgingFee(suite.Ctx, &types.QueryEffectiveBridgingFeeRequest{
+			RollappId: rollappID,
+			Denom:     denom,
+			Amount:    math.NewInt(amt),
+		})
+		suite.Require().NoError(err)
+		return res.Fee
+	}
+	set := func(sender string, o types.BridgingFeeOverride) error {
+		_, err := msgServer.SetBridgingFeeOverride(suite.Ctx, &types.MsgSetBridgingFeeOverride{Sender: sender, Override: o})
+		return err
+	}
+
+	global := k.BridgingFee(suite.Ctx)
+	suite.Require().Equal(global, effective(rollappID, denom, 100))
+
+	// denom of all rollapps, only governance can set it
+	o := types.BridgingFeeOverride{Denom: denom, Fee: dec("0.002")}
+	err := set(apptesting.Alice, o)
+	suite.Require().True(errorsmod.IsOf(err, gerrc.ErrPermissionDenied))
+	suite.Require().NoError(set(gov, o))
+	suite.Require().Equal(dec("0.002"), effective(rollappID, denom, 100))
+	suite.Require().Equal(global, effective(rollappID, "other", 100))
+
+	// rollapp, set by the owner, with tiers, within the governance bounds
+	err = set(apptesting.Alice, types.BridgingFeeOverride{RollappId: rollappID, Fee: dec("0")})
+	suite.Require().True(errorsmod.IsOf(err, gerrc.ErrOutOfRange))
+	err = set(apptesting.Alice, types.BridgingFeeOverride{
+		RollappId: rollappID,
+		Fee:       dec("0.003"),
+		Tiers:     []types.BridgingFeeTier{{MinAmount: math.NewInt(1000), Fee: dec("0.5")}},
+	})
+	suite.Require().True(errorsmod.IsOf(err, gerrc.ErrOutOfRange))
+	err = set(other, types.BridgingFeeOverride{RollappId: rollappID, Fee: dec("0.003")})
+	suite.Require().True(errorsmod.IsOf(err, gerrc.ErrPermissionDenied))
+	suite.Require().NoError(set(apptesting.Alice, types.BridgingFeeOverride{
+		RollappId: rollappID,
+		Fee:       dec("0.003"),
+		Tiers: []types.BridgingFeeTier{
+			{MinAmount: math.NewInt(1000), Fee: dec("0.0025")},
+			{MinAmount: math.NewInt(10000), Fee: dec("0.001")},
+		},
+	}))
+	suite.Require().Equal(dec("0.003"), effective(rollappID, denom, 999))
+	suite.Require().Equal(dec("0.0025"), effective(rollappID, denom, 1000))
+	suite.Require().Equal(dec("0.001"), effective(rollappID, denom, 20000))
+	suite.Require().Equal(math.NewInt(20), k.BridgingFeeFromAmt(suite.Ctx, rollappID, denom, math.NewInt(20000)))
+
+	// rollapp and denom, set by governance: the owner can no longer change it
+	suite.Require().NoError(set(gov, types.BridgingFeeOverride{RollappId: rollappID, Denom: denom, Fee: dec("0.004")}))
+	suite.Require().Equal(dec("0.004"), effective(rollappID, denom, 20000))
+	suite.Require().Equal(dec("0.001"), effective(rollappID, "other", 20000))
+	err = set(apptesting.Alice, types.BridgingFeeOverride{RollappId: rollappID, Denom: denom, Fee: dec("0")})
+	suite.Require().True(errorsmod.IsOf(err, gerrc.ErrPermissionDenied))
+	_, err = msgServer.RemoveBridgingFeeOverride(suite.Ctx, &types.MsgRemoveBridgingFeeOverride{Sender: apptesting.Alice, RollappId: rollappID, Denom: denom})
+	suite.Require().True(errorsmod.IsOf(err, gerrc.ErrPermissionDenied))
+
+	res, err := querier.BridgingFeeOverrides(suite.Ctx, &types.QueryBridgingFeeOverridesRequest{RollappId: rollappID})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Overrides, 2)
+
+	_, err = msgServer.RemoveBridgingFeeOverride(suite.Ctx, &types.MsgRemoveBridgingFeeOverride{Sender: gov, RollappId: rollappID, Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(dec("0.001"), effective(rollappID, denom, 20000))
+}
+
+func (suite *DelayedAckTestSuite) TestPacketBridgingFee() {
+	k := suite.App.DelayedAckKeeper
+	rollappID := suite.CreateDefaultRollapp()
+	denom := "ibc/ABCD"
+	amt := math.NewInt(10000)
+	packet := channeltypes.Packet{DestinationPort: "transfer", DestinationChannel: "channel-0", Sequence: 1}
+	other := channeltypes.Packet{DestinationPort: "transfer", DestinationChannel: "channel-0", Sequence: 2}
+
+	suite.Require().NoError(k.SetBridgingFeeOverride(suite.Ctx, types.BridgingFeeOverride{RollappId: rollappID, Fee: math.LegacyMustNewDecFromStr("0.002")}))
+	suite.Require().NoError(k.RecordPacketBridgingFee(suite.Ctx, packet, rollappID, denom, amt))
+
+	// the override changes while the packet is pending: the packet keeps the fee it was received with
+	suite.Require().NoError(k.SetBridgingFeeOverride(suite.Ctx, types.BridgingFeeOverride{RollappId: rollappID, Fee: math.LegacyMustNewDecFromStr("0.005")}))
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("0.002"), k.PacketBridgingFee(suite.Ctx, packet, rollappID, denom, amt))
+	suite.Require().Equal(math.NewInt(20), k.PacketBridgingFeeFromAmt(suite.Ctx, packet, rollappID, denom, amt))
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("0.005"), k.PacketBridgingFee(suite.Ctx, other, rollappID, denom, amt))
+}
@@ -5,6 +5,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	denomutils "github.com/dymensionxyz/dymension/v3/utils/denom"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	eibctypes "github.com/dymensionxyz/dymension/v3/x/eibc/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
@@ -70,6 +71,12 @@ func (k Keeper) finalizeOnRecv(ctx sdk.Context, ibc porttypes.IBCModule, p *comm
 		if err != nil {
 			return err
 		}
+		// the transfer is refunded on the rollapp, so it no longer counts towards the inflow
+		if !ack.Success() {
+			if err := k.reversePacketFlow(ctx, *p); err != nil {
+				return errorsmod.Wrap(err, "reverse refunded inflow")
+			}
+		}
 	}
 
 	return k.finalizeCompletionHook(ctx, p)
@@ -120,6 +127,7 @@ func (k Keeper) finalizeCompletionHook(ctx sdk.Context, p *commontypes.RollappPa
 	}
 	amt := pTransfer.MustAmountInt()
 	// account for the bridge fee which happened before the receiver got the funds
-	amt = amt.Sub(k.BridgingFeeFromAmt(ctx, amt))
+	denom := denomutils.GetIncomingTransferDenom(*p.Packet, pTransfer.FungibleTokenPacketData)
+	amt = amt.Sub(k.PacketBridgingFeeFromAmt(ctx, *p.Packet, p.RollappId, denom, amt))
 	return k.RunOrderCompletionHook(ctx, o, amt)
 }
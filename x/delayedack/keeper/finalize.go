@@ -11,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 )
 
 func (k Keeper) FinalizeRollappPacket(ctx sdk.Context, ibc porttypes.IBCModule, rollappPacketKey string) (*commontypes.RollappPacket, error) {
@@ -32,6 +33,53 @@ func (k Keeper) FinalizeRollappPacket(ctx sdk.Context, ibc porttypes.IBCModule,
 	return packet, nil
 }
 
+// FinalizableRollappPacketKeys returns the keys of the pending packets of the rollapp with a proof height in
+// the range, capped at the latest finalized height. At most limit+1 keys are returned, so that the caller
+// can tell whether there are more.
+func (k Keeper) FinalizableRollappPacketKeys(ctx sdk.Context, rollappID string, minHeight, maxHeight uint64, limit int) ([]string, error) {
+	latest, err := k.getRollappLatestFinalizedHeight(ctx, rollappID)
+	if err != nil {
+		return nil, err
+	}
+	if maxHeight == 0 || latest < maxHeight {
+		maxHeight = latest
+	}
+	if maxHeight < minHeight {
+		return nil, nil
+	}
+	filter := types.PendingByRollappIDByHeightRange(rollappID, minHeight, maxHeight).Take(limit + 1)
+	var keys []string
+	for _, p := range k.ListRollappPackets(ctx, filter) {
+		keys = append(keys, string(p.RollappPacketKey()))
+	}
+	return keys, nil
+}
+
+// FinalizeRollappPackets finalizes the packets one by one. A packet which fails to finalize leaves no state
+// change and its error is reported in its result. It stops before a packet when the gas left falls below
+// types.FinalizePacketGasReserve, and then reports truncated.
+func (k Keeper) FinalizeRollappPackets(ctx sdk.Context, ibc porttypes.IBCModule, keys []string) (results []types.FinalizePacketResult, finalized []*commontypes.RollappPacket, truncated bool) {
+	for _, key := range keys {
+		if ctx.GasMeter().GasRemaining() < types.FinalizePacketGasReserve {
+			return results, finalized, true
+		}
+		res := types.FinalizePacketResult{PacketKey: commontypes.EncodePacketKey([]byte(key))}
+		var p *commontypes.RollappPacket
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			var err error
+			p, err = k.FinalizeRollappPacket(ctx, ibc, key)
+			return err
+		})
+		if err != nil {
+			res.Error = err.Error()
+		} else {
+			finalized = append(finalized, p)
+		}
+		results = append(results, res)
+	}
+	return results, finalized, false
+}
+
 // used with osmo helper
 type wrappedFunc func(ctx sdk.Context) error
 
@@ -66,14 +114,33 @@ func (k Keeper) finalizeRollappPacket(
 		//  to cause the delivery transaction to be rejected.
 		//  Here, we already accepted the original msg delivery transaction, we can't retroactively reject it.
 		rollappPacket.Error = packetErr.Error()
+	} else if err := k.reverseRefundedOutflow(ctx, rollappPacket); err != nil {
+		return fmt.Errorf("reverse refunded outflow: %w", err)
+	}
+
+	// The order is looked up by the pending packet key, so it must happen before the status update.
+	orderID, fulfillers, err := k.packetFulfillment(ctx, &rollappPacket)
+	if err != nil {
+		return fmt.Errorf("packet fulfillment: %w", err)
+	}
+
+	// Pay out the fulfillers of a partially fulfilled order. The order is looked up by the pending packet
+	// key, so it must happen before the status update.
+	if err := k.SettlePartialFulfillments(ctx, &rollappPacket); err != nil {
+		return fmt.Errorf("settle partial fulfillments: %w", err)
 	}
 
 	// Update status to finalized
-	_, err := k.UpdateRollappPacketAfterFinalization(ctx, rollappPacket)
+	finalized, err := k.UpdateRollappPacketAfterFinalization(ctx, rollappPacket)
 	if err != nil {
 		return fmt.Errorf("update rollapp packet: %w", err)
 	}
 
+	err = k.recordSettledPacket(ctx, finalized, types.SettlementStatus_SETTLEMENT_FINALIZED, orderID, fulfillers)
+	if err != nil {
+		return fmt.Errorf("record settled packet: %w", err)
+	}
+
 	logger.Debug("finalized IBC rollapp packet")
 
 	return nil
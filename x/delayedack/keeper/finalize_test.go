@@ -194,3 +194,72 @@ func (s *DelayedAckTestSuite) TestFinalizePacketByPacketKey() {
 		})
 	}
 }
+
+func (s *DelayedAckTestSuite) TestFinalizePackets() {
+	rollapp := "rollapp_1234-1"
+	s.CreateRollappByName(rollapp)
+	proposer := s.CreateDefaultSequencer(s.Ctx, rollapp)
+	s.App.RollappKeeper.SetStateInfo(s.Ctx, rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{RollappId: rollapp, Index: 1},
+		StartHeight:    1,
+		NumBlocks:      10,
+		Status:         commontypes.Status_FINALIZED,
+		Sequencer:      proposer,
+	})
+	s.App.RollappKeeper.SetLatestFinalizedStateIndex(s.Ctx, rollapptypes.StateInfoIndex{RollappId: rollapp, Index: 1})
+
+	var packets []commontypes.RollappPacket
+	for i, height := range []uint64{3, 5, 8, 9, 15} {
+		p := commontypes.RollappPacket{
+			RollappId:   rollapp,
+			Status:      commontypes.Status_PENDING,
+			ProofHeight: height,
+			Packet:      apptesting.GenerateTestPacket(s.T(), uint64(i+1)),
+		}
+		s.App.DelayedAckKeeper.SetRollappPacket(s.Ctx, p)
+		packets = append(packets, p)
+	}
+	isFinalized := func(p commontypes.RollappPacket) bool {
+		p.Status = commontypes.Status_FINALIZED
+		_, err := s.App.DelayedAckKeeper.GetRollappPacket(s.Ctx, string(p.RollappPacketKey()))
+		return err == nil
+	}
+	finalize := func(msg *types.MsgFinalizePackets) *types.MsgFinalizePacketsResponse {
+		msg.Sender = apptesting.CreateRandomAccounts(1)[0].String()
+		handler := s.App.MsgServiceRouter().Handler(msg)
+		resp, err := handler(s.Ctx, msg)
+		s.Require().NoError(err)
+		var res types.MsgFinalizePacketsResponse
+		s.Require().NoError(res.Unmarshal(resp.MsgResponses[0].Value))
+		return &res
+	}
+
+	// by height range, up to the limit
+	res := finalize(&types.MsgFinalizePackets{RollappId: rollapp, MinProofHeight: 4, Limit: 1})
+	s.Require().Equal(uint32(1), res.Finalized)
+	s.Require().True(res.Truncated)
+	s.Require().True(isFinalized(packets[1]))
+	s.Require().False(isFinalized(packets[2]))
+
+	// the range is capped at the latest finalized height
+	res = finalize(&types.MsgFinalizePackets{RollappId: rollapp, MinProofHeight: 4})
+	s.Require().Equal(uint32(2), res.Finalized)
+	s.Require().False(res.Truncated)
+	s.Require().True(isFinalized(packets[2]))
+	s.Require().True(isFinalized(packets[3]))
+	s.Require().False(isFinalized(packets[4]))
+
+	// by keys, a bad packet does not fail the others
+	res = finalize(&types.MsgFinalizePackets{PacketKeys: []string{
+		commontypes.EncodePacketKey(packets[4].RollappPacketKey()),
+		commontypes.EncodePacketKey(packets[0].RollappPacketKey()),
+		commontypes.EncodePacketKey(packets[1].RollappPacketKey()),
+	}})
+	s.Require().Equal(uint32(1), res.Finalized)
+	s.Require().Len(res.Results, 3)
+	s.Require().Contains(res.Results[0].Error, "verify height")
+	s.Require().Empty(res.Results[1].Error)
+	s.Require().Contains(res.Results[2].Error, "get rollapp packet")
+	s.Require().True(isFinalized(packets[0]))
+	s.Require().False(isFinalized(packets[4]))
+}
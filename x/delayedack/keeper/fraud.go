@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,6 +40,19 @@ func (k Keeper) OnHardFork(ctx sdk.Context, rollappID string, lastValidHeight ui
 			// for incoming packets, we need to reset the packet receipt
 			ibcPacket := rollappPacket.Packet
 			k.deletePacketReceipt(ctx, ibcPacket.GetDestPort(), ibcPacket.GetDestChannel(), ibcPacket.GetSequence())
+			// the transfer will be received again over the new rollapp revision
+			if err := k.reversePacketFlow(ctx, rollappPacket); err != nil {
+				return errorsmod.Wrap(err, "reverse inflow")
+			}
+		}
+
+		orderID, fulfillers, err := k.packetFulfillment(ctx, &rollappPacket)
+		if err != nil {
+			return errorsmod.Wrap(err, "packet fulfillment")
+		}
+		err = k.recordSettledPacket(ctx, rollappPacket, types.SettlementStatus_SETTLEMENT_REVERTED, orderID, fulfillers)
+		if err != nil {
+			return errorsmod.Wrap(err, "record settled packet")
 		}
 
 		// delete the packet
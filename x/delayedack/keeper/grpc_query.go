@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 
@@ -72,3 +74,130 @@ func (q Querier) GetPendingPacketsByAddress(goCtx context.Context, req *types.Qu
 		Pagination:     pageResp,
 	}, nil
 }
+
+func (q Querier) EffectiveBridgingFee(goCtx context.Context, req *types.QueryEffectiveBridgingFeeRequest) (*types.QueryEffectiveBridgingFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Amount.IsNil() || req.Amount.IsNegative() {
+		return nil, status.Error(codes.InvalidArgument, "amount must not be negative")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	o, err := q.bridgingFeeOverride(ctx, req.RollappId, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	fee := q.Keeper.EffectiveBridgingFee(ctx, req.RollappId, req.Denom, req.Amount)
+	return &types.QueryEffectiveBridgingFeeResponse{
+		Fee:       fee,
+		FeeAmount: fee.MulInt(req.Amount).TruncateInt(),
+		Override:  o,
+	}, nil
+}
+
+func (q Querier) BridgingFeeOverrides(goCtx context.Context, req *types.QueryBridgingFeeOverridesRequest) (*types.QueryBridgingFeeOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[string, string]])
+	if req.RollappId != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, string](req.RollappId))
+	}
+	overrides, pageResp, err := query.CollectionPaginate(ctx, q.bridgingFeeOverrides, req.Pagination,
+		func(_ collections.Pair[string, string], o types.BridgingFeeOverride) (types.BridgingFeeOverride, error) {
+			return o, nil
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryBridgingFeeOverridesResponse{Overrides: overrides, Pagination: pageResp}, nil
+}
+
+func (q Querier) RateLimitQuota(goCtx context.Context, req *types.QueryRateLimitQuotaRequest) (*types.QueryRateLimitQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	l, inflow, outflow, err := q.GetRateLimitQuota(ctx, req.RollappId, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &types.QueryRateLimitQuotaResponse{
+		RateLimit:        l,
+		RemainingInflow:  inflow,
+		RemainingOutflow: outflow,
+	}
+	p, paused, err := q.GetBridgePause(ctx, req.RollappId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if paused {
+		res.Pause = &p
+	}
+	return res, nil
+}
+
+func (q Querier) RateLimits(goCtx context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[string, string]])
+	if req.RollappId != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, string](req.RollappId))
+	}
+	limits, pageResp, err := query.CollectionPaginate(ctx, q.rateLimits, req.Pagination,
+		func(_ collections.Pair[string, string], l types.RateLimit) (types.RateLimit, error) {
+			return l, nil
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryRateLimitsResponse{RateLimits: limits, Pagination: pageResp}, nil
+}
+
+func (q Querier) SettledPacketReceipt(goCtx context.Context, req *types.QuerySettledPacketReceiptRequest) (*types.QuerySettledPacketReceiptResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	r, found, err := q.GetSettledPacketReceipt(ctx, req.RollappId, req.Type, req.SrcChannel, req.Sequence)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "settled packet receipt not found")
+	}
+	return &types.QuerySettledPacketReceiptResponse{Receipt: r}, nil
+}
+
+func (q Querier) SettledPacketReceipts(goCtx context.Context, req *types.QuerySettledPacketReceiptsRequest) (*types.QuerySettledPacketReceiptsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.RollappId == "" {
+		return nil, status.Error(codes.InvalidArgument, "rollapp id must be non-empty")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	receipts, pageResp, err := query.CollectionPaginate(ctx, q.receipts, req.Pagination,
+		func(_ receiptKey, r types.SettledPacketReceipt) (types.SettledPacketReceipt, error) {
+			return r, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, collections.Triple[int32, string, uint64]](req.RollappId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySettledPacketReceiptsResponse{Receipts: receipts, Pagination: pageResp}, nil
+}
@@ -97,5 +97,11 @@ func (e epochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epoch
 			break
 		}
 	}
+
+	pruned, err := e.PruneSettledPacketReceipts(ctx, int(params.DeletePacketsEpochLimit))
+	if err != nil {
+		return errorsmod.Wrap(err, "prune settled packet receipts")
+	}
+	e.Logger(ctx).Debug("Pruned settled packet receipts", "num_receipts", pruned)
 	return nil
 }
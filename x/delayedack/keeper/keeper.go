@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/collections"
 	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -32,6 +33,29 @@ type Keeper struct {
 	// Index key: receiver address + packet key.
 	pendingPacketsByAddress collections.KeySet[collections.Pair[string, []byte]]
 
+	// bridgingFeeOverrides replace the bridging_fee param for a rollapp, a denom, or a denom of a rollapp.
+	// Key: rollapp id (or empty) + denom (or empty).
+	bridgingFeeOverrides collections.Map[collections.Pair[string, string], types.BridgingFeeOverride]
+
+	// packetBridgingFees are the bridging fee multipliers in effect when the pending packets from rollapps
+	// were received. Key: hub port + hub channel + packet sequence.
+	packetBridgingFees collections.Map[collections.Triple[string, string, uint64], math.LegacyDec]
+
+	// rateLimits cap the flow of a denom to and from a rollapp, or to and from each rollapp.
+	// Key: rollapp id (or empty) + denom.
+	rateLimits collections.Map[collections.Pair[string, string], types.RateLimit]
+
+	// rateLimitFlows track the flow of a denom to and from a rollapp, for the denoms with a limit.
+	// Key: rollapp id + denom.
+	rateLimitFlows collections.Map[collections.Pair[string, string], types.RateLimitFlow]
+
+	// bridgePauses are the rollapps whose bridge is paused. Key: rollapp id.
+	bridgePauses collections.Map[string, types.BridgePause]
+
+	// receipts record how the packets were settled, after they are deleted.
+	// Key: rollapp id + (packet type, packet src channel, packet sequence).
+	receipts *collections.IndexedMap[receiptKey, types.SettledPacketReceipt, receiptIndexes]
+
 	rollappKeeper types.RollappKeeper
 	porttypes.ICS4Wrapper
 	channelKeeper types.ChannelKeeper
@@ -51,17 +75,54 @@ func NewKeeper(
 	channelKeeper types.ChannelKeeper,
 	eibcKeeper types.EIBCKeeper,
 ) *Keeper {
+	sb := collections.NewSchemaBuilder(collcompat.NewKVStoreService(storeKey))
 	return &Keeper{
 		cdc:                   cdc,
 		storeKey:              storeKey,
 		channelKeeperStoreKey: channelKeeperStoreKey,
 		authority:             authority,
 		pendingPacketsByAddress: collections.NewKeySet(
-			collections.NewSchemaBuilder(collcompat.NewKVStoreService(storeKey)),
+			sb,
 			collections.NewPrefix(types.PendingPacketsByAddressKeyPrefix),
 			"pending_packets_by_receiver",
 			collections.PairKeyCodec(collections.StringKey, collcodec.NewBytesKey[[]byte]()),
 		),
+		bridgingFeeOverrides: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.BridgingFeeOverridesKeyPrefix),
+			"bridging_fee_overrides",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.BridgingFeeOverride](cdc),
+		),
+		packetBridgingFees: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.PacketBridgingFeesKeyPrefix),
+			"packet_bridging_fees",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key),
+			sdk.LegacyDecValue,
+		),
+		rateLimits: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.RateLimitsKeyPrefix),
+			"rate_limits",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.RateLimit](cdc),
+		),
+		rateLimitFlows: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.RateLimitFlowsKeyPrefix),
+			"rate_limit_flows",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.RateLimitFlow](cdc),
+		),
+		bridgePauses: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.BridgePausesKeyPrefix),
+			"bridge_pauses",
+			collections.StringKey,
+			codec.CollValue[types.BridgePause](cdc),
+		),
+		receipts:        makeReceipts(sb, cdc),
 		rollappKeeper:   rollappKeeper,
 		ICS4Wrapper:     ics4Wrapper,
 		channelKeeper:   channelKeeper,
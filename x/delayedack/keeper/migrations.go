@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	k Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{k: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// It sets the defaults of the receipt retention and of the range of the owner bridging fees.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.k.GetParams(ctx)
+	defaults := types.DefaultParams()
+	params.ReceiptRetentionBlocks = defaults.ReceiptRetentionBlocks
+	params.MinOwnerBridgingFee = defaults.MinOwnerBridgingFee
+	params.MaxOwnerBridgingFee = defaults.MaxOwnerBridgingFee
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+	m.k.SetParams(ctx, params)
+	return nil
+}
@@ -8,8 +8,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 )
 
@@ -115,3 +117,226 @@ func (m MsgServer) FinalizePacketByPacketKey(goCtx context.Context, msg *types.M
 
 	return &types.MsgFinalizePacketByPacketKeyResponse{}, nil
 }
+
+// FinalizePackets finalizes the packets given by key, or else the eligible packets of the rollapp in the height
+// range. The packets which fail to finalize are reported in the response and do not fail the message.
+func (m MsgServer) FinalizePackets(goCtx context.Context, msg *types.MsgFinalizePackets) (*types.MsgFinalizePacketsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		keys      []string
+		truncated bool
+	)
+	limit := msg.BatchLimit()
+	if len(msg.PacketKeys) != 0 {
+		for _, k := range msg.PacketKeys {
+			key, _ := commontypes.DecodePacketKey(k) // guaranteed ok by above validation
+			keys = append(keys, string(key))
+		}
+	} else {
+		var err error
+		keys, err = m.k.FinalizableRollappPacketKeys(ctx, msg.RollappId, msg.MinProofHeight, msg.MaxProofHeight, limit)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "finalizable rollapp packet keys")
+		}
+	}
+	if limit < len(keys) {
+		keys = keys[:limit]
+		truncated = true
+	}
+
+	// next middleware is denommetadata, see transfer stack setup
+	results, finalized, outOfGas := m.k.FinalizeRollappPackets(ctx, m.ibc.NextIBCMiddleware(), keys)
+
+	for _, packet := range finalized {
+		err := uevent.EmitTypedEvent(ctx, &types.EventFinalizePacket{
+			Sender:            msg.Sender,
+			RollappId:         packet.RollappId,
+			PacketProofHeight: packet.ProofHeight,
+			PacketType:        packet.Type,
+			PacketSrcChannel:  packet.Packet.SourceChannel,
+			PacketSequence:    packet.Packet.Sequence,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("emit event: %w", err)
+		}
+	}
+
+	return &types.MsgFinalizePacketsResponse{
+		Results:   results,
+		Finalized: uint32(len(finalized)), //nolint:gosec // bounded by the batch size
+		Truncated: truncated || outOfGas,
+	}, nil
+}
+
+// SetBridgingFeeOverride sets an override of the bridging fee, see checkBridgingFeeOverrideSender.
+func (m MsgServer) SetBridgingFeeOverride(goCtx context.Context, msg *types.MsgSetBridgingFeeOverride) (*types.MsgSetBridgingFeeOverrideResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	o := msg.Override
+	gov, err := m.k.checkBridgingFeeOverrideSender(ctx, msg.Sender, o.RollappId, o.Denom)
+	if err != nil {
+		return nil, err
+	}
+	o.Governance = gov
+	if !gov {
+		params := m.k.GetParams(ctx)
+		if !o.FeesWithin(params.MinOwnerBridgingFee, params.MaxOwnerBridgingFee) {
+			return nil, errorsmod.Wrapf(gerrc.ErrOutOfRange, "owner bridging fees must be in [%s, %s]",
+				params.MinOwnerBridgingFee, params.MaxOwnerBridgingFee)
+		}
+	}
+	if err := m.k.SetBridgingFeeOverride(ctx, o); err != nil {
+		return nil, errorsmod.Wrap(err, "set bridging fee override")
+	}
+
+	err = uevent.EmitTypedEvent(ctx, &types.EventSetBridgingFeeOverride{
+		Sender:   msg.Sender,
+		Override: o,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+	return &types.MsgSetBridgingFeeOverrideResponse{}, nil
+}
+
+// RemoveBridgingFeeOverride removes an override of the bridging fee, see checkBridgingFeeOverrideSender.
+func (m MsgServer) RemoveBridgingFeeOverride(goCtx context.Context, msg *types.MsgRemoveBridgingFeeOverride) (*types.MsgRemoveBridgingFeeOverrideResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found, err := m.k.GetBridgingFeeOverride(ctx, msg.RollappId, msg.Denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get bridging fee override")
+	}
+	if !found {
+		return nil, gerrc.ErrNotFound.Wrap("bridging fee override")
+	}
+	if _, err := m.k.checkBridgingFeeOverrideSender(ctx, msg.Sender, msg.RollappId, msg.Denom); err != nil {
+		return nil, err
+	}
+	if err := m.k.RemoveBridgingFeeOverride(ctx, msg.RollappId, msg.Denom); err != nil {
+		return nil, errorsmod.Wrap(err, "remove bridging fee override")
+	}
+
+	err = uevent.EmitTypedEvent(ctx, &types.EventRemoveBridgingFeeOverride{
+		Sender:    msg.Sender,
+		RollappId: msg.RollappId,
+		Denom:     msg.Denom,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+	return &types.MsgRemoveBridgingFeeOverrideResponse{}, nil
+}
+
+// SetRateLimit sets a limit on the flow of a denom to and from a rollapp, see checkRateLimitSender.
+func (m MsgServer) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	l := msg.RateLimit
+	gov, err := m.k.checkRateLimitSender(ctx, msg.Sender, l.RollappId, l.Denom)
+	if err != nil {
+		return nil, err
+	}
+	l.Governance = gov
+	if err := m.k.SetRateLimit(ctx, l); err != nil {
+		return nil, errorsmod.Wrap(err, "set rate limit")
+	}
+
+	err = uevent.EmitTypedEvent(ctx, &types.EventSetRateLimit{
+		Sender:    msg.Sender,
+		RateLimit: l,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit removes a limit on the flow of a denom, see checkRateLimitSender.
+func (m MsgServer) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found, err := m.k.GetRateLimit(ctx, msg.RollappId, msg.Denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get rate limit")
+	}
+	if !found {
+		return nil, gerrc.ErrNotFound.Wrap("rate limit")
+	}
+	if _, err := m.k.checkRateLimitSender(ctx, msg.Sender, msg.RollappId, msg.Denom); err != nil {
+		return nil, err
+	}
+	if err := m.k.RemoveRateLimit(ctx, msg.RollappId, msg.Denom); err != nil {
+		return nil, errorsmod.Wrap(err, "remove rate limit")
+	}
+
+	err = uevent.EmitTypedEvent(ctx, &types.EventRemoveRateLimit{
+		Sender:    msg.Sender,
+		RollappId: msg.RollappId,
+		Denom:     msg.Denom,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// SetBridgePaused pauses or resumes the bridge of a rollapp, see checkBridgePauseSender.
+func (m MsgServer) SetBridgePaused(goCtx context.Context, msg *types.MsgSetBridgePaused) (*types.MsgSetBridgePausedResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	gov, err := m.k.checkBridgePauseSender(ctx, msg.Sender, msg.RollappId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !msg.Paused {
+		if err := m.k.ResumeBridge(ctx, msg.RollappId); err != nil {
+			return nil, errorsmod.Wrap(err, "resume bridge")
+		}
+		err = uevent.EmitTypedEvent(ctx, &types.EventBridgeResumed{
+			Sender:    msg.Sender,
+			RollappId: msg.RollappId,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("emit event: %w", err)
+		}
+		return &types.MsgSetBridgePausedResponse{}, nil
+	}
+
+	p := types.BridgePause{
+		RollappId:  msg.RollappId,
+		Governance: gov,
+		Reason:     msg.Reason,
+	}
+	if err := m.k.PauseBridge(ctx, p); err != nil {
+		return nil, errorsmod.Wrap(err, "pause bridge")
+	}
+	err = uevent.EmitTypedEvent(ctx, &types.EventBridgePaused{
+		Sender: msg.Sender,
+		Pause:  p,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+	return &types.MsgSetBridgePausedResponse{}, nil
+}
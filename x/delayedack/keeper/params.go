@@ -3,6 +3,7 @@ package keeper
 import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 )
@@ -30,8 +31,17 @@ func (k Keeper) BridgingFee(ctx sdk.Context) (res math.LegacyDec) {
 	return k.GetParams(ctx).BridgingFee
 }
 
-func (k Keeper) BridgingFeeFromAmt(ctx sdk.Context, transferAmt math.Int) (res math.Int) {
-	feeMul := k.BridgingFee(ctx)
+// BridgingFeeFromAmt returns the bridging fee currently in effect on a transfer of the amount of denom from the
+// rollapp.
+func (k Keeper) BridgingFeeFromAmt(ctx sdk.Context, rollappID, denom string, transferAmt math.Int) (res math.Int) {
+	feeMul := k.EffectiveBridgingFee(ctx, rollappID, denom, transferAmt)
+	return feeMul.MulInt(transferAmt).TruncateInt()
+}
+
+// PacketBridgingFeeFromAmt returns the bridging fee charged on the transfer of the amount of denom from the
+// rollapp, received in the packet, see PacketBridgingFee.
+func (k Keeper) PacketBridgingFeeFromAmt(ctx sdk.Context, packet channeltypes.Packet, rollappID, denom string, transferAmt math.Int) (res math.Int) {
+	feeMul := k.PacketBridgingFee(ctx, packet, rollappID, denom, transferAmt)
 	return feeMul.MulInt(transferAmt).TruncateInt()
 }
 
@@ -3,9 +3,11 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	testkeeper "github.com/dymensionxyz/dymension/v3/testutil/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 )
 
@@ -17,3 +19,20 @@ func TestGetParams(t *testing.T) {
 
 	require.EqualValues(t, params, k.GetParams(ctx))
 }
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx := testkeeper.DelayedackKeeper(t)
+	// the params of version 1 do not have the owner bridging fees
+	v1 := types.NewParams("hour", math.LegacyNewDecWithPrec(1, 3), 100)
+	k.SetParams(ctx, v1)
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+
+	params := k.GetParams(ctx)
+	require.NoError(t, params.ValidateBasic())
+	require.Equal(t, v1.BridgingFee, params.BridgingFee)
+	require.Equal(t, v1.DeletePacketsEpochLimit, params.DeletePacketsEpochLimit)
+	require.Equal(t, types.DefaultParams().ReceiptRetentionBlocks, params.ReceiptRetentionBlocks)
+	require.True(t, types.DefaultMinOwnerBridgingFee.Equal(params.MinOwnerBridgingFee))
+	require.True(t, types.DefaultMaxOwnerBridgingFee.Equal(params.MaxOwnerBridgingFee))
+}
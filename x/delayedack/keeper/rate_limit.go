@@ -0,0 +1,288 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	denomutils "github.com/dymensionxyz/dymension/v3/utils/denom"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+)
+
+// SetRateLimit sets the limit, replacing any existing one for the same rollapp and denom.
+func (k Keeper) SetRateLimit(ctx sdk.Context, l types.RateLimit) error {
+	return k.rateLimits.Set(ctx, collections.Join(l.RollappId, l.Denom), l)
+}
+
+// GetRateLimit returns the limit of the rollapp and denom. The rollapp may be empty.
+func (k Keeper) GetRateLimit(ctx sdk.Context, rollappID, denom string) (types.RateLimit, bool, error) {
+	l, err := k.rateLimits.Get(ctx, collections.Join(rollappID, denom))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RateLimit{}, false, nil
+	}
+	if err != nil {
+		return types.RateLimit{}, false, err
+	}
+	return l, true, nil
+}
+
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, rollappID, denom string) error {
+	return k.rateLimits.Remove(ctx, collections.Join(rollappID, denom))
+}
+
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) ([]types.RateLimit, error) {
+	iter, err := k.rateLimits.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// checkRateLimitSender returns an error unless the sender may change the limit of the rollapp and denom.
+// The rules are the same as for the bridging fee overrides. It returns true if the sender is governance.
+func (k Keeper) checkRateLimitSender(ctx sdk.Context, sender, rollappID, denom string) (bool, error) {
+	gov, err := k.checkGovOrRollappOwner(ctx, sender, rollappID)
+	if err != nil || gov {
+		return gov, err
+	}
+	existing, found, err := k.GetRateLimit(ctx, rollappID, denom)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "get rate limit")
+	}
+	if found && existing.Governance {
+		return false, errorsmod.Wrap(gerrc.ErrPermissionDenied, "limit set by governance")
+	}
+	return false, nil
+}
+
+// rateLimit returns the limit for transfers of denom to and from the rollapp: the one for the rollapp and
+// denom, then the one for the denom.
+func (k Keeper) rateLimit(ctx sdk.Context, rollappID, denom string) (*types.RateLimit, error) {
+	for _, r := range []string{rollappID, ""} {
+		l, ok, err := k.GetRateLimit(ctx, r, denom)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "get rate limit")
+		}
+		if ok {
+			return &l, nil
+		}
+	}
+	return nil, nil
+}
+
+// rateLimitFlow returns the flow of denom between the hub and the rollapp, moved to the current window.
+func (k Keeper) rateLimitFlow(ctx sdk.Context, rollappID, denom string, l types.RateLimit) (types.RateLimitFlow, error) {
+	f, err := k.rateLimitFlows.Get(ctx, collections.Join(rollappID, denom))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.RateLimitFlow{}, err
+	}
+	return f.Roll(ctx.BlockTime(), l.Window), nil
+}
+
+// GetRateLimitQuota returns the limit which applies to the transfers of denom to and from the rollapp, if any,
+// and the amounts which can still flow in and out within the window. A nil remaining amount means no limit.
+func (k Keeper) GetRateLimitQuota(ctx sdk.Context, rollappID, denom string) (l *types.RateLimit, inflow, outflow *math.Int, err error) {
+	l, err = k.rateLimit(ctx, rollappID, denom)
+	if err != nil || l == nil {
+		return nil, nil, nil, err
+	}
+	f, err := k.rateLimitFlow(ctx, rollappID, denom, *l)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "get rate limit flow")
+	}
+	now := ctx.BlockTime()
+	if l.MaxInflow.IsPositive() {
+		x := math.MaxInt(l.MaxInflow.Sub(f.UsedInflow(now, l.Window)), math.ZeroInt())
+		inflow = &x
+	}
+	if l.MaxOutflow.IsPositive() {
+		x := math.MaxInt(l.MaxOutflow.Sub(f.UsedOutflow(now, l.Window)), math.ZeroInt())
+		outflow = &x
+	}
+	return l, inflow, outflow, nil
+}
+
+// TrackInflow adds the amount of denom received from the rollapp to the flow of the window. It returns
+// an error if this goes over the limit.
+func (k Keeper) TrackInflow(ctx sdk.Context, rollappID, denom string, amt math.Int) error {
+	return k.trackFlow(ctx, rollappID, denom, amt, true)
+}
+
+// TrackOutflow adds the amount of denom sent to the rollapp to the flow of the window. It returns
+// an error if this goes over the limit.
+func (k Keeper) TrackOutflow(ctx sdk.Context, rollappID, denom string, amt math.Int) error {
+	return k.trackFlow(ctx, rollappID, denom, amt, false)
+}
+
+// ReverseInflow takes back the amount of denom received from the rollapp from the flow, when the transfer is
+// reverted by a hard fork or refunded with an error acknowledgement on finalization. The amount is taken from
+// the current window first, then from the previous one.
+func (k Keeper) ReverseInflow(ctx sdk.Context, rollappID, denom string, amt math.Int) error {
+	return k.reverseFlow(ctx, rollappID, denom, amt, true)
+}
+
+// ReverseOutflow takes back the amount of denom sent to the rollapp from the flow, when the transfer is
+// refunded on timeout or error acknowledgement. The amount is taken from the current window first, then from
+// the previous one.
+func (k Keeper) ReverseOutflow(ctx sdk.Context, rollappID, denom string, amt math.Int) error {
+	return k.reverseFlow(ctx, rollappID, denom, amt, false)
+}
+
+// reversePacketFlow takes the transfer of the rollapp packet back from the flow it was tracked in: the inflow
+// for a received packet, the outflow for a sent one.
+func (k Keeper) reversePacketFlow(ctx sdk.Context, p commontypes.RollappPacket) error {
+	data, err := p.GetTransferPacketData()
+	if err != nil {
+		return errorsmod.Wrap(err, "get transfer packet data")
+	}
+	amt, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "amount: %s", data.Amount)
+	}
+	if p.Type == commontypes.RollappPacket_ON_RECV {
+		denom := denomutils.GetIncomingTransferDenom(*p.Packet, data)
+		return k.ReverseInflow(ctx, p.RollappId, denom, amt)
+	}
+	// the packet denom is the full trace of the hub denom, as in SendPacket
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	return k.ReverseOutflow(ctx, p.RollappId, denom, amt)
+}
+
+// reverseRefundedOutflow takes a transfer to the rollapp which timed out or failed back from the outflow. It is
+// called on finalization, since until then the timeout or the error acknowledgement may still be reverted.
+func (k Keeper) reverseRefundedOutflow(ctx sdk.Context, p commontypes.RollappPacket) error {
+	switch p.Type {
+	case commontypes.RollappPacket_ON_TIMEOUT:
+	case commontypes.RollappPacket_ON_ACK:
+		ack, err := p.GetAck()
+		if err != nil {
+			return errorsmod.Wrap(err, "get ack")
+		}
+		if ack.Success() {
+			return nil
+		}
+	default:
+		return nil
+	}
+	return k.reversePacketFlow(ctx, p)
+}
+
+func (k Keeper) reverseFlow(ctx sdk.Context, rollappID, denom string, amt math.Int, inflow bool) error {
+	l, err := k.rateLimit(ctx, rollappID, denom)
+	if err != nil {
+		return err
+	}
+	if l == nil {
+		return nil
+	}
+	f, err := k.rateLimitFlow(ctx, rollappID, denom, *l)
+	if err != nil {
+		return errorsmod.Wrap(err, "get rate limit flow")
+	}
+	if inflow {
+		cur := math.MinInt(amt, f.Inflow)
+		f.Inflow = f.Inflow.Sub(cur)
+		f.PrevInflow = f.PrevInflow.Sub(math.MinInt(amt.Sub(cur), f.PrevInflow))
+	} else {
+		cur := math.MinInt(amt, f.Outflow)
+		f.Outflow = f.Outflow.Sub(cur)
+		f.PrevOutflow = f.PrevOutflow.Sub(math.MinInt(amt.Sub(cur), f.PrevOutflow))
+	}
+	return k.rateLimitFlows.Set(ctx, collections.Join(rollappID, denom), f)
+}
+
+func (k Keeper) trackFlow(ctx sdk.Context, rollappID, denom string, amt math.Int, inflow bool) error {
+	l, err := k.rateLimit(ctx, rollappID, denom)
+	if err != nil {
+		return err
+	}
+	if l == nil {
+		return nil
+	}
+	f, err := k.rateLimitFlow(ctx, rollappID, denom, *l)
+	if err != nil {
+		return errorsmod.Wrap(err, "get rate limit flow")
+	}
+
+	now := ctx.BlockTime()
+	maxAmt, used, direction := l.MaxOutflow, f.UsedOutflow(now, l.Window), "outflow"
+	if inflow {
+		maxAmt, used, direction = l.MaxInflow, f.UsedInflow(now, l.Window), "inflow"
+	}
+	if maxAmt.IsPositive() && used.Add(amt).GT(maxAmt) {
+		return errorsmod.Wrapf(types.ErrRateLimitExceeded, "%s: rollapp: %s: denom: %s: amount: %s: remaining: %s",
+			direction, rollappID, denom, amt, math.MaxInt(maxAmt.Sub(used), math.ZeroInt()))
+	}
+
+	if inflow {
+		f.Inflow = f.Inflow.Add(amt)
+	} else {
+		f.Outflow = f.Outflow.Add(amt)
+	}
+	return k.rateLimitFlows.Set(ctx, collections.Join(rollappID, denom), f)
+}
+
+// PauseBridge halts all transfers between the hub and the rollapp.
+func (k Keeper) PauseBridge(ctx sdk.Context, p types.BridgePause) error {
+	return k.bridgePauses.Set(ctx, p.RollappId, p)
+}
+
+func (k Keeper) ResumeBridge(ctx sdk.Context, rollappID string) error {
+	return k.bridgePauses.Remove(ctx, rollappID)
+}
+
+// GetBridgePause returns the pause of the bridge of the rollapp, if it is paused.
+func (k Keeper) GetBridgePause(ctx sdk.Context, rollappID string) (types.BridgePause, bool, error) {
+	p, err := k.bridgePauses.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.BridgePause{}, false, nil
+	}
+	if err != nil {
+		return types.BridgePause{}, false, err
+	}
+	return p, true, nil
+}
+
+func (k Keeper) GetAllBridgePauses(ctx sdk.Context) ([]types.BridgePause, error) {
+	iter, err := k.bridgePauses.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// CheckBridgeNotPaused returns an error if the bridge of the rollapp is paused.
+func (k Keeper) CheckBridgeNotPaused(ctx sdk.Context, rollappID string) error {
+	p, paused, err := k.GetBridgePause(ctx, rollappID)
+	if err != nil {
+		return errorsmod.Wrap(err, "get bridge pause")
+	}
+	if paused {
+		return errorsmod.Wrapf(types.ErrBridgePaused, "rollapp: %s: reason: %s", rollappID, p.Reason)
+	}
+	return nil
+}
+
+// checkBridgePauseSender returns an error unless the sender may pause or resume the bridge of the rollapp.
+// Governance may do both. The owner of the rollapp may pause it, and resume it unless it was paused by
+// governance. It returns true if the sender is governance.
+func (k Keeper) checkBridgePauseSender(ctx sdk.Context, sender, rollappID string) (bool, error) {
+	gov, err := k.checkGovOrRollappOwner(ctx, sender, rollappID)
+	if err != nil || gov {
+		return gov, err
+	}
+	existing, found, err := k.GetBridgePause(ctx, rollappID)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "get bridge pause")
+	}
+	if found && existing.Governance {
+		return false, errorsmod.Wrap(gerrc.ErrPermissionDenied, "paused by governance")
+	}
+	return false, nil
+}
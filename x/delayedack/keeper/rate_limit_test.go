@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	denomutils "github.com/dymensionxyz/dymension/v3/utils/denom"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (suite *DelayedAckTestSuite) TestRateLimits() {
+	k := suite.App.DelayedAckKeeper
+	msgServer := keeper.NewMsgServer(k, nil)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	rollappID := suite.CreateDefaultRollapp()
+	denom := "ibc/ABCD"
+	now := suite.Ctx.BlockTime()
+
+	limit := types.RateLimit{
+		RollappId:  rollappID,
+		Denom:      denom,
+		MaxInflow:  math.NewInt(100),
+		MaxOutflow: math.ZeroInt(),
+		Window:     time.Hour,
+	}
+	remaining := func() math.Int {
+		_, inflow, _, err := k.GetRateLimitQuota(suite.Ctx, rollappID, denom)
+		suite.Require().NoError(err)
+		return *inflow
+	}
+	at := func(d time.Duration) {
+		suite.Ctx = suite.Ctx.WithBlockTime(now.Add(d))
+	}
+
+	// set by governance, the owner can no longer change it
+	_, err := msgServer.SetRateLimit(suite.Ctx, &types.MsgSetRateLimit{Sender: gov, RateLimit: limit})
+	suite.Require().NoError(err)
+	_, err = msgServer.RemoveRateLimit(suite.Ctx, &types.MsgRemoveRateLimit{Sender: apptesting.Alice, RollappId: rollappID, Denom: denom})
+	suite.Require().True(errorsmod.IsOf(err, gerrc.ErrPermissionDenied))
+
+	// the flow of the previous window fades out as the window slides
+	suite.Require().NoError(k.TrackInflow(suite.Ctx, rollappID, denom, math.NewInt(80)))
+	err = k.TrackInflow(suite.Ctx, rollappID, denom, math.NewInt(21))
+	suite.Require().True(errorsmod.IsOf(err, types.ErrRateLimitExceeded))
+	suite.Require().Equal(math.NewInt(20), remaining())
+	at(time.Hour + 15*time.Minute)
+	suite.Require().Equal(math.NewInt(40), remaining())
+	suite.Require().NoError(k.TrackInflow(suite.Ctx, rollappID, denom, math.NewInt(40)))
+	suite.Require().True(remaining().IsZero())
+	at(3 * time.Hour)
+	suite.Require().Equal(math.NewInt(100), remaining())
+
+	// the owner can pause, but not resume a bridge paused by governance
+	_, err = msgServer.SetBridgePaused(suite.Ctx, &types.MsgSetBridgePaused{Sender: apptesting.Alice, RollappId: rollappID, Paused: true})
+	suite.Require().NoError(err)
+	suite.Require().True(errorsmod.IsOf(k.CheckBridgeNotPaused(suite.Ctx, rollappID), types.ErrBridgePaused))
+	_, err = msgServer.SetBridgePaused(suite.Ctx, &types.MsgSetBridgePaused{Sender: gov, RollappId: rollappID, Paused: true, Reason: "incident"})
+	suite.Require().NoError(err)
+	_, err = msgServer.SetBridgePaused(suite.Ctx, &types.MsgSetBridgePaused{Sender: apptesting.Alice, RollappId: rollappID, Paused: false})
+	suite.Require().True(errorsmod.IsOf(err, gerrc.ErrPermissionDenied))
+	_, err = msgServer.SetBridgePaused(suite.Ctx, &types.MsgSetBridgePaused{Sender: gov, RollappId: rollappID, Paused: false})
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.CheckBridgeNotPaused(suite.Ctx, rollappID))
+}
+
+func (suite *DelayedAckTestSuite) TestReverseOutflow() {
+	k := suite.App.DelayedAckKeeper
+	rollappID := suite.CreateDefaultRollapp()
+	denom := "adym"
+	now := suite.Ctx.BlockTime()
+
+	suite.Require().NoError(k.SetRateLimit(suite.Ctx, types.RateLimit{
+		RollappId:  rollappID,
+		Denom:      denom,
+		MaxInflow:  math.ZeroInt(),
+		MaxOutflow: math.NewInt(100),
+		Window:     time.Hour,
+	}))
+	remaining := func() math.Int {
+		_, _, outflow, err := k.GetRateLimitQuota(suite.Ctx, rollappID, denom)
+		suite.Require().NoError(err)
+		return *outflow
+	}
+
+	// a refunded transfer gives its quota back
+	suite.Require().NoError(k.TrackOutflow(suite.Ctx, rollappID, denom, math.NewInt(80)))
+	suite.Require().NoError(k.ReverseOutflow(suite.Ctx, rollappID, denom, math.NewInt(50)))
+	suite.Require().Equal(math.NewInt(70), remaining())
+
+	// it is taken from the current window, then from the previous one
+	suite.Ctx = suite.Ctx.WithBlockTime(now.Add(time.Hour))
+	suite.Require().NoError(k.TrackOutflow(suite.Ctx, rollappID, denom, math.NewInt(10)))
+	suite.Require().Equal(math.NewInt(60), remaining())
+	suite.Require().NoError(k.ReverseOutflow(suite.Ctx, rollappID, denom, math.NewInt(20)))
+	suite.Require().Equal(math.NewInt(80), remaining())
+	suite.Require().NoError(k.ReverseOutflow(suite.Ctx, rollappID, denom, math.NewInt(20)))
+	suite.Require().Equal(math.NewInt(100), remaining())
+
+	// the flow never goes negative
+	suite.Require().NoError(k.ReverseOutflow(suite.Ctx, rollappID, denom, math.NewInt(30)))
+	suite.Require().Equal(math.NewInt(100), remaining())
+}
+
+// refundIBCModule refunds the sent transfers which timed out or failed
+type refundIBCModule struct {
+	porttypes.IBCModule
+}
+
+func (refundIBCModule) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
+	return nil
+}
+
+func (suite *DelayedAckTestSuite) TestReversePacketFlows() {
+	k := suite.App.DelayedAckKeeper
+	rollappID := "rollapp_1234-1"
+	suite.CreateRollappByName(rollappID)
+	proposer := suite.CreateDefaultSequencer(suite.Ctx, rollappID)
+	suite.App.RollappKeeper.SetStateInfo(suite.Ctx, rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{RollappId: rollappID, Index: 1},
+		StartHeight:    1,
+		NumBlocks:      10,
+		Status:         commontypes.Status_FINALIZED,
+		Sequencer:      proposer,
+	})
+	suite.App.RollappKeeper.SetLatestFinalizedStateIndex(suite.Ctx, rollapptypes.StateInfoIndex{RollappId: rollappID, Index: 1})
+
+	timeout := commontypes.RollappPacket{
+		RollappId:   rollappID,
+		Packet:      apptesting.GenerateTestPacket(suite.T(), 1),
+		Status:      commontypes.Status_PENDING,
+		ProofHeight: 5,
+		Type:        commontypes.RollappPacket_ON_TIMEOUT,
+	}
+	recv := commontypes.RollappPacket{
+		RollappId:   rollappID,
+		Packet:      apptesting.GenerateTestPacket(suite.T(), 2),
+		Status:      commontypes.Status_PENDING,
+		ProofHeight: 15,
+		Type:        commontypes.RollappPacket_ON_RECV,
+	}
+	outDenom := "adym"
+	inDenom := denomutils.GetIncomingTransferDenom(*recv.Packet, recv.MustGetTransferPacketData())
+	for _, denom := range []string{outDenom, inDenom} {
+		suite.Require().NoError(k.SetRateLimit(suite.Ctx, types.RateLimit{
+			RollappId:  rollappID,
+			Denom:      denom,
+			MaxInflow:  math.NewInt(1000),
+			MaxOutflow: math.NewInt(1000),
+			Window:     time.Hour,
+		}))
+	}
+	remaining := func(denom string) (math.Int, math.Int) {
+		_, inflow, outflow, err := k.GetRateLimitQuota(suite.Ctx, rollappID, denom)
+		suite.Require().NoError(err)
+		return *inflow, *outflow
+	}
+
+	// the outflow of a timed out transfer is given back once the timeout is finalized
+	suite.Require().NoError(k.TrackOutflow(suite.Ctx, rollappID, outDenom, math.NewInt(100)))
+	k.SetRollappPacket(suite.Ctx, timeout)
+	_, outflow := remaining(outDenom)
+	suite.Require().Equal(math.NewInt(900), outflow)
+	_, err := k.FinalizeRollappPacket(suite.Ctx, refundIBCModule{}, string(timeout.RollappPacketKey()))
+	suite.Require().NoError(err)
+	_, outflow = remaining(outDenom)
+	suite.Require().Equal(math.NewInt(1000), outflow)
+
+	// the inflow of a received transfer is given back when a hard fork reverts it
+	suite.Require().NoError(k.TrackInflow(suite.Ctx, rollappID, inDenom, math.NewInt(100)))
+	k.SetRollappPacket(suite.Ctx, recv)
+	suite.Require().NoError(k.OnHardFork(suite.Ctx, rollappID, 10))
+	inflow, _ := remaining(inDenom)
+	suite.Require().Equal(math.NewInt(1000), inflow)
+}
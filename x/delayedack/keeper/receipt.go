@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	eibctypes "github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// receiptKey identifies a settled packet: rollapp id + (packet type, packet src channel, packet sequence)
+type receiptKey = collections.Pair[string, collections.Triple[int32, string, uint64]]
+
+func newReceiptKey(rollappID string, packetType commontypes.RollappPacket_Type, srcChannel string, sequence uint64) receiptKey {
+	return collections.Join(rollappID, collections.Join3(int32(packetType), srcChannel, sequence))
+}
+
+type receiptIndexes struct {
+	// BySettledHeight is used to prune the receipts once they are past the retention
+	BySettledHeight *indexes.Multi[uint64, receiptKey, types.SettledPacketReceipt]
+}
+
+func (i receiptIndexes) IndexesList() []collections.Index[receiptKey, types.SettledPacketReceipt] {
+	return []collections.Index[receiptKey, types.SettledPacketReceipt]{i.BySettledHeight}
+}
+
+func makeReceipts(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) *collections.IndexedMap[receiptKey, types.SettledPacketReceipt, receiptIndexes] {
+	keyCodec := collections.PairKeyCodec(
+		collections.StringKey,
+		collections.TripleKeyCodec(collections.Int32Key, collections.StringKey, collections.Uint64Key),
+	)
+	return collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix(types.SettledPacketReceiptsKeyPrefix),
+		"settled_packet_receipts",
+		keyCodec,
+		codec.CollValue[types.SettledPacketReceipt](cdc),
+		receiptIndexes{
+			BySettledHeight: indexes.NewMulti(
+				sb,
+				collections.NewPrefix(types.SettledPacketReceiptsByHeightKeyPrefix),
+				"settled_packet_receipts_by_height",
+				collections.Uint64Key,
+				keyCodec,
+				func(_ receiptKey, r types.SettledPacketReceipt) (uint64, error) {
+					return r.SettledHeight, nil
+				},
+			),
+		},
+	)
+}
+
+// packetFulfillment returns the id of the eIBC order of the pending packet and the addresses which fulfilled
+// it, if there is an order.
+func (k Keeper) packetFulfillment(ctx sdk.Context, p *commontypes.RollappPacket) (string, []string, error) {
+	o, err := k.PendingOrderByPacket(ctx, p)
+	if errorsmod.IsOf(err, eibctypes.ErrDemandOrderDoesNotExist) {
+		return "", nil, nil
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	// the shares of an order which is not fulfilled are refunded, they did not fulfill it
+	if !o.IsFulfilled() {
+		return o.Id, nil, nil
+	}
+	return o.Id, o.Fulfillers(), nil
+}
+
+// recordSettledPacket writes the receipt of the packet, which was just finalized or reverted. The order must
+// be looked up while the packet is still pending. Nothing is recorded if receipts are disabled.
+func (k Keeper) recordSettledPacket(ctx sdk.Context, p commontypes.RollappPacket, status types.SettlementStatus, orderID string, fulfillers []string) error {
+	if k.GetParams(ctx).ReceiptRetentionBlocks == 0 {
+		return nil
+	}
+	r := types.SettledPacketReceipt{
+		PacketKey:     commontypes.EncodePacketKey(p.RollappPacketKey()),
+		RollappId:     p.RollappId,
+		Status:        status,
+		Type:          p.Type,
+		SrcChannel:    p.Packet.SourceChannel,
+		Sequence:      p.Packet.Sequence,
+		ProofHeight:   p.ProofHeight,
+		SettledHeight: uint64(ctx.BlockHeight()), //nolint:gosec
+		Error:         p.Error,
+		OrderId:       orderID,
+		Fulfillers:    fulfillers,
+	}
+	return k.SetSettledPacketReceipt(ctx, r)
+}
+
+func (k Keeper) SetSettledPacketReceipt(ctx sdk.Context, r types.SettledPacketReceipt) error {
+	return k.receipts.Set(ctx, newReceiptKey(r.RollappId, r.Type, r.SrcChannel, r.Sequence), r)
+}
+
+// GetSettledPacketReceipt returns the receipt of the last settlement of the packet.
+func (k Keeper) GetSettledPacketReceipt(ctx sdk.Context, rollappID string, packetType commontypes.RollappPacket_Type, srcChannel string, sequence uint64) (types.SettledPacketReceipt, bool, error) {
+	r, err := k.receipts.Get(ctx, newReceiptKey(rollappID, packetType, srcChannel, sequence))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.SettledPacketReceipt{}, false, nil
+	}
+	if err != nil {
+		return types.SettledPacketReceipt{}, false, err
+	}
+	return r, true, nil
+}
+
+func (k Keeper) GetAllSettledPacketReceipts(ctx sdk.Context) ([]types.SettledPacketReceipt, error) {
+	iter, err := k.receipts.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// PruneSettledPacketReceipts deletes up to limit receipts which were settled before the retention window.
+// It returns the number of deleted receipts.
+func (k Keeper) PruneSettledPacketReceipts(ctx sdk.Context, limit int) (int, error) {
+	retention := k.GetParams(ctx).ReceiptRetentionBlocks
+	height := uint64(ctx.BlockHeight()) //nolint:gosec
+	if retention == 0 || height <= retention {
+		return 0, nil
+	}
+	rng := new(collections.Range[collections.Pair[uint64, receiptKey]]).
+		EndExclusive(collections.PairPrefix[uint64, receiptKey](height - retention))
+	iter, err := k.receipts.Indexes.BySettledHeight.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	var keys []receiptKey
+	for ; iter.Valid() && len(keys) < limit; iter.Next() {
+		pk, err := iter.PrimaryKey()
+		if err != nil {
+			_ = iter.Close()
+			return 0, err
+		}
+		keys = append(keys, pk)
+	}
+	if err := iter.Close(); err != nil {
+		return 0, err
+	}
+	for _, pk := range keys {
+		if err := k.receipts.Remove(ctx, pk); err != nil {
+			return 0, err
+		}
+	}
+	return len(keys), nil
+}
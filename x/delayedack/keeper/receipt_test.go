@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (suite *DelayedAckTestSuite) TestSettledPacketReceipts() {
+	rollapp := "rollapp_1234-1"
+	k := suite.App.DelayedAckKeeper
+
+	params := k.GetParams(suite.Ctx)
+	params.ReceiptRetentionBlocks = 10
+	k.SetParams(suite.Ctx, params)
+
+	suite.CreateRollappByName(rollapp)
+	proposer := suite.CreateDefaultSequencer(suite.Ctx, rollapp)
+	stateInfo := rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{RollappId: rollapp, Index: 1},
+		StartHeight:    1,
+		NumBlocks:      10,
+		Status:         commontypes.Status_FINALIZED,
+		Sequencer:      proposer,
+	}
+	suite.App.RollappKeeper.SetStateInfo(suite.Ctx, stateInfo)
+	suite.App.RollappKeeper.SetLatestFinalizedStateIndex(suite.Ctx, stateInfo.StateInfoIndex)
+
+	packet := commontypes.RollappPacket{
+		RollappId:   rollapp,
+		Status:      commontypes.Status_PENDING,
+		ProofHeight: 8,
+		Packet:      apptesting.GenerateTestPacket(suite.T(), 2),
+	}
+	k.SetRollappPacket(suite.Ctx, packet)
+
+	ctx := suite.Ctx.WithBlockHeight(100)
+	suite.FinalizePacket(ctx, packet)
+
+	querier := keeper.NewQuerier(k)
+	res, err := querier.SettledPacketReceipt(ctx, &types.QuerySettledPacketReceiptRequest{
+		RollappId:  rollapp,
+		Type:       packet.Type,
+		SrcChannel: packet.Packet.SourceChannel,
+		Sequence:   packet.Packet.Sequence,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.SettlementStatus_SETTLEMENT_FINALIZED, res.Receipt.Status)
+	suite.Require().Equal(uint64(100), res.Receipt.SettledHeight)
+	suite.Require().Equal(packet.ProofHeight, res.Receipt.ProofHeight)
+	suite.Require().Empty(res.Receipt.OrderId)
+
+	// the receipt outlives the packet itself
+	finalized := packet
+	finalized.Status = commontypes.Status_FINALIZED
+	k.DeleteRollappPacket(ctx, &finalized)
+	_, found, err := k.GetSettledPacketReceipt(ctx, rollapp, packet.Type, packet.Packet.SourceChannel, packet.Packet.Sequence)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+
+	// still within the retention window
+	n, err := k.PruneSettledPacketReceipts(ctx.WithBlockHeight(110), 100)
+	suite.Require().NoError(err)
+	suite.Require().Zero(n)
+
+	n, err = k.PruneSettledPacketReceipts(ctx.WithBlockHeight(111), 100)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, n)
+	_, found, err = k.GetSettledPacketReceipt(ctx, rollapp, packet.Type, packet.Packet.SourceChannel, packet.Packet.Sequence)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+}
@@ -130,21 +130,28 @@ func (k Keeper) UpdateRollappPacketTransferAddress(
 
 	// Set the recipient and sender based on the rollapp packet type
 	var (
-		recipient         = transferPacketData.Receiver
-		sender            = transferPacketData.Sender
-		originalRecipient string
+		recipient     = transferPacketData.Receiver
+		sender        = transferPacketData.Sender
+		currentTarget string
 	)
 	switch rollappPacket.Type {
 	case commontypes.RollappPacket_ON_RECV:
 		// recipient will get credited
-		originalRecipient = recipient
+		currentTarget = recipient
 		recipient = newRecipient
 	case commontypes.RollappPacket_ON_ACK, commontypes.RollappPacket_ON_TIMEOUT:
 		// sender will get refunded
-		originalRecipient = sender
+		currentTarget = sender
 		sender = newRecipient
 	}
 
+	// The target can change several times, e.g. when a fulfilled claim is transferred. Only the first
+	// change records the original target, which is the one the rollapp knows about.
+	originalRecipient := rollappPacket.OriginalTransferTarget
+	if originalRecipient == "" {
+		originalRecipient = currentTarget
+	}
+
 	// Create a new packet data with the updated recipient and sender
 	newPacketData := transfertypes.NewFungibleTokenPacketData(
 		transferPacketData.Denom,
@@ -160,7 +167,7 @@ func (k Keeper) UpdateRollappPacketTransferAddress(
 	rollappPacket.OriginalTransferTarget = originalRecipient
 
 	// Update index: delete the old packet and save the new one
-	k.MustDeletePendingPacketByAddress(ctx, originalRecipient, []byte(rollappPacketKey))
+	k.MustDeletePendingPacketByAddress(ctx, currentTarget, []byte(rollappPacketKey))
 	k.MustSetPendingPacketByAddress(ctx, newRecipient, rollappPacket.RollappPacketKey())
 
 	k.SetRollappPacket(ctx, *rollappPacket)
@@ -272,6 +279,9 @@ func (k Keeper) DeleteRollappPacket(ctx sdk.Context, rollappPacket *commontypes.
 	switch rollappPacket.Type {
 	case commontypes.RollappPacket_ON_RECV:
 		pendingAddr = transfer.Receiver
+		if err := k.deletePacketBridgingFee(ctx, *rollappPacket.Packet); err != nil {
+			k.Logger(ctx).Error("Delete packet bridging fee.", "packet", rollappPacket.LogString(), "err", err)
+		}
 	case commontypes.RollappPacket_ON_ACK, commontypes.RollappPacket_ON_TIMEOUT:
 		pendingAddr = transfer.Sender
 	}
@@ -304,3 +304,40 @@ func (suite *DelayedAckTestSuite) TestUpdateRollappPacketTransferAddress_ON_ACK(
 	packets = keeper.GetAllRollappPackets(ctx)
 	suite.Require().Equal(1, len(packets))
 }
+
+func (suite *DelayedAckTestSuite) TestUpdateRollappPacketTransferAddress_Twice() {
+	var err error
+	keeper, ctx := suite.App.DelayedAckKeeper, suite.Ctx
+	packet := commontypes.RollappPacket{
+		RollappId:   "testRollappID",
+		Packet:      apptesting.GenerateTestPacket(suite.T(), 1),
+		Type:        commontypes.RollappPacket_ON_RECV,
+		Status:      commontypes.Status_PENDING,
+		ProofHeight: 1,
+	}
+	keeper.SetRollappPacket(ctx, packet)
+	err = keeper.SetPendingPacketByAddress(ctx, apptesting.TestPacketReceiver, packet.RollappPacketKey())
+	suite.Require().NoError(err)
+
+	// e.g. the order is fulfilled and the claim is then transferred
+	const fulfiller, newOwner = "fulfiller", "newOwner"
+	err = keeper.UpdateRollappPacketTransferAddress(ctx, string(packet.RollappPacketKey()), fulfiller)
+	suite.Require().NoError(err)
+	err = keeper.UpdateRollappPacketTransferAddress(ctx, string(packet.RollappPacketKey()), newOwner)
+	suite.Require().NoError(err)
+
+	actualPacket, err := keeper.GetRollappPacket(ctx, string(packet.RollappPacketKey()))
+	suite.Require().NoError(err)
+	target, err := actualPacket.TransferTarget()
+	suite.Require().NoError(err)
+	suite.Require().Equal(newOwner, target)
+	// the rollapp must still get the ack for the original receiver
+	suite.Require().Equal(apptesting.TestPacketReceiver, actualPacket.OriginalTransferTarget)
+
+	// only the current target is indexed
+	for addr, n := range map[string]int{apptesting.TestPacketReceiver: 0, fulfiller: 0, newOwner: 1} {
+		byAddr, err := keeper.GetPendingPacketsByAddress(ctx, addr)
+		suite.Require().NoError(err)
+		suite.Require().Len(byAddr, n, addr)
+	}
+}
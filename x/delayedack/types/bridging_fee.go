@@ -0,0 +1,71 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func validateBridgingFee(fee math.LegacyDec) error {
+	if fee.IsNil() || fee.IsNegative() {
+		return gerrc.ErrInvalidArgument.Wrapf("fee must not be negative: %s", fee)
+	}
+	if fee.GTE(math.LegacyOneDec()) {
+		return gerrc.ErrInvalidArgument.Wrapf("fee too large: %s", fee)
+	}
+	return nil
+}
+
+func (o BridgingFeeOverride) ValidateBasic() error {
+	if o.RollappId == "" && o.Denom == "" {
+		return gerrc.ErrInvalidArgument.Wrap("override needs a rollapp or a denom: use params for the global fee")
+	}
+	if o.Denom != "" {
+		if err := sdk.ValidateDenom(o.Denom); err != nil {
+			return gerrc.ErrInvalidArgument.Wrapf("denom: %s", err)
+		}
+	}
+	if err := validateBridgingFee(o.Fee); err != nil {
+		return err
+	}
+	prev := math.ZeroInt()
+	for i, t := range o.Tiers {
+		if t.MinAmount.IsNil() || !t.MinAmount.GT(prev) {
+			return gerrc.ErrInvalidArgument.Wrapf("tier %d: min amount must be positive and increasing", i)
+		}
+		if err := validateBridgingFee(t.Fee); err != nil {
+			return gerrc.ErrInvalidArgument.Wrapf("tier %d: %s", i, err)
+		}
+		prev = t.MinAmount
+	}
+	return nil
+}
+
+// FeesWithin returns true if the fee and the fees of all the tiers are in [min, max].
+func (o BridgingFeeOverride) FeesWithin(min, max math.LegacyDec) bool {
+	within := func(fee math.LegacyDec) bool {
+		return fee.GTE(min) && fee.LTE(max)
+	}
+	if !within(o.Fee) {
+		return false
+	}
+	for _, t := range o.Tiers {
+		if !within(t.Fee) {
+			return false
+		}
+	}
+	return true
+}
+
+// FeeFor returns the fee multiplier for a transfer of the amount: the fee of the last tier the amount
+// reaches, or the base fee.
+func (o BridgingFeeOverride) FeeFor(amt math.Int) math.LegacyDec {
+	fee := o.Fee
+	for _, t := range o.Tiers {
+		if amt.LT(t.MinAmount) {
+			break
+		}
+		fee = t.Fee
+	}
+	return fee
+}
@@ -0,0 +1,761 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/delayedack/bridging_fee.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BridgingFeeOverride replaces the global bridging fee for the transfers of a
+// rollapp, of a denom, or of a denom of a rollapp. The most specific override
+// applies: rollapp and denom, then rollapp, then denom, then the bridging_fee
+// param. A transfer pays the fee in effect when its packet was received on the
+// hub, even if the fee changes before the packet is finalized.
+type BridgingFeeOverride struct {
+	// rollapp_id is the rollapp the override applies to, empty for all rollapps.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// denom is the hub denom the override applies to, empty for all denoms.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// fee is the fee multiplier applied to transfers below the first tier.
+	Fee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee"`
+	// tiers are ordered by increasing min_amount. A transfer pays the fee of the
+	// last tier whose min_amount it reaches.
+	Tiers []BridgingFeeTier `protobuf:"bytes,4,rep,name=tiers,proto3" json:"tiers"`
+	// governance is true if the override was set by governance, in which case
+	// the rollapp owner can neither change nor remove it.
+	Governance bool `protobuf:"varint,5,opt,name=governance,proto3" json:"governance,omitempty"`
+}
+
+func (m *BridgingFeeOverride) Reset()         { *m = BridgingFeeOverride{} }
+func (m *BridgingFeeOverride) String() string { return proto.CompactTextString(m) }
+func (*BridgingFeeOverride) ProtoMessage()    {}
+func (*BridgingFeeOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86dcc38739cc9ff1, []int{0}
+}
+func (m *BridgingFeeOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgingFeeOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgingFeeOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgingFeeOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgingFeeOverride.Merge(m, src)
+}
+func (m *BridgingFeeOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgingFeeOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgingFeeOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgingFeeOverride proto.InternalMessageInfo
+
+func (m *BridgingFeeOverride) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *BridgingFeeOverride) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BridgingFeeOverride) GetTiers() []BridgingFeeTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+func (m *BridgingFeeOverride) GetGovernance() bool {
+	if m != nil {
+		return m.Governance
+	}
+	return false
+}
+
+// BridgingFeeTier is the fee multiplier for transfers of at least min_amount.
+type BridgingFeeTier struct {
+	MinAmount cosmossdk_io_math.Int       `protobuf:"bytes,1,opt,name=min_amount,json=minAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount"`
+	Fee       cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee"`
+}
+
+func (m *BridgingFeeTier) Reset()         { *m = BridgingFeeTier{} }
+func (m *BridgingFeeTier) String() string { return proto.CompactTextString(m) }
+func (*BridgingFeeTier) ProtoMessage()    {}
+func (*BridgingFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86dcc38739cc9ff1, []int{1}
+}
+func (m *BridgingFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgingFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgingFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgingFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgingFeeTier.Merge(m, src)
+}
+func (m *BridgingFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgingFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgingFeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgingFeeTier proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*BridgingFeeOverride)(nil), "dymensionxyz.dymension.delayedack.BridgingFeeOverride")
+	proto.RegisterType((*BridgingFeeTier)(nil), "dymensionxyz.dymension.delayedack.BridgingFeeTier")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/delayedack/bridging_fee.proto", fileDescriptor_86dcc38739cc9ff1)
+}
+
+var fileDescriptor_86dcc38739cc9ff1 = []byte{
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x41, 0xcb, 0xd3, 0x40,
+	0x10, 0x4d, 0xda, 0xaf, 0x62, 0xd7, 0x83, 0x12, 0x2b, 0xc4, 0x8a, 0x69, 0xed, 0xa9, 0x20, 0xdd,
+	0x40, 0x2b, 0xf4, 0x6c, 0x10, 0x21, 0x22, 0x8a, 0xc1, 0x93, 0x97, 0x90, 0x66, 0xa7, 0xe9, 0xd2,
+	0xee, 0x6e, 0xd8, 0x6c, 0x4b, 0xe3, 0xdd, 0xbb, 0x3f, 0xc3, 0x1f, 0xd0, 0x1f, 0xd1, 0x63, 0xe9,
+	0x49, 0x3c, 0x14, 0x69, 0xff, 0x88, 0x24, 0x1b, 0x6b, 0x50, 0xc4, 0x83, 0xb7, 0x7d, 0x6f, 0xf6,
+	0xcd, 0xcc, 0x1b, 0x1e, 0x7a, 0x46, 0x72, 0x06, 0x3c, 0xa3, 0x82, 0x6f, 0xf3, 0x8f, 0xee, 0x15,
+	0xb8, 0x04, 0x56, 0x51, 0x0e, 0x24, 0x8a, 0x97, 0xee, 0x4c, 0x52, 0x92, 0x50, 0x9e, 0x84, 0x73,
+	0x00, 0x9c, 0x4a, 0xa1, 0x84, 0xf5, 0xa4, 0xae, 0xc2, 0x57, 0x80, 0x7f, 0xa9, 0xba, 0x9d, 0x44,
+	0x24, 0xa2, 0xfc, 0xed, 0x16, 0x2f, 0x2d, 0xec, 0x3e, 0x8c, 0x45, 0xc6, 0x44, 0x16, 0xea, 0x82,
+	0x06, 0xba, 0x34, 0xf8, 0xd4, 0x40, 0xf7, 0xbd, 0x6a, 0xd4, 0x4b, 0x80, 0xb7, 0x1b, 0x90, 0x92,
+	0x12, 0xb0, 0x1e, 0x23, 0x24, 0xc5, 0x6a, 0x15, 0xa5, 0x69, 0x48, 0x89, 0x6d, 0xf6, 0xcd, 0x61,
+	0x3b, 0x68, 0x57, 0x8c, 0x4f, 0xac, 0x0e, 0x6a, 0x11, 0xe0, 0x82, 0xd9, 0x8d, 0xb2, 0xa2, 0x81,
+	0xe5, 0xa3, 0xe6, 0x1c, 0xc0, 0x6e, 0x16, 0x9c, 0x37, 0xdd, 0x9f, 0x7a, 0xc6, 0xb7, 0x53, 0xef,
+	0x91, 0x9e, 0x97, 0x91, 0x25, 0xa6, 0xc2, 0x65, 0x91, 0x5a, 0xe0, 0xd7, 0x90, 0x44, 0x71, 0xfe,
+	0x02, 0xe2, 0xe3, 0x6e, 0x74, 0xaf, 0x5a, 0xe7, 0xca, 0x05, 0x45, 0x0f, 0xeb, 0x0d, 0x6a, 0x29,
+	0x0a, 0x32, 0xb3, 0x6f, 0xfa, 0xcd, 0xe1, 0x9d, 0xf1, 0x18, 0xff, 0xd3, 0x3b, 0xae, 0xd9, 0x78,
+	0x4f, 0x41, 0x7a, 0x37, 0xc5, 0x02, 0x81, 0x6e, 0x63, 0x39, 0x08, 0x25, 0x62, 0x03, 0x92, 0x47,
+	0x3c, 0x06, 0xbb, 0xd5, 0x37, 0x87, 0xb7, 0x83, 0x1a, 0x33, 0xf8, 0x62, 0xa2, 0xbb, 0xbf, 0x35,
+	0xb0, 0x5e, 0x21, 0xc4, 0x28, 0x0f, 0x23, 0x26, 0xd6, 0x5c, 0xe9, 0x1b, 0x78, 0x4f, 0x2b, 0x57,
+	0x0f, 0xfe, 0x74, 0xe5, 0x73, 0x75, 0xdc, 0x8d, 0x50, 0xe5, 0xc7, 0xe7, 0x2a, 0x68, 0x33, 0xca,
+	0x9f, 0x97, 0xea, 0x9f, 0xa7, 0x69, 0xfc, 0xff, 0x69, 0xbc, 0x77, 0xfb, 0xb3, 0x63, 0x1e, 0xce,
+	0x8e, 0xf9, 0xfd, 0xec, 0x98, 0x9f, 0x2f, 0x8e, 0x71, 0xb8, 0x38, 0xc6, 0xd7, 0x8b, 0x63, 0x7c,
+	0x98, 0x26, 0x54, 0x2d, 0xd6, 0x33, 0x1c, 0x0b, 0xe6, 0xfe, 0x25, 0x61, 0x9b, 0x89, 0xbb, 0xad,
+	0xc7, 0x4c, 0xe5, 0x29, 0x64, 0xb3, 0x5b, 0x65, 0x18, 0x26, 0x3f, 0x06, 0x00, 0xaf, 0x79, 0x78,
+	0x39, 0x98, 0x02, 0x00, 0x00,
+}
+
+func (m *BridgingFeeOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgingFeeOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgingFeeOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Governance {
+		i--
+		if m.Governance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBridgingFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridgingFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBridgingFee(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintBridgingFee(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgingFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgingFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgingFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridgingFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridgingFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBridgingFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBridgingFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BridgingFeeOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovBridgingFee(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBridgingFee(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovBridgingFee(uint64(l))
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovBridgingFee(uint64(l))
+		}
+	}
+	if m.Governance {
+		n += 2
+	}
+	return n
+}
+
+func (m *BridgingFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinAmount.Size()
+	n += 1 + l + sovBridgingFee(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovBridgingFee(uint64(l))
+	return n
+}
+
+func sovBridgingFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBridgingFee(x uint64) (n int) {
+	return sovBridgingFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BridgingFeeOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridgingFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgingFeeOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgingFeeOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgingFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgingFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgingFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgingFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgingFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgingFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgingFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgingFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgingFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgingFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridgingFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgingFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, BridgingFeeTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Governance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgingFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Governance = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridgingFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridgingFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgingFeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridgingFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgingFeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgingFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgingFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgingFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgingFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgingFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgingFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgingFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridgingFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridgingFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBridgingFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBridgingFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBridgingFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBridgingFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBridgingFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBridgingFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBridgingFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBridgingFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBridgingFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBridgingFee = fmt.Errorf("proto: unexpected end of group")
+)
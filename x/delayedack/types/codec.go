@@ -12,7 +12,13 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgFinalizePacket{}, "delayedack/FinalizePacket", nil)
 	cdc.RegisterConcrete(&MsgFinalizePacketByPacketKey{}, "delayedack/FinalizeByPacketKey", nil)
+	cdc.RegisterConcrete(&MsgFinalizePackets{}, "delayedack/FinalizePackets", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "delayedack/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetBridgingFeeOverride{}, "delayedack/SetBridgingFeeOverride", nil)
+	cdc.RegisterConcrete(&MsgRemoveBridgingFeeOverride{}, "delayedack/RemoveBridgingFeeOverride", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimit{}, "delayedack/SetRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, "delayedack/RemoveRateLimit", nil)
+	cdc.RegisterConcrete(&MsgSetBridgePaused{}, "delayedack/SetBridgePaused", nil)
 	cdc.RegisterConcrete(Params{}, "delayedack/Params", nil)
 }
 
@@ -22,7 +28,13 @@ func RegisterInterfaces(reg types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgFinalizePacket{},
 		&MsgFinalizePacketByPacketKey{},
+		&MsgFinalizePackets{},
 		&MsgUpdateParams{},
+		&MsgSetBridgingFeeOverride{},
+		&MsgRemoveBridgingFeeOverride{},
+		&MsgSetRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgSetBridgePaused{},
 	)
 	msgservice.RegisterMsgServiceDesc(reg, &_Msg_serviceDesc)
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 )
 
 var (
@@ -10,4 +11,7 @@ var (
 	ErrRollappPacketAlreadyExists = errorsmod.Register(ModuleName, 3, "rollapp packet already exists")
 	ErrUnknownRequest             = errorsmod.Register(ModuleName, 8, "unknown request")
 	ErrBadEIBCFee                 = errorsmod.Register(ModuleName, 10, "provided eibc fee is invalid")
+	ErrBadEIBCFeeDecay            = errorsmod.Register(ModuleName, 11, "provided eibc fee decay is invalid")
+	ErrRateLimitExceeded          = errorsmod.Wrap(gerrc.ErrResourceExhausted, "rate limit exceeded")
+	ErrBridgePaused               = errorsmod.Wrap(gerrc.ErrUnavailable, "bridge paused")
 )
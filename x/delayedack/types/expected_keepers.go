@@ -20,6 +20,7 @@ type RollappKeeper interface {
 	MustGetStateInfo(ctx sdk.Context, rollappId string, index uint64) types.StateInfo
 	GetLatestFinalizedStateIndex(ctx sdk.Context, rollappId string) (val types.StateInfoIndex, found bool)
 	GetAllRollapps(ctx sdk.Context) (list []types.Rollapp)
+	GetRollapp(ctx sdk.Context, rollappId string) (val types.Rollapp, found bool)
 	GetValidTransfer(
 		ctx sdk.Context,
 		packetData []byte,
@@ -30,4 +31,5 @@ type RollappKeeper interface {
 type EIBCKeeper interface {
 	EIBCDemandOrderHandler(ctx sdk.Context, rollappPacket commontypes.RollappPacket, data transfertypes.FungibleTokenPacketData) error
 	PendingOrderByPacket(ctx sdk.Context, p *commontypes.RollappPacket) (*eibctypes.DemandOrder, error)
+	SettlePartialFulfillments(ctx sdk.Context, p *commontypes.RollappPacket) error
 }
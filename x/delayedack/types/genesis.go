@@ -1,5 +1,7 @@
 package types
 
+import "github.com/dymensionxyz/gerr-cosmos/gerrc"
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -20,5 +22,42 @@ func (gs GenesisState) Validate() error {
 		}
 		rollappPacketMap[string(rollappPacket.RollappPacketKey())] = struct{}{}
 	}
+	overrides := make(map[string]struct{})
+	for _, o := range gs.GetBridgingFeeOverrides() {
+		if err := o.ValidateBasic(); err != nil {
+			return err
+		}
+		key := o.RollappId + "/" + o.Denom
+		if _, ok := overrides[key]; ok {
+			return gerrc.ErrAlreadyExists.Wrapf("bridging fee override: %s", key)
+		}
+		overrides[key] = struct{}{}
+	}
+	limits := make(map[string]struct{})
+	for _, l := range gs.GetRateLimits() {
+		if err := l.ValidateBasic(); err != nil {
+			return err
+		}
+		key := l.RollappId + "/" + l.Denom
+		if _, ok := limits[key]; ok {
+			return gerrc.ErrAlreadyExists.Wrapf("rate limit: %s", key)
+		}
+		limits[key] = struct{}{}
+	}
+	pauses := make(map[string]struct{})
+	for _, p := range gs.GetBridgePauses() {
+		if err := p.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := pauses[p.RollappId]; ok {
+			return gerrc.ErrAlreadyExists.Wrapf("bridge pause: %s", p.RollappId)
+		}
+		pauses[p.RollappId] = struct{}{}
+	}
+	for _, r := range gs.GetSettledPacketReceipts() {
+		if r.RollappId == "" || r.PacketKey == "" {
+			return gerrc.ErrInvalidArgument.Wrap("settled packet receipt: rollapp id and packet key must be non-empty")
+		}
+	}
 	return gs.Params.ValidateBasic()
 }
@@ -30,6 +30,15 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// streams are all streams that should exist at genesis
 	RollappPackets []types.RollappPacket `protobuf:"bytes,2,rep,name=rollapp_packets,json=rollappPackets,proto3" json:"rollapp_packets"`
+	// bridging_fee_overrides are the overrides of the global bridging fee
+	BridgingFeeOverrides []BridgingFeeOverride `protobuf:"bytes,3,rep,name=bridging_fee_overrides,json=bridgingFeeOverrides,proto3" json:"bridging_fee_overrides"`
+	// rate_limits are the limits on the flow of denoms to and from rollapps.
+	// The flows are not exported: the windows start over.
+	RateLimits []RateLimit `protobuf:"bytes,4,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// bridge_pauses are the rollapps whose bridge is paused
+	BridgePauses []BridgePause `protobuf:"bytes,5,rep,name=bridge_pauses,json=bridgePauses,proto3" json:"bridge_pauses"`
+	// settled_packet_receipts are the receipts of the settled rollapp packets
+	SettledPacketReceipts []SettledPacketReceipt `protobuf:"bytes,6,rep,name=settled_packet_receipts,json=settledPacketReceipts,proto3" json:"settled_packet_receipts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +88,34 @@ func (m *GenesisState) GetRollappPackets() []types.RollappPacket {
 	return nil
 }
 
+func (m *GenesisState) GetBridgingFeeOverrides() []BridgingFeeOverride {
+	if m != nil {
+		return m.BridgingFeeOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgePauses() []BridgePause {
+	if m != nil {
+		return m.BridgePauses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSettledPacketReceipts() []SettledPacketReceipt {
+	if m != nil {
+		return m.SettledPacketReceipts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.delayedack.GenesisState")
 }
@@ -88,24 +125,34 @@ func init() {
 }
 
 var fileDescriptor_1d8c175b9e6478cc = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4f, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0x42, 0x70, 0xf4, 0x53, 0x52, 0x73, 0x12, 0x2b,
-	0x53, 0x53, 0x12, 0x93, 0xb3, 0xf5, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x14, 0x91, 0x35, 0xe8, 0xc1, 0x39, 0x7a, 0x08, 0x0d, 0x52, 0x22, 0xe9,
-	0xf9, 0xe9, 0xf9, 0x60, 0xd5, 0xfa, 0x20, 0x16, 0x44, 0xa3, 0x94, 0x1e, 0x61, 0x9b, 0x0a, 0x12,
-	0x8b, 0x12, 0x73, 0xa1, 0x16, 0x49, 0x19, 0xe1, 0x50, 0x9f, 0x9c, 0x9f, 0x9b, 0x9b, 0x9f, 0xa7,
-	0x5f, 0x94, 0x9f, 0x93, 0x93, 0x58, 0x50, 0x10, 0x5f, 0x90, 0x98, 0x9c, 0x9d, 0x5a, 0x02, 0xd1,
-	0xa3, 0xb4, 0x85, 0x91, 0x8b, 0xc7, 0x1d, 0xe2, 0xdc, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x77,
-	0x2e, 0x36, 0x88, 0xa1, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x9a, 0x7a, 0x04, 0x9d, 0xaf,
-	0x17, 0x00, 0xd6, 0xe0, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xbb, 0x50, 0x34, 0x17,
-	0x3f, 0xaa, 0x8d, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x3a, 0xb8, 0x4c, 0x84, 0xb8,
-	0x53, 0x2f, 0x08, 0xa2, 0x2b, 0x00, 0xac, 0x09, 0x6a, 0x28, 0x5f, 0x11, 0xb2, 0x60, 0xb1, 0x53,
-	0xe0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa7, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0x81, 0x0c, 0xc3, 0x15, 0x53, 0x65, 0xc6, 0xfa, 0x15, 0xc8, 0x81, 0x58, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x10, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x19,
-	0x8d, 0x31, 0xe0, 0x01, 0x00, 0x00,
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x86, 0x1b, 0x56, 0x7a, 0x70, 0x07, 0x48, 0xd1, 0x80, 0xa8, 0x87, 0x30, 0x38, 0x0d, 0x09,
+	0x39, 0x52, 0x87, 0xd8, 0xbd, 0x07, 0x76, 0x41, 0xa2, 0xb4, 0x27, 0xe0, 0x10, 0x39, 0xc9, 0x47,
+	0xb0, 0x96, 0xc4, 0x96, 0x3f, 0x77, 0x5a, 0xf8, 0x15, 0xfc, 0xac, 0xdd, 0xd8, 0x91, 0x13, 0x42,
+	0xed, 0x1f, 0x41, 0xb1, 0x3d, 0x12, 0x04, 0x25, 0xb9, 0x25, 0xb6, 0x9f, 0xf7, 0xb1, 0x5f, 0x9b,
+	0x44, 0x59, 0x5d, 0x42, 0x85, 0x5c, 0x54, 0x57, 0xf5, 0x97, 0xf6, 0x27, 0xca, 0xa0, 0x60, 0x35,
+	0x64, 0x2c, 0xbd, 0x88, 0x72, 0xa8, 0x00, 0x39, 0x52, 0xa9, 0x84, 0x16, 0xfe, 0xd3, 0x2e, 0x40,
+	0x7f, 0xff, 0xd0, 0x16, 0x98, 0x1d, 0xe5, 0x22, 0x17, 0x66, 0x75, 0xd4, 0x7c, 0x59, 0x70, 0x46,
+	0xfb, 0x4d, 0x92, 0x29, 0x56, 0x3a, 0xd1, 0x6c, 0xbe, 0x67, 0x7d, 0x2a, 0xca, 0x52, 0x54, 0x91,
+	0x12, 0x45, 0xc1, 0xa4, 0x8c, 0x25, 0x4b, 0x2f, 0x40, 0x3b, 0xe6, 0x65, 0xbf, 0x23, 0x51, 0x3c,
+	0xcb, 0x79, 0x95, 0xc7, 0x9f, 0x00, 0x7a, 0x4c, 0x1d, 0x4a, 0x31, 0x0d, 0x71, 0xc1, 0x4b, 0x7e,
+	0x6b, 0x1a, 0xd0, 0x9b, 0x82, 0x14, 0xb8, 0x74, 0xc0, 0xb3, 0x6f, 0x63, 0x72, 0x78, 0x6e, 0x9b,
+	0x5c, 0x6b, 0xa6, 0xc1, 0x3f, 0x27, 0x13, 0x7b, 0xde, 0xc0, 0x3b, 0xf6, 0x4e, 0xa6, 0xf3, 0xe7,
+	0xb4, 0xb7, 0x59, 0xba, 0x34, 0xc0, 0x62, 0x7c, 0xfd, 0xe3, 0xc9, 0x68, 0xe5, 0x70, 0xff, 0x23,
+	0x79, 0xf0, 0x67, 0x19, 0x18, 0xdc, 0x39, 0x3e, 0x38, 0x99, 0xce, 0x5f, 0xec, 0x4b, 0xb4, 0x15,
+	0xd2, 0x95, 0xa5, 0x96, 0x06, 0x72, 0xa1, 0xf7, 0x55, 0x77, 0x10, 0x7d, 0x45, 0x1e, 0x75, 0x1b,
+	0x8b, 0xc5, 0x25, 0x28, 0xc5, 0x33, 0xc0, 0xe0, 0xc0, 0x38, 0x5e, 0x0d, 0xd8, 0xf5, 0xc2, 0x05,
+	0xbc, 0x06, 0x78, 0xeb, 0x70, 0x67, 0x3b, 0x4a, 0xfe, 0x9e, 0x42, 0x7f, 0x4d, 0xa6, 0x6d, 0xdf,
+	0x18, 0x8c, 0xff, 0x7f, 0x98, 0x8e, 0x68, 0xc5, 0x34, 0xbc, 0x69, 0x20, 0x17, 0x4f, 0xd4, 0xed,
+	0x00, 0xfa, 0xef, 0xc9, 0x3d, 0x23, 0x83, 0x58, 0xb2, 0x0d, 0x02, 0x06, 0x77, 0x4d, 0x2c, 0x1d,
+	0xba, 0x7f, 0x58, 0x36, 0x98, 0x0b, 0x3e, 0x4c, 0xda, 0x21, 0xf4, 0x37, 0xe4, 0x31, 0x82, 0xd6,
+	0x05, 0x64, 0xee, 0x02, 0x62, 0x77, 0xf5, 0x18, 0x4c, 0x8c, 0xe4, 0x6c, 0x80, 0x64, 0x6d, 0x13,
+	0x6c, 0xef, 0x2b, 0xcb, 0x3b, 0xdb, 0x43, 0xfc, 0xc7, 0x1c, 0x2e, 0xde, 0x5d, 0x6f, 0x43, 0xef,
+	0x66, 0x1b, 0x7a, 0x3f, 0xb7, 0xa1, 0xf7, 0x75, 0x17, 0x8e, 0x6e, 0x76, 0xe1, 0xe8, 0xfb, 0x2e,
+	0x1c, 0x7d, 0x38, 0xcb, 0xb9, 0xfe, 0xbc, 0x49, 0x9a, 0x7b, 0xde, 0xf7, 0x4e, 0x2f, 0x4f, 0xa3,
+	0xab, 0xee, 0x63, 0xd5, 0xb5, 0x04, 0x4c, 0x26, 0xe6, 0xad, 0x9e, 0xfe, 0x1a, 0x00, 0x53, 0x9e,
+	0xaa, 0x46, 0x16, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -128,6 +175,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SettledPacketReceipts) > 0 {
+		for iNdEx := len(m.SettledPacketReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledPacketReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BridgePauses) > 0 {
+		for iNdEx := len(m.BridgePauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgePauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BridgingFeeOverrides) > 0 {
+		for iNdEx := len(m.BridgingFeeOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgingFeeOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.RollappPackets) > 0 {
 		for iNdEx := len(m.RollappPackets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -180,6 +283,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BridgingFeeOverrides) > 0 {
+		for _, e := range m.BridgingFeeOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgePauses) > 0 {
+		for _, e := range m.BridgePauses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SettledPacketReceipts) > 0 {
+		for _, e := range m.SettledPacketReceipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -285,6 +412,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgingFeeOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgingFeeOverrides = append(m.BridgingFeeOverrides, BridgingFeeOverride{})
+			if err := m.BridgingFeeOverrides[len(m.BridgingFeeOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgePauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgePauses = append(m.BridgePauses, BridgePause{})
+			if err := m.BridgePauses[len(m.BridgePauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledPacketReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledPacketReceipts = append(m.SettledPacketReceipts, SettledPacketReceipt{})
+			if err := m.SettledPacketReceipts[len(m.SettledPacketReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -35,8 +35,10 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
 				Params: types.Params{
-					EpochIdentifier: "hour",
-					BridgingFee:     math.LegacyNewDecWithPrec(1, 1),
+					EpochIdentifier:     "hour",
+					BridgingFee:         math.LegacyNewDecWithPrec(1, 1),
+					MinOwnerBridgingFee: math.LegacyNewDecWithPrec(1, 3),
+					MaxOwnerBridgingFee: math.LegacyNewDecWithPrec(1, 2),
 				},
 				RollappPackets: []ctypes.RollappPacket{validRollappPacket},
 			},
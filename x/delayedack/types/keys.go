@@ -17,4 +17,13 @@ const (
 var (
 	ParamsKey                        = []byte{0x02}
 	PendingPacketsByAddressKeyPrefix = []byte{0x01}
+	BridgingFeeOverridesKeyPrefix    = []byte{0x03}
+	RateLimitsKeyPrefix              = []byte{0x04}
+	RateLimitFlowsKeyPrefix          = []byte{0x05}
+	BridgePausesKeyPrefix            = []byte{0x06}
+
+	SettledPacketReceiptsKeyPrefix         = []byte{0x07}
+	SettledPacketReceiptsByHeightKeyPrefix = []byte{0x08}
+
+	PacketBridgingFeesKeyPrefix = []byte{0x09}
 )
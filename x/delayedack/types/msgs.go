@@ -15,7 +15,13 @@ import (
 var (
 	_ sdk.Msg = &MsgFinalizePacket{}
 	_ sdk.Msg = &MsgFinalizePacketByPacketKey{}
+	_ sdk.Msg = &MsgFinalizePackets{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetBridgingFeeOverride{}
+	_ sdk.Msg = &MsgRemoveBridgingFeeOverride{}
+	_ sdk.Msg = &MsgSetRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+	_ sdk.Msg = &MsgSetBridgePaused{}
 )
 
 func (m MsgFinalizePacket) ValidateBasic() error {
@@ -72,6 +78,58 @@ func (m MsgFinalizePacketByPacketKey) MustDecodePacketKey() []byte {
 	return packetKey
 }
 
+const (
+	// MaxFinalizePacketsBatch is the maximum number of packets finalized by a MsgFinalizePackets.
+	MaxFinalizePacketsBatch = 100
+	// FinalizePacketGasReserve is the gas which must be left to attempt the finalization of another packet
+	// of a batch. It is above the cost of a transfer with a completion hook.
+	FinalizePacketGasReserve = 300_000
+)
+
+func (m MsgFinalizePackets) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Join(
+			sdkerrors.ErrInvalidAddress,
+			errorsmod.Wrapf(err, "sender must be a valid bech32 address: %s", m.Sender),
+		)
+	}
+	if MaxFinalizePacketsBatch < m.Limit {
+		return gerrc.ErrInvalidArgument.Wrapf("limit must not exceed %d", MaxFinalizePacketsBatch)
+	}
+	if len(m.PacketKeys) == 0 {
+		if len(m.RollappId) == 0 {
+			return gerrc.ErrInvalidArgument.Wrap("rollappId must be non-empty without packet keys")
+		}
+		if m.MaxProofHeight != 0 && m.MaxProofHeight < m.MinProofHeight {
+			return gerrc.ErrInvalidArgument.Wrap("max proof height must not be below min proof height")
+		}
+		return nil
+	}
+	if MaxFinalizePacketsBatch < len(m.PacketKeys) {
+		return gerrc.ErrInvalidArgument.Wrapf("at most %d packet keys", MaxFinalizePacketsBatch)
+	}
+	seen := make(map[string]struct{}, len(m.PacketKeys))
+	for _, k := range m.PacketKeys {
+		if _, err := commontypes.DecodePacketKey(k); err != nil {
+			return gerrc.ErrInvalidArgument.Wrapf("packet key must be a valid base64 encoded string: %s", k)
+		}
+		if _, ok := seen[k]; ok {
+			return gerrc.ErrInvalidArgument.Wrapf("duplicate packet key: %s", k)
+		}
+		seen[k] = struct{}{}
+	}
+	return nil
+}
+
+// BatchLimit returns the maximum number of packets to finalize.
+func (m MsgFinalizePackets) BatchLimit() int {
+	if m.Limit == 0 {
+		return MaxFinalizePacketsBatch
+	}
+	return int(m.Limit)
+}
+
 func (m MsgUpdateParams) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(m.Authority)
 	if err != nil {
@@ -91,3 +149,67 @@ func (m MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+func (m MsgSetBridgingFeeOverride) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Join(
+			sdkerrors.ErrInvalidAddress,
+			errorsmod.Wrapf(err, "sender must be a valid bech32 address: %s", m.Sender),
+		)
+	}
+	return m.Override.ValidateBasic()
+}
+
+func (m MsgRemoveBridgingFeeOverride) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Join(
+			sdkerrors.ErrInvalidAddress,
+			errorsmod.Wrapf(err, "sender must be a valid bech32 address: %s", m.Sender),
+		)
+	}
+	if m.RollappId == "" && m.Denom == "" {
+		return gerrc.ErrInvalidArgument.Wrap("rollapp and denom must not both be empty")
+	}
+	return nil
+}
+
+func (m MsgSetRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Join(
+			sdkerrors.ErrInvalidAddress,
+			errorsmod.Wrapf(err, "sender must be a valid bech32 address: %s", m.Sender),
+		)
+	}
+	return m.RateLimit.ValidateBasic()
+}
+
+func (m MsgRemoveRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Join(
+			sdkerrors.ErrInvalidAddress,
+			errorsmod.Wrapf(err, "sender must be a valid bech32 address: %s", m.Sender),
+		)
+	}
+	if m.Denom == "" {
+		return gerrc.ErrInvalidArgument.Wrap("denom must be non-empty")
+	}
+	return nil
+}
+
+func (m MsgSetBridgePaused) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Join(
+			sdkerrors.ErrInvalidAddress,
+			errorsmod.Wrapf(err, "sender must be a valid bech32 address: %s", m.Sender),
+		)
+	}
+	if m.RollappId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("rollapp id must be non-empty")
+	}
+	return nil
+}
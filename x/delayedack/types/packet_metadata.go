@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
@@ -21,6 +22,15 @@ type EIBCMemo struct {
 	Fee string `json:"fee"`
 	// can be nil
 	OnCompletionHook []byte `json:"dym_on_completion,omitempty"`
+	// can be nil
+	FeeDecay *EIBCFeeDecay `json:"fee_decay,omitempty"`
+}
+
+// EIBCFeeDecay is an optional dutch auction schedule for the eIBC fee: the offered fee starts at the memo fee
+// and decreases by PerBlock every hub block, down to Floor.
+type EIBCFeeDecay struct {
+	Floor    string `json:"floor"`
+	PerBlock string `json:"per_block"`
 }
 
 func DefaultEIBCMemo() EIBCMemo {
@@ -71,6 +81,11 @@ func (e EIBCMemo) ValidateBasic() error {
 	if _, err := e.GetCompletionHook(); err != nil {
 		return fmt.Errorf("get on completion hook: %w", err)
 	}
+	if e.FeeDecay != nil {
+		if _, _, err := e.FeeDecayInts(); err != nil {
+			return fmt.Errorf("fee decay: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -82,6 +97,27 @@ func (e EIBCMemo) FeeInt() (math.Int, error) {
 	return i, nil
 }
 
+// FeeDecayInts returns the floor and the per block decay of the fee. The floor cannot exceed the fee, and
+// the per block decay cannot exceed the span between the fee and the floor.
+func (e EIBCMemo) FeeDecayInts() (floor, perBlock math.Int, err error) {
+	if e.FeeDecay == nil {
+		return math.Int{}, math.Int{}, ErrBadEIBCFeeDecay
+	}
+	fee, err := e.FeeInt()
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+	floor, ok := math.NewIntFromString(e.FeeDecay.Floor)
+	if !ok || floor.IsNegative() || floor.GT(fee) {
+		return math.Int{}, math.Int{}, errorsmod.Wrap(ErrBadEIBCFeeDecay, "floor")
+	}
+	perBlock, ok = math.NewIntFromString(e.FeeDecay.PerBlock)
+	if !ok || !perBlock.IsPositive() || perBlock.GT(fee.Sub(floor)) {
+		return math.Int{}, math.Int{}, errorsmod.Wrap(ErrBadEIBCFeeDecay, "per block")
+	}
+	return floor, perBlock, nil
+}
+
 const (
 	memoObjectKeyEIBC = "eibc"
 	memoObjectKeyPFM  = "forward" // not to be confused with dymension/x/forward
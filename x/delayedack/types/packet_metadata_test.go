@@ -40,6 +40,19 @@ func Test_parsePacketMetadata(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid with fee decay",
+			args{
+				`{"eibc":{"fee":"100","fee_decay":{"floor":"10","per_block":"2"}}}`,
+			},
+			&Memo{
+				EIBC: &EIBCMemo{
+					Fee:      "100",
+					FeeDecay: &EIBCFeeDecay{Floor: "10", PerBlock: "2"},
+				},
+			},
+			false,
+		},
 		{
 			"invalid - misquoted fee",
 			args{
@@ -78,3 +91,15 @@ func Test_parsePacketMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestEIBCMemoFeeDecay(t *testing.T) {
+	valid := EIBCMemo{Fee: "100", FeeDecay: &EIBCFeeDecay{Floor: "10", PerBlock: "90"}}
+	if err := valid.ValidateBasic(); err != nil {
+		t.Errorf("ValidateBasic() error = %v", err)
+	}
+	// a huge per block decay would overflow when multiplied by the number of blocks
+	invalid := EIBCMemo{Fee: "100", FeeDecay: &EIBCFeeDecay{Floor: "10", PerBlock: "91"}}
+	if err := invalid.ValidateBasic(); err == nil {
+		t.Errorf("ValidateBasic() per block exceeds fee minus floor: want error")
+	}
+}
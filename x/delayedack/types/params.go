@@ -10,6 +10,12 @@ import (
 const (
 	defaultEpochIdentifier         = "hour"
 	defaultDeletePacketsEpochLimit = 1000_000
+	defaultReceiptRetentionBlocks  = 201_600 // 2 weeks worth of blocks at 1 block per 6 seconds
+)
+
+var (
+	DefaultMinOwnerBridgingFee = math.LegacyNewDecWithPrec(5, 4) // 0.05%
+	DefaultMaxOwnerBridgingFee = math.LegacyNewDecWithPrec(1, 2) // 1%
 )
 
 // NewParams creates a new Params instance
@@ -23,11 +29,15 @@ func NewParams(epochIdentifier string, bridgingFee math.LegacyDec, deletePackets
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(
+	p := NewParams(
 		defaultEpochIdentifier,
 		math.LegacyNewDecWithPrec(1, 3), // 0.1%
 		defaultDeletePacketsEpochLimit,
 	)
+	p.ReceiptRetentionBlocks = defaultReceiptRetentionBlocks
+	p.MinOwnerBridgingFee = DefaultMinOwnerBridgingFee
+	p.MaxOwnerBridgingFee = DefaultMaxOwnerBridgingFee
+	return p
 }
 
 // Validate validates the set of params
@@ -53,6 +63,17 @@ func (p Params) ValidateBasic() error {
 	if p.DeletePacketsEpochLimit < 0 {
 		return fmt.Errorf("delete packet epoch limit must not be negative: %d", p.DeletePacketsEpochLimit)
 	}
+
+	// validate the range of the owner bridging fees
+	if err := validateBridgingFee(p.MinOwnerBridgingFee); err != nil {
+		return fmt.Errorf("min owner bridging fee: %w", err)
+	}
+	if err := validateBridgingFee(p.MaxOwnerBridgingFee); err != nil {
+		return fmt.Errorf("max owner bridging fee: %w", err)
+	}
+	if p.MinOwnerBridgingFee.GT(p.MaxOwnerBridgingFee) {
+		return fmt.Errorf("min owner bridging fee greater than max: %s > %s", p.MinOwnerBridgingFee, p.MaxOwnerBridgingFee)
+	}
 	return nil
 }
 
@@ -38,6 +38,16 @@ type Params struct {
 	// piling up packets that weren't deleted but rather "postponed", to
 	// subsequent epochs.
 	DeletePacketsEpochLimit int32 `protobuf:"varint,3,opt,name=delete_packets_epoch_limit,json=deletePacketsEpochLimit,proto3" json:"delete_packets_epoch_limit,omitempty" yaml:"delete_packets_epoch_limit"`
+	// `receipt_retention_blocks` is the number of blocks for which the receipt
+	// of a settled rollapp packet is kept. Receipts are pruned on epoch end,
+	// along with the finalized packets and within the same limit. Zero disables
+	// the receipts.
+	ReceiptRetentionBlocks uint64 `protobuf:"varint,4,opt,name=receipt_retention_blocks,json=receiptRetentionBlocks,proto3" json:"receipt_retention_blocks,omitempty" yaml:"receipt_retention_blocks"`
+	// `min_owner_bridging_fee` and `max_owner_bridging_fee` bound the fees of
+	// the bridging fee overrides set by rollapp owners, including their tiers.
+	// Governance overrides are not bound.
+	MinOwnerBridgingFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=min_owner_bridging_fee,json=minOwnerBridgingFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_owner_bridging_fee" yaml:"min_owner_bridging_fee"`
+	MaxOwnerBridgingFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_owner_bridging_fee,json=maxOwnerBridgingFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_owner_bridging_fee" yaml:"max_owner_bridging_fee"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -86,6 +96,13 @@ func (m *Params) GetDeletePacketsEpochLimit() int32 {
 	return 0
 }
 
+func (m *Params) GetReceiptRetentionBlocks() uint64 {
+	if m != nil {
+		return m.ReceiptRetentionBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.delayedack.Params")
 }
@@ -95,30 +112,36 @@ func init() {
 }
 
 var fileDescriptor_9516cc08de197609 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x52, 0x73, 0x12,
-	0x2b, 0x53, 0x53, 0x12, 0x93, 0xb3, 0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x14, 0x91, 0xd5, 0x23, 0x34, 0xeb, 0x21, 0xd4, 0x4b, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0x55, 0xeb, 0x83, 0x58, 0x10, 0x8d, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9,
-	0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x22, 0xa5, 0xb4, 0x96, 0x89, 0x8b, 0x2d, 0x00, 0x6c, 0x89,
-	0x90, 0x1b, 0x97, 0x40, 0x6a, 0x41, 0x7e, 0x72, 0x46, 0x7c, 0x66, 0x4a, 0x6a, 0x5e, 0x49, 0x66,
-	0x5a, 0x66, 0x6a, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xf4, 0xa7, 0x7b, 0xf2, 0xe2,
-	0x95, 0x89, 0xb9, 0x39, 0x56, 0x4a, 0xe8, 0x2a, 0x94, 0x82, 0xf8, 0xc1, 0x42, 0x9e, 0x70, 0x11,
-	0xa1, 0x42, 0x2e, 0x9e, 0xa4, 0xa2, 0xcc, 0x94, 0xf4, 0xcc, 0xbc, 0xf4, 0xf8, 0xb4, 0xd4, 0x54,
-	0x09, 0x26, 0xb0, 0x19, 0x7e, 0x27, 0xee, 0xc9, 0x33, 0xdc, 0xba, 0x27, 0x2f, 0x0d, 0xb1, 0xbe,
-	0x38, 0x25, 0x5b, 0x2f, 0x33, 0x5f, 0x3f, 0x37, 0xb1, 0x24, 0x43, 0xcf, 0x27, 0x35, 0x3d, 0x31,
-	0xb9, 0xd2, 0x25, 0x35, 0xf9, 0xd3, 0x3d, 0x79, 0x61, 0x88, 0x35, 0xc8, 0x06, 0x28, 0x5d, 0xda,
-	0xa2, 0x2b, 0x00, 0x75, 0x34, 0x5c, 0x69, 0x10, 0x37, 0x4c, 0x89, 0x5b, 0x6a, 0xaa, 0x50, 0x12,
-	0x97, 0x54, 0x4a, 0x6a, 0x4e, 0x6a, 0x49, 0x6a, 0x7c, 0x41, 0x62, 0x72, 0x76, 0x6a, 0x49, 0x71,
-	0x3c, 0xc4, 0x9d, 0x39, 0x99, 0xb9, 0x99, 0x25, 0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0xac, 0x4e, 0xaa,
-	0x9f, 0xee, 0xc9, 0x2b, 0x42, 0x4c, 0xc7, 0xad, 0x56, 0x29, 0x48, 0x1c, 0x22, 0x19, 0x00, 0x91,
-	0x73, 0x05, 0x49, 0xf9, 0x80, 0x64, 0xac, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x70, 0x0a, 0x3c, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xf3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x1c, 0x11, 0x5b, 0x66, 0xac, 0x5f, 0x81, 0x1c, 0xbb, 0x25, 0x95,
-	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x98, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xea,
-	0x4b, 0x2e, 0x0f, 0x02, 0x00, 0x00,
+	// 461 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6a, 0xd4, 0x40,
+	0x18, 0xc7, 0x13, 0xdd, 0x5d, 0x30, 0x0a, 0x96, 0x54, 0xda, 0xb8, 0xc5, 0x64, 0x1b, 0x11, 0xf6,
+	0x62, 0x72, 0xe8, 0x41, 0xe8, 0x31, 0x68, 0x41, 0x28, 0x5a, 0x73, 0x12, 0x41, 0x86, 0xc9, 0xe4,
+	0x6b, 0x76, 0xd8, 0x4c, 0x26, 0x26, 0xa3, 0x26, 0xbe, 0x83, 0xe0, 0xd1, 0xa3, 0x0f, 0xe0, 0xd1,
+	0x87, 0xe8, 0xb1, 0x78, 0x12, 0x0f, 0x41, 0x76, 0xdf, 0x60, 0x9f, 0x40, 0x32, 0xd3, 0x6e, 0xe3,
+	0xb2, 0x0b, 0xea, 0x2d, 0x33, 0xbf, 0xdf, 0x7c, 0xdf, 0x3f, 0x1f, 0x7c, 0x86, 0x17, 0xd7, 0x0c,
+	0xb2, 0x92, 0xf2, 0xac, 0xaa, 0x3f, 0xf8, 0xcb, 0x83, 0x1f, 0x43, 0x8a, 0x6b, 0x88, 0x31, 0x99,
+	0xfa, 0x39, 0x2e, 0x30, 0x2b, 0xbd, 0xbc, 0xe0, 0x82, 0x9b, 0xfb, 0x5d, 0xff, 0xea, 0xb1, 0x77,
+	0xe5, 0x0f, 0xef, 0x24, 0x3c, 0xe1, 0xd2, 0xf6, 0xdb, 0x2f, 0xf5, 0x70, 0x78, 0x97, 0xf0, 0x92,
+	0xf1, 0x12, 0x29, 0xa0, 0x0e, 0x0a, 0xb9, 0x5f, 0xfb, 0xc6, 0xe0, 0x44, 0x36, 0x31, 0x8f, 0x8c,
+	0x2d, 0xc8, 0x39, 0x99, 0x20, 0x1a, 0x43, 0x26, 0xe8, 0x29, 0x85, 0xc2, 0xd2, 0x47, 0xfa, 0xf8,
+	0x46, 0xb0, 0xb7, 0x68, 0x9c, 0xdd, 0x1a, 0xb3, 0xf4, 0xd0, 0x5d, 0x35, 0xdc, 0xf0, 0xb6, 0xbc,
+	0x7a, 0xba, 0xbc, 0x31, 0xdf, 0x18, 0xb7, 0xa2, 0x82, 0xc6, 0x09, 0xcd, 0x12, 0x74, 0x0a, 0x60,
+	0x5d, 0x93, 0x35, 0x9e, 0x9d, 0x35, 0x8e, 0xf6, 0xb3, 0x71, 0xf6, 0x54, 0xfb, 0x32, 0x9e, 0x7a,
+	0x94, 0xfb, 0x0c, 0x8b, 0x89, 0x77, 0x0c, 0x09, 0x26, 0xf5, 0x63, 0x20, 0x8b, 0xc6, 0xd9, 0x56,
+	0x6d, 0xba, 0x05, 0xdc, 0xef, 0xdf, 0x1e, 0x6e, 0x5d, 0x84, 0x5e, 0xaa, 0xe1, 0xcd, 0x4b, 0xe5,
+	0x08, 0xc0, 0x8c, 0x8c, 0x61, 0x0c, 0x29, 0x08, 0x40, 0x39, 0x26, 0x53, 0x10, 0x25, 0x52, 0x39,
+	0x53, 0xca, 0xa8, 0xb0, 0xae, 0x8f, 0xf4, 0x71, 0x3f, 0x78, 0xb0, 0x68, 0x9c, 0x7d, 0x55, 0x7d,
+	0xb3, 0xeb, 0x86, 0xbb, 0x0a, 0x9e, 0x28, 0xf6, 0xa4, 0x45, 0xc7, 0x2d, 0x31, 0x5f, 0x1b, 0x56,
+	0x01, 0x04, 0x68, 0x2e, 0x50, 0x01, 0xa2, 0xfd, 0x5b, 0x9e, 0xa1, 0x28, 0xe5, 0x64, 0x5a, 0x5a,
+	0xbd, 0x91, 0x3e, 0xee, 0x05, 0xf7, 0x17, 0x8d, 0xe3, 0xa8, 0x0e, 0x9b, 0x4c, 0x37, 0xdc, 0xb9,
+	0x40, 0xe1, 0x25, 0x09, 0x24, 0x30, 0x3f, 0xea, 0xc6, 0x0e, 0xa3, 0x19, 0xe2, 0xef, 0x33, 0x28,
+	0xd0, 0x1f, 0x03, 0xec, 0xcb, 0x01, 0xbe, 0xfc, 0xbb, 0x01, 0xde, 0x53, 0x01, 0xd6, 0x97, 0x5a,
+	0x3f, 0xca, 0x6d, 0x46, 0xb3, 0xe7, 0xad, 0x1b, 0x74, 0x46, 0x2a, 0xf3, 0xe0, 0x6a, 0x5d, 0x9e,
+	0xc1, 0xff, 0xe4, 0xc1, 0xd5, 0x3f, 0xe4, 0xc1, 0xd5, 0x6a, 0x9e, 0xc3, 0xde, 0xe7, 0x2f, 0x8e,
+	0x16, 0xbc, 0x38, 0x9b, 0xd9, 0xfa, 0xf9, 0xcc, 0xd6, 0x7f, 0xcd, 0x6c, 0xfd, 0xd3, 0xdc, 0xd6,
+	0xce, 0xe7, 0xb6, 0xf6, 0x63, 0x6e, 0x6b, 0xaf, 0x1e, 0x25, 0x54, 0x4c, 0xde, 0x46, 0x1e, 0xe1,
+	0xcc, 0xdf, 0xb0, 0x57, 0xef, 0x0e, 0xfc, 0xaa, 0xbb, 0x5c, 0xa2, 0xce, 0xa1, 0x8c, 0x06, 0x72,
+	0x11, 0x0e, 0x7e, 0x0f, 0x00, 0xeb, 0x19, 0xf6, 0x76, 0x8e, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -141,6 +164,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxOwnerBridgingFee.Size()
+		i -= size
+		if _, err := m.MaxOwnerBridgingFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinOwnerBridgingFee.Size()
+		i -= size
+		if _, err := m.MinOwnerBridgingFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ReceiptRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReceiptRetentionBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.DeletePacketsEpochLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DeletePacketsEpochLimit))
 		i--
@@ -192,6 +240,13 @@ func (m *Params) Size() (n int) {
 	if m.DeletePacketsEpochLimit != 0 {
 		n += 1 + sovParams(uint64(m.DeletePacketsEpochLimit))
 	}
+	if m.ReceiptRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ReceiptRetentionBlocks))
+	}
+	l = m.MinOwnerBridgingFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxOwnerBridgingFee.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -315,6 +370,93 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptRetentionBlocks", wireType)
+			}
+			m.ReceiptRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOwnerBridgingFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOwnerBridgingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOwnerBridgingFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOwnerBridgingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,809 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/delayedack/receipt.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/dymensionxyz/dymension/v3/x/common/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SettlementStatus is how a rollapp packet was settled.
+type SettlementStatus int32
+
+const (
+	// SETTLEMENT_FINALIZED packets were finalized.
+	SettlementStatus_SETTLEMENT_FINALIZED SettlementStatus = 0
+	// SETTLEMENT_REVERTED packets were reverted by a hard fork of the rollapp.
+	SettlementStatus_SETTLEMENT_REVERTED SettlementStatus = 1
+)
+
+var SettlementStatus_name = map[int32]string{
+	0: "SETTLEMENT_FINALIZED",
+	1: "SETTLEMENT_REVERTED",
+}
+
+var SettlementStatus_value = map[string]int32{
+	"SETTLEMENT_FINALIZED": 0,
+	"SETTLEMENT_REVERTED":  1,
+}
+
+func (x SettlementStatus) String() string {
+	return proto.EnumName(SettlementStatus_name, int32(x))
+}
+
+func (SettlementStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e4ab982111b76083, []int{0}
+}
+
+// SettledPacketReceipt is a compact record of how a rollapp packet was
+// settled. It outlives the rollapp packet, which is deleted after finalization,
+// and is pruned after the receipt_retention_blocks param.
+type SettledPacketReceipt struct {
+	// packet_key is the key of the rollapp packet when it was settled, base64
+	// encoded
+	PacketKey string                   `protobuf:"bytes,1,opt,name=packet_key,json=packetKey,proto3" json:"packet_key,omitempty"`
+	RollappId string                   `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Status    SettlementStatus         `protobuf:"varint,3,opt,name=status,proto3,enum=dymensionxyz.dymension.delayedack.SettlementStatus" json:"status,omitempty"`
+	Type      types.RollappPacket_Type `protobuf:"varint,4,opt,name=type,proto3,enum=dymensionxyz.dymension.common.RollappPacket_Type" json:"type,omitempty"`
+	// src_channel and sequence identify the IBC packet
+	SrcChannel string `protobuf:"bytes,5,opt,name=src_channel,json=srcChannel,proto3" json:"src_channel,omitempty"`
+	Sequence   uint64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// proof_height is the rollapp height of the packet
+	ProofHeight uint64 `protobuf:"varint,7,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height,omitempty"`
+	// settled_height is the hub height at which the packet was settled
+	SettledHeight uint64 `protobuf:"varint,8,opt,name=settled_height,json=settledHeight,proto3" json:"settled_height,omitempty"`
+	// error is the error of the transfer application on finalization, if any
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// order_id is the id of the eIBC demand order of the packet, if any
+	OrderId string `protobuf:"bytes,10,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// fulfillers are the addresses which fulfilled the eIBC order, if any
+	Fulfillers []string `protobuf:"bytes,11,rep,name=fulfillers,proto3" json:"fulfillers,omitempty"`
+}
+
+func (m *SettledPacketReceipt) Reset()         { *m = SettledPacketReceipt{} }
+func (m *SettledPacketReceipt) String() string { return proto.CompactTextString(m) }
+func (*SettledPacketReceipt) ProtoMessage()    {}
+func (*SettledPacketReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ab982111b76083, []int{0}
+}
+func (m *SettledPacketReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettledPacketReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettledPacketReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettledPacketReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettledPacketReceipt.Merge(m, src)
+}
+func (m *SettledPacketReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettledPacketReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettledPacketReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettledPacketReceipt proto.InternalMessageInfo
+
+func (m *SettledPacketReceipt) GetPacketKey() string {
+	if m != nil {
+		return m.PacketKey
+	}
+	return ""
+}
+
+func (m *SettledPacketReceipt) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *SettledPacketReceipt) GetStatus() SettlementStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SettlementStatus_SETTLEMENT_FINALIZED
+}
+
+func (m *SettledPacketReceipt) GetType() types.RollappPacket_Type {
+	if m != nil {
+		return m.Type
+	}
+	return types.RollappPacket_ON_RECV
+}
+
+func (m *SettledPacketReceipt) GetSrcChannel() string {
+	if m != nil {
+		return m.SrcChannel
+	}
+	return ""
+}
+
+func (m *SettledPacketReceipt) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *SettledPacketReceipt) GetProofHeight() uint64 {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return 0
+}
+
+func (m *SettledPacketReceipt) GetSettledHeight() uint64 {
+	if m != nil {
+		return m.SettledHeight
+	}
+	return 0
+}
+
+func (m *SettledPacketReceipt) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *SettledPacketReceipt) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *SettledPacketReceipt) GetFulfillers() []string {
+	if m != nil {
+		return m.Fulfillers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.delayedack.SettlementStatus", SettlementStatus_name, SettlementStatus_value)
+	proto.RegisterType((*SettledPacketReceipt)(nil), "dymensionxyz.dymension.delayedack.SettledPacketReceipt")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/delayedack/receipt.proto", fileDescriptor_e4ab982111b76083)
+}
+
+var fileDescriptor_e4ab982111b76083 = []byte{
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x14, 0x8c, 0x49, 0x9a, 0x26, 0x2f, 0x50, 0x45, 0x4b, 0x24, 0x96, 0x4a, 0x98, 0x14, 0x09, 0x29,
+	0xe2, 0x60, 0x8b, 0xe6, 0xc0, 0x19, 0xe8, 0x22, 0xa2, 0x96, 0x0a, 0x1c, 0x8b, 0x43, 0x2f, 0x96,
+	0x6b, 0xbf, 0x34, 0x56, 0x1c, 0xaf, 0xd9, 0x5d, 0xa3, 0x9a, 0xaf, 0xe0, 0x93, 0x38, 0x72, 0xec,
+	0x91, 0x23, 0x4a, 0x7e, 0x04, 0x79, 0xd7, 0x6d, 0x22, 0xa4, 0xa8, 0xc7, 0x99, 0x79, 0x6f, 0x76,
+	0x76, 0xf4, 0xc0, 0x8d, 0xcb, 0x25, 0x66, 0x32, 0xe1, 0xd9, 0x75, 0xf9, 0x63, 0x03, 0xdc, 0x18,
+	0xd3, 0xb0, 0xc4, 0x38, 0x8c, 0x16, 0xae, 0xc0, 0x08, 0x93, 0x5c, 0x39, 0xb9, 0xe0, 0x8a, 0x93,
+	0xa3, 0xed, 0x05, 0xe7, 0x0e, 0x38, 0x9b, 0x85, 0xc3, 0xe3, 0x1d, 0x9e, 0x11, 0x5f, 0x2e, 0x79,
+	0xe6, 0x0a, 0x9e, 0xa6, 0x61, 0x9e, 0x07, 0x79, 0x18, 0x2d, 0xb0, 0xb6, 0x7d, 0xf1, 0xab, 0x09,
+	0x83, 0x29, 0x2a, 0x95, 0x62, 0xfc, 0x59, 0xf3, 0x9e, 0x79, 0x95, 0x3c, 0x03, 0x30, 0x83, 0xc1,
+	0x02, 0x4b, 0x6a, 0x0d, 0xad, 0x51, 0xd7, 0xeb, 0x1a, 0xe6, 0x14, 0xcb, 0x4a, 0xbe, 0xf5, 0x4b,
+	0x62, 0xfa, 0xc0, 0xc8, 0x35, 0x33, 0x89, 0xc9, 0x29, 0xb4, 0xa5, 0x0a, 0x55, 0x21, 0x69, 0x73,
+	0x68, 0x8d, 0x0e, 0x8e, 0xc7, 0xce, 0xbd, 0xf1, 0x1d, 0x13, 0x63, 0x89, 0x99, 0x9a, 0xea, 0x55,
+	0xaf, 0xb6, 0x20, 0x0c, 0x5a, 0xaa, 0xcc, 0x91, 0xb6, 0xb4, 0xd5, 0xeb, 0x5d, 0x56, 0xe6, 0x9b,
+	0x8e, 0x67, 0x42, 0x98, 0xdf, 0x38, 0x7e, 0x99, 0xa3, 0xa7, 0xd7, 0xc9, 0x73, 0xe8, 0x49, 0x11,
+	0x05, 0xd1, 0x3c, 0xcc, 0x32, 0x4c, 0xe9, 0x9e, 0xce, 0x0c, 0x52, 0x44, 0xef, 0x0d, 0x43, 0x0e,
+	0xa1, 0x23, 0xf1, 0x5b, 0x81, 0x59, 0x84, 0xb4, 0x3d, 0xb4, 0x46, 0x2d, 0xef, 0x0e, 0x93, 0x23,
+	0x78, 0x98, 0x0b, 0xce, 0x67, 0xc1, 0x1c, 0x93, 0xab, 0xb9, 0xa2, 0xfb, 0x5a, 0xef, 0x69, 0xee,
+	0xa3, 0xa6, 0xc8, 0x4b, 0x38, 0x90, 0xa6, 0xc9, 0xdb, 0xa1, 0x8e, 0x1e, 0x7a, 0x54, 0xb3, 0xf5,
+	0xd8, 0x00, 0xf6, 0x50, 0x08, 0x2e, 0x68, 0x57, 0x07, 0x30, 0x80, 0x3c, 0x85, 0x0e, 0x17, 0x31,
+	0x8a, 0xaa, 0x4d, 0xd0, 0xc2, 0xbe, 0xc6, 0x93, 0x98, 0xd8, 0x00, 0xb3, 0x22, 0x9d, 0x25, 0x69,
+	0x8a, 0x42, 0xd2, 0xde, 0xb0, 0x59, 0xc5, 0xde, 0x30, 0xaf, 0x18, 0xf4, 0xff, 0xaf, 0x8e, 0x50,
+	0x18, 0x4c, 0x99, 0xef, 0x9f, 0xb1, 0x4f, 0xec, 0xdc, 0x0f, 0x3e, 0x4c, 0xce, 0xdf, 0x9e, 0x4d,
+	0x2e, 0xd8, 0x49, 0xbf, 0x41, 0x9e, 0xc0, 0xe3, 0x2d, 0xc5, 0x63, 0x5f, 0x99, 0xe7, 0xb3, 0x93,
+	0xbe, 0xf5, 0xee, 0xcb, 0xef, 0x95, 0x6d, 0xdd, 0xac, 0x6c, 0xeb, 0xef, 0xca, 0xb6, 0x7e, 0xae,
+	0xed, 0xc6, 0xcd, 0xda, 0x6e, 0xfc, 0x59, 0xdb, 0x8d, 0x8b, 0x37, 0x57, 0x89, 0x9a, 0x17, 0x97,
+	0x55, 0xc1, 0xbb, 0xce, 0xf6, 0xfb, 0xd8, 0xbd, 0xde, 0xbe, 0xdd, 0xaa, 0x70, 0x79, 0xd9, 0xd6,
+	0x37, 0x36, 0xfe, 0x37, 0x00, 0x60, 0x47, 0xed, 0x15, 0xed, 0x02, 0x00, 0x00,
+}
+
+func (m *SettledPacketReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettledPacketReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettledPacketReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fulfillers) > 0 {
+		for iNdEx := len(m.Fulfillers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fulfillers[iNdEx])
+			copy(dAtA[i:], m.Fulfillers[iNdEx])
+			i = encodeVarintReceipt(dAtA, i, uint64(len(m.Fulfillers[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SettledHeight != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.SettledHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ProofHeight != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SrcChannel) > 0 {
+		i -= len(m.SrcChannel)
+		copy(dAtA[i:], m.SrcChannel)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.SrcChannel)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Type != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PacketKey) > 0 {
+		i -= len(m.PacketKey)
+		copy(dAtA[i:], m.PacketKey)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.PacketKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReceipt(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReceipt(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SettledPacketReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovReceipt(uint64(m.Status))
+	}
+	if m.Type != 0 {
+		n += 1 + sovReceipt(uint64(m.Type))
+	}
+	l = len(m.SrcChannel)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovReceipt(uint64(m.Sequence))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovReceipt(uint64(m.ProofHeight))
+	}
+	if m.SettledHeight != 0 {
+		n += 1 + sovReceipt(uint64(m.SettledHeight))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	if len(m.Fulfillers) > 0 {
+		for _, s := range m.Fulfillers {
+			l = len(s)
+			n += 1 + l + sovReceipt(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovReceipt(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReceipt(x uint64) (n int) {
+	return sovReceipt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SettledPacketReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReceipt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettledPacketReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettledPacketReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SettlementStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledHeight", wireType)
+			}
+			m.SettledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettledHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfillers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfillers = append(m.Fulfillers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReceipt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReceipt(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReceipt
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReceipt
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReceipt
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReceipt
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReceipt        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReceipt          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReceipt = fmt.Errorf("proto: unexpected end of group")
+)
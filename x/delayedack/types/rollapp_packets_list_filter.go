@@ -35,6 +35,21 @@ func PendingByRollappIDByMaxHeight(
 	}
 }
 
+// PendingByRollappIDByHeightRange lists the pending packets of the rollapp with a proof height in
+// [minProofHeight, maxProofHeight].
+func PendingByRollappIDByHeightRange(rollappID string, minProofHeight, maxProofHeight uint64) RollappPacketListFilter {
+	status := commontypes.Status_PENDING
+	return RollappPacketListFilter{
+		Prefixes: []Prefix{
+			{
+				Start: commontypes.RollappPacketByStatusByRollappIDByProofHeightPrefix(rollappID, status, minProofHeight),
+				End:   commontypes.RollappPacketByStatusByRollappIDByProofHeightPrefix(rollappID, status, maxProofHeight+1), // inclusive end
+			},
+		},
+		FilterFunc: bypassFilter,
+	}
+}
+
 func PendingByRollappIDFromHeight(rollappID string, fromHeight uint64) RollappPacketListFilter {
 	return RollappPacketListFilter{
 		Prefixes: []Prefix{
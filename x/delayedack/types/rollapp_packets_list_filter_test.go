@@ -214,6 +214,17 @@ func TestPendingByRollappIDByMaxHeight(t *testing.T) {
 	}
 }
 
+func TestPendingByRollappIDByHeightRange(t *testing.T) {
+	filter := types.PendingByRollappIDByHeightRange("testRollappID1", 10, 100)
+	want := []types.Prefix{
+		{
+			Start: []uint8{0x0, 0x1, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x70, 0x49, 0x44, 0x31, 0x2f, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0a},
+			End:   []uint8{0x0, 0x1, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x70, 0x49, 0x44, 0x31, 0x2f, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x65},
+		},
+	}
+	require.Equal(t, want, filter.Prefixes)
+}
+
 func TestByType(t *testing.T) {
 	type args struct {
 		packetType commontypes.RollappPacket_Type
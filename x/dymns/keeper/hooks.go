@@ -79,8 +79,16 @@ func (h rollappHooks) AfterStateFinalized(_ sdk.Context, _ string, _ *rollapptyp
 	return nil
 }
 
+func (h rollappHooks) AfterBlockDescriptorProven(_ sdk.Context, _ *rollapptypes.StateInfo, _ rollapptypes.BlockDescriptor) error {
+	return nil
+}
+
 func (h rollappHooks) OnHardFork(_ sdk.Context, _ string, _ uint64) error { return nil }
 
+func (h rollappHooks) AfterLifecycleStateChanged(_ sdk.Context, _ string, _, _ rollapptypes.Rollapp_LifecycleState) error {
+	return nil
+}
+
 func (h rollappHooks) AfterTransfersEnabled(_ sdk.Context, _, _ string) error {
 	return nil
 }
@@ -26,8 +26,13 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListDemandOrdersByStatus())
+	cmd.AddCommand(CmdListDemandOrders())
+	cmd.AddCommand(CmdListClaimListings())
+	cmd.AddCommand(CmdListRevertedDemandOrders())
 	cmd.AddCommand(CmdQueryOnDemandLPs())
 	cmd.AddCommand(CmdQueryOnDemandLPsAddr())
+	cmd.AddCommand(CmdQueryOnDemandLPStats())
+	cmd.AddCommand(CmdQueryFulfillerStats())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -143,6 +143,180 @@ func CmdListDemandOrdersByStatus() *cobra.Command {
 	return cmd
 }
 
+func CmdListDemandOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "demand-orders",
+		Short: "List demand orders in any status, with optional filters",
+		Long: `Query demand orders with optional filters on rollapp, denom, min fee percentage, packet type (recv, timeout, ack),
+recipient, fulfiller and fulfillment state (fulfilled, unfulfilled). Results are ordered by id and paginated.`,
+		Example: "dymd q eibc demand-orders --rollapp rollapp_1234-1 --min-fee 0.01 --fulfilled unfulfilled",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			request := &types.QueryDemandOrdersRequest{
+				Type:       commontypes.RollappPacket_UNDEFINED, // default to undefined, as '0' is a valid type
+				Pagination: pageReq,
+			}
+
+			request.RollappId, err = cmd.Flags().GetString("rollapp")
+			if err != nil {
+				return err
+			}
+
+			request.Denom, err = cmd.Flags().GetString("denom")
+			if err != nil {
+				return err
+			}
+
+			request.MinFeePercent, err = cmd.Flags().GetString("min-fee")
+			if err != nil {
+				return err
+			}
+
+			packetType, err := cmd.Flags().GetString("type")
+			if err != nil {
+				return err
+			}
+			if packetType != "" {
+				packetType = strings.ToUpper(packetType)
+				if !strings.HasPrefix(packetType, "ON_") {
+					packetType = "ON_" + packetType
+				}
+				ptype, ok := commontypes.RollappPacket_Type_value[packetType]
+				if !ok {
+					return fmt.Errorf("invalid packet type: %s", packetType)
+				}
+				request.Type = commontypes.RollappPacket_Type(ptype)
+			}
+
+			request.Recipient, err = cmd.Flags().GetString("recipient")
+			if err != nil {
+				return err
+			}
+
+			request.Fulfiller, err = cmd.Flags().GetString("fulfiller")
+			if err != nil {
+				return err
+			}
+
+			fulfilled, err := cmd.Flags().GetString("fulfilled")
+			if err != nil {
+				return err
+			}
+			if fulfilled != "" {
+				fulfillmentState, ok := types.FulfillmentState_value[strings.ToUpper(fulfilled)]
+				if !ok {
+					return fmt.Errorf("invalid fulfillment state: %s", fulfilled)
+				}
+				request.FulfillmentState = types.FulfillmentState(fulfillmentState)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DemandOrders(cmd.Context(), request)
+			if err != nil {
+				return fmt.Errorf("failed to fetch demand orders: %w", err)
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringP("rollapp", "r", "", "Rollapp ID")
+	cmd.Flags().StringP("denom", "d", "", "Denom")
+	cmd.Flags().StringP("min-fee", "m", "", "Min fee, as a percentage of the price in [0,1]")
+	cmd.Flags().StringP("type", "t", "", "Packet type")
+	cmd.Flags().StringP("recipient", "c", "", "Recipient address")
+	cmd.Flags().StringP("fulfiller", "a", "", "Fulfiller address")
+	cmd.Flags().StringP("fulfilled", "f", "", "Filter by fulfillment status")
+	flags.AddPaginationFlagsToCmd(cmd, "demand-orders")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListClaimListings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-listings [order-id]",
+		Short:   "List the claims of fulfilled orders offered for sale",
+		Example: "dymd query eibc claim-listings\ndymd query eibc claim-listings <order-id>",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			request := &types.QueryClaimListingsRequest{Pagination: pageReq}
+			if len(args) == 1 {
+				request.OrderId = args[0]
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClaimListings(cmd.Context(), request)
+			if err != nil {
+				return fmt.Errorf("failed to fetch claim listings: %w", err)
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "claim-listings")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListRevertedDemandOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reverted-demand-orders [rollapp-id]",
+		Short:   "List the fulfilled demand orders whose packet was reverted by a hard fork",
+		Example: "dymd query eibc reverted-demand-orders\ndymd query eibc reverted-demand-orders <rollapp-id>",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			request := &types.QueryRevertedDemandOrdersRequest{Pagination: pageReq}
+			if len(args) == 1 {
+				request.RollappId = args[0]
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RevertedDemandOrders(cmd.Context(), request)
+			if err != nil {
+				return fmt.Errorf("failed to fetch reverted demand orders: %w", err)
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "reverted-demand-orders")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func parseAndFormat(amount sdk.Coins) string {
 	if len(amount) == 0 {
 		return "0"
@@ -64,3 +64,55 @@ func CmdQueryOnDemandLPsAddr() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryOnDemandLPStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lp-demand-stats [id]",
+		Short: "Query the aggregate stats of an on demand lp",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			m := &types.QueryOnDemandLPStatsRequest{Id: id}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OnDemandLPStats(cmd.Context(), m)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryFulfillerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fulfiller-stats [addr]",
+		Short: "Query the aggregate stats of a fulfiller",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m := &types.QueryFulfillerStatsRequest{Address: args[0]}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FulfillerStats(cmd.Context(), m)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -25,12 +25,17 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(NewFulfillOrderTxCmd())
+	cmd.AddCommand(NewFulfillOrderPartialTxCmd())
 	cmd.AddCommand(NewFulfillOrderAuthorizedTxCmd())
 	cmd.AddCommand(NewUpdateDemandOrderTxCmd())
 	cmd.AddCommand(NewCmdGrantAuthorization())
 	cmd.AddCommand(NewCmdTryFulfillOnDemand())
 	cmd.AddCommand(NewCmdCreateOnDemandLP())
 	cmd.AddCommand(NewCmdDeleteOnDemandLP())
+	cmd.AddCommand(NewCmdTransferFulfilledClaim())
+	cmd.AddCommand(NewCmdListFulfilledClaim())
+	cmd.AddCommand(NewCmdDelistFulfilledClaim())
+	cmd.AddCommand(NewCmdBuyFulfilledClaim())
 	return cmd
 }
 
@@ -69,11 +74,57 @@ func NewFulfillOrderTxCmd() *cobra.Command {
 	return cmd
 }
 
+func NewFulfillOrderPartialTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fulfill-order-partial [order-id] [expected-fee-amount] [amount]",
+		Short:   "Fulfill a part of an eibc order",
+		Example: "dymd tx eibc fulfill-order-partial <order-id> <expected-fee-amount> <amount>",
+		Long: `Fulfill a part of an eibc order by providing the order ID, the expected fee amount and the part of the price to pay.
+		On finalization, the proceeds of the order are split between the fulfillers pro-rata to the amount each of them paid.
+		`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			orderId := args[0]
+			fee := args[1]
+
+			amount, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount")
+			}
+
+			msg := types.NewMsgFulfillOrderPartial(
+				clientCtx.GetFromAddress().String(),
+				orderId,
+				fee,
+				amount,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 const (
 	FlagOperatorFeeAddress = "operator-fee-address"
 	FlagRollappId          = "rollapp-id"
 	FlagPrice              = "price"
 	FlagAmount             = "amount"
+
+	FlagWindowSpendLimit      = "window-spend-limit"
+	FlagWindowBlocks          = "window-blocks"
+	FlagWindowEpochIdentifier = "window-epoch-identifier"
+	FlagRollappSpendLimit     = "rollapp-spend-limit"
 )
 
 func NewFulfillOrderAuthorizedTxCmd() *cobra.Command {
@@ -248,7 +299,7 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 		Use:     "create-demand-lp [rollapp] [denom] [max-price] [min-fee] [spend-limit] [order-min-age-blocks]",
 		Short:   short,
 		Long:    long,
-		Example: "dymd tx eibc create-demand-lp rollapp1 foo 1000 0.005 500 100",
+		Example: "dymd tx eibc create-demand-lp rollapp1 foo 1000 0.005 500 100 --window-spend-limit 200 --window-epoch-identifier day",
 
 		Args: cobra.ExactArgs(6),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -280,6 +331,40 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 				return fmt.Errorf("invalid order min age blocks: %w", err)
 			}
 
+			windowSpendLimit := math.ZeroInt()
+			windowSpendLimitStr, err := cmd.Flags().GetString(FlagWindowSpendLimit)
+			if err != nil {
+				return err
+			}
+			if windowSpendLimitStr != "" {
+				windowSpendLimit, ok = math.NewIntFromString(windowSpendLimitStr)
+				if !ok {
+					return fmt.Errorf("invalid window spend limit")
+				}
+			}
+
+			windowBlocks, err := cmd.Flags().GetUint64(FlagWindowBlocks)
+			if err != nil {
+				return err
+			}
+
+			windowEpochIdentifier, err := cmd.Flags().GetString(FlagWindowEpochIdentifier)
+			if err != nil {
+				return err
+			}
+
+			rollappSpendLimit := math.ZeroInt()
+			rollappSpendLimitStr, err := cmd.Flags().GetString(FlagRollappSpendLimit)
+			if err != nil {
+				return err
+			}
+			if rollappSpendLimitStr != "" {
+				rollappSpendLimit, ok = math.NewIntFromString(rollappSpendLimitStr)
+				if !ok {
+					return fmt.Errorf("invalid rollapp spend limit")
+				}
+			}
+
 			msg := &types.MsgCreateOnDemandLP{
 				Lp: &types.OnDemandLP{
 					FundsAddr:         clientCtx.GetFromAddress().String(),
@@ -289,6 +374,12 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 					MinFee:            minFee,
 					SpendLimit:        spendLimit,
 					OrderMinAgeBlocks: orderMinAgeBlocks,
+
+					WindowSpendLimit:      windowSpendLimit,
+					WindowBlocks:          windowBlocks,
+					WindowEpochIdentifier: windowEpochIdentifier,
+
+					RollappSpendLimit: rollappSpendLimit,
 				},
 				Signer: clientCtx.GetFromAddress().String(),
 			}
@@ -301,6 +392,10 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagWindowSpendLimit, "", "Max amount spent per window, regardless of repayments")
+	cmd.Flags().Uint64(FlagWindowBlocks, 0, "Length of the window in blocks")
+	cmd.Flags().String(FlagWindowEpochIdentifier, "", "Epoch identifier of the window, instead of blocks")
+	cmd.Flags().String(FlagRollappSpendLimit, "", "Max amount spent on unfinalized orders of the rollapp by all your lps")
 
 	return cmd
 }
@@ -339,3 +434,136 @@ func NewCmdDeleteOnDemandLP() *cobra.Command {
 
 	return cmd
 }
+
+func NewCmdTransferFulfilledClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-claim [order-id] [new-owner]",
+		Short:   "Transfer the claim on the proceeds of a fulfilled order",
+		Example: "dymd tx eibc transfer-claim <order-id> <new-owner>",
+		Long: `Transfer the claim on the proceeds of a fulfilled order to another account, which gets paid when the order is finalized.
+		For a partially fulfilled order, the claim is the share of the sender.
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgTransferFulfilledClaim{
+				Owner:    clientCtx.GetFromAddress().String(),
+				OrderId:  args[0],
+				NewOwner: args[1],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCmdListFulfilledClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-claim [order-id] [price]",
+		Short:   "Offer the claim on the proceeds of a fulfilled order for sale",
+		Example: "dymd tx eibc list-claim <order-id> 1000adym",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid price: %w", err)
+			}
+
+			msg := &types.MsgListFulfilledClaim{
+				Owner:   clientCtx.GetFromAddress().String(),
+				OrderId: args[0],
+				Price:   price,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCmdDelistFulfilledClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delist-claim [order-id]",
+		Short:   "Withdraw the claim on the proceeds of a fulfilled order from sale",
+		Example: "dymd tx eibc delist-claim <order-id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDelistFulfilledClaim{
+				Owner:   clientCtx.GetFromAddress().String(),
+				OrderId: args[0],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCmdBuyFulfilledClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "buy-claim [order-id] [seller] [expected-price]",
+		Short:   "Buy a listed claim on the proceeds of a fulfilled order",
+		Example: "dymd tx eibc buy-claim <order-id> <seller> 1000adym",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid expected price: %w", err)
+			}
+
+			msg := &types.MsgBuyFulfilledClaim{
+				Buyer:         clientCtx.GetFromAddress().String(),
+				OrderId:       args[0],
+				Seller:        args[1],
+				ExpectedPrice: price,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
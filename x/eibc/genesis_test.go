@@ -54,9 +54,10 @@ func TestInitGenesis(t *testing.T) {
 func TestExportGenesis(t *testing.T) {
 	k, ctx := keepertest.EIBCKeeper(t)
 	params := types.Params{
-		EpochIdentifier: "week",
-		TimeoutFee:      math.LegacyNewDecWithPrec(4, 1),
-		ErrackFee:       math.LegacyNewDecWithPrec(4, 1),
+		EpochIdentifier:              "week",
+		TimeoutFee:                   math.LegacyNewDecWithPrec(4, 1),
+		ErrackFee:                    math.LegacyNewDecWithPrec(4, 1),
+		RevertedOrderRetentionBlocks: 100,
 	}
 	// Set some demand orders
 	demandOrders := []types.DemandOrder{
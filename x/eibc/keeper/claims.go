@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+var ClaimListingsPrefix = collections.NewPrefix("cl0")
+
+// <order id,seller> -> listing
+type claimListings = collections.Map[collections.Pair[string, string], types.ClaimListing]
+
+func makeClaimListings(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) claimListings {
+	return collections.NewMap(
+		sb, ClaimListingsPrefix, "claimListings",
+		collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		codec.CollValue[types.ClaimListing](cdc),
+	)
+}
+
+// fulfilledClaimOrder returns the pending order with the given id, provided there is a claim on its proceeds.
+// The shares of an order which is not completely filled are not a claim: they are refunded if the packet is
+// settled before the order is filled.
+func (k Keeper) fulfilledClaimOrder(ctx sdk.Context, orderID string) (*types.DemandOrder, error) {
+	o, err := k.GetDemandOrder(ctx, commontypes.Status_PENDING, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if !o.IsFulfilled() {
+		return nil, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "order is not fulfilled")
+	}
+	return o, nil
+}
+
+// checkClaimOwner returns an error unless the address gets paid for the order on finalization. For a
+// partially fulfilled order, this is any of the partial fulfillers. Otherwise, this is the transfer target
+// of the underlying packet, which is not necessarily the fulfiller, e.g. for authorized fulfillments.
+func (k Keeper) checkClaimOwner(ctx sdk.Context, o *types.DemandOrder, owner string) error {
+	if o.IsPartiallyFulfilled() {
+		if !o.HasFulfiller(owner) {
+			return types.ErrNotClaimOwner
+		}
+		return nil
+	}
+	p, err := k.dack.GetRollappPacket(ctx, o.TrackingPacketKey)
+	if err != nil {
+		return errorsmod.Wrap(err, "get rollapp packet")
+	}
+	target, err := p.TransferTarget()
+	if err != nil {
+		return errorsmod.Wrap(err, "transfer target")
+	}
+	if target != owner {
+		return types.ErrNotClaimOwner
+	}
+	return nil
+}
+
+// TransferFulfilledClaim hands over the claim of the from address on the proceeds of the order to the to
+// address. The price is only used for the event, it is nil for a plain transfer.
+func (k Keeper) TransferFulfilledClaim(ctx sdk.Context, orderID string, from, to sdk.AccAddress, price *sdk.Coin) error {
+	o, err := k.fulfilledClaimOrder(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if err := k.checkClaimOwner(ctx, o, from.String()); err != nil {
+		return err
+	}
+	if k.bk.BlockedAddr(to) {
+		return errorsmod.Wrapf(types.ErrBlockedAddress, "new owner: %s", to)
+	}
+
+	if o.IsPartiallyFulfilled() {
+		o.TransferPartialFulfillment(from.String(), to.String())
+		if err := k.SetDemandOrder(ctx, o); err != nil {
+			return errorsmod.Wrap(err, "set demand order")
+		}
+		// The share of the new owner changed, so its listing, priced for the old share, is withdrawn
+		if err := k.delistMergedClaim(ctx, orderID, to); err != nil {
+			return errorsmod.Wrap(err, "delist merged claim")
+		}
+	} else {
+		// The on-demand lp which fulfilled the order, if any, is still repaid on finalization only
+		if err := k.dack.UpdateRollappPacketTransferAddress(ctx, o.TrackingPacketKey, to.String()); err != nil {
+			return errorsmod.Wrap(err, "update packet transfer address")
+		}
+	}
+
+	if err := k.claimListings.Remove(ctx, collections.Join(orderID, from.String())); err != nil {
+		return errorsmod.Wrap(err, "remove listing")
+	}
+
+	var priceStr string
+	if price != nil {
+		priceStr = price.String()
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventFulfilledClaimTransferred{
+		OrderId: orderID,
+		From:    from.String(),
+		To:      to.String(),
+		Price:   priceStr,
+	})
+}
+
+// ListFulfilledClaim offers the claim of the owner for sale, or updates its price if it is already listed.
+func (k Keeper) ListFulfilledClaim(ctx sdk.Context, orderID string, owner sdk.AccAddress, price sdk.Coin) error {
+	o, err := k.fulfilledClaimOrder(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if err := k.checkClaimOwner(ctx, o, owner.String()); err != nil {
+		return err
+	}
+	listing := types.ClaimListing{
+		OrderId: orderID,
+		Seller:  owner.String(),
+		Price:   price,
+	}
+	if err := k.claimListings.Set(ctx, collections.Join(orderID, owner.String()), listing); err != nil {
+		return errorsmod.Wrap(err, "set listing")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventFulfilledClaimListed{
+		OrderId: orderID,
+		Seller:  owner.String(),
+		Price:   price.String(),
+	})
+}
+
+// delistMergedClaim withdraws the listing of the owner, if any, after another share was merged into its share.
+func (k Keeper) delistMergedClaim(ctx sdk.Context, orderID string, owner sdk.AccAddress) error {
+	err := k.DelistFulfilledClaim(ctx, orderID, owner)
+	if errorsmod.IsOf(err, types.ErrClaimNotListed) {
+		return nil
+	}
+	return err
+}
+
+// DelistFulfilledClaim withdraws the claim of the owner from sale.
+func (k Keeper) DelistFulfilledClaim(ctx sdk.Context, orderID string, owner sdk.AccAddress) error {
+	key := collections.Join(orderID, owner.String())
+	ok, err := k.claimListings.Has(ctx, key)
+	if err != nil {
+		return errorsmod.Wrap(err, "has listing")
+	}
+	if !ok {
+		return types.ErrClaimNotListed
+	}
+	if err := k.claimListings.Remove(ctx, key); err != nil {
+		return errorsmod.Wrap(err, "remove listing")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventFulfilledClaimDelisted{
+		OrderId: orderID,
+		Seller:  owner.String(),
+	})
+}
+
+// BuyFulfilledClaim pays the listed price to the seller and transfers the claim to the buyer. The listed
+// price must match the expected price.
+func (k Keeper) BuyFulfilledClaim(ctx sdk.Context, orderID string, buyer, seller sdk.AccAddress, expectedPrice sdk.Coin) error {
+	listing, err := k.claimListings.Get(ctx, collections.Join(orderID, seller.String()))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrClaimNotListed
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get listing")
+	}
+	if !listing.Price.Equal(expectedPrice) {
+		return errorsmod.Wrapf(types.ErrPriceMismatch, "listed: %s: expected: %s", listing.Price, expectedPrice)
+	}
+	if err := k.bk.SendCoins(ctx, buyer, seller, sdk.NewCoins(listing.Price)); err != nil {
+		return errorsmod.Wrap(err, "pay seller")
+	}
+	return k.TransferFulfilledClaim(ctx, orderID, seller, buyer, &listing.Price)
+}
+
+// GetClaimListings returns the listings of the claims on the order.
+func (k Keeper) GetClaimListings(ctx sdk.Context, orderID string) ([]types.ClaimListing, error) {
+	rng := collections.NewPrefixedPairRange[string, string](orderID)
+	iter, err := k.claimListings.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// removeClaimListings drops all the listings of the claims on the order, once the order can no longer
+// change hands.
+func (k Keeper) removeClaimListings(ctx sdk.Context, orderID string) error {
+	listings, err := k.GetClaimListings(ctx, orderID)
+	if err != nil {
+		return errorsmod.Wrap(err, "get claim listings")
+	}
+	for _, l := range listings {
+		if err := k.claimListings.Remove(ctx, collections.Join(l.OrderId, l.Seller)); err != nil {
+			return errorsmod.Wrap(err, "remove listing")
+		}
+		if err := uevent.EmitTypedEvent(ctx, &types.EventFulfilledClaimDelisted{
+			OrderId: l.OrderId,
+			Seller:  l.Seller,
+		}); err != nil {
+			return errorsmod.Wrap(err, "emit event")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func (suite *KeeperTestSuite) TestFulfilledClaimSale() {
+	denom := sdk.DefaultBondDenom
+	k := suite.App.EIBCKeeper
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 4, math.NewInt(1000))
+	recipient, fulfiller, buyer, other := addrs[0], addrs[1], addrs[2], addrs[3]
+
+	rPacket := *rollappPacket
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, rPacket)
+	order := types.NewDemandOrder(rPacket, math.NewInt(100), math.NewInt(10), denom, recipient.String(), 1, nil)
+	suite.Require().NoError(k.SetDemandOrder(suite.Ctx, order))
+
+	claimOwner := func() string {
+		p, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, string(rollappPacketKey))
+		suite.Require().NoError(err)
+		target, err := p.TransferTarget()
+		suite.Require().NoError(err)
+		// the ack written back to the rollapp is always for the original receiver
+		suite.Require().Equal(eibcReceiverAddr.String(), p.OriginalTransferTarget)
+		return target
+	}
+	listings := func() []types.ClaimListing {
+		res, err := suite.queryClient.ClaimListings(suite.Ctx, &types.QueryClaimListingsRequest{OrderId: order.Id})
+		suite.Require().NoError(err)
+		return res.Listings
+	}
+	price := sdk.NewInt64Coin(denom, 105)
+
+	// nothing to sell before fulfillment
+	_, err := suite.msgServer.ListFulfilledClaim(suite.Ctx, &types.MsgListFulfilledClaim{Owner: fulfiller.String(), OrderId: order.Id, Price: price})
+	suite.Require().True(errorsmod.IsOf(err, gerrc.ErrFailedPrecondition))
+
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfiller.String(), order.Id, "10"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(fulfiller.String(), claimOwner())
+
+	// only the owner can sell
+	_, err = suite.msgServer.ListFulfilledClaim(suite.Ctx, &types.MsgListFulfilledClaim{Owner: other.String(), OrderId: order.Id, Price: price})
+	suite.Require().True(errorsmod.IsOf(err, types.ErrNotClaimOwner))
+	_, err = suite.msgServer.ListFulfilledClaim(suite.Ctx, &types.MsgListFulfilledClaim{Owner: fulfiller.String(), OrderId: order.Id, Price: price})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ClaimListing{{OrderId: order.Id, Seller: fulfiller.String(), Price: price}}, listings())
+
+	// the buyer must agree on the listed price
+	_, err = suite.msgServer.BuyFulfilledClaim(suite.Ctx, &types.MsgBuyFulfilledClaim{
+		Buyer: buyer.String(), OrderId: order.Id, Seller: fulfiller.String(), ExpectedPrice: sdk.NewInt64Coin(denom, 100),
+	})
+	suite.Require().True(errorsmod.IsOf(err, types.ErrPriceMismatch))
+	_, err = suite.msgServer.BuyFulfilledClaim(suite.Ctx, &types.MsgBuyFulfilledClaim{
+		Buyer: buyer.String(), OrderId: order.Id, Seller: fulfiller.String(), ExpectedPrice: price,
+	})
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventFulfilledClaimTransferred{}), 1)
+
+	suite.Require().Equal(buyer.String(), claimOwner())
+	suite.Require().Empty(listings())
+	suite.Require().Equal(math.NewInt(1005), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller, denom).Amount)
+	suite.Require().Equal(math.NewInt(895), suite.App.BankKeeper.GetBalance(suite.Ctx, buyer, denom).Amount)
+
+	// the former owner cannot transfer the claim anymore, the new owner can
+	_, err = suite.msgServer.TransferFulfilledClaim(suite.Ctx, &types.MsgTransferFulfilledClaim{Owner: fulfiller.String(), OrderId: order.Id, NewOwner: other.String()})
+	suite.Require().True(errorsmod.IsOf(err, types.ErrNotClaimOwner))
+	_, err = suite.msgServer.TransferFulfilledClaim(suite.Ctx, &types.MsgTransferFulfilledClaim{Owner: buyer.String(), OrderId: order.Id, NewOwner: other.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(other.String(), claimOwner())
+
+	// listings are dropped on finalization
+	_, err = suite.msgServer.ListFulfilledClaim(suite.Ctx, &types.MsgListFulfilledClaim{Owner: other.String(), OrderId: order.Id, Price: price})
+	suite.Require().NoError(err)
+	suite.Require().Len(listings(), 1)
+	p, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, string(rollappPacketKey))
+	suite.Require().NoError(err)
+	p.Status = commontypes.Status_FINALIZED
+	err = k.GetDelayedAckHooks().AfterPacketStatusUpdated(suite.Ctx, p, string(rollappPacketKey), string(p.RollappPacketKey()))
+	suite.Require().NoError(err)
+	suite.Require().Empty(listings())
+}
+
+func (suite *KeeperTestSuite) TestFulfilledClaimPartialShare() {
+	denom := sdk.DefaultBondDenom
+	k := suite.App.EIBCKeeper
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1000))
+	recipient, fulfillerA, fulfillerB := addrs[0], addrs[1], addrs[2]
+
+	rPacket := *rollappPacket
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, rPacket)
+	order := types.NewDemandOrder(rPacket, math.NewInt(300), math.NewInt(30), denom, recipient.String(), 1, nil)
+	suite.Require().NoError(k.SetDemandOrder(suite.Ctx, order))
+
+	_, err := suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfillerA.String(), order.Id, "30", math.NewInt(100)))
+	suite.Require().NoError(err)
+	// the share may still be refunded, it is not a claim on the proceeds until the order is filled
+	err = k.ListFulfilledClaim(suite.Ctx, order.Id, fulfillerA, sdk.NewInt64Coin(denom, 50))
+	suite.Require().True(errorsmod.IsOf(err, gerrc.ErrFailedPrecondition))
+	_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfillerB.String(), order.Id, "30", math.NewInt(200)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.ListFulfilledClaim(suite.Ctx, order.Id, fulfillerB, sdk.NewInt64Coin(denom, 150)))
+
+	// the share of A is merged into the share of B, whose listing priced for the old share is withdrawn
+	_, err = suite.msgServer.TransferFulfilledClaim(suite.Ctx, &types.MsgTransferFulfilledClaim{Owner: fulfillerA.String(), OrderId: order.Id, NewOwner: fulfillerB.String()})
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(k.DelistFulfilledClaim(suite.Ctx, order.Id, fulfillerB), types.ErrClaimNotListed)
+	order, err = k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, order.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PartialFulfillment{{FulfillerAddress: fulfillerB.String(), Amount: math.NewInt(300)}}, order.Fulfillments)
+
+	suite.FundAcc(types.PartialEscrowAddress(order.Id), sdk.NewCoins(sdk.NewInt64Coin(denom, 330)))
+	suite.Require().NoError(k.SettlePartialFulfillments(suite.Ctx, &rPacket))
+	suite.Require().Equal(math.NewInt(900), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfillerA, denom).Amount)
+	suite.Require().Equal(math.NewInt(1130), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfillerB, denom).Amount)
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+var (
+	DemandOrdersByRollappPrefix   = collections.NewPrefix("do0")
+	DemandOrdersByDenomPrefix     = collections.NewPrefix("do1")
+	DemandOrdersByRecipientPrefix = collections.NewPrefix("do2")
+	DemandOrdersByFulfillerPrefix = collections.NewPrefix("do3")
+	DemandOrdersOutstandingPrefix = collections.NewPrefix("do4")
+)
+
+// demandOrderIndexes are secondary indexes of the demand orders in any status.
+// Each index is a set of <attribute,order id>, so that orders are listed by id.
+type demandOrderIndexes struct {
+	byRollapp   collections.KeySet[collections.Pair[string, string]]
+	byDenom     collections.KeySet[collections.Pair[string, string]]
+	byRecipient collections.KeySet[collections.Pair[string, string]]
+	// includes partial fulfillers
+	byFulfiller collections.KeySet[collections.Pair[string, string]]
+	// <rollapp,order id> of the pending orders which no one started to fill, which the on-demand lps can fill
+	outstanding collections.KeySet[collections.Pair[string, string]]
+}
+
+func makeDemandOrderIndexes(sb *collections.SchemaBuilder) demandOrderIndexes {
+	pairKey := collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+	return demandOrderIndexes{
+		byRollapp:   collections.NewKeySet(sb, DemandOrdersByRollappPrefix, "byRollapp", pairKey),
+		byDenom:     collections.NewKeySet(sb, DemandOrdersByDenomPrefix, "byDenom", pairKey),
+		byRecipient: collections.NewKeySet(sb, DemandOrdersByRecipientPrefix, "byRecipient", pairKey),
+		byFulfiller: collections.NewKeySet(sb, DemandOrdersByFulfillerPrefix, "byFulfiller", pairKey),
+		outstanding: collections.NewKeySet(sb, DemandOrdersOutstandingPrefix, "outstanding", pairKey),
+	}
+}
+
+type demandOrderIndexEntries struct {
+	index collections.KeySet[collections.Pair[string, string]]
+	vals  []string
+}
+
+// entries returns the index entries of the order, per index.
+func (idx demandOrderIndexes) entries(o *types.DemandOrder) []demandOrderIndexEntries {
+	var denoms []string
+	if len(o.Price) != 0 {
+		denoms = append(denoms, o.Denom())
+	}
+	var outstanding []string
+	if o.TrackingPacketStatus == commontypes.Status_PENDING && !o.IsReverted() && !o.IsFulfilled() && !o.IsPartiallyFulfilled() {
+		outstanding = append(outstanding, o.RollappId)
+	}
+	return []demandOrderIndexEntries{
+		{idx.byRollapp, []string{o.RollappId}},
+		{idx.byDenom, denoms},
+		{idx.byRecipient, []string{o.Recipient}},
+		{idx.byFulfiller, o.Fulfillers()},
+		{idx.outstanding, outstanding},
+	}
+}
+
+func (idx demandOrderIndexes) set(ctx sdk.Context, o *types.DemandOrder) error {
+	for _, e := range idx.entries(o) {
+		for _, v := range e.vals {
+			if err := e.index.Set(ctx, collections.Join(v, o.Id)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (idx demandOrderIndexes) remove(ctx sdk.Context, o *types.DemandOrder) error {
+	for _, e := range idx.entries(o) {
+		for _, v := range e.vals {
+			if err := e.index.Remove(ctx, collections.Join(v, o.Id)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getDemandOrderAnyStatus returns the demand order with the given id, whatever its status.
+func (k Keeper) getDemandOrderAnyStatus(ctx sdk.Context, id string) (*types.DemandOrder, error) {
+	for _, status := range []commontypes.Status{commontypes.Status_PENDING, commontypes.Status_FINALIZED} {
+		o, err := k.GetDemandOrder(ctx, status, id)
+		if errors.Is(err, types.ErrDemandOrderDoesNotExist) {
+			continue
+		}
+		return o, err
+	}
+	return k.GetRevertedDemandOrder(ctx, id)
+}
+
+// RebuildDemandOrderIndexes indexes all the demand orders in the store.
+func (k Keeper) RebuildDemandOrderIndexes(ctx sdk.Context) error {
+	orders, err := k.ListAllDemandOrders(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "list all demand orders")
+	}
+	for _, o := range orders {
+		if err := k.orderIdx.set(ctx, o); err != nil {
+			return errorsmod.Wrapf(err, "index order: %s", o.Id)
+		}
+	}
+	return nil
+}
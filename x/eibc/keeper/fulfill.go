@@ -4,9 +4,12 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 )
 
 // basic i.e. not authorized
@@ -38,6 +41,10 @@ func (k Keeper) fulfill(ctx sdk.Context,
 	o *types.DemandOrder,
 	args fulfillArgs,
 ) error {
+	if o.IsPartiallyFulfilled() {
+		return types.ErrDemandOrderPartiallyFilled
+	}
+
 	if err := k.ensureAccount(ctx, args.FundsSource); err != nil {
 		return errorsmod.Wrap(err, "ensure fulfiller account")
 	}
@@ -53,6 +60,10 @@ func (k Keeper) fulfill(ctx sdk.Context,
 		return err
 	}
 
+	if err = k.recordFulfilled(ctx, o, o.FulfillerAddress, o.PriceAmount()); err != nil {
+		return errorsmod.Wrap(err, "record fulfilled")
+	}
+
 	err = k.hooks.AfterDemandOrderFulfilled(ctx, o, args.FundsSource.String())
 	if err != nil {
 		return err
@@ -60,3 +71,142 @@ func (k Keeper) fulfill(ctx sdk.Context,
 
 	return nil
 }
+
+// fulfillPartial escrows amt of the order price in the module account and records the share of the
+// fulfiller. The escrowed shares are tracked by the order itself. Once they cover the whole price, the price
+// is paid to the recipient and the order is fulfilled by its escrow account, like by a single fulfiller: the
+// underlying packet is redirected to the escrow account, which splits the proceeds between the fulfillers on
+// finalization (see SettlePartialFulfillments). Until then, the
+// shares are refunded if the packet is finalized, fails or is reverted.
+func (k Keeper) fulfillPartial(ctx sdk.Context,
+	o *types.DemandOrder,
+	fulfiller sdk.AccAddress,
+	amt math.Int,
+) error {
+	if o.CompletionHook != nil {
+		return errorsmod.Wrap(types.ErrInvalidPartialFulfillment, "order has a completion hook")
+	}
+
+	remaining := o.UnfilledAmount()
+	if amt.GT(remaining) {
+		return errorsmod.Wrapf(types.ErrPartialFulfillmentTooLarge, "amount: %s: remaining: %s", amt, remaining)
+	}
+
+	if !o.HasFulfiller(fulfiller.String()) && len(o.Fulfillments) >= types.MaxPartialFulfillments {
+		return errorsmod.Wrapf(types.ErrTooManyFulfillers, "max: %d", types.MaxPartialFulfillments)
+	}
+
+	if err := k.ensureAccount(ctx, fulfiller); err != nil {
+		return errorsmod.Wrap(err, "ensure fulfiller account")
+	}
+
+	err := k.bk.SendCoinsFromAccountToModule(ctx, fulfiller, types.ModuleName, sdk.NewCoins(sdk.NewCoin(o.Denom(), amt)))
+	if err != nil {
+		return errorsmod.Wrap(err, "send coins")
+	}
+
+	o.AddPartialFulfillment(fulfiller.String(), amt)
+	completed := o.IsFulfilled()
+	escrow := types.PartialEscrowAddress(o.Id)
+	if completed {
+		// the whole order is taken, the recipient is paid and the escrow account of the order fulfills it
+		err = k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, o.GetRecipientBech32Address(), o.Price)
+		if err != nil {
+			return errorsmod.Wrap(err, "pay recipient")
+		}
+		o.FulfillerAddress = escrow.String()
+	}
+
+	err = k.SetDemandOrder(ctx, o)
+	if err != nil {
+		return err
+	}
+
+	if err = k.recordFulfilled(ctx, o, fulfiller.String(), amt); err != nil {
+		return errorsmod.Wrap(err, "record fulfilled")
+	}
+
+	if completed {
+		// the packet is redirected to the escrow account, which splits the proceeds between the fulfillers
+		err = k.hooks.AfterDemandOrderFulfilled(ctx, o, escrow.String())
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = uevent.EmitTypedEvent(ctx, types.GetPartiallyFulfilledEvent(o, fulfiller.String(), amt)); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+
+	if completed {
+		if err = uevent.EmitTypedEvent(ctx, types.GetFulfilledEvent(o)); err != nil {
+			return fmt.Errorf("emit event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// SettlePartialFulfillments pays out the proceeds of a partially fulfilled order. It must be called when the
+// underlying packet is finalized, after the transfer credited the escrow account of the order and before the
+// order is moved to the finalized status. Each fulfiller gets the part of the proceeds matching its share of
+// the price, the original recipient gets the rounding dust. If the shares do not cover the price, the
+// recipient was not paid and the packet was not redirected: the shares are refunded instead.
+func (k Keeper) SettlePartialFulfillments(ctx sdk.Context, p *commontypes.RollappPacket) error {
+	o, err := k.PendingOrderByPacket(ctx, p)
+	if errorsmod.IsOf(err, types.ErrDemandOrderDoesNotExist) {
+		return nil
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "pending order by packet")
+	}
+	if !o.IsPartiallyFulfilled() {
+		return nil
+	}
+	if !o.IsFulfilled() {
+		return errorsmod.Wrap(k.refundPartialFulfillments(ctx, o), "refund partial fulfillments")
+	}
+
+	// The proceeds are price + fee, unless the transfer failed in which case there is nothing to split.
+	escrow := types.PartialEscrowAddress(o.Id)
+	proceeds := k.bk.GetBalance(ctx, escrow, o.Denom()).Amount
+
+	payouts, rest := o.PartialPayouts(proceeds)
+	for i, f := range o.Fulfillments {
+		if err := k.payout(ctx, o, escrow, f.GetFulfillerBech32Address(), payouts[i]); err != nil {
+			return errorsmod.Wrapf(err, "payout fulfiller: %s", f.FulfillerAddress)
+		}
+	}
+	if err := k.payout(ctx, o, escrow, o.GetRecipientBech32Address(), rest); err != nil {
+		return errorsmod.Wrap(err, "payout recipient")
+	}
+	return nil
+}
+
+// refundPartialFulfillments gives the escrowed shares of an order which is not fulfilled back to their
+// fulfillers, and drops the shares.
+func (k Keeper) refundPartialFulfillments(ctx sdk.Context, o *types.DemandOrder) error {
+	escrow := k.ak.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+	for _, f := range o.Fulfillments {
+		if err := k.payout(ctx, o, escrow, f.GetFulfillerBech32Address(), f.Amount); err != nil {
+			return errorsmod.Wrapf(err, "refund fulfiller: %s", f.FulfillerAddress)
+		}
+	}
+	o.Fulfillments = nil
+	return errorsmod.Wrap(k.SetDemandOrder(ctx, o), "set demand order")
+}
+
+func (k Keeper) payout(ctx sdk.Context, o *types.DemandOrder, from, to sdk.AccAddress, amt math.Int) error {
+	if !amt.IsPositive() {
+		return nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(o.Denom(), amt))
+	if err := k.bk.SendCoins(ctx, from, to, coins); err != nil {
+		return err
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventPartialFulfillmentPayout{
+		OrderId: o.Id,
+		Address: to.String(),
+		Amount:  coins.String(),
+	})
+}
@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
@@ -41,16 +44,17 @@ func (q Querier) DemandOrderById(goCtx context.Context, req *types.QueryGetDeman
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Get the demand order by its ID and search for it in all statuses
-	var demandOrder *types.DemandOrder
-	var err error
-	statuses := []commontypes.Status{commontypes.Status_PENDING, commontypes.Status_FINALIZED}
-	for _, status := range statuses {
-		demandOrder, err = q.GetDemandOrder(ctx, status, req.Id)
-		if err == nil && demandOrder != nil {
-			return &types.QueryGetDemandOrderResponse{DemandOrder: demandOrder}, nil
-		}
+	demandOrder, err := q.getDemandOrderAnyStatus(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return nil, status.Error(codes.Internal, err.Error())
+	effective := *demandOrder
+	effective.ApplyFeeDecay(uint64(ctx.BlockHeight())) //nolint:gosec // block height is always positive
+	return &types.QueryGetDemandOrderResponse{
+		DemandOrder:    demandOrder,
+		EffectiveFee:   effective.Fee,
+		EffectivePrice: effective.Price,
+	}, nil
 }
 
 func (q Querier) DemandOrdersByStatus(goCtx context.Context, req *types.QueryDemandOrdersByStatusRequest) (*types.QueryDemandOrdersByStatusResponse, error) {
@@ -69,6 +73,88 @@ func (q Querier) DemandOrdersByStatus(goCtx context.Context, req *types.QueryDem
 	}, nil
 }
 
+func (q Querier) DemandOrders(goCtx context.Context, req *types.QueryDemandOrdersRequest) (*types.QueryDemandOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	opts, err := demandOrdersFilterOpts(ctx, req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// use the most selective index available
+	var (
+		index collections.KeySet[collections.Pair[string, string]]
+		attr  string
+	)
+	switch {
+	case req.Fulfiller != "":
+		index, attr = q.orderIdx.byFulfiller, req.Fulfiller
+	case req.Recipient != "":
+		index, attr = q.orderIdx.byRecipient, req.Recipient
+	case req.RollappId != "":
+		index, attr = q.orderIdx.byRollapp, req.RollappId
+	case req.Denom != "":
+		index, attr = q.orderIdx.byDenom, req.Denom
+	default:
+		demandOrders, pageResp, err := q.listDemandOrdersPaginated(ctx, req.Pagination, opts...)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryDemandOrdersResponse{DemandOrders: demandOrders, Pagination: pageResp}, nil
+	}
+
+	demandOrders, pageResp, err := query.CollectionFilteredPaginate(ctx, index, req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (bool, error) {
+			o, err := q.getDemandOrderAnyStatus(ctx, key.K2())
+			if err != nil {
+				return false, err
+			}
+			return matchesAll(*o, opts...), nil
+		},
+		func(key collections.Pair[string, string], _ collections.NoValue) (*types.DemandOrder, error) {
+			return q.getDemandOrderAnyStatus(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](attr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryDemandOrdersResponse{DemandOrders: demandOrders, Pagination: pageResp}, nil
+}
+
+func demandOrdersFilterOpts(ctx sdk.Context, req *types.QueryDemandOrdersRequest) ([]filterOption, error) {
+	var opts []filterOption
+	if req.RollappId != "" {
+		opts = append(opts, isRollappId(req.RollappId))
+	}
+	if req.Denom != "" {
+		opts = append(opts, isDenom(req.Denom))
+	}
+	if req.MinFeePercent != "" {
+		minFee, err := math.LegacyNewDecFromStr(req.MinFeePercent)
+		if err != nil {
+			return nil, fmt.Errorf("min fee percent: %w", err)
+		}
+		opts = append(opts, hasMinFeePercent(minFee, uint64(ctx.BlockHeight()))) //nolint:gosec
+	}
+	if req.Type != commontypes.RollappPacket_UNDEFINED {
+		opts = append(opts, isOrderType(req.Type))
+	}
+	if req.Recipient != "" {
+		opts = append(opts, isRecipient(req.Recipient))
+	}
+	if req.Fulfiller != "" {
+		opts = append(opts, hasFulfiller(req.Fulfiller))
+	}
+	if req.FulfillmentState != types.FulfillmentState_UNDEFINED {
+		opts = append(opts, isFulfillmentState(req.FulfillmentState))
+	}
+	return opts, nil
+}
+
 func filterOpts(req *types.QueryDemandOrdersByStatusRequest) []filterOption {
 	var opts []filterOption
 	if req.RollappId != "" {
@@ -117,6 +203,34 @@ func isFulfiller(fulfiller string) filterOption {
 	}
 }
 
+// hasFulfiller matches the fulfiller of the order, or any of its partial fulfillers.
+func hasFulfiller(fulfiller string) filterOption {
+	return func(order types.DemandOrder) bool {
+		return order.FulfillerAddress == fulfiller || order.HasFulfiller(fulfiller)
+	}
+}
+
+// hasMinFeePercent matches orders which offer at least the given fee, as a percentage of the price, at the given
+// height.
+func hasMinFeePercent(minFee math.LegacyDec, height uint64) filterOption {
+	return func(order types.DemandOrder) bool {
+		if len(order.Price) == 0 || !order.PriceAmount().IsPositive() {
+			return false
+		}
+		order.ApplyFeeDecay(height)
+		return minFee.LTE(order.GetFeePercent())
+	}
+}
+
+func matchesAll(order types.DemandOrder, opts ...filterOption) bool {
+	for _, opt := range opts {
+		if !opt(order) {
+			return false
+		}
+	}
+	return true
+}
+
 func isFulfillmentState(fulfillmentState types.FulfillmentState) filterOption {
 	return func(order types.DemandOrder) bool {
 		return order.IsFulfilled() == (types.FulfillmentState_FULFILLED == fulfillmentState)
@@ -135,6 +249,64 @@ func isRecipient(recipient string) filterOption {
 	}
 }
 
+func (q Querier) RevertedDemandOrders(goCtx context.Context, req *types.QueryRevertedDemandOrdersRequest) (*types.QueryRevertedDemandOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	var opts []filterOption
+	if req.RollappId != "" {
+		opts = append(opts, isRollappId(req.RollappId))
+	}
+	demandOrders, pageResp, err := q.listDemandOrdersByPrefixPaginated(sdk.UnwrapSDKContext(goCtx), types.RevertedDemandOrderKeyPrefix, req.Pagination, opts...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryRevertedDemandOrdersResponse{DemandOrders: demandOrders, Pagination: pageResp}, nil
+}
+
+func (q Querier) ClaimListings(goCtx context.Context, req *types.QueryClaimListingsRequest) (*types.QueryClaimListingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[string, string]])
+	if req.OrderId != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, string](req.OrderId))
+	}
+	listings, pageResp, err := query.CollectionPaginate(ctx, q.claimListings, req.Pagination,
+		func(_ collections.Pair[string, string], l types.ClaimListing) (types.ClaimListing, error) {
+			return l, nil
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryClaimListingsResponse{Listings: listings, Pagination: pageResp}, nil
+}
+
+func (q Querier) FulfillerStats(gctx context.Context, r *types.QueryFulfillerStatsRequest) (*types.QueryFulfillerStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(gctx)
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return nil, errorsmod.Wrap(err, "acc address from bech32")
+	}
+	stats, err := q.GetFulfillerStats(ctx, r.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get fulfiller stats")
+	}
+	return &types.QueryFulfillerStatsResponse{Stats: stats}, nil
+}
+
+func (q Querier) OnDemandLPStats(gctx context.Context, r *types.QueryOnDemandLPStatsRequest) (*types.QueryOnDemandLPStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(gctx)
+	stats, err := q.GetLPStats(ctx, r.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get lp stats")
+	}
+	return &types.QueryOnDemandLPStatsResponse{Stats: stats}, nil
+}
+
 func (q Querier) OnDemandLPs(gctx context.Context, r *types.QueryOnDemandLPsRequest) (*types.QueryOnDemandLPsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(gctx)
 
@@ -6,6 +6,8 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
@@ -100,3 +102,98 @@ func (suite *KeeperTestSuite) TestQueryDemandOrdersByStatus() {
 	suite.Require().NotNil(res.DemandOrders)
 	suite.Require().Equal(false, res.DemandOrders[0].IsFulfilled(), "Expected 0 demand orders with fulfillment state unfulfilled")
 }
+
+func (suite *KeeperTestSuite) TestQueryDemandOrders() {
+	k := suite.App.EIBCKeeper
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1000))
+	recipient, other, fulfiller := addrs[0].String(), addrs[1].String(), addrs[2].String()
+
+	specs := []struct {
+		rollapp   string
+		recipient string
+		fee       int64
+		ptype     commontypes.RollappPacket_Type
+		fulfilled bool
+	}{
+		{"rollappa_1-1", recipient, 10, commontypes.RollappPacket_ON_RECV, false},
+		{"rollappa_1-1", recipient, 50, commontypes.RollappPacket_ON_RECV, true},
+		{"rollappa_1-1", other, 50, commontypes.RollappPacket_ON_TIMEOUT, false},
+		{"rollappb_2-1", recipient, 50, commontypes.RollappPacket_ON_RECV, false},
+		{"rollappb_2-1", other, 10, commontypes.RollappPacket_ON_ACK, true},
+	}
+	var ids []string
+	for i, spec := range specs {
+		p := channeltypes.NewPacket(transferPacketData.GetBytes(), uint64(i), portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp) //nolint:gosec
+		rPacket := commontypes.RollappPacket{RollappId: spec.rollapp, Status: commontypes.Status_PENDING, Type: spec.ptype, Packet: &p}
+		o := types.NewDemandOrder(rPacket, math.NewInt(100), math.NewInt(spec.fee), "stake", spec.recipient, 1, nil)
+		suite.Require().NoError(k.SetDemandOrder(suite.Ctx, o))
+		if spec.fulfilled {
+			// the index follows the update of the order
+			o.FulfillerAddress = fulfiller
+			suite.Require().NoError(k.SetDemandOrder(suite.Ctx, o))
+		}
+		ids = append(ids, o.Id)
+	}
+	// finalized orders are listed too
+	o, err := k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, ids[4])
+	suite.Require().NoError(err)
+	_, err = k.UpdateDemandOrderWithStatus(suite.Ctx, o, commontypes.Status_FINALIZED)
+	suite.Require().NoError(err)
+
+	query := func(req *types.QueryDemandOrdersRequest) []string {
+		if req.Type == 0 {
+			req.Type = commontypes.RollappPacket_UNDEFINED
+		}
+		res, err := suite.queryClient.DemandOrders(suite.Ctx, req)
+		suite.Require().NoError(err)
+		var ret []string
+		for _, o := range res.DemandOrders {
+			ret = append(ret, o.Id)
+		}
+		return ret
+	}
+	expect := func(idxs ...int) []string {
+		var ret []string
+		for _, i := range idxs {
+			ret = append(ret, ids[i])
+		}
+		return ret
+	}
+
+	suite.Require().ElementsMatch(expect(0, 1, 2, 3, 4), query(&types.QueryDemandOrdersRequest{}))
+	suite.Require().ElementsMatch(expect(0, 1, 2), query(&types.QueryDemandOrdersRequest{RollappId: "rollappa_1-1"}))
+	suite.Require().ElementsMatch(expect(0, 1, 3), query(&types.QueryDemandOrdersRequest{Recipient: recipient}))
+	suite.Require().ElementsMatch(expect(1, 4), query(&types.QueryDemandOrdersRequest{Fulfiller: fulfiller}))
+	suite.Require().ElementsMatch(expect(0, 1, 2, 3, 4), query(&types.QueryDemandOrdersRequest{Denom: "stake"}))
+	suite.Require().ElementsMatch(expect(1, 2, 3), query(&types.QueryDemandOrdersRequest{MinFeePercent: "0.5"}))
+	suite.Require().ElementsMatch(expect(2), query(&types.QueryDemandOrdersRequest{Type: commontypes.RollappPacket_ON_TIMEOUT}))
+	suite.Require().ElementsMatch(expect(1), query(&types.QueryDemandOrdersRequest{
+		RollappId:        "rollappa_1-1",
+		Recipient:        recipient,
+		MinFeePercent:    "0.5",
+		FulfillmentState: types.FulfillmentState_FULFILLED,
+	}))
+	suite.Require().Empty(query(&types.QueryDemandOrdersRequest{Fulfiller: other}))
+
+	// pagination
+	all := expect(0, 1, 2, 3, 4)
+	res, err := suite.queryClient.DemandOrders(suite.Ctx, &types.QueryDemandOrdersRequest{
+		Type:       commontypes.RollappPacket_UNDEFINED,
+		Pagination: &sdkquery.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.DemandOrders, 2)
+	suite.Require().Equal(uint64(5), res.Pagination.Total)
+	suite.Require().Subset(all, []string{res.DemandOrders[0].Id, res.DemandOrders[1].Id})
+	res, err = suite.queryClient.DemandOrders(suite.Ctx, &types.QueryDemandOrdersRequest{
+		RollappId:  "rollappa_1-1",
+		Type:       commontypes.RollappPacket_UNDEFINED,
+		Pagination: &sdkquery.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.DemandOrders, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	_, err = suite.queryClient.DemandOrders(suite.Ctx, &types.QueryDemandOrdersRequest{MinFeePercent: "x"})
+	suite.Require().Error(err)
+}
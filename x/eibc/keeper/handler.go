@@ -57,12 +57,27 @@ func (k Keeper) EIBCDemandOrderHandler(ctx sdk.Context, rollappPacket commontype
 		return fmt.Errorf("emit event: %w", err)
 	}
 
+	// The packet may be re-submitted after a hard fork, the order then stays with its former fulfillers
+	carried, err := k.carryRevertedOrder(ctx, eibcDemandOrder, &rollappPacket)
+	if err != nil {
+		return fmt.Errorf("carry reverted order: %w", err)
+	}
+	if carried {
+		return nil
+	}
+
+	// Let the standing on-demand liquidity fill the order straight away, or as soon as it is old enough
+	if err = k.matchOnDemandLPOnCreation(ctx, eibcDemandOrder); err != nil {
+		return fmt.Errorf("match on demand lp: %w", err)
+	}
+
 	return nil
 }
 
 // CreateDemandOrderOnRecv creates a demand order from an IBC packet.
 // It extracts the fee from the memo,calculates the demand order price, and creates a new demand order.
 // price calculated with the fee and the bridging fee. (price = amount - fee - bridging fee)
+// If the memo has a fee decay schedule, the fee and price are the ones at the creation height.
 // It returns the created demand order or an error if there is any.
 func (k *Keeper) CreateDemandOrderOnRecv(ctx sdk.Context, fungibleTokenPacketData transfertypes.FungibleTokenPacketData,
 	rollappPacket *commontypes.RollappPacket,
@@ -75,12 +90,13 @@ func (k *Keeper) CreateDemandOrderOnRecv(ctx sdk.Context, fungibleTokenPacketDat
 	// Calculate the demand order price and validate it,
 	amt, _ := math.NewIntFromString(fungibleTokenPacketData.Amount) // guaranteed ok and positive by above validation
 	fee, _ := memoEIBC.FeeInt()                                     // guaranteed ok by above validation
-	demandOrderPrice, err := types.CalcPriceWithBridgingFee(amt, fee, k.dack.BridgingFee(ctx))
+	demandOrderDenom := denomutils.GetIncomingTransferDenom(*rollappPacket.Packet, fungibleTokenPacketData)
+	bridgingFee := k.dack.PacketBridgingFee(ctx, *rollappPacket.Packet, rollappPacket.RollappId, demandOrderDenom, amt)
+	demandOrderPrice, err := types.CalcPriceWithBridgingFee(amt, fee, bridgingFee)
 	if err != nil {
 		return nil, err
 	}
 
-	demandOrderDenom := denomutils.GetIncomingTransferDenom(*rollappPacket.Packet, fungibleTokenPacketData)
 	demandOrderRecipient := fungibleTokenPacketData.Receiver // who we tried to send to
 	creationHeight := uint64(ctx.BlockHeight())              //nolint:gosec // block height is always positive
 
@@ -95,6 +111,10 @@ func (k *Keeper) CreateDemandOrderOnRecv(ctx sdk.Context, fungibleTokenPacketDat
 	}
 
 	order := types.NewDemandOrder(*rollappPacket, demandOrderPrice, fee, demandOrderDenom, demandOrderRecipient, creationHeight, onComplete)
+	if memoEIBC.FeeDecay != nil {
+		floor, perBlock, _ := memoEIBC.FeeDecayInts() // guaranteed ok by above validation
+		order.FeeDecay = &types.FeeDecay{Floor: floor, PerBlock: perBlock}
+	}
 	return order, nil
 }
 
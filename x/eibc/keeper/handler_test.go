@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 )
@@ -13,6 +14,7 @@ func (suite *KeeperTestSuite) TestCreateDemandOrderOnRecv() {
 		expectedErr   bool
 		expectedFee   string
 		expectedPrice string // considering bridging fee of 1%
+		expectedDecay bool
 	}{
 		{
 			name:          "fee by memo - create demand order",
@@ -21,6 +23,24 @@ func (suite *KeeperTestSuite) TestCreateDemandOrderOnRecv() {
 			expectedFee:   "100",
 			expectedPrice: "890",
 		},
+		{
+			name:          "fee decay by memo - create demand order",
+			memo:          `{"eibc":{"fee":"100","fee_decay":{"floor":"20","per_block":"5"}}}`,
+			expectedErr:   false,
+			expectedFee:   "100",
+			expectedPrice: "890",
+			expectedDecay: true,
+		},
+		{
+			name:        "fee decay floor above fee - fail",
+			memo:        `{"eibc":{"fee":"100","fee_decay":{"floor":"200","per_block":"5"}}}`,
+			expectedErr: true,
+		},
+		{
+			name:        "fee decay without per block - fail",
+			memo:        `{"eibc":{"fee":"100","fee_decay":{"floor":"20"}}}`,
+			expectedErr: true,
+		},
 		{
 			name:          "empty memo - create demand order",
 			memo:          "",
@@ -50,7 +70,7 @@ func (suite *KeeperTestSuite) TestCreateDemandOrderOnRecv() {
 	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dackParams)
 
 	amt, _ := math.NewIntFromString(transferPacketData.Amount)
-	bridgeFee := suite.App.DelayedAckKeeper.BridgingFeeFromAmt(suite.Ctx, amt)
+	bridgeFee := suite.App.DelayedAckKeeper.BridgingFeeFromAmt(suite.Ctx, rollappPacket.RollappId, sdk.DefaultBondDenom, amt)
 	suite.Require().True(bridgeFee.IsPositive())
 
 	for _, tt := range tests {
@@ -77,6 +97,7 @@ func (suite *KeeperTestSuite) TestCreateDemandOrderOnRecv() {
 				suite.Require().Len(order.Fee, 0)
 				suite.Require().Equal(tt.expectedPrice, order.Price[0].Amount.String())
 			}
+			suite.Require().Equal(tt.expectedDecay, order.FeeDecay != nil)
 		})
 	}
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	epochstypes "github.com/osmosis-labs/osmosis/v15/x/epochs/types"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	delayeacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
@@ -50,7 +51,21 @@ func (d delayedAckHooks) AfterPacketStatusUpdated(ctx sdk.Context, packet *commo
 		return err
 	}
 
-	return nil
+	// The claim on the proceeds can no longer be sold
+	if err := d.removeClaimListings(ctx, demandOrderID); err != nil {
+		return err
+	}
+
+	if packet.Status == commontypes.Status_FINALIZED {
+		if err := d.recordFinalized(ctx, demandOrder); err != nil {
+			return err
+		}
+	}
+	// The on-demand lp which fulfilled the order gets its funds back on finalization without error only
+	if packet.Status == commontypes.Status_FINALIZED && packet.Error == "" {
+		return d.repayOnDemandLP(ctx, demandOrder)
+	}
+	return d.forgetOnDemandLP(ctx, demandOrderID)
 }
 
 // AfterPacketDeleted is called every time the underlying IBC packet is deleted.
@@ -59,10 +74,42 @@ func (d delayedAckHooks) AfterPacketStatusUpdated(ctx sdk.Context, packet *commo
 func (d delayedAckHooks) AfterPacketDeleted(ctx sdk.Context, rollappPacket *commontypes.RollappPacket) {
 	// Get the demand order from the packet key. The initial demand order was built when
 	// the packet was created, hence with PENDING status.
+	// A pending packet is only deleted when it is reverted by a hard fork, finalized ones are pruned
+	reverted := rollappPacket.Status == commontypes.Status_PENDING
 	rollappPacket.Status = commontypes.Status_PENDING
 	packetKey := rollappPacket.RollappPacketKey()
 	demandOrderID := types.BuildDemandIDFromPacketKey(string(packetKey))
 
+	if reverted {
+		if err := d.recordRevertedOrder(ctx, demandOrderID); err != nil {
+			d.Logger(ctx).Error("record reverted order", "error", err)
+		}
+		// A fulfilled order is kept so that its fulfillment is carried to the re-submitted packet. The shares
+		// of an order which is not fulfilled are refunded.
+		o, err := d.GetDemandOrder(ctx, commontypes.Status_PENDING, demandOrderID)
+		if err == nil && o.IsFulfilled() {
+			if err := d.revertOrder(ctx, o, rollappPacket); err != nil {
+				d.Logger(ctx).Error("revert demand order", "order", demandOrderID, "error", err)
+			}
+			return
+		}
+		if err == nil && o.IsPartiallyFulfilled() {
+			if err := d.refundPartialFulfillments(ctx, o); err != nil {
+				d.Logger(ctx).Error("refund partial fulfillments", "order", demandOrderID, "error", err)
+			}
+		}
+	}
+
+	if err := d.forgetOnDemandLP(ctx, demandOrderID); err != nil {
+		d.Logger(ctx).Error("forget on demand lp", "error", err)
+	}
+	if err := d.forgetOrderFulfillers(ctx, demandOrderID); err != nil {
+		d.Logger(ctx).Error("forget order fulfillers", "error", err)
+	}
+	if err := d.removeClaimListings(ctx, demandOrderID); err != nil {
+		d.Logger(ctx).Error("remove claim listings", "error", err)
+	}
+
 	statuses := []commontypes.Status{commontypes.Status_PENDING, commontypes.Status_FINALIZED}
 	for _, status := range statuses {
 		d.deleteDemandOrder(ctx, status, demandOrderID)
@@ -78,3 +125,35 @@ func (d delayedAckHooks) AfterPacketDeleted(ctx sdk.Context, rollappPacket *comm
 		}
 	}
 }
+
+/* -------------------------------------------------------------------------- */
+/*                                 epoch hooks                                */
+/* -------------------------------------------------------------------------- */
+var _ epochstypes.EpochHooks = epochHooks{}
+
+type epochHooks struct {
+	Keeper
+}
+
+func (k Keeper) GetEpochHooks() epochstypes.EpochHooks {
+	return epochHooks{
+		Keeper: k,
+	}
+}
+
+// BeforeEpochStart is the epoch start hook.
+func (e epochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// AfterEpochEnd is the epoch end hook.
+// It starts a new spend window for the on-demand lps which count their windows in epochs, and prunes the
+// reverted orders at the end of the epoch of the module.
+func (e epochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier == e.EpochIdentifier(ctx) {
+		if err := e.pruneRevertedOrders(ctx); err != nil {
+			return err
+		}
+	}
+	return e.resetOnDemandLPWindows(ctx, epochIdentifier)
+}
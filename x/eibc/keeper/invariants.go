@@ -58,10 +58,11 @@ func DemandOrderCountInvariant(k Keeper) sdk.Invariant {
 			msg += fmt.Sprintf("list finalized demand orders failed: %v\n", err)
 			broken = true
 		}
+		revertedDemandOrders := k.ListRevertedDemandOrders(ctx)
 		// Validate the count of demand orders is equal to the sum of demand orders in all statuses
-		if len(allDemandOrders) != len(pendingDemandOrders)+len(finalizedDemandOrders) {
-			msg += fmt.Sprintf("demand orders count mismatch: all(%d) != pending(%d)  + finalized(%d)\n",
-				len(allDemandOrders), len(pendingDemandOrders), len(finalizedDemandOrders))
+		if len(allDemandOrders) != len(pendingDemandOrders)+len(finalizedDemandOrders)+len(revertedDemandOrders) {
+			msg += fmt.Sprintf("demand orders count mismatch: all(%d) != pending(%d)  + finalized(%d) + reverted(%d)\n",
+				len(allDemandOrders), len(pendingDemandOrders), len(finalizedDemandOrders), len(revertedDemandOrders))
 			broken = true
 		}
 		return sdk.FormatInvariant(types.ModuleName, demandOrderCountInvariantName, msg), broken
@@ -80,6 +81,10 @@ func UnderlyingPacketExistInvariant(k Keeper) sdk.Invariant {
 			broken = true
 		}
 		for _, demandOrder := range allDemandOrders {
+			// The packet of a reverted order was deleted by the hard fork
+			if demandOrder.IsReverted() {
+				continue
+			}
 			// Get the underlying packet for the demand order
 			_, err := k.dack.GetRollappPacket(ctx, demandOrder.TrackingPacketKey)
 			if err != nil {
@@ -22,16 +22,22 @@ import (
 
 type (
 	Keeper struct {
-		cdc       codec.BinaryCodec
-		storeKey  storetypes.StoreKey
-		memKey    storetypes.StoreKey
-		hooks     types.EIBCHooks
-		ak        types.AccountKeeper
-		bk        types.BankKeeper
-		dack      types.DelayedAckKeeper
-		rk        types.RollappKeeper
-		Schema    collections.Schema
-		LPs       LPs
+		cdc      codec.BinaryCodec
+		storeKey storetypes.StoreKey
+		memKey   storetypes.StoreKey
+		hooks    types.EIBCHooks
+		ak       types.AccountKeeper
+		bk       types.BankKeeper
+		dack     types.DelayedAckKeeper
+		rk       types.RollappKeeper
+		Schema   collections.Schema
+		LPs      LPs
+		orderIdx demandOrderIndexes
+		// claims of fulfilled orders offered for sale
+		claimListings claimListings
+		stats         stats
+		// reverted orders waiting for their packet to be re-submitted
+		reverted  revertedOrders
 		authority string
 	}
 )
@@ -49,6 +55,10 @@ func NewKeeper(
 	service := collcompat.NewKVStoreService(storeKey)
 	sb := collections.NewSchemaBuilder(service)
 	lps := makeLPsStore(sb, cdc)
+	orderIdx := makeDemandOrderIndexes(sb)
+	listings := makeClaimListings(sb, cdc)
+	st := makeStats(sb, cdc)
+	reverted := makeRevertedOrders(sb)
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -56,16 +66,20 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		memKey:    memKey,
-		ak:        accountKeeper,
-		bk:        bankKeeper,
-		dack:      delayedAckKeeper,
-		rk:        rk,
-		Schema:    schema,
-		LPs:       lps,
-		authority: authority,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		memKey:        memKey,
+		ak:            accountKeeper,
+		bk:            bankKeeper,
+		dack:          delayedAckKeeper,
+		rk:            rk,
+		Schema:        schema,
+		LPs:           lps,
+		orderIdx:      orderIdx,
+		claimListings: listings,
+		stats:         st,
+		reverted:      reverted,
+		authority:     authority,
 	}
 }
 
@@ -75,7 +89,7 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 func (k Keeper) SetDemandOrder(ctx sdk.Context, order *types.DemandOrder) error {
 	store := ctx.KVStore(k.storeKey)
-	demandOrderKey, err := types.GetDemandOrderKey(order.TrackingPacketStatus, order.Id)
+	demandOrderKey, err := types.GetDemandOrderStoreKey(order)
 	if err != nil {
 		return err
 	}
@@ -83,15 +97,40 @@ func (k Keeper) SetDemandOrder(ctx sdk.Context, order *types.DemandOrder) error
 	if err != nil {
 		return err
 	}
+
+	// drop the index entries of the previous version of the order, e.g. before it was fulfilled
+	if bz := store.Get(demandOrderKey); bz != nil {
+		var prev types.DemandOrder
+		if err := k.cdc.Unmarshal(bz, &prev); err != nil {
+			return err
+		}
+		if err := k.orderIdx.remove(ctx, &prev); err != nil {
+			return errorsmod.Wrap(err, "remove index")
+		}
+	}
 	store.Set(demandOrderKey, data)
+	if err := k.orderIdx.set(ctx, order); err != nil {
+		return errorsmod.Wrap(err, "set index")
+	}
 
 	return nil
 }
 
 func (k Keeper) deleteDemandOrder(ctx sdk.Context, status commontypes.Status, orderID string) {
-	store := ctx.KVStore(k.storeKey)
 	// we can skip error check, the status is known, if key is not valid, order will not be deleted anyway
 	demandOrderKey, _ := types.GetDemandOrderKey(status, orderID)
+	k.deleteDemandOrderByKey(ctx, demandOrderKey, orderID)
+}
+
+func (k Keeper) deleteDemandOrderByKey(ctx sdk.Context, demandOrderKey []byte, orderID string) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(demandOrderKey); bz != nil {
+		var order types.DemandOrder
+		k.cdc.MustUnmarshal(bz, &order)
+		if err := k.orderIdx.remove(ctx, &order); err != nil {
+			k.Logger(ctx).Error("Remove demand order index.", "order", orderID, "err", err)
+		}
+	}
 	store.Delete(demandOrderKey)
 }
 
@@ -161,6 +200,9 @@ func (k Keeper) GetOutstandingOrder(ctx sdk.Context, orderId string) (*types.Dem
 		return nil, types.ErrDemandOrderInactive
 	}
 
+	// Fulfillers deal with the fee currently offered by the order
+	demandOrder.ApplyFeeDecay(uint64(ctx.BlockHeight())) //nolint:gosec // block height is always positive
+
 	return demandOrder, nil
 }
 
@@ -182,8 +224,6 @@ func (k Keeper) ListAllDemandOrders(
 }
 
 func (k Keeper) ListDemandOrdersByStatus(ctx sdk.Context, status commontypes.Status, limit int, opts ...filterOption) (list []*types.DemandOrder, err error) {
-	store := ctx.KVStore(k.storeKey)
-
 	var statusPrefix []byte
 	switch status {
 	case commontypes.Status_PENDING:
@@ -193,7 +233,11 @@ func (k Keeper) ListDemandOrdersByStatus(ctx sdk.Context, status commontypes.Sta
 	default:
 		return nil, fmt.Errorf("invalid packet status: %s", status)
 	}
+	return k.listDemandOrdersByPrefix(ctx, statusPrefix, limit, opts...), nil
+}
 
+func (k Keeper) listDemandOrdersByPrefix(ctx sdk.Context, statusPrefix []byte, limit int, opts ...filterOption) (list []*types.DemandOrder) {
+	store := ctx.KVStore(k.storeKey)
 	iterator := storetypes.KVStorePrefixIterator(store, statusPrefix)
 	defer iterator.Close() // nolint: errcheck
 
@@ -212,7 +256,7 @@ outer:
 		list = append(list, &val)
 	}
 
-	return list, nil
+	return list
 }
 
 func (k Keeper) ListDemandOrdersByStatusPaginated(
@@ -221,8 +265,6 @@ func (k Keeper) ListDemandOrdersByStatusPaginated(
 	pageReq *query.PageRequest,
 	opts ...filterOption,
 ) (list []*types.DemandOrder, pageResp *query.PageResponse, err error) {
-	store := ctx.KVStore(k.storeKey)
-
 	var statusPrefix []byte
 	switch status {
 	case commontypes.Status_PENDING:
@@ -233,7 +275,16 @@ func (k Keeper) ListDemandOrdersByStatusPaginated(
 		err = fmt.Errorf("invalid demand order status: %s", status)
 		return
 	}
+	return k.listDemandOrdersByPrefixPaginated(ctx, statusPrefix, pageReq, opts...)
+}
 
+func (k Keeper) listDemandOrdersByPrefixPaginated(
+	ctx sdk.Context,
+	statusPrefix []byte,
+	pageReq *query.PageRequest,
+	opts ...filterOption,
+) (list []*types.DemandOrder, pageResp *query.PageResponse, err error) {
+	store := ctx.KVStore(k.storeKey)
 	prefixStore := prefix.NewStore(store, statusPrefix)
 
 	if pageReq == nil {
@@ -257,6 +308,29 @@ func (k Keeper) ListDemandOrdersByStatusPaginated(
 	return
 }
 
+// listDemandOrdersPaginated returns the demand orders in any status which match all the filters.
+func (k Keeper) listDemandOrdersPaginated(
+	ctx sdk.Context,
+	pageReq *query.PageRequest,
+	opts ...filterOption,
+) (list []*types.DemandOrder, pageResp *query.PageResponse, err error) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AllDemandOrdersKeyPrefix)
+	pageResp, err = query.FilteredPaginate(prefixStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var val types.DemandOrder
+		if err := k.cdc.Unmarshal(value, &val); err != nil {
+			return false, err
+		}
+		if !matchesAll(val, opts...) {
+			return false, nil
+		}
+		if accumulate {
+			list = append(list, &val)
+		}
+		return true, nil
+	})
+	return
+}
+
 func (k Keeper) ensureAccount(ctx sdk.Context, address sdk.AccAddress) error {
 	account := k.ak.GetAccount(ctx, address)
 	if account == nil {
@@ -3,6 +3,13 @@ package keeper_test
 import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	denomutils "github.com/dymensionxyz/dymension/v3/utils/denom"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 )
 
@@ -176,3 +183,313 @@ func (suite *KeeperTestSuite) TestLPQueriesByAddr() {
 		}
 	}
 }
+
+// orders are matched against the lps when created, once old enough, and when a new lp is created
+func (suite *KeeperTestSuite) TestLPAutoMatch() {
+	k := suite.App.EIBCKeeper
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	lpAddr := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, math.NewInt(1000))[0]
+
+	data := transferPacketData
+	data.Memo = `{"eibc":{"fee":"100"}}`
+	denom := denomutils.GetIncomingTransferDenom(packet, data)
+	suite.FundAcc(lpAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 10_000)))
+
+	createOrder := func(seq uint64) *types.DemandOrder {
+		p := channeltypes.NewPacket(data.GetBytes(), seq, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+		rPacket := *rollappPacket
+		rPacket.Packet = &p
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, rPacket)
+		err := k.EIBCDemandOrderHandler(suite.Ctx, rPacket, data)
+		suite.Require().NoError(err)
+		o, err := k.PendingOrderByPacket(suite.Ctx, &rPacket)
+		suite.Require().NoError(err)
+		return o
+	}
+	isFulfilled := func(o *types.DemandOrder) bool {
+		o, err := k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, o.Id)
+		suite.Require().NoError(err)
+		return o.IsFulfilled()
+	}
+	createLP := func(minAge uint64) uint64 {
+		id, err := k.CreateLP(suite.Ctx, &types.OnDemandLP{
+			FundsAddr:         lpAddr.String(),
+			Rollapp:           rollappPacket.RollappId,
+			Denom:             denom,
+			MaxPrice:          math.NewInt(1000),
+			MinFee:            math.LegacyZeroDec(),
+			SpendLimit:        math.NewInt(10_000),
+			OrderMinAgeBlocks: minAge,
+		})
+		suite.Require().NoError(err)
+		return id
+	}
+
+	endBlock := func() {
+		suite.Require().NoError(k.ScanOrdersForNewLPs(suite.Ctx))
+		suite.Require().NoError(k.MatchScheduledOrders(suite.Ctx))
+	}
+
+	// no lp: the order stays open
+	unmatched := createOrder(1)
+	endBlock()
+	suite.Require().False(isFulfilled(unmatched))
+
+	// matched at the end of the block it is created in, not when the packet is received
+	id := createLP(0)
+	o := createOrder(2)
+	suite.Require().False(isFulfilled(o))
+	endBlock()
+	suite.Require().True(isFulfilled(o))
+	o, err := k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, o.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(lpAddr.String(), o.FulfillerAddress)
+	// the order created before the lp is matched too
+	suite.Require().True(isFulfilled(unmatched))
+	suite.Require().NoError(k.LPs.Del(suite.Ctx, id, "test"))
+
+	// matched once old enough
+	createLP(5)
+	o = createOrder(3)
+	endBlock()
+	suite.Require().False(isFulfilled(o))
+	suite.Ctx = suite.Ctx.WithBlockHeight(14)
+	endBlock()
+	suite.Require().False(isFulfilled(o))
+	suite.Ctx = suite.Ctx.WithBlockHeight(15)
+	endBlock()
+	suite.Require().True(isFulfilled(o))
+}
+
+// the outstanding orders are scheduled for a new lp across blocks, skipping the orders which are not outstanding
+func (suite *KeeperTestSuite) TestLPScanOrdersAcrossBlocks() {
+	k := suite.App.EIBCKeeper
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	lpAddr := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, math.NewInt(1000))[0]
+
+	data := transferPacketData
+	data.Memo = `{"eibc":{"fee":"100"}}`
+	denom := denomutils.GetIncomingTransferDenom(packet, data)
+	suite.FundAcc(lpAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000_000)))
+
+	var orders []*types.DemandOrder
+	for seq := uint64(1); seq <= keeper.MaxOrdersScannedPerBlock+1; seq++ {
+		p := channeltypes.NewPacket(data.GetBytes(), seq, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+		rPacket := *rollappPacket
+		rPacket.Packet = &p
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, rPacket)
+		suite.Require().NoError(k.EIBCDemandOrderHandler(suite.Ctx, rPacket, data))
+		o, err := k.PendingOrderByPacket(suite.Ctx, &rPacket)
+		suite.Require().NoError(err)
+		orders = append(orders, o)
+	}
+	// no lp yet: the orders are not matched when created
+	suite.Require().NoError(k.MatchScheduledOrders(suite.Ctx))
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(11)
+	_, err := k.CreateLP(suite.Ctx, &types.OnDemandLP{
+		FundsAddr:  lpAddr.String(),
+		Rollapp:    rollappPacket.RollappId,
+		Denom:      denom,
+		MaxPrice:   math.NewInt(1000),
+		MinFee:     math.LegacyZeroDec(),
+		SpendLimit: math.NewInt(1_000_000),
+	})
+	suite.Require().NoError(err)
+	fulfilled := func() int {
+		n := 0
+		for _, o := range orders {
+			o, err := k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, o.Id)
+			suite.Require().NoError(err)
+			if o.IsFulfilled() {
+				n++
+			}
+		}
+		return n
+	}
+
+	suite.Require().NoError(k.ScanOrdersForNewLPs(suite.Ctx))
+	suite.Require().NoError(k.MatchScheduledOrders(suite.Ctx))
+	suite.Require().Equal(keeper.MaxOrdersScannedPerBlock, fulfilled())
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(12)
+	suite.Require().NoError(k.ScanOrdersForNewLPs(suite.Ctx))
+	suite.Require().NoError(k.MatchScheduledOrders(suite.Ctx))
+	suite.Require().Equal(len(orders), fulfilled())
+}
+
+// the spend of the lp is limited per window and credited back when the order is finalized
+func (suite *KeeperTestSuite) TestLPWindowAndRepay() {
+	k := suite.App.EIBCKeeper
+	denom := sdk.DefaultBondDenom
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1000))
+	recipient, lpAddr := addrs[0], addrs[1]
+
+	id, err := k.LPs.Create(suite.Ctx, &types.OnDemandLP{
+		FundsAddr:        lpAddr.String(),
+		Rollapp:          rollappPacket.RollappId,
+		Denom:            denom,
+		MaxPrice:         math.NewInt(100),
+		MinFee:           math.LegacyZeroDec(),
+		SpendLimit:       math.NewInt(250),
+		WindowSpendLimit: math.NewInt(150),
+		WindowBlocks:     5,
+	})
+	suite.Require().NoError(err)
+
+	createOrder := func(seq uint64) (*types.DemandOrder, commontypes.RollappPacket) {
+		p := channeltypes.NewPacket(transferPacketData.GetBytes(), seq, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+		rPacket := *rollappPacket
+		rPacket.Packet = &p
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, rPacket)
+		o := types.NewDemandOrder(rPacket, math.NewInt(100), math.NewInt(10), denom, recipient.String(), 1, nil)
+		suite.Require().NoError(k.SetDemandOrder(suite.Ctx, o))
+		return o, rPacket
+	}
+	spent := func() (math.Int, math.Int) {
+		lp, err := k.LPs.Get(suite.Ctx, id)
+		suite.Require().NoError(err)
+		return lp.Spent, lp.WindowSpentAt(uint64(suite.Ctx.BlockHeight())) //nolint:gosec
+	}
+
+	o1, p1 := createOrder(1)
+	suite.Require().NoError(k.FulfillByOnDemandLP(suite.Ctx, o1.Id, 0))
+
+	// the window limit is reached
+	o2, _ := createOrder(2)
+	suite.Require().Error(k.FulfillByOnDemandLP(suite.Ctx, o2.Id, 0))
+
+	// a new window starts
+	suite.Ctx = suite.Ctx.WithBlockHeight(15)
+	suite.Require().NoError(k.FulfillByOnDemandLP(suite.Ctx, o2.Id, 0))
+	s, w := spent()
+	suite.Require().Equal(math.NewInt(200), s)
+	suite.Require().Equal(math.NewInt(100), w)
+
+	// the spend limit is reached
+	suite.Ctx = suite.Ctx.WithBlockHeight(20)
+	o3, _ := createOrder(3)
+	suite.Require().Error(k.FulfillByOnDemandLP(suite.Ctx, o3.Id, 0))
+
+	// finalizing the first order credits back the spend
+	oldKey := string(p1.RollappPacketKey())
+	p1.Status = commontypes.Status_FINALIZED
+	err = k.GetDelayedAckHooks().AfterPacketStatusUpdated(suite.Ctx, &p1, oldKey, string(p1.RollappPacketKey()))
+	suite.Require().NoError(err)
+	s, _ = spent()
+	suite.Require().Equal(math.NewInt(100), s)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventRepaidOnDemandLP{}), 1)
+
+	suite.Require().NoError(k.FulfillByOnDemandLP(suite.Ctx, o3.Id, 0))
+}
+
+// lps with epoch windows get a new window at the end of the epoch
+func (suite *KeeperTestSuite) TestLPEpochWindow() {
+	k := suite.App.EIBCKeeper
+	lp := &types.OnDemandLP{
+		FundsAddr:             apptesting.CreateRandomAccounts(1)[0].String(),
+		Rollapp:               rollappPacket.RollappId,
+		Denom:                 sdk.DefaultBondDenom,
+		MaxPrice:              math.NewInt(100),
+		MinFee:                math.LegacyZeroDec(),
+		SpendLimit:            math.NewInt(1000),
+		WindowSpendLimit:      math.NewInt(150),
+		WindowEpochIdentifier: "day",
+	}
+	suite.Require().NoError(lp.Validate())
+	id, err := k.LPs.Create(suite.Ctx, lp)
+	suite.Require().NoError(err)
+
+	r, err := k.LPs.Get(suite.Ctx, id)
+	suite.Require().NoError(err)
+	r.Spend(1, math.NewInt(100))
+	suite.Require().NoError(k.LPs.Set(suite.Ctx, *r))
+	suite.Require().Equal(math.NewInt(50), r.MaxSpend(100))
+
+	suite.Require().NoError(k.GetEpochHooks().AfterEpochEnd(suite.Ctx, "hour", 1))
+	r, err = k.LPs.Get(suite.Ctx, id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(100), r.WindowSpent)
+
+	suite.Require().NoError(k.GetEpochHooks().AfterEpochEnd(suite.Ctx, "day", 1))
+	r, err = k.LPs.Get(suite.Ctx, id)
+	suite.Require().NoError(err)
+	suite.Require().True(r.WindowSpent.IsZero())
+	suite.Require().Equal(math.NewInt(100), r.Spent)
+}
+
+// the spend of all the lps of an addr is limited per rollapp, and is not credited back when the packet of the
+// order is finalized with an error
+func (suite *KeeperTestSuite) TestLPRollappLimitAndErrorAck() {
+	k := suite.App.EIBCKeeper
+	denom := sdk.DefaultBondDenom
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1000))
+	recipient, lpAddr := addrs[0], addrs[1]
+
+	for range 2 {
+		_, err := k.LPs.Create(suite.Ctx, &types.OnDemandLP{
+			FundsAddr:         lpAddr.String(),
+			Rollapp:           rollappPacket.RollappId,
+			Denom:             denom,
+			MaxPrice:          math.NewInt(100),
+			MinFee:            math.LegacyZeroDec(),
+			SpendLimit:        math.NewInt(500),
+			RollappSpendLimit: math.NewInt(150),
+		})
+		suite.Require().NoError(err)
+	}
+
+	createOrder := func(seq uint64, price int64) (*types.DemandOrder, commontypes.RollappPacket) {
+		p := channeltypes.NewPacket(transferPacketData.GetBytes(), seq, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+		rPacket := *rollappPacket
+		rPacket.Packet = &p
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, rPacket)
+		o := types.NewDemandOrder(rPacket, math.NewInt(price), math.NewInt(10), denom, recipient.String(), 1, nil)
+		suite.Require().NoError(k.SetDemandOrder(suite.Ctx, o))
+		return o, rPacket
+	}
+	rollappSpent := func() math.Int {
+		s, err := k.LPs.RollappSpent(suite.Ctx, lpAddr.String(), rollappPacket.RollappId)
+		suite.Require().NoError(err)
+		return s
+	}
+
+	// above the max price of a single order
+	o0, _ := createOrder(1, 101)
+	suite.Require().Error(k.FulfillByOnDemandLP(suite.Ctx, o0.Id, 0))
+
+	o1, p1 := createOrder(2, 100)
+	suite.Require().NoError(k.FulfillByOnDemandLP(suite.Ctx, o1.Id, 0))
+	suite.Require().Equal(math.NewInt(100), rollappSpent())
+
+	// the other lp of the addr is within its own limits, but the rollapp limit is reached
+	o2, _ := createOrder(3, 100)
+	suite.Require().Error(k.FulfillByOnDemandLP(suite.Ctx, o2.Id, 0))
+
+	// finalized with an error: the lp did not get its funds back, but the order is no longer at stake on the rollapp
+	oldKey := string(p1.RollappPacketKey())
+	p1.Status = commontypes.Status_FINALIZED
+	p1.Error = "transfer failed"
+	err := k.GetDelayedAckHooks().AfterPacketStatusUpdated(suite.Ctx, &p1, oldKey, string(p1.RollappPacketKey()))
+	suite.Require().NoError(err)
+	suite.Require().True(rollappSpent().IsZero())
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventRepaidOnDemandLP{}), 0)
+	suite.Require().NoError(k.FulfillByOnDemandLP(suite.Ctx, o2.Id, 0))
+	suite.Require().Equal(math.NewInt(100), rollappSpent())
+
+	// the lps are deleted before the order is finalized: the rollapp spend is still released
+	lps, err := k.LPs.GetByAddr(suite.Ctx, lpAddr)
+	suite.Require().NoError(err)
+	for _, lp := range lps {
+		suite.Require().NoError(k.LPs.Del(suite.Ctx, lp.Id, "test"))
+	}
+	p2, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, o2.TrackingPacketKey)
+	suite.Require().NoError(err)
+	oldKey = string(p2.RollappPacketKey())
+	p2.Status = commontypes.Status_FINALIZED
+	err = k.GetDelayedAckHooks().AfterPacketStatusUpdated(suite.Ctx, p2, oldKey, string(p2.RollappPacketKey()))
+	suite.Require().NoError(err)
+	suite.Require().True(rollappSpent().IsZero())
+}
@@ -10,9 +10,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 )
 
 var (
@@ -20,8 +22,25 @@ var (
 	LPsByIDPrefix           = collections.NewPrefix("lps1")
 	LPsNextIDPrefix         = collections.NewPrefix("lps2")
 	LPsByAddrPrefix         = collections.NewPrefix("lps3")
+	LPsMatchQueuePrefix     = collections.NewPrefix("lps4")
+	LPsByOrderPrefix        = collections.NewPrefix("lps5")
+	LPsByEpochPrefix        = collections.NewPrefix("lps6")
+	LPsRollappSpentPrefix   = collections.NewPrefix("lps7")
+	LPsNewScansPrefix       = collections.NewPrefix("lps8")
 )
 
+// MaxScheduledMatchesPerBlock bounds the number of orders matched against the lps at the end of a block.
+// Orders left over are matched in the following blocks.
+const MaxScheduledMatchesPerBlock = 100
+
+// MaxOrdersScannedPerBlock bounds the number of outstanding orders looked at for the new lps at the end of a
+// block. The scans left over continue in the following blocks.
+const MaxOrdersScannedPerBlock = 100
+
+// MaxLPsScannedPerOrder bounds the number of lps of the rollapp and denom of an order looked at when the order
+// is matched. The lps are looked at by id, the oldest first.
+const MaxLPsScannedPerOrder = 100
+
 type LPs struct {
 	// <rollapp,denom,id>
 	byRollAppDenom collections.KeySet[collections.Triple[string, string, uint64]]
@@ -30,6 +49,16 @@ type LPs struct {
 	// <addr,id>
 	byAddr collections.KeySet[collections.Pair[string, uint64]]
 	nextID collections.Sequence
+	// <height,order id> orders to match at the end of the block at height
+	matchQueue collections.KeySet[collections.Pair[uint64, string]]
+	// order id -> id of the lp which fulfilled it, until the order is finalized
+	byOrder collections.Map[string, uint64]
+	// <epoch identifier,id> lps which count their windows in epochs
+	byEpoch collections.KeySet[collections.Pair[string, uint64]]
+	// <addr,rollapp> -> amt spent by all the lps of the addr on orders of the rollapp which are not finalized yet
+	rollappSpent collections.Map[collections.Pair[string, string], math.Int]
+	// id -> id of the last outstanding order of its rollapp scheduled for the new lp, until all are scheduled
+	newScans collections.Map[uint64, string]
 }
 
 func makeLPsStore(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) LPs {
@@ -53,6 +82,36 @@ func makeLPsStore(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) LPs {
 			),
 		),
 		nextID: collections.NewSequence(sb, LPsNextIDPrefix, "nextID"),
+		matchQueue: collections.NewKeySet(
+			sb, LPsMatchQueuePrefix, "matchQueue",
+			collections.PairKeyCodec(
+				collections.Uint64Key,
+				collections.StringKey,
+			),
+		),
+		byOrder: collections.NewMap(
+			sb, LPsByOrderPrefix, "byOrder",
+			collections.StringKey, collections.Uint64Value,
+		),
+		byEpoch: collections.NewKeySet(
+			sb, LPsByEpochPrefix, "byEpoch",
+			collections.PairKeyCodec(
+				collections.StringKey,
+				collections.Uint64Key,
+			),
+		),
+		rollappSpent: collections.NewMap(
+			sb, LPsRollappSpentPrefix, "rollappSpent",
+			collections.PairKeyCodec(
+				collections.StringKey,
+				collections.StringKey,
+			),
+			sdk.IntValue,
+		),
+		newScans: collections.NewMap(
+			sb, LPsNewScansPrefix, "newScans",
+			collections.Uint64Key, collections.StringValue,
+		),
 	}
 }
 
@@ -91,6 +150,12 @@ func (s LPs) Set(ctx sdk.Context, lp types.OnDemandLPRecord) error {
 	if err != nil {
 		return errorsmod.Wrap(err, "set by rollapp denom")
 	}
+	if lp.Lp.HasEpochWindow() {
+		err = s.byEpoch.Set(ctx, collections.Join(lp.Lp.WindowEpochIdentifier, lp.Id))
+		if err != nil {
+			return errorsmod.Wrap(err, "set by epoch")
+		}
+	}
 	return nil
 }
 
@@ -133,6 +198,14 @@ func (s LPs) Del(ctx sdk.Context, id uint64, reason string) error {
 	if err != nil {
 		return errorsmod.Wrap(err, "remove by addr")
 	}
+	err = s.byEpoch.Remove(ctx, collections.Join(lp.Lp.WindowEpochIdentifier, lp.Id))
+	if err != nil {
+		return errorsmod.Wrap(err, "remove by epoch")
+	}
+	err = s.newScans.Remove(ctx, id)
+	if err != nil {
+		return errorsmod.Wrap(err, "remove new scan")
+	}
 	if err := uevent.EmitTypedEvent(ctx, &types.EventDeletedOnDemandLP{
 		Id:        id,
 		FundsAddr: lp.Lp.FundsAddr,
@@ -165,29 +238,38 @@ func (s LPs) GetByAddr(ctx sdk.Context, addr sdk.AccAddress) ([]*types.OnDemandL
 	return ret, err
 }
 
-func (s LPs) GetOrderCompatibleLPs(ctx sdk.Context, o types.DemandOrder) ([]types.OnDemandLPRecord, error) {
-	rol := o.RollappId
-	denom := o.Denom()
-	ranger := collections.NewSuperPrefixedTripleRange[string, string, uint64](rol, denom)
+// orderLPs returns up to MaxLPsScannedPerOrder lps of the rollapp and denom of the order.
+func (s LPs) orderLPs(ctx sdk.Context, o types.DemandOrder) ([]types.OnDemandLPRecord, error) {
+	ranger := collections.NewSuperPrefixedTripleRange[string, string, uint64](o.RollappId, o.Denom())
 	iter, err := s.byRollAppDenom.Iterate(ctx, ranger)
 	if err != nil {
 		return nil, err
 	}
 	defer iter.Close()
 
-	var compat []types.OnDemandLPRecord
-	for ; iter.Valid(); iter.Next() {
+	var ret []types.OnDemandLPRecord
+	for ; iter.Valid() && len(ret) < MaxLPsScannedPerOrder; iter.Next() {
 		key, err := iter.Key()
 		if err != nil {
 			return nil, err
 		}
-		id := key.K3()
-
-		lpr, err := s.byID.Get(ctx, id)
+		lpr, err := s.byID.Get(ctx, key.K3())
 		if err != nil {
 			return nil, err
 		}
-		h := uint64(ctx.BlockHeight()) //nolint:gosec
+		ret = append(ret, lpr)
+	}
+	return ret, nil
+}
+
+func (s LPs) GetOrderCompatibleLPs(ctx sdk.Context, o types.DemandOrder) ([]types.OnDemandLPRecord, error) {
+	lps, err := s.orderLPs(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	var compat []types.OnDemandLPRecord
+	for _, lpr := range lps {
 		if lpr.Accepts(h, &o) {
 			compat = append(compat, lpr)
 		}
@@ -195,6 +277,100 @@ func (s LPs) GetOrderCompatibleLPs(ctx sdk.Context, o types.DemandOrder) ([]type
 	return compat, nil
 }
 
+// RollappSpent returns the amt spent by all the lps of the addr on orders of the rollapp which are not finalized
+// yet.
+func (s LPs) RollappSpent(ctx sdk.Context, addr, rollapp string) (math.Int, error) {
+	ret, err := s.rollappSpent.Get(ctx, collections.Join(addr, rollapp))
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return ret, err
+}
+
+// addRollappSpent adds the (possibly negative) amt to the amt spent by the addr on orders of the rollapp.
+func (s LPs) addRollappSpent(ctx sdk.Context, addr, rollapp string, amt math.Int) error {
+	spent, err := s.RollappSpent(ctx, addr, rollapp)
+	if err != nil {
+		return err
+	}
+	spent = math.MaxInt(math.ZeroInt(), spent.Add(amt))
+	if spent.IsZero() {
+		return s.rollappSpent.Remove(ctx, collections.Join(addr, rollapp))
+	}
+	return s.rollappSpent.Set(ctx, collections.Join(addr, rollapp), spent)
+}
+
+// withinRollappLimit returns true if the lp can spend the amt without bringing the amt spent by its funds addr
+// on the rollapp above its rollapp limit.
+func (s LPs) withinRollappLimit(ctx sdk.Context, lp types.OnDemandLPRecord, amt math.Int) (bool, error) {
+	if !lp.Lp.HasRollappLimit() {
+		return true, nil
+	}
+	spent, err := s.RollappSpent(ctx, lp.Lp.FundsAddr, lp.Lp.Rollapp)
+	if err != nil {
+		return false, err
+	}
+	return spent.Add(amt).LTE(lp.Lp.RollappSpendLimit), nil
+}
+
+// ScheduleMatch queues the order to be matched against the lps at the end of the block at height h.
+func (s LPs) ScheduleMatch(ctx sdk.Context, h uint64, orderID string) error {
+	return s.matchQueue.Set(ctx, collections.Join(h, orderID))
+}
+
+// popScheduledMatches removes and returns up to limit orders which were scheduled for matching at or before h.
+func (s LPs) popScheduledMatches(ctx sdk.Context, h uint64, limit int) ([]string, error) {
+	iter, err := s.matchQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var keys []collections.Pair[uint64, string]
+	for ; iter.Valid() && len(keys) < limit; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		if h < key.K1() {
+			break
+		}
+		keys = append(keys, key)
+	}
+	var ret []string
+	for _, key := range keys {
+		if err := s.matchQueue.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+		ret = append(ret, key.K2())
+	}
+	return ret, nil
+}
+
+// maturityHeights returns the heights at which the order becomes old enough for the lps of its rollapp and denom
+// which are waiting for OrderMinAgeBlocks.
+func (s LPs) maturityHeights(ctx sdk.Context, o types.DemandOrder) ([]uint64, error) {
+	lps, err := s.orderLPs(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	seen := make(map[uint64]struct{})
+	var ret []uint64
+	for _, lpr := range lps {
+		mature := o.CreationHeight + lpr.Lp.OrderMinAgeBlocks
+		if mature <= h {
+			continue
+		}
+		if _, ok := seen[mature]; ok {
+			continue
+		}
+		seen[mature] = struct{}{}
+		ret = append(ret, mature)
+	}
+	return ret, nil
+}
+
 func (k Keeper) FulfillByOnDemandLP(ctx sdk.Context, order string, rng uint64) error {
 	o, err := k.GetOutstandingOrder(ctx, order)
 	if err != nil {
@@ -209,7 +385,14 @@ func (k Keeper) FulfillByOnDemandLP(ctx sdk.Context, order string, rng uint64) e
 		lps[i], lps[j] = lps[j], lps[i]
 	})
 	for _, lp := range lps {
-		err := k.fulfillBasic(ctx, o, lp.Lp.MustAddr())
+		ok, err := k.LPs.withinRollappLimit(ctx, lp, o.PriceAmount())
+		if err != nil {
+			return errorsmod.Wrap(err, "rollapp limit")
+		}
+		if !ok {
+			continue
+		}
+		err = k.fulfillBasic(ctx, o, lp.Lp.MustAddr())
 		if err != nil {
 			if errorsmod.IsOf(err, sdkerrors.ErrInsufficientFunds) {
 				if err := k.LPs.Del(ctx, lp.Id, "out of funds"); err != nil {
@@ -228,17 +411,259 @@ func (k Keeper) FulfillByOnDemandLP(ctx sdk.Context, order string, rng uint64) e
 		}); err != nil {
 			return errorsmod.Wrap(err, "emit event")
 		}
-		lp.Spent = lp.Spent.Add(o.PriceAmount())
+		lp.Spend(uint64(ctx.BlockHeight()), o.PriceAmount()) //nolint:gosec
 		if err = k.LPs.Set(ctx, lp); err != nil {
 			return errorsmod.Wrap(err, "set lp")
 		}
+		if err = k.LPs.addRollappSpent(ctx, lp.Lp.FundsAddr, lp.Lp.Rollapp, o.PriceAmount()); err != nil {
+			return errorsmod.Wrap(err, "add rollapp spent")
+		}
+		if err = k.LPs.byOrder.Set(ctx, o.Id, lp.Id); err != nil {
+			return errorsmod.Wrap(err, "set by order")
+		}
+		if err = k.recordLPFulfilled(ctx, o, lp.Id); err != nil {
+			return errorsmod.Wrap(err, "record lp fulfilled")
+		}
 		return nil
 	}
 	return errorsmod.Wrap(gerrc.ErrNotFound, "no compatible lp")
 }
 
+// repayOnDemandLP credits back the spend of the lp which fulfilled the order, if any. It is called when the
+// order is finalized without error and the lp gets its funds back.
+func (k Keeper) repayOnDemandLP(ctx sdk.Context, o *types.DemandOrder) error {
+	id, err := k.LPs.byOrder.Get(ctx, o.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get by order")
+	}
+	if err := k.LPs.byOrder.Remove(ctx, o.Id); err != nil {
+		return errorsmod.Wrap(err, "remove by order")
+	}
+	if err := k.releaseRollappSpent(ctx, o); err != nil {
+		return errorsmod.Wrap(err, "release rollapp spent")
+	}
+	lp, err := k.LPs.byID.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		// deleted in the meantime
+		return nil
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get lp")
+	}
+	lp.Repay(o.PriceAmount())
+	if err := k.LPs.Set(ctx, lp); err != nil {
+		return errorsmod.Wrap(err, "set lp")
+	}
+	if err := uevent.EmitTypedEvent(ctx, &types.EventRepaidOnDemandLP{
+		OrderId: o.Id,
+		LpId:    id,
+		Amount:  o.PriceAmount().String(),
+	}); err != nil {
+		return errorsmod.Wrap(err, "event")
+	}
+	return nil
+}
+
+// forgetOnDemandLP drops the link between the order and the lp which fulfilled it without repaying the lp,
+// e.g. when the order is reverted or its packet finalized with an error. The spend of the lp stays counted, but
+// the order no longer counts toward the rollapp spend of its funds addr.
+func (k Keeper) forgetOnDemandLP(ctx sdk.Context, orderID string) error {
+	_, err := k.LPs.byOrder.Get(ctx, orderID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get by order")
+	}
+	if err := k.LPs.byOrder.Remove(ctx, orderID); err != nil {
+		return errorsmod.Wrap(err, "remove by order")
+	}
+	o, err := k.getDemandOrderAnyStatus(ctx, orderID)
+	if err != nil {
+		return errorsmod.Wrap(err, "get demand order")
+	}
+	return errorsmod.Wrap(k.releaseRollappSpent(ctx, o), "release rollapp spent")
+}
+
+// releaseRollappSpent takes the order, fulfilled by an lp, off the amt spent on its rollapp by the funds addr of
+// the lp. The funds addr is the fulfiller of the order, so the spend is released even if the lp was deleted.
+func (k Keeper) releaseRollappSpent(ctx sdk.Context, o *types.DemandOrder) error {
+	return k.LPs.addRollappSpent(ctx, o.FulfillerAddress, o.RollappId, o.PriceAmount().Neg())
+}
+
+// resetOnDemandLPWindows starts a new spend window for the lps which count their windows in epochs of the given
+// identifier.
+func (k Keeper) resetOnDemandLPWindows(ctx sdk.Context, epochIdentifier string) error {
+	rng := collections.NewPrefixedPairRange[string, uint64](epochIdentifier)
+	iter, err := k.LPs.byEpoch.Iterate(ctx, rng)
+	if err != nil {
+		return errorsmod.Wrap(err, "iterate by epoch")
+	}
+	ids, err := iter.Keys()
+	if err != nil {
+		return errorsmod.Wrap(err, "keys")
+	}
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	for _, key := range ids {
+		lp, err := k.LPs.byID.Get(ctx, key.K2())
+		if err != nil {
+			return errorsmod.Wrapf(err, "get lp: %d", key.K2())
+		}
+		lp.ResetWindow(h)
+		if err := k.LPs.Set(ctx, lp); err != nil {
+			return errorsmod.Wrapf(err, "set lp: %d", lp.Id)
+		}
+	}
+	return nil
+}
+
+// tryFulfillByOnDemandLP is a best effort FulfillByOnDemandLP: if no lp can fulfill the order, the state is
+// left untouched and false is returned.
+func (k Keeper) tryFulfillByOnDemandLP(ctx sdk.Context, orderID string) bool {
+	cacheCtx, write := ctx.CacheContext()
+	rng := uint64(ctx.BlockHeight()) //nolint:gosec
+	if err := k.FulfillByOnDemandLP(cacheCtx, orderID, rng); err != nil {
+		k.Logger(ctx).Debug("Auto match on demand lp.", "order", orderID, "err", err)
+		return false
+	}
+	write()
+	return true
+}
+
+// matchOnDemandLPOnCreation schedules a newly created order for matching by the lps at the end of the block, so
+// that the relayer of the packet does not pay for looking at the lps.
+func (k Keeper) matchOnDemandLPOnCreation(ctx sdk.Context, o *types.DemandOrder) error {
+	return k.LPs.ScheduleMatch(ctx, uint64(ctx.BlockHeight()), o.Id) //nolint:gosec
+}
+
+// MatchScheduledOrders tries to fulfill the orders scheduled for matching by lps. It is called in EndBlock.
+// An order which no lp accepts yet is scheduled again for when it is old enough for the lps waiting for a min
+// order age.
+func (k Keeper) MatchScheduledOrders(ctx sdk.Context) error {
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	ids, err := k.LPs.popScheduledMatches(ctx, h, MaxScheduledMatchesPerBlock)
+	if err != nil {
+		return errorsmod.Wrap(err, "pop scheduled matches")
+	}
+	for _, id := range ids {
+		// a panic while matching an order must not halt the chain
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			if k.tryFulfillByOnDemandLP(ctx, id) {
+				return nil
+			}
+			return k.scheduleMaturityMatches(ctx, id)
+		})
+	}
+	return nil
+}
+
+// scheduleMaturityMatches schedules the outstanding order for matching at the heights it becomes old enough for
+// the lps of its rollapp and denom.
+func (k Keeper) scheduleMaturityMatches(ctx sdk.Context, orderID string) error {
+	o, err := k.GetOutstandingOrder(ctx, orderID)
+	if err != nil {
+		// filled or settled in the meantime
+		return nil
+	}
+	heights, err := k.LPs.maturityHeights(ctx, *o)
+	if err != nil {
+		return errorsmod.Wrap(err, "maturity heights")
+	}
+	for _, h := range heights {
+		if err := k.LPs.ScheduleMatch(ctx, h, o.Id); err != nil {
+			return errorsmod.Wrap(err, "schedule match")
+		}
+	}
+	return nil
+}
+
+// CreateLP creates the lp. The outstanding orders of its rollapp and denom are scheduled for matching from the
+// end of the block, see ScanOrdersForNewLPs.
 func (k Keeper) CreateLP(ctx sdk.Context, lp *types.OnDemandLP) (uint64, error) {
-	return k.LPs.Create(ctx, lp)
+	id, err := k.LPs.Create(ctx, lp)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.LPs.newScans.Set(ctx, id, ""); err != nil {
+		return 0, errorsmod.Wrap(err, "set new scan")
+	}
+	return id, nil
+}
+
+// ScanOrdersForNewLPs schedules the outstanding orders of the rollapp and denom of the new lps for matching,
+// either at the end of the current block or once they are old enough for the lp. It is called in EndBlock,
+// before the scheduled orders are matched. At most MaxOrdersScannedPerBlock orders are looked at, the scans
+// continue where they stopped in the following blocks.
+func (k Keeper) ScanOrdersForNewLPs(ctx sdk.Context) error {
+	iter, err := k.LPs.newScans.Iterate(ctx, nil)
+	if err != nil {
+		return errorsmod.Wrap(err, "iterate new scans")
+	}
+	scans, err := iter.KeyValues()
+	if err != nil {
+		return errorsmod.Wrap(err, "key values")
+	}
+	budget := MaxOrdersScannedPerBlock
+	for _, scan := range scans {
+		if budget == 0 {
+			break
+		}
+		scanned, err := k.scanOrdersForNewLP(ctx, scan.Key, scan.Value, budget)
+		if err != nil {
+			return errorsmod.Wrapf(err, "scan orders for lp: %d", scan.Key)
+		}
+		budget -= scanned
+	}
+	return nil
+}
+
+// scanOrdersForNewLP schedules up to n outstanding orders for the lp, after the cursor. It returns the number
+// of orders looked at, and drops the scan once all were looked at.
+func (k Keeper) scanOrdersForNewLP(ctx sdk.Context, id uint64, cursor string, n int) (int, error) {
+	lp, err := k.LPs.byID.Get(ctx, id)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "get lp")
+	}
+	rng := collections.NewPrefixedPairRange[string, string](lp.Lp.Rollapp)
+	if cursor != "" {
+		rng = rng.StartExclusive(cursor)
+	}
+	iter, err := k.orderIdx.outstanding.Iterate(ctx, rng)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "iterate outstanding")
+	}
+	defer iter.Close() // nolint: errcheck
+
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	scanned := 0
+	for ; iter.Valid() && scanned < n; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return 0, errorsmod.Wrap(err, "key")
+		}
+		scanned++
+		cursor = key.K2()
+		o, err := k.GetDemandOrder(ctx, commontypes.Status_PENDING, cursor)
+		if errors.Is(err, types.ErrDemandOrderDoesNotExist) {
+			continue
+		}
+		if err != nil {
+			return 0, errorsmod.Wrapf(err, "get demand order: %s", cursor)
+		}
+		if o.Denom() != lp.Lp.Denom {
+			continue
+		}
+		if err := k.LPs.ScheduleMatch(ctx, max(h, o.CreationHeight+lp.Lp.OrderMinAgeBlocks), o.Id); err != nil {
+			return 0, errorsmod.Wrap(err, "schedule match")
+		}
+	}
+	if !iter.Valid() {
+		return scanned, errorsmod.Wrap(k.LPs.newScans.Remove(ctx, id), "remove new scan")
+	}
+	return scanned, errorsmod.Wrap(k.LPs.newScans.Set(ctx, id, cursor), "set new scan")
 }
 
 func (k Keeper) DeleteLP(ctx sdk.Context, owner sdk.AccAddress, id uint64, reason string) error {
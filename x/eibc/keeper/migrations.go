@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	k Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{k: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// It builds the secondary indexes of the existing demand orders.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.k.RebuildDemandOrderIndexes(ctx)
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It indexes the outstanding demand orders and sets the retention of the reverted orders.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.k.GetParams(ctx)
+	params.RevertedOrderRetentionBlocks = types.DefaultParams().RevertedOrderRetentionBlocks
+	m.k.SetParams(ctx, params)
+	return m.k.RebuildDemandOrderIndexes(ctx)
+}
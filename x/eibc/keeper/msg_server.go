@@ -75,6 +75,34 @@ func (m msgServer) FulfillOrder(goCtx context.Context, msg *types.MsgFulfillOrde
 	return &types.MsgFulfillOrderResponse{}, nil
 }
 
+func (m msgServer) FulfillOrderPartial(goCtx context.Context, msg *types.MsgFulfillOrderPartial) (*types.MsgFulfillOrderPartialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	demandOrder, err := m.GetOutstandingOrder(ctx, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the fulfiller expected fee is equal to the demand order fee
+	expectedFee, _ := math.NewIntFromString(msg.ExpectedFee)
+	orderFee := demandOrder.GetFeeAmount()
+	if !orderFee.Equal(expectedFee) {
+		return nil, types.ErrExpectedFeeNotMet
+	}
+
+	err = m.fulfillPartial(ctx, demandOrder, msg.GetFulfillerBech32Address(), msg.Amount)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "fulfill partial")
+	}
+
+	return &types.MsgFulfillOrderPartialResponse{Remaining: demandOrder.UnfilledAmount()}, nil
+}
+
 func (m msgServer) FulfillOrderAuthorized(goCtx context.Context, msg *types.MsgFulfillOrderAuthorized) (*types.MsgFulfillOrderAuthorizedResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	logger := ctx.Logger()
@@ -205,6 +233,11 @@ func (m msgServer) UpdateDemandOrder(goCtx context.Context, msg *types.MsgUpdate
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the recipient can update the order")
 	}
 
+	// The price is fixed once fulfillers took shares of the order
+	if demandOrder.IsPartiallyFulfilled() {
+		return nil, types.ErrDemandOrderPartiallyFilled
+	}
+
 	raPacket, err := m.dack.GetRollappPacket(ctx, demandOrder.TrackingPacketKey)
 	if err != nil {
 		// TODO: isn't this internal error?
@@ -216,17 +249,17 @@ func (m msgServer) UpdateDemandOrder(goCtx context.Context, msg *types.MsgUpdate
 		return nil, err
 	}
 
+	newFeeInt, _ := math.NewIntFromString(msg.NewFee)
+	transferTotal, _ := math.NewIntFromString(data.Amount)
+
 	// Get the bridging fee multiplier
 	// ErrAck or Timeout packets do not incur bridging fees
-	bridgingFeeMultiplier := m.dack.BridgingFee(ctx)
-	raPacketType := raPacket.GetType()
-	if raPacketType != commontypes.RollappPacket_ON_RECV {
-		bridgingFeeMultiplier = math.LegacyZeroDec()
+	bridgingFeeMultiplier := math.LegacyZeroDec()
+	if raPacket.GetType() == commontypes.RollappPacket_ON_RECV {
+		bridgingFeeMultiplier = m.dack.PacketBridgingFee(ctx, *raPacket.Packet, demandOrder.RollappId, demandOrder.Denom(), transferTotal)
 	}
 
 	// calculate the new price: transferTotal - newFee - bridgingFee
-	newFeeInt, _ := math.NewIntFromString(msg.NewFee)
-	transferTotal, _ := math.NewIntFromString(data.Amount)
 	newPrice, err := types.CalcPriceWithBridgingFee(transferTotal, newFeeInt, bridgingFeeMultiplier)
 	if err != nil {
 		return nil, err
@@ -297,3 +330,69 @@ func (m msgServer) DeleteOnDemandLP(goCtx context.Context, msg *types.MsgDeleteO
 
 	return &types.MsgDeleteOnDemandLPResponse{}, nil
 }
+
+func (m msgServer) TransferFulfilledClaim(goCtx context.Context, msg *types.MsgTransferFulfilledClaim) (*types.MsgTransferFulfilledClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+
+	err = m.Keeper.TransferFulfilledClaim(ctx, msg.OrderId,
+		sdk.MustAccAddressFromBech32(msg.Owner), sdk.MustAccAddressFromBech32(msg.NewOwner), nil)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "transfer fulfilled claim")
+	}
+
+	return &types.MsgTransferFulfilledClaimResponse{}, nil
+}
+
+func (m msgServer) ListFulfilledClaim(goCtx context.Context, msg *types.MsgListFulfilledClaim) (*types.MsgListFulfilledClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+
+	err = m.Keeper.ListFulfilledClaim(ctx, msg.OrderId, sdk.MustAccAddressFromBech32(msg.Owner), msg.Price)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "list fulfilled claim")
+	}
+
+	return &types.MsgListFulfilledClaimResponse{}, nil
+}
+
+func (m msgServer) DelistFulfilledClaim(goCtx context.Context, msg *types.MsgDelistFulfilledClaim) (*types.MsgDelistFulfilledClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+
+	err = m.Keeper.DelistFulfilledClaim(ctx, msg.OrderId, sdk.MustAccAddressFromBech32(msg.Owner))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "delist fulfilled claim")
+	}
+
+	return &types.MsgDelistFulfilledClaimResponse{}, nil
+}
+
+func (m msgServer) BuyFulfilledClaim(goCtx context.Context, msg *types.MsgBuyFulfilledClaim) (*types.MsgBuyFulfilledClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+
+	err = m.Keeper.BuyFulfilledClaim(ctx, msg.OrderId,
+		sdk.MustAccAddressFromBech32(msg.Buyer), sdk.MustAccAddressFromBech32(msg.Seller), msg.ExpectedPrice)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "buy fulfilled claim")
+	}
+
+	return &types.MsgBuyFulfilledClaimResponse{}, nil
+}
@@ -830,3 +830,155 @@ func (suite *KeeperTestSuite) TestMsgOnDemandLPFlow() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgFulfillOrderPartial() {
+	denom := sdk.DefaultBondDenom
+	k := suite.App.EIBCKeeper
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1000))
+	recipient, fulfillerA, fulfillerB := addrs[0], addrs[1], addrs[2]
+
+	rPacket := *rollappPacket
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, rPacket)
+	order := types.NewDemandOrder(rPacket, math.NewInt(300), math.NewInt(30), denom, recipient.String(), 1, nil)
+	err := k.SetDemandOrder(suite.Ctx, order)
+	suite.Require().NoError(err)
+
+	// first share
+	res, err := suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfillerA.String(), order.Id, "30", math.NewInt(100)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(200), res.Remaining)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventDemandOrderPartiallyFulfilled{}), 1)
+
+	// the share is escrowed, the packet is not redirected yet
+	module := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(math.NewInt(100), suite.App.BankKeeper.GetBalance(suite.Ctx, module, denom).Amount)
+	suite.Require().Equal(math.NewInt(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, denom).Amount)
+	p, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, string(rollappPacketKey))
+	suite.Require().NoError(err)
+	data, err := p.GetTransferPacketData()
+	suite.Require().NoError(err)
+	suite.Require().Equal(eibcReceiverAddr.String(), data.Receiver)
+
+	// other fulfillers cannot take the whole order anymore, and the fee is fixed
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfillerB.String(), order.Id, "30"))
+	suite.Require().True(errorsmod.IsOf(err, types.ErrDemandOrderPartiallyFilled))
+	_, err = suite.msgServer.UpdateDemandOrder(suite.Ctx, types.NewMsgUpdateDemandOrder(recipient.String(), order.Id, "40"))
+	suite.Require().True(errorsmod.IsOf(err, types.ErrDemandOrderPartiallyFilled))
+
+	// cannot take more than the remaining amount
+	_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfillerB.String(), order.Id, "30", math.NewInt(201)))
+	suite.Require().True(errorsmod.IsOf(err, types.ErrPartialFulfillmentTooLarge))
+
+	// second share completes the order
+	res, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfillerB.String(), order.Id, "30", math.NewInt(200)))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Remaining.IsZero())
+
+	order, err = k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, order.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(order.IsFulfilled())
+	suite.Require().Len(order.Fulfillments, 2)
+	suite.Require().Equal([]string{fulfillerA.String(), fulfillerB.String()}, order.Fulfillers())
+
+	// the escrow account of the order fulfills it and the packet is redirected to it
+	escrow := types.PartialEscrowAddress(order.Id)
+	suite.Require().Equal(escrow.String(), order.FulfillerAddress)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventDemandOrderFulfilled{}), 1)
+	p, err = suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, string(rollappPacketKey))
+	suite.Require().NoError(err)
+	data, err = p.GetTransferPacketData()
+	suite.Require().NoError(err)
+	suite.Require().Equal(escrow.String(), data.Receiver)
+	suite.Require().Equal(eibcReceiverAddr.String(), p.OriginalTransferTarget)
+
+	_, err = k.GetOutstandingOrder(suite.Ctx, order.Id)
+	suite.Require().True(errorsmod.IsOf(err, types.ErrDemandAlreadyFulfilled))
+
+	suite.Require().Equal(math.NewInt(1300), suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, denom).Amount)
+	suite.Require().Equal(math.NewInt(900), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfillerA, denom).Amount)
+	suite.Require().Equal(math.NewInt(800), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfillerB, denom).Amount)
+
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, module, denom).IsZero())
+
+	// funds of other orders in the module account are not used
+	suite.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 500)))
+
+	// simulate the finalized transfer crediting the escrow account with price + fee
+	suite.FundAcc(escrow, sdk.NewCoins(sdk.NewInt64Coin(denom, 330)))
+	err = k.SettlePartialFulfillments(suite.Ctx, &rPacket)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(math.NewInt(1010), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfillerA, denom).Amount)
+	suite.Require().Equal(math.NewInt(1020), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfillerB, denom).Amount)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, escrow, denom).IsZero())
+	suite.Require().Equal(math.NewInt(500), suite.App.BankKeeper.GetBalance(suite.Ctx, module, denom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestPartialFulfillmentRefund() {
+	denom := sdk.DefaultBondDenom
+	k := suite.App.EIBCKeeper
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1000))
+	recipient, fulfiller := addrs[0], addrs[1]
+
+	rPacket := *rollappPacket
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, rPacket)
+	order := types.NewDemandOrder(rPacket, math.NewInt(300), math.NewInt(30), denom, recipient.String(), 1, nil)
+	suite.Require().NoError(k.SetDemandOrder(suite.Ctx, order))
+
+	_, err := suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfiller.String(), order.Id, "30", math.NewInt(100)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(900), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller, denom).Amount)
+
+	// the packet settles before the order is fulfilled: the share is refunded
+	suite.Require().NoError(k.SettlePartialFulfillments(suite.Ctx, &rPacket))
+	suite.Require().Equal(math.NewInt(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller, denom).Amount)
+	suite.Require().Equal(math.NewInt(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, denom).Amount)
+	order, err = k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, order.Id)
+	suite.Require().NoError(err)
+	suite.Require().False(order.IsPartiallyFulfilled())
+}
+
+func (suite *KeeperTestSuite) TestMsgFulfillOrderFeeDecay() {
+	denom := sdk.DefaultBondDenom
+	k := suite.App.EIBCKeeper
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1000))
+	recipient, fulfiller := addrs[0], addrs[1]
+
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	order := types.NewDemandOrder(*rollappPacket, math.NewInt(300), math.NewInt(100), denom, recipient.String(), 10, nil)
+	order.FeeDecay = &types.FeeDecay{Floor: math.NewInt(40), PerBlock: math.NewInt(15)}
+	suite.Require().NoError(k.SetDemandOrder(suite.Ctx, order))
+	querier := keeper.NewQuerier(k)
+
+	// 3 blocks after creation the fee decayed by 45
+	suite.Ctx = suite.Ctx.WithBlockHeight(13)
+	res, err := querier.DemandOrderById(suite.Ctx, &types.QueryGetDemandOrderRequest{Id: order.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 55)), res.EffectiveFee)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 345)), res.EffectivePrice)
+	suite.Require().Equal(order, res.DemandOrder)
+
+	// the fee does not go below the floor
+	suite.Ctx = suite.Ctx.WithBlockHeight(100)
+	res, err = querier.DemandOrderById(suite.Ctx, &types.QueryGetDemandOrderRequest{Id: order.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 40)), res.EffectiveFee)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 360)), res.EffectivePrice)
+
+	// fulfillers must expect the current fee
+	suite.Ctx = suite.Ctx.WithBlockHeight(13)
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfiller.String(), order.Id, "100"))
+	suite.Require().True(errorsmod.IsOf(err, types.ErrExpectedFeeNotMet))
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfiller.String(), order.Id, "55"))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(math.NewInt(1345), suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, denom).Amount)
+	suite.Require().Equal(math.NewInt(655), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller, denom).Amount)
+
+	// the fee is fixed once the order is fulfilled
+	order, err = k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, order.Id)
+	suite.Require().NoError(err)
+	suite.Require().Nil(order.FeeDecay)
+	suite.Require().Equal(math.NewInt(55), order.EffectiveFee(100))
+	suite.Require().Equal(math.NewInt(345), order.PriceAmount())
+}
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+var (
+	RevertedOrdersByPacketPrefix = collections.NewPrefix("rv0")
+	RevertedOrdersQueuePrefix    = collections.NewPrefix("rv1")
+)
+
+type revertedOrders struct {
+	// <reverted packet id> -> reverted order id
+	byPacket collections.Map[string, string]
+	// <revert height,order id,reverted packet id>
+	queue collections.KeySet[collections.Triple[uint64, string, string]]
+}
+
+func makeRevertedOrders(sb *collections.SchemaBuilder) revertedOrders {
+	return revertedOrders{
+		byPacket: collections.NewMap(
+			sb, RevertedOrdersByPacketPrefix, "revertedByPacket",
+			collections.StringKey, collections.StringValue,
+		),
+		queue: collections.NewKeySet(
+			sb, RevertedOrdersQueuePrefix, "revertedQueue",
+			collections.TripleKeyCodec(
+				collections.Uint64Key,
+				collections.StringKey,
+				collections.StringKey,
+			),
+		),
+	}
+}
+
+// GetRevertedDemandOrder returns the reverted demand order with the given id.
+func (k Keeper) GetRevertedDemandOrder(ctx sdk.Context, id string) (*types.DemandOrder, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRevertedDemandOrderKey(id))
+	if bz == nil {
+		return nil, types.ErrDemandOrderDoesNotExist
+	}
+	var order types.DemandOrder
+	if err := k.cdc.Unmarshal(bz, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// ListRevertedDemandOrders returns all the reverted demand orders.
+func (k Keeper) ListRevertedDemandOrders(ctx sdk.Context) []*types.DemandOrder {
+	return k.listDemandOrdersByPrefix(ctx, types.RevertedDemandOrderKeyPrefix, 0)
+}
+
+// revertedPacketID identifies a packet across rollapp revisions: the re-submitted packet has the same
+// rollapp, type, hub port and channel, and sequence, but not the same proof height.
+func revertedPacketID(p *commontypes.RollappPacket) string {
+	port, channel := commontypes.PacketHubPortChan(p.Type, *p.Packet)
+	return fmt.Sprintf("%s/%s/%s/%s/%d", p.RollappId, p.Type, port, channel, p.Packet.Sequence)
+}
+
+// revertOrder settles a fulfilled order whose packet is reverted by a hard fork. The order is kept as
+// reverted, together with the owner of the claim on its proceeds, until the rollapp re-submits the packet on
+// its new revision or the order is pruned. The fulfillment is then carried to the order of the new packet.
+func (k Keeper) revertOrder(ctx sdk.Context, o *types.DemandOrder, p *commontypes.RollappPacket) error {
+	if !o.IsPartiallyFulfilled() {
+		owner, err := p.TransferTarget()
+		if err != nil {
+			return errorsmod.Wrap(err, "transfer target")
+		}
+		o.ClaimOwner = owner
+	}
+	if err := k.removeClaimListings(ctx, o.Id); err != nil {
+		return errorsmod.Wrap(err, "remove claim listings")
+	}
+	k.deleteDemandOrder(ctx, o.TrackingPacketStatus, o.Id)
+	o.OrderStatus = types.OrderStatus_ORDER_REVERTED
+	o.RevertedHeight = uint64(ctx.BlockHeight()) //nolint:gosec
+	if err := k.SetDemandOrder(ctx, o); err != nil {
+		return errorsmod.Wrap(err, "set demand order")
+	}
+	pid := revertedPacketID(p)
+	if err := k.reverted.byPacket.Set(ctx, pid, o.Id); err != nil {
+		return errorsmod.Wrap(err, "set reverted by packet")
+	}
+	if err := k.reverted.queue.Set(ctx, collections.Join3(o.RevertedHeight, o.Id, pid)); err != nil {
+		return errorsmod.Wrap(err, "set reverted queue")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventDemandOrderReverted{
+		OrderId:    o.Id,
+		RollappId:  o.RollappId,
+		PacketType: o.Type.String(),
+		Fulfiller:  o.FulfillerAddress,
+		ClaimOwner: o.ClaimOwner,
+	})
+}
+
+// carryRevertedOrder hands over the fulfillment of the reverted order of the same packet, if any, to the new
+// order of the re-submitted packet. It is only carried if the new order is for the same transfer, so that
+// the fulfiller gets what it paid for. It returns true if the order was carried.
+func (k Keeper) carryRevertedOrder(ctx sdk.Context, o *types.DemandOrder, p *commontypes.RollappPacket) (bool, error) {
+	pid := revertedPacketID(p)
+	id, err := k.reverted.byPacket.Get(ctx, pid)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errorsmod.Wrap(err, "get reverted by packet")
+	}
+	// the packet was re-submitted, there is no other chance to carry the order
+	if err := k.reverted.byPacket.Remove(ctx, pid); err != nil {
+		return false, errorsmod.Wrap(err, "remove reverted by packet")
+	}
+	reverted, err := k.GetRevertedDemandOrder(ctx, id)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "get reverted order")
+	}
+	if !reverted.SameTransfer(o) {
+		k.Logger(ctx).Info("Re-submitted packet does not match reverted order.", "reverted", reverted.Id, "order", o.Id)
+		return false, nil
+	}
+
+	o.CarryFulfillment(reverted)
+	target := reverted.ClaimOwner
+	if o.IsPartiallyFulfilled() {
+		target = types.PartialEscrowAddress(o.Id).String()
+	}
+	if err := k.dack.UpdateRollappPacketTransferAddress(ctx, o.TrackingPacketKey, target); err != nil {
+		return false, errorsmod.Wrap(err, "update packet transfer address")
+	}
+	if err := k.SetDemandOrder(ctx, o); err != nil {
+		return false, errorsmod.Wrap(err, "set demand order")
+	}
+
+	reverted.CarriedTo = o.Id
+	if err := k.SetDemandOrder(ctx, reverted); err != nil {
+		return false, errorsmod.Wrap(err, "set reverted order")
+	}
+
+	// the fulfillers of the reverted order are credited when the new order is finalized
+	if err := k.carryOrderFulfillers(ctx, reverted.Id, o.Id); err != nil {
+		return false, errorsmod.Wrap(err, "carry order fulfillers")
+	}
+
+	// the on-demand lp which fulfilled the order is repaid when the new order is finalized
+	lpID, err := k.LPs.byOrder.Get(ctx, reverted.Id)
+	if err == nil {
+		if err := k.LPs.byOrder.Remove(ctx, reverted.Id); err != nil {
+			return false, errorsmod.Wrap(err, "remove lp by order")
+		}
+		if err := k.LPs.byOrder.Set(ctx, o.Id, lpID); err != nil {
+			return false, errorsmod.Wrap(err, "set lp by order")
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return false, errorsmod.Wrap(err, "get lp by order")
+	}
+
+	return true, uevent.EmitTypedEvent(ctx, &types.EventDemandOrderCarried{
+		RevertedOrderId: reverted.Id,
+		OrderId:         o.Id,
+	})
+}
+
+// pruneRevertedOrders deletes the reverted orders older than the retention period of the params, whether they
+// were carried or their packet was never re-submitted.
+func (k Keeper) pruneRevertedOrders(ctx sdk.Context) error {
+	retention := k.GetParams(ctx).RevertedOrderRetentionBlocks
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	if h < retention {
+		return nil
+	}
+	rng := new(collections.Range[collections.Triple[uint64, string, string]]).
+		EndExclusive(collections.TriplePrefix[uint64, string, string](h - retention + 1))
+	iter, err := k.reverted.queue.Iterate(ctx, rng)
+	if err != nil {
+		return errorsmod.Wrap(err, "iterate reverted queue")
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return errorsmod.Wrap(err, "keys")
+	}
+	for _, key := range keys {
+		if err := k.reverted.queue.Remove(ctx, key); err != nil {
+			return errorsmod.Wrap(err, "remove reverted queue")
+		}
+		if err := k.reverted.byPacket.Remove(ctx, key.K3()); err != nil {
+			return errorsmod.Wrap(err, "remove reverted by packet")
+		}
+		// the on-demand lp which fulfilled an order which was not carried is never repaid
+		if err := k.forgetOnDemandLP(ctx, key.K2()); err != nil {
+			return errorsmod.Wrap(err, "forget on demand lp")
+		}
+		if err := k.forgetOrderFulfillers(ctx, key.K2()); err != nil {
+			return errorsmod.Wrap(err, "forget order fulfillers")
+		}
+		k.deleteDemandOrderByKey(ctx, types.GetRevertedDemandOrderKey(key.K2()), key.K2())
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	denomutils "github.com/dymensionxyz/dymension/v3/utils/denom"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// a fulfilled order reverted by a hard fork is carried to the re-submitted packet
+func (suite *KeeperTestSuite) TestRevertedOrderCarried() {
+	k := suite.App.EIBCKeeper
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1000))
+	fulfiller, buyer := addrs[0], addrs[1]
+
+	data := transferPacketData
+	data.Memo = `{"eibc":{"fee":"100"}}`
+	denom := denomutils.GetIncomingTransferDenom(packet, data)
+	suite.FundAcc(fulfiller, sdk.NewCoins(sdk.NewInt64Coin(denom, 10_000)))
+
+	createOrder := func(seq, proofHeight uint64) (*types.DemandOrder, commontypes.RollappPacket) {
+		p := channeltypes.NewPacket(data.GetBytes(), seq, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+		rPacket := *rollappPacket
+		rPacket.Packet = &p
+		rPacket.ProofHeight = proofHeight
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, rPacket)
+		suite.Require().NoError(k.EIBCDemandOrderHandler(suite.Ctx, rPacket, data))
+		o, err := k.PendingOrderByPacket(suite.Ctx, &rPacket)
+		suite.Require().NoError(err)
+		return o, rPacket
+	}
+	// the hard fork deletes the packet as it is in the store
+	revert := func(p commontypes.RollappPacket) {
+		stored, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, string(p.RollappPacketKey()))
+		suite.Require().NoError(err)
+		suite.App.DelayedAckKeeper.DeleteRollappPacket(suite.Ctx, stored)
+	}
+	reverted := func() []*types.DemandOrder {
+		res, err := suite.queryClient.RevertedDemandOrders(suite.Ctx, &types.QueryRevertedDemandOrdersRequest{RollappId: rollappPacket.RollappId})
+		suite.Require().NoError(err)
+		return res.DemandOrders
+	}
+
+	// an order which was not fulfilled is simply deleted
+	o, p := createOrder(1, 5)
+	revert(p)
+	_, err := suite.queryClient.DemandOrderById(suite.Ctx, &types.QueryGetDemandOrderRequest{Id: o.Id})
+	suite.Require().Error(err)
+	suite.Require().Empty(reverted())
+
+	// the claim of a fulfilled order is kept with its owner
+	o, p = createOrder(2, 5)
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfiller.String(), o.Id, "100"))
+	suite.Require().NoError(err)
+	err = k.TransferFulfilledClaim(suite.Ctx, o.Id, fulfiller, buyer, nil)
+	suite.Require().NoError(err)
+	revert(p)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventDemandOrderReverted{}), 1)
+
+	suite.Require().Len(reverted(), 1)
+	r, err := k.GetRevertedDemandOrder(suite.Ctx, o.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(buyer.String(), r.ClaimOwner)
+	suite.Require().Equal(fulfiller.String(), r.FulfillerAddress)
+
+	// the packet is re-submitted on the new revision
+	carried, p2 := createOrder(2, 8)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventDemandOrderCarried{}), 1)
+	suite.Require().True(carried.IsFulfilled())
+	suite.Require().Equal(fulfiller.String(), carried.FulfillerAddress)
+	suite.Require().Equal(r.Price, carried.Price)
+
+	newPacket, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, string(p2.RollappPacketKey()))
+	suite.Require().NoError(err)
+	target, err := newPacket.TransferTarget()
+	suite.Require().NoError(err)
+	suite.Require().Equal(buyer.String(), target)
+	suite.Require().Equal(eibcReceiverAddr.String(), newPacket.OriginalTransferTarget)
+
+	r, err = k.GetRevertedDemandOrder(suite.Ctx, o.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(carried.Id, r.CarriedTo)
+
+	// a later hard fork reverts the carried order again
+	revert(p2)
+	suite.Require().Len(reverted(), 2)
+	carried, _ = createOrder(2, 10)
+	suite.Require().True(carried.IsFulfilled())
+	suite.Require().Equal(fulfiller.String(), carried.FulfillerAddress)
+}
+
+// reverted orders are pruned at the end of the epoch once they are older than the retention period
+func (suite *KeeperTestSuite) TestRevertedOrderPruned() {
+	k := suite.App.EIBCKeeper
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	fulfiller := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, math.NewInt(1000))[0]
+
+	data := transferPacketData
+	data.Memo = `{"eibc":{"fee":"100"}}`
+	suite.FundAcc(fulfiller, sdk.NewCoins(sdk.NewInt64Coin(denomutils.GetIncomingTransferDenom(packet, data), 10_000)))
+
+	p := channeltypes.NewPacket(data.GetBytes(), 1, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	rPacket := *rollappPacket
+	rPacket.Packet = &p
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, rPacket)
+	suite.Require().NoError(k.EIBCDemandOrderHandler(suite.Ctx, rPacket, data))
+	o, err := k.PendingOrderByPacket(suite.Ctx, &rPacket)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfiller.String(), o.Id, "100"))
+	suite.Require().NoError(err)
+	stored, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, string(rPacket.RollappPacketKey()))
+	suite.Require().NoError(err)
+	suite.App.DelayedAckKeeper.DeleteRollappPacket(suite.Ctx, stored)
+	_, err = k.GetRevertedDemandOrder(suite.Ctx, o.Id)
+	suite.Require().NoError(err)
+
+	epoch := k.EpochIdentifier(suite.Ctx)
+	retention := int64(k.GetParams(suite.Ctx).RevertedOrderRetentionBlocks) //nolint:gosec
+	suite.Ctx = suite.Ctx.WithBlockHeight(10 + retention - 1)
+	suite.Require().NoError(k.GetEpochHooks().AfterEpochEnd(suite.Ctx, epoch, 1))
+	_, err = k.GetRevertedDemandOrder(suite.Ctx, o.Id)
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(10 + retention)
+	suite.Require().NoError(k.GetEpochHooks().AfterEpochEnd(suite.Ctx, epoch, 2))
+	_, err = k.GetRevertedDemandOrder(suite.Ctx, o.Id)
+	suite.Require().ErrorIs(err, types.ErrDemandOrderDoesNotExist)
+
+	// the packet re-submitted after the pruning gets a new order
+	suite.Require().NoError(k.EIBCDemandOrderHandler(suite.Ctx, rPacket, data))
+	o, err = k.PendingOrderByPacket(suite.Ctx, &rPacket)
+	suite.Require().NoError(err)
+	suite.Require().False(o.IsFulfilled())
+}
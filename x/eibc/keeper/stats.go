@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+var (
+	FulfillerStatsPrefix  = collections.NewPrefix("st0")
+	LPStatsPrefix         = collections.NewPrefix("st1")
+	OrderFulfillersPrefix = collections.NewPrefix("st2")
+)
+
+type stats struct {
+	// fulfiller address -> stats
+	byFulfiller collections.Map[string, types.FulfillmentStats]
+	// on-demand lp id -> stats
+	byLP collections.Map[uint64, types.FulfillmentStats]
+	// <order id,fulfiller> -> part of the price paid. The fulfillers of an order are recorded when they fill
+	// it, because the claim on its proceeds can change hands afterwards.
+	fulfillers collections.Map[collections.Pair[string, string], math.Int]
+}
+
+func makeStats(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) stats {
+	return stats{
+		byFulfiller: collections.NewMap(
+			sb, FulfillerStatsPrefix, "fulfillerStats",
+			collections.StringKey, codec.CollValue[types.FulfillmentStats](cdc),
+		),
+		byLP: collections.NewMap(
+			sb, LPStatsPrefix, "lpStats",
+			collections.Uint64Key, codec.CollValue[types.FulfillmentStats](cdc),
+		),
+		fulfillers: collections.NewMap(
+			sb, OrderFulfillersPrefix, "orderFulfillers",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue,
+		),
+	}
+}
+
+// updateStats applies f to the stats stored under the key, starting from empty stats.
+func updateStats[K any](ctx sdk.Context, m collections.Map[K, types.FulfillmentStats], key K, f func(*types.FulfillmentStats)) error {
+	s, err := m.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	f(&s)
+	return m.Set(ctx, key, s)
+}
+
+func statsFilled(volume sdk.Coin) func(*types.FulfillmentStats) {
+	return func(s *types.FulfillmentStats) {
+		s.OrdersFilled++
+		s.Volume = s.Volume.Add(volume)
+	}
+}
+
+func statsFeeEarned(fee sdk.Coin) func(*types.FulfillmentStats) {
+	return func(s *types.FulfillmentStats) {
+		if fee.IsPositive() {
+			s.FeesEarned = s.FeesEarned.Add(fee)
+		}
+	}
+}
+
+func statsReverted(s *types.FulfillmentStats) {
+	s.OrdersReverted++
+}
+
+// GetFulfillerStats returns the stats of the fulfiller, empty if it never filled an order.
+func (k Keeper) GetFulfillerStats(ctx sdk.Context, fulfiller string) (types.FulfillmentStats, error) {
+	s, err := k.stats.byFulfiller.Get(ctx, fulfiller)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.FulfillmentStats{}, nil
+	}
+	return s, err
+}
+
+// GetLPStats returns the stats of the on-demand lp, empty if it never filled an order.
+func (k Keeper) GetLPStats(ctx sdk.Context, id uint64) (types.FulfillmentStats, error) {
+	s, err := k.stats.byLP.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.FulfillmentStats{}, nil
+	}
+	return s, err
+}
+
+// recordFulfilled records that the fulfiller paid amt of the price of the order.
+func (k Keeper) recordFulfilled(ctx sdk.Context, o *types.DemandOrder, fulfiller string, amt math.Int) error {
+	key := collections.Join(o.Id, fulfiller)
+	paid, err := k.stats.fulfillers.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		paid = math.ZeroInt()
+	} else if err != nil {
+		return errorsmod.Wrap(err, "get order fulfiller")
+	}
+	if err := k.stats.fulfillers.Set(ctx, key, paid.Add(amt)); err != nil {
+		return errorsmod.Wrap(err, "set order fulfiller")
+	}
+	return updateStats(ctx, k.stats.byFulfiller, fulfiller, statsFilled(sdk.NewCoin(o.Denom(), amt)))
+}
+
+// recordLPFulfilled records that the on-demand lp filled the order.
+func (k Keeper) recordLPFulfilled(ctx sdk.Context, o *types.DemandOrder, id uint64) error {
+	return updateStats(ctx, k.stats.byLP, id, statsFilled(sdk.NewCoin(o.Denom(), o.PriceAmount())))
+}
+
+// orderFulfillers returns the fulfillers which filled the order, with the part of the price each of them paid.
+func (k Keeper) orderFulfillers(ctx sdk.Context, orderID string) ([]collections.KeyValue[collections.Pair[string, string], math.Int], error) {
+	iter, err := k.stats.fulfillers.Iterate(ctx, collections.NewPrefixedPairRange[string, string](orderID))
+	if err != nil {
+		return nil, err
+	}
+	return iter.KeyValues()
+}
+
+// forgetOrderFulfillers drops the fulfillers of the order, once their stats can no longer change.
+func (k Keeper) forgetOrderFulfillers(ctx sdk.Context, orderID string) error {
+	return k.stats.fulfillers.Clear(ctx, collections.NewPrefixedPairRange[string, string](orderID))
+}
+
+// carryOrderFulfillers hands over the fulfillers of the reverted order to the order which took over its
+// fulfillment.
+func (k Keeper) carryOrderFulfillers(ctx sdk.Context, revertedID, orderID string) error {
+	kvs, err := k.orderFulfillers(ctx, revertedID)
+	if err != nil {
+		return errorsmod.Wrap(err, "order fulfillers")
+	}
+	for _, kv := range kvs {
+		if err := k.stats.fulfillers.Set(ctx, collections.Join(orderID, kv.Key.K2()), kv.Value); err != nil {
+			return errorsmod.Wrap(err, "set order fulfiller")
+		}
+	}
+	return k.forgetOrderFulfillers(ctx, revertedID)
+}
+
+// recordFinalized credits the fee of the finalized order to the fulfillers which filled it, pro-rata to the
+// part of the price each of them paid, and to the on-demand lp which fulfilled it if the lp still holds the
+// claim on the proceeds. The shares of an order which was not completely filled are refunded, they earn no
+// fee.
+func (k Keeper) recordFinalized(ctx sdk.Context, o *types.DemandOrder) error {
+	kvs, err := k.orderFulfillers(ctx, o.Id)
+	if err != nil {
+		return errorsmod.Wrap(err, "order fulfillers")
+	}
+	if o.IsFulfilled() {
+		for _, kv := range kvs {
+			f := kv.Key.K2()
+			fee := o.GetFeeAmount().Mul(kv.Value).Quo(o.PriceAmount())
+			if err := updateStats(ctx, k.stats.byFulfiller, f, statsFeeEarned(sdk.NewCoin(o.Denom(), fee))); err != nil {
+				return errorsmod.Wrapf(err, "update fulfiller stats: %s", f)
+			}
+		}
+	}
+	if err := k.forgetOrderFulfillers(ctx, o.Id); err != nil {
+		return errorsmod.Wrap(err, "forget order fulfillers")
+	}
+	id, err := k.LPs.byOrder.Get(ctx, o.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get lp by order")
+	}
+	if err := updateStats(ctx, k.stats.byLP, id, statsFeeEarned(sdk.NewCoin(o.Denom(), o.GetFeeAmount()))); err != nil {
+		return errorsmod.Wrapf(err, "update lp stats: %d", id)
+	}
+	return nil
+}
+
+// recordRevertedOrder records that the pending order, if it was filled, was reverted. The fulfillers which
+// filled it and the on-demand lp which fulfilled it, if any, get the revert on their record.
+func (k Keeper) recordRevertedOrder(ctx sdk.Context, orderID string) error {
+	kvs, err := k.orderFulfillers(ctx, orderID)
+	if err != nil {
+		return errorsmod.Wrap(err, "order fulfillers")
+	}
+	for _, kv := range kvs {
+		f := kv.Key.K2()
+		if err := updateStats(ctx, k.stats.byFulfiller, f, statsReverted); err != nil {
+			return errorsmod.Wrapf(err, "update fulfiller stats: %s", f)
+		}
+	}
+	id, err := k.LPs.byOrder.Get(ctx, orderID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get lp by order")
+	}
+	if err := updateStats(ctx, k.stats.byLP, id, statsReverted); err != nil {
+		return errorsmod.Wrapf(err, "update lp stats: %d", id)
+	}
+	return nil
+}
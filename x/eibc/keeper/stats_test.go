@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func (suite *KeeperTestSuite) TestFulfillmentStats() {
+	denom := sdk.DefaultBondDenom
+	k := suite.App.EIBCKeeper
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 5, math.NewInt(1000))
+	recipient, fulfiller, fulfillerB, lpAddr, buyer := addrs[0], addrs[1], addrs[2], addrs[3], addrs[4]
+
+	createOrder := func(seq uint64, price int64) (*types.DemandOrder, commontypes.RollappPacket) {
+		p := channeltypes.NewPacket(transferPacketData.GetBytes(), seq, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+		rPacket := *rollappPacket
+		rPacket.Packet = &p
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, rPacket)
+		o := types.NewDemandOrder(rPacket, math.NewInt(price), math.NewInt(price/10), denom, recipient.String(), 1, nil)
+		suite.Require().NoError(k.SetDemandOrder(suite.Ctx, o))
+		return o, rPacket
+	}
+	finalize := func(p commontypes.RollappPacket) {
+		oldKey := string(p.RollappPacketKey())
+		p.Status = commontypes.Status_FINALIZED
+		err := k.GetDelayedAckHooks().AfterPacketStatusUpdated(suite.Ctx, &p, oldKey, string(p.RollappPacketKey()))
+		suite.Require().NoError(err)
+	}
+	fulfillerStats := func(addr sdk.AccAddress) types.FulfillmentStats {
+		res, err := suite.queryClient.FulfillerStats(suite.Ctx, &types.QueryFulfillerStatsRequest{Address: addr.String()})
+		suite.Require().NoError(err)
+		return res.Stats
+	}
+	lpStats := func(id uint64) types.FulfillmentStats {
+		res, err := suite.queryClient.OnDemandLPStats(suite.Ctx, &types.QueryOnDemandLPStatsRequest{Id: id})
+		suite.Require().NoError(err)
+		return res.Stats
+	}
+	coins := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amt))
+	}
+
+	suite.Require().Equal(types.FulfillmentStats{}, fulfillerStats(fulfiller))
+
+	// full fulfillment
+	o1, p1 := createOrder(1, 100)
+	_, err := suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfiller.String(), o1.Id, "10"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FulfillmentStats{OrdersFilled: 1, Volume: coins(100)}, fulfillerStats(fulfiller))
+	finalize(p1)
+	suite.Require().Equal(types.FulfillmentStats{OrdersFilled: 1, Volume: coins(100), FeesEarned: coins(10)}, fulfillerStats(fulfiller))
+
+	// partial fulfillment, the fee is split pro-rata
+	o2, p2 := createOrder(2, 300)
+	_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfiller.String(), o2.Id, "30", math.NewInt(100)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfillerB.String(), o2.Id, "30", math.NewInt(200)))
+	suite.Require().NoError(err)
+	finalize(p2)
+	suite.Require().Equal(types.FulfillmentStats{OrdersFilled: 2, Volume: coins(200), FeesEarned: coins(20)}, fulfillerStats(fulfiller))
+	suite.Require().Equal(types.FulfillmentStats{OrdersFilled: 1, Volume: coins(200), FeesEarned: coins(20)}, fulfillerStats(fulfillerB))
+
+	// the claims change hands, the fee is still credited to the fulfillers which filled the orders
+	o5, p5 := createOrder(5, 100)
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfiller.String(), o5.Id, "10"))
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.TransferFulfilledClaim(suite.Ctx, o5.Id, fulfiller, buyer, nil))
+	o6, p6 := createOrder(6, 100)
+	_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfillerB.String(), o6.Id, "10", math.NewInt(100)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.TransferFulfilledClaim(suite.Ctx, o6.Id, fulfillerB, buyer, nil))
+	finalize(p5)
+	finalize(p6)
+	suite.Require().Equal(types.FulfillmentStats{OrdersFilled: 3, Volume: coins(300), FeesEarned: coins(30)}, fulfillerStats(fulfiller))
+	suite.Require().Equal(types.FulfillmentStats{OrdersFilled: 2, Volume: coins(300), FeesEarned: coins(30)}, fulfillerStats(fulfillerB))
+	suite.Require().Equal(types.FulfillmentStats{}, fulfillerStats(buyer))
+
+	// on demand lp
+	id, err := k.LPs.Create(suite.Ctx, &types.OnDemandLP{
+		FundsAddr:  lpAddr.String(),
+		Rollapp:    rollappPacket.RollappId,
+		Denom:      denom,
+		MaxPrice:   math.NewInt(100),
+		MinFee:     math.LegacyZeroDec(),
+		SpendLimit: math.NewInt(1000),
+	})
+	suite.Require().NoError(err)
+	o3, p3 := createOrder(3, 100)
+	suite.Require().NoError(k.FulfillByOnDemandLP(suite.Ctx, o3.Id, 0))
+	finalize(p3)
+	suite.Require().Equal(types.FulfillmentStats{OrdersFilled: 1, Volume: coins(100), FeesEarned: coins(10)}, lpStats(id))
+
+	// reverted by a hard fork
+	o4, p4 := createOrder(4, 50)
+	suite.Require().NoError(k.FulfillByOnDemandLP(suite.Ctx, o4.Id, 0))
+	k.GetDelayedAckHooks().AfterPacketDeleted(suite.Ctx, &p4)
+	suite.Require().Equal(types.FulfillmentStats{OrdersFilled: 2, Volume: coins(150), FeesEarned: coins(10), OrdersReverted: 1}, lpStats(id))
+	suite.Require().Equal(types.FulfillmentStats{OrdersFilled: 2, Volume: coins(150), FeesEarned: coins(10), OrdersReverted: 1}, fulfillerStats(lpAddr))
+}
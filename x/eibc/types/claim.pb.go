@@ -0,0 +1,434 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/eibc/claim.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClaimListing is an offer to sell the claim on the proceeds of a fulfilled
+// demand order, before the underlying packet is finalized.
+type ClaimListing struct {
+	// order_id is the unique identifier of the fulfilled order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// seller is the bech32-encoded address of the owner of the claim.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// price is what the buyer pays to the seller for the claim.
+	Price types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+}
+
+func (m *ClaimListing) Reset()         { *m = ClaimListing{} }
+func (m *ClaimListing) String() string { return proto.CompactTextString(m) }
+func (*ClaimListing) ProtoMessage()    {}
+func (*ClaimListing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_600843c9a7a1b8bb, []int{0}
+}
+func (m *ClaimListing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimListing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimListing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimListing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimListing.Merge(m, src)
+}
+func (m *ClaimListing) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimListing) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimListing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimListing proto.InternalMessageInfo
+
+func (m *ClaimListing) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *ClaimListing) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *ClaimListing) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*ClaimListing)(nil), "dymensionxyz.dymension.eibc.ClaimListing")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/claim.proto", fileDescriptor_600843c9a7a1b8bb)
+}
+
+var fileDescriptor_600843c9a7a1b8bb = []byte{
+	// 258 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
+	0x10, 0x80, 0x63, 0x7e, 0x0a, 0x04, 0xa6, 0x08, 0xa1, 0xb4, 0x48, 0xa6, 0x62, 0xa1, 0x93, 0xad,
+	0x50, 0xf1, 0x02, 0xed, 0x84, 0x60, 0xea, 0xc8, 0x82, 0x62, 0xc7, 0x0a, 0x27, 0x25, 0xbe, 0xc8,
+	0x36, 0x55, 0xc2, 0x53, 0xf0, 0x58, 0x1d, 0x3b, 0x32, 0x21, 0x94, 0xbc, 0x08, 0x4a, 0x5c, 0x55,
+	0x2c, 0x6c, 0xfe, 0x74, 0x9f, 0xf5, 0xe9, 0x2e, 0xbc, 0xcb, 0x9a, 0x52, 0x69, 0x0b, 0xa8, 0xeb,
+	0xe6, 0x83, 0xef, 0x81, 0x2b, 0x10, 0x92, 0xcb, 0x22, 0x85, 0x92, 0x55, 0x06, 0x1d, 0x46, 0xd7,
+	0x7f, 0x45, 0xb6, 0x07, 0xd6, 0x8b, 0x93, 0xcb, 0x1c, 0x73, 0x1c, 0x3c, 0xde, 0xbf, 0xfc, 0x97,
+	0x09, 0x95, 0x68, 0x4b, 0xb4, 0x5c, 0xa4, 0x56, 0xf1, 0x75, 0x22, 0x94, 0x4b, 0x13, 0x2e, 0x11,
+	0xb4, 0x9f, 0xdf, 0xd6, 0xe1, 0xc5, 0xb2, 0x2f, 0x3c, 0x83, 0x75, 0xa0, 0xf3, 0x68, 0x1c, 0x9e,
+	0xa2, 0xc9, 0x94, 0x79, 0x85, 0x2c, 0x26, 0x53, 0x32, 0x3b, 0x5b, 0x9d, 0x0c, 0xfc, 0x98, 0x45,
+	0x57, 0xe1, 0xc8, 0xaa, 0xa2, 0x50, 0x26, 0x3e, 0x18, 0x06, 0x3b, 0x8a, 0x1e, 0xc2, 0xe3, 0xca,
+	0x80, 0x54, 0xf1, 0xe1, 0x94, 0xcc, 0xce, 0xef, 0xc7, 0xcc, 0x27, 0x59, 0x9f, 0x64, 0xbb, 0x24,
+	0x5b, 0x22, 0xe8, 0xc5, 0xd1, 0xe6, 0xfb, 0x26, 0x58, 0x79, 0x7b, 0xf1, 0xb4, 0x69, 0x29, 0xd9,
+	0xb6, 0x94, 0xfc, 0xb4, 0x94, 0x7c, 0x76, 0x34, 0xd8, 0x76, 0x34, 0xf8, 0xea, 0x68, 0xf0, 0x92,
+	0xe4, 0xe0, 0xde, 0xde, 0x05, 0x93, 0x58, 0xf2, 0x7f, 0x4e, 0xb3, 0x9e, 0xf3, 0xda, 0xdf, 0xc7,
+	0x35, 0x95, 0xb2, 0x62, 0x34, 0x6c, 0x33, 0xff, 0x1d, 0x00, 0x1f, 0x83, 0x08, 0x93, 0x4b, 0x01,
+	0x00, 0x00,
+}
+
+func (m *ClaimListing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimListing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimListing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClaim(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClaim(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClaim(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClaimListing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovClaim(uint64(l))
+	return n
+}
+
+func sovClaim(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClaim(x uint64) (n int) {
+	return sovClaim(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClaimListing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimListing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimListing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClaim(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClaim
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClaim
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClaim
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClaim        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClaim          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClaim = fmt.Errorf("proto: unexpected end of group")
+)
@@ -10,11 +10,16 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgFulfillOrder{}, "eibc/MsgFulfillOrder", nil)
+	cdc.RegisterConcrete(&MsgFulfillOrderPartial{}, "eibc/MsgFulfillOrderPartial", nil)
 	cdc.RegisterConcrete(&MsgFulfillOrderAuthorized{}, "eibc/MsgFulfillOrderAuthorized", nil)
 	cdc.RegisterConcrete(&MsgUpdateDemandOrder{}, "eibc/MsgUpdateDemandOrder", nil)
 	cdc.RegisterConcrete(&MsgCreateOnDemandLP{}, "eibc/CreateOnDemandLP", nil)
 	cdc.RegisterConcrete(&MsgDeleteOnDemandLP{}, "eibc/DeleteOnDemandLP", nil)
 	cdc.RegisterConcrete(&MsgTryFulfillOnDemand{}, "eibc/TryFulfillOnDemand", nil)
+	cdc.RegisterConcrete(&MsgTransferFulfilledClaim{}, "eibc/TransferFulfilledClaim", nil)
+	cdc.RegisterConcrete(&MsgListFulfilledClaim{}, "eibc/ListFulfilledClaim", nil)
+	cdc.RegisterConcrete(&MsgDelistFulfilledClaim{}, "eibc/DelistFulfilledClaim", nil)
+	cdc.RegisterConcrete(&MsgBuyFulfilledClaim{}, "eibc/BuyFulfilledClaim", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "eibc/UpdateParams", nil)
 	cdc.RegisterConcrete(Params{}, "eibc/Params", nil)
 	cdc.RegisterConcrete(&FulfillOrderAuthorization{}, "eibc/FulfillOrderAuthorization", nil)
@@ -25,11 +30,16 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgFulfillOrder{},
+		&MsgFulfillOrderPartial{},
 		&MsgFulfillOrderAuthorized{},
 		&MsgUpdateDemandOrder{},
 		&MsgCreateOnDemandLP{},
 		&MsgDeleteOnDemandLP{},
 		&MsgTryFulfillOnDemand{},
+		&MsgTransferFulfilledClaim{},
+		&MsgListFulfilledClaim{},
+		&MsgDelistFulfilledClaim{},
+		&MsgBuyFulfilledClaim{},
 		&MsgUpdateParams{},
 	)
 	registry.RegisterImplementations(
@@ -4,14 +4,20 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"fmt"
 
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 )
 
+// MaxPartialFulfillments is the maximum number of fulfillers which can share a single demand order.
+// It bounds the work done when the order is settled on finalization.
+const MaxPartialFulfillments = 16
+
 // NewDemandOrder creates a new demand order.
 // Price is the cost to a market maker to buy the option, (recipient receives straight away).
 // Fee is what the market maker gets in return.
@@ -66,6 +72,41 @@ func (m *DemandOrder) ValidateBasic() error {
 		return ErrInvalidCreationHeight
 	}
 
+	if err := m.validateFulfillments(); err != nil {
+		return errors.Join(ErrInvalidPartialFulfillment, err)
+	}
+
+	if m.FeeDecay != nil {
+		if err := m.FeeDecay.validate(m.GetFeeAmount()); err != nil {
+			return errors.Join(ErrInvalidFeeDecay, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *DemandOrder) validateFulfillments() error {
+	if len(m.Fulfillments) > MaxPartialFulfillments {
+		return fmt.Errorf("too many fulfillments: %d", len(m.Fulfillments))
+	}
+	seen := make(map[string]struct{}, len(m.Fulfillments))
+	filled := math.ZeroInt()
+	for _, f := range m.Fulfillments {
+		if _, err := sdk.AccAddressFromBech32(f.FulfillerAddress); err != nil {
+			return fmt.Errorf("fulfiller address: %w", err)
+		}
+		if _, ok := seen[f.FulfillerAddress]; ok {
+			return fmt.Errorf("duplicate fulfiller: %s", f.FulfillerAddress)
+		}
+		seen[f.FulfillerAddress] = struct{}{}
+		if f.Amount.IsNil() || !f.Amount.IsPositive() {
+			return fmt.Errorf("amount must be positive: %s", f.FulfillerAddress)
+		}
+		filled = filled.Add(f.Amount)
+	}
+	if filled.GT(m.PriceAmount()) {
+		return fmt.Errorf("filled amount exceeds price: %s > %s", filled, m.PriceAmount())
+	}
 	return nil
 }
 
@@ -112,8 +153,179 @@ func (m *DemandOrder) ValidateOrderIsOutstanding() error {
 	return nil
 }
 
+// IsReverted returns true if the packet of the fulfilled order was reverted by a hard fork.
+func (m *DemandOrder) IsReverted() bool {
+	return m.OrderStatus == OrderStatus_ORDER_REVERTED
+}
+
+// IsFulfilled returns true if the order was fulfilled by a single fulfiller, or if its shares cover the
+// whole price.
 func (m *DemandOrder) IsFulfilled() bool {
-	return m.FulfillerAddress != "" || m.DeprecatedIsFulfilled
+	return m.FulfillerAddress != "" || m.DeprecatedIsFulfilled ||
+		(m.IsPartiallyFulfilled() && m.FilledAmount().GTE(m.PriceAmount()))
+}
+
+// IsPartiallyFulfilled returns true if at least one share of the order was taken via partial fulfillment.
+// Note that an order which was completely filled by shares is both partially fulfilled and fulfilled.
+func (m *DemandOrder) IsPartiallyFulfilled() bool {
+	return len(m.Fulfillments) > 0
+}
+
+// FilledAmount returns the part of the price already paid by partial fulfillers.
+func (m *DemandOrder) FilledAmount() math.Int {
+	filled := math.ZeroInt()
+	for _, f := range m.Fulfillments {
+		filled = filled.Add(f.Amount)
+	}
+	return filled
+}
+
+// UnfilledAmount returns the part of the price which is still available for fulfillment.
+func (m *DemandOrder) UnfilledAmount() math.Int {
+	if m.IsFulfilled() {
+		return math.ZeroInt()
+	}
+	return m.PriceAmount().Sub(m.FilledAmount())
+}
+
+// Fulfillers returns the addresses which paid for the order: its partial fulfillers if it was filled by
+// shares, else its fulfiller if any. The escrow account fulfilling an order filled by shares is not one of
+// them.
+func (m *DemandOrder) Fulfillers() []string {
+	if m.IsPartiallyFulfilled() {
+		fulfillers := make([]string, 0, len(m.Fulfillments))
+		for _, f := range m.Fulfillments {
+			fulfillers = append(fulfillers, f.FulfillerAddress)
+		}
+		return fulfillers
+	}
+	if m.FulfillerAddress != "" {
+		return []string{m.FulfillerAddress}
+	}
+	return nil
+}
+
+// HasFulfiller returns true if the address already holds a share of the order.
+func (m *DemandOrder) HasFulfiller(fulfiller string) bool {
+	for _, f := range m.Fulfillments {
+		if f.FulfillerAddress == fulfiller {
+			return true
+		}
+	}
+	return false
+}
+
+// AddPartialFulfillment records that the fulfiller paid amt of the price. Repeated fulfillments by the
+// same address are merged into a single share.
+func (m *DemandOrder) AddPartialFulfillment(fulfiller string, amt math.Int) {
+	for i := range m.Fulfillments {
+		if m.Fulfillments[i].FulfillerAddress == fulfiller {
+			m.Fulfillments[i].Amount = m.Fulfillments[i].Amount.Add(amt)
+			return
+		}
+	}
+	m.Fulfillments = append(m.Fulfillments, PartialFulfillment{
+		FulfillerAddress: fulfiller,
+		Amount:           amt,
+	})
+}
+
+// TransferPartialFulfillment hands over the share of the from address to the to address, merging it with
+// the share the to address may already hold. It returns false if from holds no share.
+func (m *DemandOrder) TransferPartialFulfillment(from, to string) bool {
+	for i, f := range m.Fulfillments {
+		if f.FulfillerAddress == from {
+			m.Fulfillments = append(m.Fulfillments[:i], m.Fulfillments[i+1:]...)
+			m.AddPartialFulfillment(to, f.Amount)
+			return true
+		}
+	}
+	return false
+}
+
+// PartialEscrowAddress is the account which receives the proceeds of the order once its shares cover the
+// whole price. Each order has its own, so that the proceeds of an order only pay its own fulfillers.
+func PartialEscrowAddress(orderID string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(orderID))
+}
+
+// PartialPayouts splits the proceeds of the finalized packet between the partial fulfillers, pro-rata to
+// the part of the price each of them paid. Each fulfiller therefore gets back its share plus the same share
+// of the fee. The returned rest belongs to the original recipient: it is the proceeds of the unfilled part
+// of the order plus the rounding dust.
+func (m *DemandOrder) PartialPayouts(proceeds math.Int) (payouts []math.Int, rest math.Int) {
+	price := m.PriceAmount()
+	rest = proceeds
+	for _, f := range m.Fulfillments {
+		payout := proceeds.Mul(f.Amount).Quo(price)
+		payouts = append(payouts, payout)
+		rest = rest.Sub(payout)
+	}
+	return payouts, rest
+}
+
+// decaysFee returns true if the fee of the order still follows its decay schedule. The fee is fixed once
+// the order is fulfilled or any share of it is taken.
+func (m *DemandOrder) decaysFee() bool {
+	return m.FeeDecay != nil && !m.IsFulfilled() && !m.IsPartiallyFulfilled()
+}
+
+// EffectiveFee returns the fee offered by the order at the given hub height.
+func (m *DemandOrder) EffectiveFee(height uint64) math.Int {
+	fee := m.GetFeeAmount()
+	if !m.decaysFee() || height <= m.CreationHeight {
+		return fee
+	}
+	return m.FeeDecay.FeeAt(fee, height-m.CreationHeight)
+}
+
+// ApplyFeeDecay sets the fee and the price of the order to the values effective at the given height, and
+// drops the schedule so that the decay is not applied twice. The sum of price and fee is preserved,
+// the fulfiller still gets the whole packet amount on finalization.
+func (m *DemandOrder) ApplyFeeDecay(height uint64) {
+	if !m.decaysFee() {
+		return
+	}
+	fee := m.EffectiveFee(height)
+	total := m.PriceAmount().Add(m.GetFeeAmount())
+	denom := m.Denom()
+	m.Fee = sdk.NewCoins(sdk.NewCoin(denom, fee))
+	m.Price = sdk.NewCoins(sdk.NewCoin(denom, total.Sub(fee)))
+	m.FeeDecay = nil
+}
+
+// FeeAt returns the fee after the given number of blocks, starting from startFee.
+func (d FeeDecay) FeeAt(startFee math.Int, blocks uint64) math.Int {
+	span := startFee.Sub(d.Floor)
+	if !span.IsPositive() {
+		return d.Floor
+	}
+	// the number of blocks to reach the floor is computed first, so that the decay cannot overflow
+	toFloor := span.Quo(d.PerBlock)
+	if !span.Mod(d.PerBlock).IsZero() {
+		toFloor = toFloor.AddRaw(1)
+	}
+	n := math.NewIntFromUint64(blocks)
+	if n.GTE(toFloor) {
+		return d.Floor
+	}
+	return startFee.Sub(d.PerBlock.Mul(n))
+}
+
+func (d FeeDecay) validate(startFee math.Int) error {
+	if d.Floor.IsNil() || d.Floor.IsNegative() {
+		return fmt.Errorf("floor must not be negative")
+	}
+	if d.Floor.GT(startFee) {
+		return fmt.Errorf("floor exceeds fee: %s > %s", d.Floor, startFee)
+	}
+	if d.PerBlock.IsNil() || !d.PerBlock.IsPositive() {
+		return fmt.Errorf("per block must be positive")
+	}
+	if d.PerBlock.GT(startFee.Sub(d.Floor)) {
+		return fmt.Errorf("per block exceeds fee minus floor: %s > %s", d.PerBlock, startFee.Sub(d.Floor))
+	}
+	return nil
 }
 
 // BuildDemandIDFromPacketKey returns a unique demand order id from the packet key.
@@ -130,3 +342,31 @@ func (m *DemandOrder) Denom() string {
 	// it's guaranteed price/fee are exactly one coin with the same denom
 	return m.Price[0].Denom
 }
+
+// SameTransfer returns true if the other order is for the same transfer as this one: same type, recipient,
+// denom and amount net of the bridging fee. The split between price and fee may differ, e.g. with fee decay.
+func (m *DemandOrder) SameTransfer(other *DemandOrder) bool {
+	return m.Type == other.Type &&
+		m.Recipient == other.Recipient &&
+		m.Denom() == other.Denom() &&
+		m.PriceAmount().Add(m.GetFeeAmount()).Equal(other.PriceAmount().Add(other.GetFeeAmount()))
+}
+
+// CarryFulfillment takes over the fulfillment of the reverted order, at the price and fee its fulfillers
+// paid for.
+func (m *DemandOrder) CarryFulfillment(reverted *DemandOrder) {
+	m.Price = reverted.Price
+	m.Fee = reverted.Fee
+	m.FeeDecay = nil
+	m.FulfillerAddress = reverted.FulfillerAddress
+	m.Fulfillments = reverted.Fulfillments
+	if m.IsPartiallyFulfilled() && m.FulfillerAddress != "" {
+		m.FulfillerAddress = PartialEscrowAddress(m.Id).String()
+	}
+}
+
+// GetFulfillerBech32Address returns the fulfiller address.
+// Should be called after ValidateBasic hence should not panic.
+func (f PartialFulfillment) GetFulfillerBech32Address() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(f.FulfillerAddress)
+}
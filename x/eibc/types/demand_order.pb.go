@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -26,6 +28,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OrderStatus is the status of a demand order which is only tracked by eibc.
+type OrderStatus int32
+
+const (
+	// ORDER_ACTIVE orders follow the status of their packet.
+	OrderStatus_ORDER_ACTIVE OrderStatus = 0
+	// ORDER_REVERTED orders were fulfilled before their packet was reverted by a
+	// hard fork of the rollapp. They are kept until the packet is re-submitted,
+	// or they are pruned.
+	OrderStatus_ORDER_REVERTED OrderStatus = 1
+)
+
+var OrderStatus_name = map[int32]string{
+	0: "ORDER_ACTIVE",
+	1: "ORDER_REVERTED",
+}
+
+var OrderStatus_value = map[string]int32{
+	"ORDER_ACTIVE":   0,
+	"ORDER_REVERTED": 1,
+}
+
+func (x OrderStatus) String() string {
+	return proto.EnumName(OrderStatus_name, int32(x))
+}
+
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2fc99140861fbacd, []int{0}
+}
+
 type DemandOrder struct {
 	// id is a hash of the form generated by GetRollappPacketKey,
 	// e.g
@@ -56,6 +88,31 @@ type DemandOrder struct {
 	CreationHeight uint64 `protobuf:"varint,12,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
 	// an optional hook which uses the funds when the order is fulfilled
 	CompletionHook *types1.CompletionHookCall `protobuf:"bytes,13,opt,name=completion_hook,json=completionHook,proto3" json:"completion_hook,omitempty"`
+	// fulfillments are the shares taken by fulfillers via partial fulfillment.
+	// The shares are escrowed in the module account until they cover the price.
+	// The price is then paid to the recipient, the underlying packet is
+	// redirected to the escrow account of the order and the proceeds are split
+	// pro-rata between the fulfillers on finalization. The shares of an order
+	// which is not fulfilled when its packet settles are refunded.
+	Fulfillments []PartialFulfillment `protobuf:"bytes,14,rep,name=fulfillments,proto3" json:"fulfillments"`
+	// fee_decay is an optional dutch auction schedule, set from the eIBC memo.
+	// The fee offered by the unfulfilled order decays every hub block since the
+	// creation height, and the price grows accordingly. price and fee hold the
+	// values at the creation height.
+	FeeDecay *FeeDecay `protobuf:"bytes,15,opt,name=fee_decay,json=feeDecay,proto3" json:"fee_decay,omitempty"`
+	// claim_owner is set when the order is reverted: it is the account which
+	// owned the claim on the proceeds of the order, if it was not partially
+	// fulfilled.
+	ClaimOwner string `protobuf:"bytes,16,opt,name=claim_owner,json=claimOwner,proto3" json:"claim_owner,omitempty"`
+	// carried_to is the id of the order of the re-submitted packet which took
+	// over the fulfillment of this reverted order.
+	CarriedTo string `protobuf:"bytes,17,opt,name=carried_to,json=carriedTo,proto3" json:"carried_to,omitempty"`
+	// order_status is the eibc specific status of the order, on top of the
+	// status of its packet.
+	OrderStatus OrderStatus `protobuf:"varint,18,opt,name=order_status,json=orderStatus,proto3,enum=dymensionxyz.dymension.eibc.OrderStatus" json:"order_status,omitempty"`
+	// reverted_height is the height of the block on the hub when the order was
+	// reverted.
+	RevertedHeight uint64 `protobuf:"varint,19,opt,name=reverted_height,json=revertedHeight,proto3" json:"reverted_height,omitempty"`
 }
 
 func (m *DemandOrder) Reset()         { *m = DemandOrder{} }
@@ -176,8 +233,144 @@ func (m *DemandOrder) GetCompletionHook() *types1.CompletionHookCall {
 	return nil
 }
 
+func (m *DemandOrder) GetFulfillments() []PartialFulfillment {
+	if m != nil {
+		return m.Fulfillments
+	}
+	return nil
+}
+
+func (m *DemandOrder) GetFeeDecay() *FeeDecay {
+	if m != nil {
+		return m.FeeDecay
+	}
+	return nil
+}
+
+func (m *DemandOrder) GetClaimOwner() string {
+	if m != nil {
+		return m.ClaimOwner
+	}
+	return ""
+}
+
+func (m *DemandOrder) GetCarriedTo() string {
+	if m != nil {
+		return m.CarriedTo
+	}
+	return ""
+}
+
+func (m *DemandOrder) GetOrderStatus() OrderStatus {
+	if m != nil {
+		return m.OrderStatus
+	}
+	return OrderStatus_ORDER_ACTIVE
+}
+
+func (m *DemandOrder) GetRevertedHeight() uint64 {
+	if m != nil {
+		return m.RevertedHeight
+	}
+	return 0
+}
+
+// FeeDecay is a schedule under which the fee of a demand order decreases
+// linearly with the hub height.
+type FeeDecay struct {
+	// floor is the minimum fee offered by the order.
+	Floor cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=floor,proto3,customtype=cosmossdk.io/math.Int" json:"floor"`
+	// per_block is the amount by which the fee decreases every hub block.
+	PerBlock cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=per_block,json=perBlock,proto3,customtype=cosmossdk.io/math.Int" json:"per_block"`
+}
+
+func (m *FeeDecay) Reset()         { *m = FeeDecay{} }
+func (m *FeeDecay) String() string { return proto.CompactTextString(m) }
+func (*FeeDecay) ProtoMessage()    {}
+func (*FeeDecay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc99140861fbacd, []int{1}
+}
+func (m *FeeDecay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDecay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDecay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDecay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDecay.Merge(m, src)
+}
+func (m *FeeDecay) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDecay) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDecay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDecay proto.InternalMessageInfo
+
+// PartialFulfillment is the share of a demand order taken by one fulfiller.
+type PartialFulfillment struct {
+	// fulfiller_address is the bech32-encoded address of the account which
+	// fulfilled the share.
+	FulfillerAddress string `protobuf:"bytes,1,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// amount is the part of the order price paid by the fulfiller.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *PartialFulfillment) Reset()         { *m = PartialFulfillment{} }
+func (m *PartialFulfillment) String() string { return proto.CompactTextString(m) }
+func (*PartialFulfillment) ProtoMessage()    {}
+func (*PartialFulfillment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc99140861fbacd, []int{2}
+}
+func (m *PartialFulfillment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartialFulfillment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartialFulfillment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartialFulfillment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialFulfillment.Merge(m, src)
+}
+func (m *PartialFulfillment) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartialFulfillment) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialFulfillment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartialFulfillment proto.InternalMessageInfo
+
+func (m *PartialFulfillment) GetFulfillerAddress() string {
+	if m != nil {
+		return m.FulfillerAddress
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.eibc.OrderStatus", OrderStatus_name, OrderStatus_value)
 	proto.RegisterType((*DemandOrder)(nil), "dymensionxyz.dymension.eibc.DemandOrder")
+	proto.RegisterType((*FeeDecay)(nil), "dymensionxyz.dymension.eibc.FeeDecay")
+	proto.RegisterType((*PartialFulfillment)(nil), "dymensionxyz.dymension.eibc.PartialFulfillment")
 }
 
 func init() {
@@ -185,42 +378,59 @@ func init() {
 }
 
 var fileDescriptor_2fc99140861fbacd = []byte{
-	// 551 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcb, 0x6e, 0xd4, 0x3c,
-	0x18, 0x9d, 0x4c, 0x2f, 0x6a, 0x3d, 0xff, 0x3f, 0xa5, 0xa6, 0x80, 0x29, 0x90, 0x46, 0x48, 0x88,
-	0x08, 0x84, 0xc3, 0xb4, 0x3b, 0x76, 0xb4, 0x80, 0x5a, 0x75, 0x01, 0x0a, 0xac, 0x8a, 0x50, 0xe4,
-	0xb1, 0xbf, 0x4e, 0xad, 0x5c, 0x1c, 0xc5, 0x6e, 0xd5, 0xf0, 0x14, 0x3c, 0x07, 0x4f, 0xd2, 0x65,
-	0x97, 0xac, 0x0a, 0x6a, 0x5f, 0x04, 0xc5, 0xce, 0xf4, 0x26, 0x4d, 0xbb, 0x61, 0x65, 0xe7, 0xf8,
-	0x1c, 0x1f, 0x7f, 0xc7, 0x9f, 0x83, 0xa8, 0xa8, 0x73, 0x28, 0xb4, 0x54, 0xc5, 0x61, 0xfd, 0x3d,
-	0x3a, 0xff, 0x88, 0x40, 0x0e, 0x79, 0x24, 0x20, 0x67, 0x85, 0x48, 0x54, 0x25, 0xa0, 0xa2, 0x65,
-	0xa5, 0x8c, 0xc2, 0x8f, 0x2e, 0xf3, 0x2f, 0xc4, 0xb4, 0xe1, 0x2f, 0xfb, 0x5c, 0xe9, 0x5c, 0xe9,
-	0x68, 0xc8, 0x34, 0x44, 0x07, 0x83, 0x21, 0x18, 0x36, 0x88, 0xb8, 0x92, 0x85, 0x13, 0x2f, 0xaf,
-	0x4d, 0x30, 0xe3, 0x2a, 0xcf, 0xdd, 0x50, 0x66, 0x60, 0xa4, 0x2a, 0x92, 0x3d, 0xa5, 0xd2, 0x56,
-	0xb4, 0x7a, 0xb3, 0xa8, 0x52, 0x59, 0xc6, 0xca, 0x32, 0x29, 0x19, 0x4f, 0xc1, 0xb4, 0x9a, 0x17,
-	0x37, 0x6b, 0xb4, 0x61, 0x66, 0x5f, 0xb7, 0xdc, 0xa5, 0x91, 0x1a, 0x29, 0x3b, 0x8d, 0x9a, 0x99,
-	0x43, 0x9f, 0x9e, 0xcc, 0xa0, 0xde, 0x3b, 0x5b, 0xfe, 0xc7, 0xa6, 0x7a, 0xdc, 0x47, 0x5d, 0x29,
-	0x88, 0x17, 0x78, 0xe1, 0x7c, 0xdc, 0x95, 0x02, 0x53, 0x74, 0xd7, 0x54, 0x8c, 0xa7, 0xb2, 0x18,
-	0xb5, 0xd6, 0x49, 0x0a, 0x35, 0xe9, 0x5a, 0xc2, 0xe2, 0x78, 0xe9, 0x93, 0x5d, 0xd9, 0x86, 0x1a,
-	0x33, 0x34, 0x53, 0x56, 0x92, 0x03, 0x99, 0x0a, 0xa6, 0xc2, 0xde, 0xea, 0x43, 0xea, 0xa2, 0xa2,
-	0x4d, 0x54, 0xb4, 0x8d, 0x8a, 0x6e, 0x28, 0x59, 0xac, 0xbf, 0x3e, 0x3a, 0x59, 0xe9, 0xfc, 0xfc,
-	0xbd, 0x12, 0x8e, 0xa4, 0xd9, 0xdb, 0x1f, 0x52, 0xae, 0xf2, 0xa8, 0xcd, 0xd5, 0x0d, 0xaf, 0xb4,
-	0x48, 0x23, 0x53, 0x97, 0xa0, 0xad, 0x40, 0xc7, 0x6e, 0x67, 0xfc, 0x0d, 0x4d, 0xed, 0x02, 0x90,
-	0xe9, 0x7f, 0x6f, 0xd0, 0xec, 0x8b, 0x1f, 0xa3, 0xf9, 0x0a, 0xb8, 0x2c, 0x25, 0x14, 0x86, 0xcc,
-	0xd8, 0x3a, 0x2f, 0x00, 0xfc, 0x06, 0x3d, 0x10, 0x50, 0x56, 0xc0, 0x99, 0x01, 0x91, 0x48, 0x9d,
-	0xec, 0xee, 0x67, 0xbb, 0x32, 0xcb, 0x40, 0x90, 0xd9, 0xc0, 0x0b, 0xe7, 0xd6, 0xbb, 0xc4, 0x8b,
-	0xef, 0x5d, 0x50, 0xb6, 0xf4, 0x87, 0x31, 0x01, 0x7f, 0x45, 0xf7, 0xaf, 0x67, 0xe9, 0x6e, 0x88,
-	0xcc, 0x05, 0x5e, 0xd8, 0x5f, 0x7d, 0x46, 0x27, 0x34, 0x9d, 0xbb, 0x4e, 0xfa, 0xd9, 0x92, 0xe3,
-	0xa5, 0xab, 0xa9, 0x3b, 0x14, 0x3f, 0x41, 0x68, 0xdc, 0x22, 0x52, 0x90, 0xf9, 0xf6, 0xdc, 0x0e,
-	0xd9, 0x12, 0xf8, 0x3d, 0x9a, 0x6e, 0x2a, 0x25, 0xc8, 0x3a, 0x0d, 0x6e, 0x71, 0x8a, 0x9d, 0xce,
-	0x19, 0xd0, 0x2f, 0x75, 0x09, 0xb1, 0x95, 0xe3, 0x97, 0x68, 0x71, 0x5c, 0x70, 0x95, 0x30, 0x21,
-	0x2a, 0xd0, 0x9a, 0xf4, 0xac, 0xd9, 0x9d, 0xf3, 0x85, 0xb7, 0x0e, 0xc7, 0xcf, 0xd1, 0x02, 0xaf,
-	0x80, 0xb9, 0x46, 0x07, 0x39, 0xda, 0x33, 0xe4, 0xbf, 0xc0, 0x0b, 0xa7, 0xe3, 0xfe, 0x18, 0xde,
-	0xb4, 0x28, 0xde, 0x41, 0x0b, 0xd7, 0xde, 0x04, 0xf9, 0x3f, 0xf0, 0xc2, 0xde, 0xad, 0xe7, 0xdc,
-	0x38, 0x57, 0x6d, 0x2a, 0x95, 0x6e, 0xb0, 0x2c, 0x8b, 0xfb, 0xfc, 0x0a, 0xb6, 0xbe, 0x7d, 0x74,
-	0xea, 0x7b, 0xc7, 0xa7, 0xbe, 0xf7, 0xe7, 0xd4, 0xf7, 0x7e, 0x9c, 0xf9, 0x9d, 0xe3, 0x33, 0xbf,
-	0xf3, 0xeb, 0xcc, 0xef, 0xec, 0x0c, 0x2e, 0xf5, 0xc5, 0x84, 0x77, 0x74, 0xb0, 0x16, 0x1d, 0xba,
-	0x5f, 0x84, 0x6d, 0x93, 0xe1, 0xac, 0x7d, 0x34, 0x6b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x72,
-	0xa5, 0x5b, 0xb0, 0x4e, 0x04, 0x00, 0x00,
+	// 828 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0x1b, 0x37,
+	0x10, 0xd6, 0xca, 0x3f, 0x90, 0x28, 0x57, 0x96, 0x99, 0xa4, 0x65, 0xd2, 0x56, 0x16, 0x0c, 0x04,
+	0x15, 0x12, 0x64, 0xb7, 0xb6, 0x6f, 0xbd, 0x59, 0xb2, 0x0c, 0x1b, 0x3e, 0x38, 0xd8, 0x1a, 0x01,
+	0x9a, 0xa2, 0x58, 0x50, 0xe4, 0x48, 0x26, 0xf6, 0x87, 0x0b, 0x2e, 0xed, 0x46, 0x7d, 0x80, 0x9e,
+	0x7b, 0xeb, 0x3b, 0xf4, 0xda, 0x3e, 0x44, 0x8e, 0x41, 0x4f, 0x45, 0x0f, 0x69, 0x61, 0xbf, 0x48,
+	0xb1, 0x24, 0xd7, 0xb2, 0xd3, 0x5a, 0x41, 0x8b, 0x9e, 0x96, 0xfc, 0x66, 0x3e, 0xce, 0x70, 0xbe,
+	0xe1, 0x2c, 0xf2, 0xf9, 0x2c, 0x85, 0xac, 0x10, 0x32, 0x7b, 0x35, 0xfb, 0x2e, 0xb8, 0xde, 0x04,
+	0x20, 0xc6, 0x2c, 0xe0, 0x90, 0xd2, 0x8c, 0x47, 0x52, 0x71, 0x50, 0x7e, 0xae, 0xa4, 0x96, 0xf8,
+	0xe3, 0x9b, 0xfe, 0x73, 0xb2, 0x5f, 0xfa, 0x3f, 0xea, 0x32, 0x59, 0xa4, 0xb2, 0x08, 0xc6, 0xb4,
+	0x80, 0xe0, 0x62, 0x7b, 0x0c, 0x9a, 0x6e, 0x07, 0x4c, 0x8a, 0xcc, 0x92, 0x1f, 0xed, 0xde, 0x11,
+	0x8c, 0xc9, 0x34, 0xb5, 0x9f, 0x3c, 0x01, 0x2d, 0x64, 0x16, 0x9d, 0x49, 0x19, 0x3b, 0xd2, 0xce,
+	0x62, 0x92, 0x92, 0x49, 0x42, 0xf3, 0x3c, 0xca, 0x29, 0x8b, 0x41, 0x3b, 0xce, 0x93, 0xc5, 0x9c,
+	0x42, 0x53, 0x7d, 0x5e, 0x38, 0xdf, 0xfb, 0x53, 0x39, 0x95, 0x66, 0x19, 0x94, 0x2b, 0x87, 0x3e,
+	0xb4, 0x57, 0x89, 0xac, 0xc1, 0x6e, 0xac, 0x69, 0xeb, 0xe7, 0x06, 0x6a, 0xed, 0x9b, 0xca, 0x9c,
+	0x94, 0x85, 0xc1, 0x6d, 0x54, 0x17, 0x9c, 0x78, 0x3d, 0xaf, 0xdf, 0x0c, 0xeb, 0x82, 0x63, 0x1f,
+	0xdd, 0xd3, 0x8a, 0xb2, 0x58, 0x64, 0x53, 0x97, 0x55, 0x14, 0xc3, 0x8c, 0xd4, 0x8d, 0xc3, 0x46,
+	0x65, 0x7a, 0x6e, 0x2c, 0xc7, 0x30, 0xc3, 0x14, 0xad, 0xe4, 0x4a, 0x30, 0x20, 0x4b, 0xbd, 0xa5,
+	0x7e, 0x6b, 0xe7, 0xa1, 0xef, 0xa2, 0x95, 0x55, 0xf4, 0x5d, 0x15, 0xfd, 0xa1, 0x14, 0xd9, 0xe0,
+	0xf3, 0xd7, 0x6f, 0x37, 0x6b, 0x3f, 0xfd, 0xb1, 0xd9, 0x9f, 0x0a, 0x7d, 0x76, 0x3e, 0xf6, 0x99,
+	0x4c, 0x5d, 0x6a, 0xee, 0xf3, 0xac, 0xe0, 0x71, 0xa0, 0x67, 0x39, 0x14, 0x86, 0x50, 0x84, 0xf6,
+	0x64, 0xfc, 0x0d, 0x5a, 0x9a, 0x00, 0x90, 0xe5, 0xff, 0x3f, 0x40, 0x79, 0x2e, 0xfe, 0x04, 0x35,
+	0x15, 0x30, 0x91, 0x0b, 0xc8, 0x34, 0x59, 0x31, 0xf7, 0x9c, 0x03, 0xf8, 0x0b, 0xf4, 0x11, 0x87,
+	0x5c, 0x01, 0xa3, 0x1a, 0x78, 0x24, 0x8a, 0x68, 0x72, 0x9e, 0x4c, 0x44, 0x92, 0x00, 0x27, 0xab,
+	0x3d, 0xaf, 0xdf, 0x18, 0xd4, 0x89, 0x17, 0x3e, 0x98, 0xbb, 0x1c, 0x15, 0x07, 0x95, 0x03, 0xfe,
+	0x1a, 0x7d, 0xf8, 0x6e, 0x2d, 0xad, 0x78, 0xa4, 0xd1, 0xf3, 0xfa, 0xed, 0x9d, 0xc7, 0xfe, 0x1d,
+	0xfd, 0x68, 0x95, 0xf6, 0xbf, 0x34, 0xce, 0xe1, 0xfd, 0xdb, 0x55, 0xb7, 0x28, 0xfe, 0x14, 0xa1,
+	0xaa, 0x7b, 0x04, 0x27, 0x4d, 0x97, 0xb7, 0x45, 0x8e, 0x38, 0x1e, 0xa1, 0xe5, 0xf2, 0xa6, 0x04,
+	0x99, 0x48, 0xdb, 0xef, 0x89, 0x14, 0x5a, 0x9e, 0x0d, 0xe0, 0x9f, 0xce, 0x72, 0x08, 0x0d, 0x1d,
+	0x3f, 0x45, 0x1b, 0xd5, 0x85, 0x55, 0x44, 0x39, 0x57, 0x50, 0x14, 0xa4, 0x65, 0x82, 0x75, 0xae,
+	0x0d, 0x7b, 0x16, 0xc7, 0x9f, 0xa1, 0x75, 0xa6, 0x80, 0xda, 0x37, 0x00, 0x62, 0x7a, 0xa6, 0xc9,
+	0x5a, 0xcf, 0xeb, 0x2f, 0x87, 0xed, 0x0a, 0x3e, 0x34, 0x28, 0x7e, 0x89, 0xd6, 0xdf, 0x79, 0x2e,
+	0xe4, 0x83, 0x9e, 0xd7, 0x6f, 0xbd, 0x37, 0xcf, 0xe1, 0x35, 0xeb, 0x50, 0xca, 0x78, 0x48, 0x93,
+	0x24, 0x6c, 0xb3, 0x5b, 0x18, 0xfe, 0x0a, 0xad, 0xb9, 0xc4, 0x52, 0xc8, 0x74, 0x41, 0xda, 0xa6,
+	0x6d, 0x02, 0x7f, 0xc1, 0xd3, 0xf7, 0x9f, 0x53, 0xa5, 0x05, 0x4d, 0x0e, 0xe6, 0xbc, 0xc1, 0x72,
+	0xd9, 0x4c, 0xe1, 0xad, 0xa3, 0xf0, 0x00, 0x35, 0x27, 0x00, 0x11, 0x07, 0x46, 0x67, 0x64, 0xdd,
+	0x24, 0xfc, 0x78, 0xe1, 0xb9, 0x07, 0x00, 0xfb, 0xa5, 0x73, 0xd8, 0x98, 0xb8, 0x15, 0xde, 0x44,
+	0x2d, 0x96, 0x50, 0x91, 0x46, 0xf2, 0xdb, 0x0c, 0x14, 0xe9, 0x98, 0x52, 0x22, 0x03, 0x9d, 0x94,
+	0x48, 0xa9, 0x2b, 0xa3, 0x4a, 0x09, 0xe0, 0x91, 0x96, 0x64, 0xc3, 0xea, 0xea, 0x90, 0x53, 0x89,
+	0x8f, 0xd1, 0x9a, 0x99, 0x68, 0x55, 0x27, 0x61, 0xa3, 0x6f, 0x7f, 0x61, 0x1a, 0xe6, 0xa5, 0xbb,
+	0x66, 0x6a, 0xc9, 0xf9, 0xa6, 0x14, 0x4c, 0xc1, 0x05, 0xa8, 0xb2, 0xb5, 0x9d, 0x60, 0xf7, 0xac,
+	0x60, 0x15, 0x6c, 0x05, 0xdb, 0xfa, 0xd1, 0x43, 0x8d, 0xea, 0x32, 0x78, 0x0f, 0xad, 0x4c, 0x12,
+	0x29, 0x95, 0x9d, 0x1a, 0x83, 0xa7, 0x65, 0xa5, 0x7e, 0x7f, 0xbb, 0xf9, 0xc0, 0x3e, 0xb2, 0x82,
+	0xc7, 0xbe, 0x90, 0x41, 0x4a, 0xf5, 0x99, 0x7f, 0x94, 0xe9, 0x5f, 0x7f, 0x79, 0x86, 0xac, 0xa1,
+	0xdc, 0x85, 0x96, 0x89, 0x0f, 0x51, 0x33, 0x07, 0x15, 0x8d, 0x13, 0xc9, 0x62, 0x52, 0xff, 0xf7,
+	0xc7, 0x34, 0x72, 0x50, 0x83, 0x92, 0xbc, 0xf5, 0xbd, 0x87, 0xf0, 0xdf, 0xe5, 0xfb, 0xe7, 0xbe,
+	0xf5, 0xee, 0xe8, 0xdb, 0x21, 0x5a, 0xa5, 0xa9, 0x3c, 0xcf, 0xf4, 0x7f, 0x49, 0xc5, 0x51, 0x9f,
+	0xec, 0xa2, 0xd6, 0x8d, 0x3a, 0xe3, 0x0e, 0x5a, 0x3b, 0x09, 0xf7, 0x47, 0x61, 0xb4, 0x37, 0x3c,
+	0x3d, 0x7a, 0x31, 0xea, 0xd4, 0x30, 0x46, 0x6d, 0x8b, 0x84, 0xa3, 0x17, 0xa3, 0xf0, 0x74, 0xb4,
+	0xdf, 0xf1, 0x06, 0xc7, 0xaf, 0x2f, 0xbb, 0xde, 0x9b, 0xcb, 0xae, 0xf7, 0xe7, 0x65, 0xd7, 0xfb,
+	0xe1, 0xaa, 0x5b, 0x7b, 0x73, 0xd5, 0xad, 0xfd, 0x76, 0xd5, 0xad, 0xbd, 0xdc, 0xbe, 0x31, 0xc4,
+	0xee, 0xf8, 0x1f, 0x5c, 0xec, 0x06, 0xaf, 0xec, 0xaf, 0xce, 0xcc, 0xb4, 0xf1, 0xaa, 0x99, 0xf0,
+	0xbb, 0x7f, 0x0d, 0x00, 0x11, 0x6f, 0x35, 0xad, 0x16, 0x07, 0x00, 0x00,
 }
 
 func (m *DemandOrder) Marshal() (dAtA []byte, err error) {
@@ -243,6 +453,64 @@ func (m *DemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RevertedHeight != 0 {
+		i = encodeVarintDemandOrder(dAtA, i, uint64(m.RevertedHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.OrderStatus != 0 {
+		i = encodeVarintDemandOrder(dAtA, i, uint64(m.OrderStatus))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.CarriedTo) > 0 {
+		i -= len(m.CarriedTo)
+		copy(dAtA[i:], m.CarriedTo)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.CarriedTo)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ClaimOwner) > 0 {
+		i -= len(m.ClaimOwner)
+		copy(dAtA[i:], m.ClaimOwner)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.ClaimOwner)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.FeeDecay != nil {
+		{
+			size, err := m.FeeDecay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Fulfillments) > 0 {
+		for iNdEx := len(m.Fulfillments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fulfillments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if m.CompletionHook != nil {
 		{
 			size, err := m.CompletionHook.MarshalToSizedBuffer(dAtA[:i])
@@ -346,6 +614,89 @@ func (m *DemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDecay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDecay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDecay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PerBlock.Size()
+		i -= size
+		if _, err := m.PerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Floor.Size()
+		i -= size
+		if _, err := m.Floor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PartialFulfillment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartialFulfillment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartialFulfillment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDemandOrder(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDemandOrder(v)
 	base := offset
@@ -411,6 +762,58 @@ func (m *DemandOrder) Size() (n int) {
 		l = m.CompletionHook.Size()
 		n += 1 + l + sovDemandOrder(uint64(l))
 	}
+	if len(m.Fulfillments) > 0 {
+		for _, e := range m.Fulfillments {
+			l = e.Size()
+			n += 1 + l + sovDemandOrder(uint64(l))
+		}
+	}
+	if m.FeeDecay != nil {
+		l = m.FeeDecay.Size()
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	l = len(m.ClaimOwner)
+	if l > 0 {
+		n += 2 + l + sovDemandOrder(uint64(l))
+	}
+	l = len(m.CarriedTo)
+	if l > 0 {
+		n += 2 + l + sovDemandOrder(uint64(l))
+	}
+	if m.OrderStatus != 0 {
+		n += 2 + sovDemandOrder(uint64(m.OrderStatus))
+	}
+	if m.RevertedHeight != 0 {
+		n += 2 + sovDemandOrder(uint64(m.RevertedHeight))
+	}
+	return n
+}
+
+func (m *FeeDecay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Floor.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	l = m.PerBlock.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	return n
+}
+
+func (m *PartialFulfillment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
 	return n
 }
 
@@ -790,6 +1193,412 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfillments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfillments = append(m.Fulfillments, PartialFulfillment{})
+			if err := m.Fulfillments[len(m.Fulfillments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeDecay == nil {
+				m.FeeDecay = &FeeDecay{}
+			}
+			if err := m.FeeDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarriedTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CarriedTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderStatus", wireType)
+			}
+			m.OrderStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderStatus |= OrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertedHeight", wireType)
+			}
+			m.RevertedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevertedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDemandOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDecay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDemandOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDecay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDecay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Floor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDemandOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartialFulfillment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDemandOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartialFulfillment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartialFulfillment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDemandOrder(dAtA[iNdEx:])
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+func TestPartialPayouts(t *testing.T) {
+	t.Run("simple", func(t *testing.T) {
+		o := DemandOrder{Price: sdk.NewCoins(sdk.NewInt64Coin("adym", 300))}
+		o.AddPartialFulfillment("a", math.NewInt(100))
+		o.AddPartialFulfillment("b", math.NewInt(150))
+		o.AddPartialFulfillment("a", math.NewInt(50))
+		require.Len(t, o.Fulfillments, 2)
+		require.Equal(t, math.NewInt(300), o.FilledAmount())
+
+		payouts, rest := o.PartialPayouts(math.NewInt(330))
+		require.Equal(t, []math.Int{math.NewInt(165), math.NewInt(165)}, payouts)
+		require.True(t, rest.IsZero())
+	})
+	rapid.Check(t, func(r *rapid.T) {
+		price := rapid.Int64Range(1, 1_000_000).Draw(r, "price")
+		fee := rapid.Int64Range(0, 1_000_000).Draw(r, "fee")
+		o := DemandOrder{Price: sdk.NewCoins(sdk.NewInt64Coin("adym", price))}
+		remaining := price
+		for _, amt := range rapid.SliceOfN(rapid.Int64Range(1, price), 1, MaxPartialFulfillments).Draw(r, "amts") {
+			amt = min(amt, remaining)
+			if amt == 0 {
+				break
+			}
+			o.AddPartialFulfillment(rapid.StringMatching(`[a-z]{8}`).Draw(r, "fulfiller"), math.NewInt(amt))
+			remaining -= amt
+		}
+
+		proceeds := math.NewInt(price + fee)
+		payouts, rest := o.PartialPayouts(proceeds)
+		total := rest
+		for i, p := range payouts {
+			// every fulfiller gets at least what it paid
+			require.True(t, p.GTE(o.Fulfillments[i].Amount), "payout < amount: %s < %s", p, o.Fulfillments[i].Amount)
+			total = total.Add(p)
+		}
+		require.True(t, total.Equal(proceeds))
+		require.True(t, rest.GTE(math.NewInt(remaining)))
+	})
+}
+
+func TestFeeAtDoesNotOverflow(t *testing.T) {
+	huge, ok := math.NewIntFromString("100000000000000000000000000000000000000000000000000000000000000000000000")
+	require.True(t, ok)
+	d := FeeDecay{Floor: math.NewInt(1), PerBlock: huge}
+	require.Equal(t, math.NewInt(1), d.FeeAt(huge.AddRaw(1), ^uint64(0)))
+	require.Equal(t, huge.AddRaw(1), d.FeeAt(huge.AddRaw(1), 0))
+}
+
+func TestApplyFeeDecay(t *testing.T) {
+	rapid.Check(t, func(r *rapid.T) {
+		fee := rapid.Int64Range(0, 1000).Draw(r, "fee")
+		price := rapid.Int64Range(1, 1000).Draw(r, "price")
+		floor := rapid.Int64Range(0, fee).Draw(r, "floor")
+		perBlock := rapid.Int64Range(1, 100).Draw(r, "perBlock")
+		blocks := rapid.Uint64Range(0, 100).Draw(r, "blocks")
+
+		o := DemandOrder{
+			Price:          sdk.NewCoins(sdk.NewInt64Coin("adym", price)),
+			Fee:            sdk.NewCoins(sdk.NewInt64Coin("adym", fee)),
+			CreationHeight: 1,
+			FeeDecay:       &FeeDecay{Floor: math.NewInt(floor), PerBlock: math.NewInt(perBlock)},
+		}
+		eff := o.EffectiveFee(1 + blocks)
+		require.True(r, eff.GTE(math.NewInt(floor)))
+		require.True(r, eff.LTE(math.NewInt(fee)))
+		require.True(r, eff.Equal(math.NewInt(floor)) || eff.Equal(math.NewInt(fee-perBlock*int64(blocks)))) //nolint:gosec
+
+		o.ApplyFeeDecay(1 + blocks)
+		require.Nil(r, o.FeeDecay)
+		require.Equal(r, eff, o.GetFeeAmount())
+		require.Equal(r, math.NewInt(price+fee), o.PriceAmount().Add(o.GetFeeAmount()))
+
+		// applying again is a no-op
+		o.ApplyFeeDecay(1000)
+		require.Equal(r, eff, o.GetFeeAmount())
+	})
+}
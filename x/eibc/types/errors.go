@@ -27,4 +27,11 @@ var (
 	ErrOrderNotSettlementValidated = errorsmod.Register(ModuleName, 20, "demand order not settlement validated")
 	ErrRollappIdMismatch           = errorsmod.Register(ModuleName, 21, "rollapp ID mismatch")
 	ErrPriceMismatch               = errorsmod.Register(ModuleName, 22, "price mismatch")
+	ErrInvalidPartialFulfillment   = gerrc.ErrInvalidArgument.Wrap("partial fulfillment")
+	ErrDemandOrderPartiallyFilled  = gerrc.ErrFailedPrecondition.Wrap("demand order is partially fulfilled")
+	ErrPartialFulfillmentTooLarge  = gerrc.ErrOutOfRange.Wrap("partial fulfillment exceeds unfilled amount")
+	ErrTooManyFulfillers           = gerrc.ErrResourceExhausted.Wrap("too many fulfillers for demand order")
+	ErrInvalidFeeDecay             = gerrc.ErrInvalidArgument.Wrap("fee decay")
+	ErrNotClaimOwner               = gerrc.ErrPermissionDenied.Wrap("not the owner of the fulfilled claim")
+	ErrClaimNotListed              = gerrc.ErrNotFound.Wrap("claim listing")
 )
@@ -2,6 +2,9 @@ package types
 
 import (
 	"encoding/base64"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func GetCreatedEvent(m *DemandOrder, proofHeight uint64, amount string) *EventDemandOrderCreated {
@@ -32,6 +35,17 @@ func GetFulfilledEvent(m *DemandOrder) *EventDemandOrderFulfilled {
 	}
 }
 
+func GetPartiallyFulfilledEvent(m *DemandOrder, fulfiller string, amount math.Int) *EventDemandOrderPartiallyFulfilled {
+	return &EventDemandOrderPartiallyFulfilled{
+		OrderId:    m.Id,
+		Fulfiller:  fulfiller,
+		Amount:     sdk.NewCoin(m.Denom(), amount).String(),
+		Remaining:  sdk.NewCoin(m.Denom(), m.UnfilledAmount()).String(),
+		RollappId:  m.RollappId,
+		PacketType: m.Type.String(),
+	}
+}
+
 func GetFulfilledAuthorizedEvent(m *DemandOrder,
 	creationHeight uint64,
 	lpAddress, operatorAddress, operatorFee string,
@@ -429,6 +429,164 @@ func (m *EventDemandOrderFulfilled) GetPacketType() string {
 	return ""
 }
 
+// EventDemandOrderPartiallyFulfilled is emitted when a share of the demand
+// order is fulfilled.
+type EventDemandOrderPartiallyFulfilled struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// fulfiller is the address of the fulfiller.
+	Fulfiller string `protobuf:"bytes,2,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// amount is the part of the price paid by the fulfiller.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// remaining is the unfilled part of the price.
+	Remaining string `protobuf:"bytes,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// rollapp_id is the id of the rollapp.
+	RollappId string `protobuf:"bytes,5,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// packet_type is the type of the packet.
+	PacketType string `protobuf:"bytes,6,opt,name=packet_type,json=packetType,proto3" json:"packet_type,omitempty"`
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) Reset()         { *m = EventDemandOrderPartiallyFulfilled{} }
+func (m *EventDemandOrderPartiallyFulfilled) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderPartiallyFulfilled) ProtoMessage()    {}
+func (*EventDemandOrderPartiallyFulfilled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{4}
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderPartiallyFulfilled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderPartiallyFulfilled.Merge(m, src)
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderPartiallyFulfilled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDemandOrderPartiallyFulfilled proto.InternalMessageInfo
+
+func (m *EventDemandOrderPartiallyFulfilled) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetRemaining() string {
+	if m != nil {
+		return m.Remaining
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetPacketType() string {
+	if m != nil {
+		return m.PacketType
+	}
+	return ""
+}
+
+// EventPartialFulfillmentPayout is emitted on finalization for every payout of
+// a partially fulfilled demand order, and for every refund of a share.
+type EventPartialFulfillmentPayout struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// address is the account which got paid: either a fulfiller, or the
+	// original recipient for the rounding dust.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the amount paid out.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventPartialFulfillmentPayout) Reset()         { *m = EventPartialFulfillmentPayout{} }
+func (m *EventPartialFulfillmentPayout) String() string { return proto.CompactTextString(m) }
+func (*EventPartialFulfillmentPayout) ProtoMessage()    {}
+func (*EventPartialFulfillmentPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{5}
+}
+func (m *EventPartialFulfillmentPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPartialFulfillmentPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPartialFulfillmentPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPartialFulfillmentPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPartialFulfillmentPayout.Merge(m, src)
+}
+func (m *EventPartialFulfillmentPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPartialFulfillmentPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPartialFulfillmentPayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPartialFulfillmentPayout proto.InternalMessageInfo
+
+func (m *EventPartialFulfillmentPayout) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventPartialFulfillmentPayout) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventPartialFulfillmentPayout) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
 // EventDemandOrderFulfilledAuthorized is emitted when the demand order is
 // fulfilled from an authorization.
 type EventDemandOrderFulfilledAuthorized struct {
@@ -460,7 +618,7 @@ func (m *EventDemandOrderFulfilledAuthorized) Reset()         { *m = EventDemand
 func (m *EventDemandOrderFulfilledAuthorized) String() string { return proto.CompactTextString(m) }
 func (*EventDemandOrderFulfilledAuthorized) ProtoMessage()    {}
 func (*EventDemandOrderFulfilledAuthorized) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{4}
+	return fileDescriptor_87abe4479c806cf7, []int{6}
 }
 func (m *EventDemandOrderFulfilledAuthorized) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +741,7 @@ func (m *EventDemandOrderDeleted) Reset()         { *m = EventDemandOrderDeleted
 func (m *EventDemandOrderDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventDemandOrderDeleted) ProtoMessage()    {}
 func (*EventDemandOrderDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{5}
+	return fileDescriptor_87abe4479c806cf7, []int{7}
 }
 func (m *EventDemandOrderDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,25 +805,34 @@ func (m *EventDemandOrderDeleted) GetPacketType() string {
 	return ""
 }
 
-// normal fulfilled event will be emitted in same tx
-type EventMatchedOnDemandLP struct {
-	OrderId   string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	LpId      uint64 `protobuf:"varint,2,opt,name=lp_id,json=lpId,proto3" json:"lp_id,omitempty"`
-	Fulfiller string `protobuf:"bytes,3,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+// EventDemandOrderReverted is emitted when the packet of a fulfilled demand
+// order is reverted by a hard fork of the rollapp.
+type EventDemandOrderReverted struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// rollapp_id is the id of the rollapp.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// packet_type is the type of the packet.
+	PacketType string `protobuf:"bytes,3,opt,name=packet_type,json=packetType,proto3" json:"packet_type,omitempty"`
+	// fulfiller is the address of the fulfiller.
+	Fulfiller string `protobuf:"bytes,4,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// claim_owner is the address of the owner of the claim on the proceeds,
+	// empty for a partially fulfilled order.
+	ClaimOwner string `protobuf:"bytes,5,opt,name=claim_owner,json=claimOwner,proto3" json:"claim_owner,omitempty"`
 }
 
-func (m *EventMatchedOnDemandLP) Reset()         { *m = EventMatchedOnDemandLP{} }
-func (m *EventMatchedOnDemandLP) String() string { return proto.CompactTextString(m) }
-func (*EventMatchedOnDemandLP) ProtoMessage()    {}
-func (*EventMatchedOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{6}
+func (m *EventDemandOrderReverted) Reset()         { *m = EventDemandOrderReverted{} }
+func (m *EventDemandOrderReverted) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderReverted) ProtoMessage()    {}
+func (*EventDemandOrderReverted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{8}
 }
-func (m *EventMatchedOnDemandLP) XXX_Unmarshal(b []byte) error {
+func (m *EventDemandOrderReverted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMatchedOnDemandLP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventDemandOrderReverted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMatchedOnDemandLP.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventDemandOrderReverted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -675,56 +842,74 @@ func (m *EventMatchedOnDemandLP) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *EventMatchedOnDemandLP) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMatchedOnDemandLP.Merge(m, src)
+func (m *EventDemandOrderReverted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderReverted.Merge(m, src)
 }
-func (m *EventMatchedOnDemandLP) XXX_Size() int {
+func (m *EventDemandOrderReverted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMatchedOnDemandLP) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMatchedOnDemandLP.DiscardUnknown(m)
+func (m *EventDemandOrderReverted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderReverted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMatchedOnDemandLP proto.InternalMessageInfo
+var xxx_messageInfo_EventDemandOrderReverted proto.InternalMessageInfo
 
-func (m *EventMatchedOnDemandLP) GetOrderId() string {
+func (m *EventDemandOrderReverted) GetOrderId() string {
 	if m != nil {
 		return m.OrderId
 	}
 	return ""
 }
 
-func (m *EventMatchedOnDemandLP) GetLpId() uint64 {
+func (m *EventDemandOrderReverted) GetRollappId() string {
 	if m != nil {
-		return m.LpId
+		return m.RollappId
 	}
-	return 0
+	return ""
 }
 
-func (m *EventMatchedOnDemandLP) GetFulfiller() string {
+func (m *EventDemandOrderReverted) GetPacketType() string {
+	if m != nil {
+		return m.PacketType
+	}
+	return ""
+}
+
+func (m *EventDemandOrderReverted) GetFulfiller() string {
 	if m != nil {
 		return m.Fulfiller
 	}
 	return ""
 }
 
-type EventCreatedOnDemandLP struct {
-	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	FundsAddr string `protobuf:"bytes,2,opt,name=funds_addr,json=fundsAddr,proto3" json:"funds_addr,omitempty"`
+func (m *EventDemandOrderReverted) GetClaimOwner() string {
+	if m != nil {
+		return m.ClaimOwner
+	}
+	return ""
 }
 
-func (m *EventCreatedOnDemandLP) Reset()         { *m = EventCreatedOnDemandLP{} }
-func (m *EventCreatedOnDemandLP) String() string { return proto.CompactTextString(m) }
-func (*EventCreatedOnDemandLP) ProtoMessage()    {}
-func (*EventCreatedOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{7}
+// EventDemandOrderCarried is emitted when the fulfillment of a reverted
+// demand order is carried to the order of the re-submitted packet.
+type EventDemandOrderCarried struct {
+	// reverted_order_id is the unique identifier of the reverted demand order.
+	RevertedOrderId string `protobuf:"bytes,1,opt,name=reverted_order_id,json=revertedOrderId,proto3" json:"reverted_order_id,omitempty"`
+	// order_id is the unique identifier of the new demand order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
-func (m *EventCreatedOnDemandLP) XXX_Unmarshal(b []byte) error {
+
+func (m *EventDemandOrderCarried) Reset()         { *m = EventDemandOrderCarried{} }
+func (m *EventDemandOrderCarried) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderCarried) ProtoMessage()    {}
+func (*EventDemandOrderCarried) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{9}
+}
+func (m *EventDemandOrderCarried) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventCreatedOnDemandLP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventDemandOrderCarried) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventCreatedOnDemandLP.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventDemandOrderCarried.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -734,51 +919,58 @@ func (m *EventCreatedOnDemandLP) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *EventCreatedOnDemandLP) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventCreatedOnDemandLP.Merge(m, src)
+func (m *EventDemandOrderCarried) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderCarried.Merge(m, src)
 }
-func (m *EventCreatedOnDemandLP) XXX_Size() int {
+func (m *EventDemandOrderCarried) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventCreatedOnDemandLP) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventCreatedOnDemandLP.DiscardUnknown(m)
+func (m *EventDemandOrderCarried) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderCarried.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventCreatedOnDemandLP proto.InternalMessageInfo
+var xxx_messageInfo_EventDemandOrderCarried proto.InternalMessageInfo
 
-func (m *EventCreatedOnDemandLP) GetId() uint64 {
+func (m *EventDemandOrderCarried) GetRevertedOrderId() string {
 	if m != nil {
-		return m.Id
+		return m.RevertedOrderId
 	}
-	return 0
+	return ""
 }
 
-func (m *EventCreatedOnDemandLP) GetFundsAddr() string {
+func (m *EventDemandOrderCarried) GetOrderId() string {
 	if m != nil {
-		return m.FundsAddr
+		return m.OrderId
 	}
 	return ""
 }
 
-type EventDeletedOnDemandLP struct {
-	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	FundsAddr string `protobuf:"bytes,2,opt,name=funds_addr,json=fundsAddr,proto3" json:"funds_addr,omitempty"`
-	// human readable
-	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-}
-
-func (m *EventDeletedOnDemandLP) Reset()         { *m = EventDeletedOnDemandLP{} }
-func (m *EventDeletedOnDemandLP) String() string { return proto.CompactTextString(m) }
-func (*EventDeletedOnDemandLP) ProtoMessage()    {}
-func (*EventDeletedOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{8}
-}
-func (m *EventDeletedOnDemandLP) XXX_Unmarshal(b []byte) error {
+// EventFulfilledClaimTransferred is emitted when the claim on the proceeds of a
+// fulfilled order changes hands.
+type EventFulfilledClaimTransferred struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// from is the address of the previous owner of the claim.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the address of the new owner of the claim.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// price is what the new owner paid for the claim, empty for a plain
+	// transfer.
+	Price string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *EventFulfilledClaimTransferred) Reset()         { *m = EventFulfilledClaimTransferred{} }
+func (m *EventFulfilledClaimTransferred) String() string { return proto.CompactTextString(m) }
+func (*EventFulfilledClaimTransferred) ProtoMessage()    {}
+func (*EventFulfilledClaimTransferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{10}
+}
+func (m *EventFulfilledClaimTransferred) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventDeletedOnDemandLP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventFulfilledClaimTransferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventDeletedOnDemandLP.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventFulfilledClaimTransferred.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -788,324 +980,493 @@ func (m *EventDeletedOnDemandLP) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *EventDeletedOnDemandLP) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventDeletedOnDemandLP.Merge(m, src)
+func (m *EventFulfilledClaimTransferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFulfilledClaimTransferred.Merge(m, src)
 }
-func (m *EventDeletedOnDemandLP) XXX_Size() int {
+func (m *EventFulfilledClaimTransferred) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventDeletedOnDemandLP) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventDeletedOnDemandLP.DiscardUnknown(m)
+func (m *EventFulfilledClaimTransferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFulfilledClaimTransferred.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventDeletedOnDemandLP proto.InternalMessageInfo
+var xxx_messageInfo_EventFulfilledClaimTransferred proto.InternalMessageInfo
 
-func (m *EventDeletedOnDemandLP) GetId() uint64 {
+func (m *EventFulfilledClaimTransferred) GetOrderId() string {
 	if m != nil {
-		return m.Id
+		return m.OrderId
 	}
-	return 0
+	return ""
 }
 
-func (m *EventDeletedOnDemandLP) GetFundsAddr() string {
+func (m *EventFulfilledClaimTransferred) GetFrom() string {
 	if m != nil {
-		return m.FundsAddr
+		return m.From
 	}
 	return ""
 }
 
-func (m *EventDeletedOnDemandLP) GetReason() string {
+func (m *EventFulfilledClaimTransferred) GetTo() string {
 	if m != nil {
-		return m.Reason
+		return m.To
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventDemandOrderCreated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderCreated")
-	proto.RegisterType((*EventDemandOrderPacketStatusUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderPacketStatusUpdated")
-	proto.RegisterType((*EventDemandOrderFeeUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFeeUpdated")
-	proto.RegisterType((*EventDemandOrderFulfilled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilled")
-	proto.RegisterType((*EventDemandOrderFulfilledAuthorized)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilledAuthorized")
-	proto.RegisterType((*EventDemandOrderDeleted)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderDeleted")
-	proto.RegisterType((*EventMatchedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventMatchedOnDemandLP")
-	proto.RegisterType((*EventCreatedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventCreatedOnDemandLP")
-	proto.RegisterType((*EventDeletedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventDeletedOnDemandLP")
+func (m *EventFulfilledClaimTransferred) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/eibc/events.proto", fileDescriptor_87abe4479c806cf7)
+// EventFulfilledClaimListed is emitted when a claim is offered for sale or its
+// price is updated.
+type EventFulfilledClaimListed struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// seller is the address of the owner of the claim.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// price is the asked price.
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
 }
 
-var fileDescriptor_87abe4479c806cf7 = []byte{
-	// 762 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4f, 0x4f, 0xdb, 0x4a,
-	0x10, 0xc7, 0xf9, 0x9f, 0x49, 0x08, 0x3c, 0x3f, 0x04, 0x26, 0x0f, 0xf2, 0x20, 0x08, 0xbd, 0xbc,
-	0x1e, 0x62, 0x51, 0x3e, 0x01, 0x94, 0xa6, 0x45, 0xb4, 0x82, 0xa6, 0xed, 0xa5, 0x97, 0xc8, 0xb1,
-	0x27, 0xc9, 0x0a, 0x67, 0xd7, 0xb2, 0x37, 0x40, 0xb8, 0xf7, 0xde, 0x4f, 0x53, 0xf5, 0x23, 0xf4,
-	0xc8, 0xb1, 0x47, 0x04, 0xe2, 0x7b, 0x54, 0xbb, 0x5e, 0x87, 0xc4, 0xe1, 0x4f, 0x85, 0x7a, 0xea,
-	0x2d, 0xf3, 0xdb, 0xf1, 0xcc, 0xec, 0xfc, 0x7e, 0x33, 0x1b, 0xa8, 0x39, 0xc3, 0x3e, 0xd2, 0x80,
-	0x30, 0x7a, 0x36, 0x3c, 0x37, 0x47, 0x86, 0x89, 0xa4, 0x6d, 0x9b, 0x78, 0x82, 0x94, 0x07, 0x75,
-	0xcf, 0x67, 0x9c, 0xe9, 0xff, 0x8c, 0x7b, 0xd6, 0x47, 0x46, 0x5d, 0x78, 0x96, 0x17, 0xba, 0xac,
-	0xcb, 0xa4, 0x9f, 0x29, 0x7e, 0x85, 0x9f, 0x94, 0x9f, 0xdd, 0x13, 0xdc, 0x66, 0xfd, 0x3e, 0xa3,
-	0x66, 0xc0, 0x2d, 0x3e, 0x50, 0xe1, 0xcb, 0x15, 0x9b, 0x05, 0x7d, 0x16, 0x98, 0x6d, 0x2b, 0x40,
-	0xf3, 0x64, 0xab, 0x8d, 0xdc, 0xda, 0x32, 0x6d, 0x46, 0x68, 0x78, 0x5e, 0xbd, 0x4c, 0xc0, 0xd2,
-	0x4b, 0x51, 0xcf, 0x1e, 0xf6, 0x2d, 0xea, 0x1c, 0xfa, 0x0e, 0xfa, 0x2f, 0x7c, 0xb4, 0x38, 0x3a,
-	0xfa, 0x32, 0xe4, 0x98, 0xb0, 0x5b, 0xc4, 0x31, 0xb4, 0x35, 0xad, 0x96, 0x6f, 0x66, 0xa5, 0xbd,
-	0xef, 0xe8, 0x0b, 0x90, 0xf6, 0x7c, 0x62, 0xa3, 0x91, 0x90, 0x78, 0x68, 0xe8, 0xf3, 0x90, 0xec,
-	0x20, 0x1a, 0x49, 0x89, 0x89, 0x9f, 0xfa, 0x26, 0x14, 0x49, 0xd0, 0xea, 0x0c, 0xdc, 0x0e, 0x71,
-	0x5d, 0x74, 0x8c, 0xd4, 0x9a, 0x56, 0xcb, 0xed, 0x26, 0x0c, 0xad, 0x59, 0x20, 0x41, 0x23, 0x82,
-	0xf5, 0x0d, 0x98, 0xf5, 0x2c, 0xfb, 0x18, 0x79, 0x2b, 0x2c, 0xde, 0x48, 0xcb, 0x10, 0xc5, 0x10,
-	0x7c, 0x2f, 0x31, 0x7d, 0x15, 0x40, 0x39, 0x1d, 0xe3, 0xd0, 0xc8, 0x48, 0x8f, 0x7c, 0x88, 0x1c,
-	0xe0, 0x50, 0x1c, 0xfb, 0xcc, 0x75, 0x2d, 0xcf, 0x13, 0xf5, 0x66, 0xc3, 0x63, 0x85, 0xec, 0x3b,
-	0xfa, 0x0a, 0xe4, 0x7d, 0xb4, 0x89, 0x47, 0x90, 0x72, 0x23, 0xa7, 0x4e, 0x23, 0x40, 0xff, 0x17,
-	0x0a, 0x2a, 0x36, 0x1f, 0x7a, 0x68, 0xe4, 0xe5, 0xb9, 0x4a, 0xf7, 0x61, 0xe8, 0xa1, 0xbe, 0x0e,
-	0x45, 0xcf, 0x67, 0xac, 0xd3, 0xea, 0x21, 0xe9, 0xf6, 0xb8, 0x01, 0x6b, 0x5a, 0x2d, 0xd5, 0x2c,
-	0x48, 0xec, 0xb5, 0x84, 0xf4, 0x45, 0xc8, 0x58, 0x7d, 0x36, 0xa0, 0xdc, 0x28, 0xc8, 0xcf, 0x95,
-	0x55, 0xfd, 0xaa, 0xc1, 0x46, 0xbc, 0xc5, 0x47, 0x63, 0x17, 0xfb, 0xe8, 0x39, 0x8f, 0xb5, 0xfb,
-	0x1d, 0xfc, 0x45, 0xf1, 0xb4, 0x35, 0xd9, 0x23, 0xd1, 0xfa, 0xd2, 0xf3, 0xcd, 0xfa, 0x3d, 0x02,
-	0x0a, 0xd5, 0x50, 0x0f, 0x73, 0x34, 0xe7, 0x28, 0x9e, 0x8e, 0x27, 0x15, 0x17, 0x9a, 0x60, 0x46,
-	0x90, 0x96, 0x9b, 0x60, 0xa5, 0x7a, 0xa3, 0x41, 0x39, 0x5e, 0x78, 0x03, 0xf1, 0x17, 0xea, 0x5d,
-	0x82, 0xac, 0xa8, 0x57, 0x88, 0x21, 0x14, 0x48, 0x86, 0xe2, 0x69, 0x03, 0xf1, 0x56, 0x37, 0xc9,
-	0x71, 0xdd, 0x4c, 0xd1, 0x9f, 0xba, 0x9b, 0xfe, 0x31, 0x7e, 0xd3, 0x71, 0x7e, 0xe3, 0x04, 0x65,
-	0x1e, 0x22, 0x28, 0x3b, 0x41, 0xd0, 0x8d, 0x06, 0xcb, 0x53, 0xf7, 0x1c, 0x69, 0xf3, 0x37, 0x4c,
-	0xc1, 0xfa, 0x5d, 0x53, 0xf0, 0x84, 0x09, 0x58, 0x81, 0x7c, 0x14, 0xc4, 0x57, 0x1a, 0xbd, 0x05,
-	0xe2, 0x1a, 0x86, 0xb8, 0x86, 0xab, 0x9f, 0x93, 0xd3, 0x42, 0x1c, 0x55, 0xb0, 0x33, 0xe0, 0x3d,
-	0xe6, 0x93, 0xf3, 0x3f, 0xe9, 0xc6, 0xfa, 0x7f, 0x30, 0x67, 0x8b, 0x65, 0x46, 0x18, 0x8d, 0x74,
-	0x51, 0x90, 0xba, 0x28, 0x45, 0xb0, 0x92, 0xc6, 0x2a, 0x80, 0xeb, 0xb5, 0x2c, 0xc7, 0xf1, 0x31,
-	0x08, 0x8c, 0x62, 0x98, 0xc8, 0xf5, 0x76, 0x42, 0x40, 0xff, 0x1f, 0xe6, 0x99, 0x87, 0xbe, 0xc5,
-	0x99, 0x3f, 0x72, 0x9a, 0x95, 0x4e, 0x73, 0x11, 0x1e, 0xb9, 0xae, 0x43, 0x71, 0xe4, 0x2a, 0x9a,
-	0x52, 0x92, 0x6e, 0x85, 0x08, 0x6b, 0x20, 0x56, 0xbf, 0x69, 0xd3, 0x3b, 0x77, 0x0f, 0x5d, 0x7c,
-	0x64, 0xa8, 0x26, 0xf7, 0x5f, 0x22, 0xbe, 0xff, 0xa6, 0xfa, 0x99, 0x7c, 0x74, 0x88, 0x52, 0xf1,
-	0x21, 0x8a, 0x35, 0x34, 0x3d, 0x25, 0xa1, 0x0e, 0x2c, 0xca, 0xca, 0xdf, 0x5a, 0xdc, 0xee, 0xa1,
-	0x73, 0x48, 0xc3, 0x2b, 0xbc, 0x39, 0x7a, 0xa8, 0xf0, 0xbf, 0x21, 0xed, 0xca, 0x7c, 0x09, 0xd9,
-	0xfb, 0x94, 0xab, 0xf6, 0xf1, 0x2d, 0xb3, 0xc9, 0x18, 0xb3, 0xd5, 0x57, 0x2a, 0x8f, 0x7a, 0x8a,
-	0xc6, 0xf2, 0x94, 0x20, 0xa1, 0x32, 0xa4, 0x9a, 0x09, 0x22, 0xbb, 0xd2, 0x19, 0x50, 0x27, 0x90,
-	0xbc, 0x44, 0x5d, 0x91, 0x88, 0x60, 0xa4, 0xda, 0x52, 0x81, 0x54, 0x7f, 0x9f, 0x1c, 0x48, 0x2c,
-	0x0f, 0x1f, 0xad, 0x80, 0x51, 0x55, 0xac, 0xb2, 0x76, 0x0f, 0xbe, 0x5f, 0x55, 0xb4, 0x8b, 0xab,
-	0x8a, 0x76, 0x79, 0x55, 0xd1, 0xbe, 0x5c, 0x57, 0x66, 0x2e, 0xae, 0x2b, 0x33, 0x3f, 0xae, 0x2b,
-	0x33, 0x9f, 0xb6, 0xba, 0x84, 0xf7, 0x06, 0x6d, 0xb1, 0x88, 0xcd, 0x7b, 0x5e, 0xec, 0x93, 0x6d,
-	0xf3, 0x2c, 0xfc, 0x4f, 0x20, 0xfa, 0x1d, 0xb4, 0x33, 0xf2, 0x51, 0xde, 0xfe, 0x19, 0x00, 0x00,
-	0xff, 0xff, 0xde, 0x6e, 0x60, 0x33, 0x3f, 0x08, 0x00, 0x00,
+func (m *EventFulfilledClaimListed) Reset()         { *m = EventFulfilledClaimListed{} }
+func (m *EventFulfilledClaimListed) String() string { return proto.CompactTextString(m) }
+func (*EventFulfilledClaimListed) ProtoMessage()    {}
+func (*EventFulfilledClaimListed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{11}
 }
-
-func (m *EventDemandOrderCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventFulfilledClaimListed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFulfilledClaimListed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFulfilledClaimListed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventDemandOrderCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventFulfilledClaimListed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFulfilledClaimListed.Merge(m, src)
+}
+func (m *EventFulfilledClaimListed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFulfilledClaimListed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFulfilledClaimListed.DiscardUnknown(m)
 }
 
-func (m *EventDemandOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.ProofHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ProofHeight))
-		i--
-		dAtA[i] = 0x50
+var xxx_messageInfo_EventFulfilledClaimListed proto.InternalMessageInfo
+
+func (m *EventFulfilledClaimListed) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	if len(m.PacketType) > 0 {
-		i -= len(m.PacketType)
-		copy(dAtA[i:], m.PacketType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *EventFulfilledClaimListed) GetSeller() string {
+	if m != nil {
+		return m.Seller
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *EventFulfilledClaimListed) GetPrice() string {
+	if m != nil {
+		return m.Price
 	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+// EventFulfilledClaimDelisted is emitted when a claim is no longer for sale.
+type EventFulfilledClaimDelisted struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// seller is the address of the owner of the claim.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+}
+
+func (m *EventFulfilledClaimDelisted) Reset()         { *m = EventFulfilledClaimDelisted{} }
+func (m *EventFulfilledClaimDelisted) String() string { return proto.CompactTextString(m) }
+func (*EventFulfilledClaimDelisted) ProtoMessage()    {}
+func (*EventFulfilledClaimDelisted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{12}
+}
+func (m *EventFulfilledClaimDelisted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFulfilledClaimDelisted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFulfilledClaimDelisted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.PacketKey) > 0 {
-		i -= len(m.PacketKey)
-		copy(dAtA[i:], m.PacketKey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketKey)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *EventFulfilledClaimDelisted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFulfilledClaimDelisted.Merge(m, src)
+}
+func (m *EventFulfilledClaimDelisted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFulfilledClaimDelisted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFulfilledClaimDelisted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFulfilledClaimDelisted proto.InternalMessageInfo
+
+func (m *EventFulfilledClaimDelisted) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	if len(m.PacketStatus) > 0 {
-		i -= len(m.PacketStatus)
-		copy(dAtA[i:], m.PacketStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *EventFulfilledClaimDelisted) GetSeller() string {
+	if m != nil {
+		return m.Seller
 	}
-	if m.IsFulfilled {
-		i--
-		if m.IsFulfilled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// normal fulfilled event will be emitted in same tx
+type EventMatchedOnDemandLP struct {
+	OrderId   string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	LpId      uint64 `protobuf:"varint,2,opt,name=lp_id,json=lpId,proto3" json:"lp_id,omitempty"`
+	Fulfiller string `protobuf:"bytes,3,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+}
+
+func (m *EventMatchedOnDemandLP) Reset()         { *m = EventMatchedOnDemandLP{} }
+func (m *EventMatchedOnDemandLP) String() string { return proto.CompactTextString(m) }
+func (*EventMatchedOnDemandLP) ProtoMessage()    {}
+func (*EventMatchedOnDemandLP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{13}
+}
+func (m *EventMatchedOnDemandLP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMatchedOnDemandLP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMatchedOnDemandLP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Fee) > 0 {
-		i -= len(m.Fee)
-		copy(dAtA[i:], m.Fee)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventMatchedOnDemandLP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMatchedOnDemandLP.Merge(m, src)
+}
+func (m *EventMatchedOnDemandLP) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMatchedOnDemandLP) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMatchedOnDemandLP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMatchedOnDemandLP proto.InternalMessageInfo
+
+func (m *EventMatchedOnDemandLP) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventMatchedOnDemandLP) GetLpId() uint64 {
+	if m != nil {
+		return m.LpId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventDemandOrderPacketStatusUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMatchedOnDemandLP) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDemandOrderPacketStatusUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// emitted when an order fulfilled by the lp is finalized and its spend is
+// credited back
+type EventRepaidOnDemandLP struct {
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	LpId    uint64 `protobuf:"varint,2,opt,name=lp_id,json=lpId,proto3" json:"lp_id,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *EventDemandOrderPacketStatusUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsFulfilled {
-		i--
-		if m.IsFulfilled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventRepaidOnDemandLP) Reset()         { *m = EventRepaidOnDemandLP{} }
+func (m *EventRepaidOnDemandLP) String() string { return proto.CompactTextString(m) }
+func (*EventRepaidOnDemandLP) ProtoMessage()    {}
+func (*EventRepaidOnDemandLP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{14}
+}
+func (m *EventRepaidOnDemandLP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRepaidOnDemandLP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRepaidOnDemandLP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.NewPacketStatus != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.NewPacketStatus))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EventRepaidOnDemandLP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRepaidOnDemandLP.Merge(m, src)
+}
+func (m *EventRepaidOnDemandLP) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRepaidOnDemandLP) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRepaidOnDemandLP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRepaidOnDemandLP proto.InternalMessageInfo
+
+func (m *EventRepaidOnDemandLP) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventRepaidOnDemandLP) GetLpId() uint64 {
+	if m != nil {
+		return m.LpId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventDemandOrderFeeUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRepaidOnDemandLP) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDemandOrderFeeUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventCreatedOnDemandLP struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FundsAddr string `protobuf:"bytes,2,opt,name=funds_addr,json=fundsAddr,proto3" json:"funds_addr,omitempty"`
 }
 
-func (m *EventDemandOrderFeeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.ProofHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ProofHeight))
-		i--
-		dAtA[i] = 0x30
+func (m *EventCreatedOnDemandLP) Reset()         { *m = EventCreatedOnDemandLP{} }
+func (m *EventCreatedOnDemandLP) String() string { return proto.CompactTextString(m) }
+func (*EventCreatedOnDemandLP) ProtoMessage()    {}
+func (*EventCreatedOnDemandLP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{15}
+}
+func (m *EventCreatedOnDemandLP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreatedOnDemandLP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreatedOnDemandLP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *EventCreatedOnDemandLP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreatedOnDemandLP.Merge(m, src)
+}
+func (m *EventCreatedOnDemandLP) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreatedOnDemandLP) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreatedOnDemandLP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreatedOnDemandLP proto.InternalMessageInfo
+
+func (m *EventCreatedOnDemandLP) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.PacketStatus) > 0 {
-		i -= len(m.PacketStatus)
-		copy(dAtA[i:], m.PacketStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *EventCreatedOnDemandLP) GetFundsAddr() string {
+	if m != nil {
+		return m.FundsAddr
 	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+type EventDeletedOnDemandLP struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FundsAddr string `protobuf:"bytes,2,opt,name=funds_addr,json=fundsAddr,proto3" json:"funds_addr,omitempty"`
+	// human readable
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventDeletedOnDemandLP) Reset()         { *m = EventDeletedOnDemandLP{} }
+func (m *EventDeletedOnDemandLP) String() string { return proto.CompactTextString(m) }
+func (*EventDeletedOnDemandLP) ProtoMessage()    {}
+func (*EventDeletedOnDemandLP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{16}
+}
+func (m *EventDeletedOnDemandLP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeletedOnDemandLP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeletedOnDemandLP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.NewFee) > 0 {
-		i -= len(m.NewFee)
-		copy(dAtA[i:], m.NewFee)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewFee)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventDeletedOnDemandLP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeletedOnDemandLP.Merge(m, src)
+}
+func (m *EventDeletedOnDemandLP) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeletedOnDemandLP) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeletedOnDemandLP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeletedOnDemandLP proto.InternalMessageInfo
+
+func (m *EventDeletedOnDemandLP) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventDeletedOnDemandLP) GetFundsAddr() string {
+	if m != nil {
+		return m.FundsAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDemandOrderFulfilled) Marshal() (dAtA []byte, err error) {
+func (m *EventDeletedOnDemandLP) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventDemandOrderCreated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderCreated")
+	proto.RegisterType((*EventDemandOrderPacketStatusUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderPacketStatusUpdated")
+	proto.RegisterType((*EventDemandOrderFeeUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFeeUpdated")
+	proto.RegisterType((*EventDemandOrderFulfilled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilled")
+	proto.RegisterType((*EventDemandOrderPartiallyFulfilled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderPartiallyFulfilled")
+	proto.RegisterType((*EventPartialFulfillmentPayout)(nil), "dymensionxyz.dymension.eibc.EventPartialFulfillmentPayout")
+	proto.RegisterType((*EventDemandOrderFulfilledAuthorized)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilledAuthorized")
+	proto.RegisterType((*EventDemandOrderDeleted)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderDeleted")
+	proto.RegisterType((*EventDemandOrderReverted)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderReverted")
+	proto.RegisterType((*EventDemandOrderCarried)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderCarried")
+	proto.RegisterType((*EventFulfilledClaimTransferred)(nil), "dymensionxyz.dymension.eibc.EventFulfilledClaimTransferred")
+	proto.RegisterType((*EventFulfilledClaimListed)(nil), "dymensionxyz.dymension.eibc.EventFulfilledClaimListed")
+	proto.RegisterType((*EventFulfilledClaimDelisted)(nil), "dymensionxyz.dymension.eibc.EventFulfilledClaimDelisted")
+	proto.RegisterType((*EventMatchedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventMatchedOnDemandLP")
+	proto.RegisterType((*EventRepaidOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventRepaidOnDemandLP")
+	proto.RegisterType((*EventCreatedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventCreatedOnDemandLP")
+	proto.RegisterType((*EventDeletedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventDeletedOnDemandLP")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/events.proto", fileDescriptor_87abe4479c806cf7)
+}
+
+var fileDescriptor_87abe4479c806cf7 = []byte{
+	// 989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
+	0x13, 0x37, 0x29, 0x4a, 0xb2, 0x46, 0x8a, 0x9c, 0xf0, 0xcb, 0xe7, 0xd0, 0x4e, 0xac, 0xda, 0x0c,
+	0x82, 0xba, 0x39, 0x88, 0x70, 0xf3, 0x04, 0x49, 0x5c, 0xb7, 0x41, 0x52, 0xd8, 0x55, 0xd3, 0x4b,
+	0x2f, 0x2c, 0x45, 0x8e, 0xa4, 0x45, 0xc8, 0x5d, 0x62, 0x49, 0xd9, 0x51, 0xee, 0xbd, 0xf7, 0x61,
+	0x8a, 0xa2, 0x8f, 0xd0, 0x63, 0x80, 0x5e, 0x7a, 0x0c, 0x6c, 0xe4, 0x3d, 0x8a, 0x5d, 0x2e, 0x25,
+	0x8a, 0x92, 0xac, 0xc0, 0xed, 0xa9, 0x37, 0xce, 0x6f, 0x47, 0xf3, 0xf7, 0x37, 0xb3, 0x2b, 0x38,
+	0x0c, 0x26, 0x11, 0xd2, 0x84, 0x30, 0xfa, 0x76, 0xf2, 0xce, 0x99, 0x0a, 0x0e, 0x92, 0xbe, 0xef,
+	0xe0, 0x39, 0xd2, 0x34, 0xe9, 0xc6, 0x9c, 0xa5, 0xcc, 0xbc, 0x5f, 0xd4, 0xec, 0x4e, 0x85, 0xae,
+	0xd0, 0xdc, 0xbd, 0x3b, 0x64, 0x43, 0x26, 0xf5, 0x1c, 0xf1, 0x95, 0xfd, 0x64, 0xf7, 0xf1, 0x0a,
+	0xe3, 0x3e, 0x8b, 0x22, 0x46, 0x9d, 0x24, 0xf5, 0xd2, 0xb1, 0x32, 0xbf, 0xdb, 0xf1, 0x59, 0x12,
+	0xb1, 0xc4, 0xe9, 0x7b, 0x09, 0x3a, 0xe7, 0x47, 0x7d, 0x4c, 0xbd, 0x23, 0xc7, 0x67, 0x84, 0x66,
+	0xe7, 0xf6, 0x07, 0x1d, 0xee, 0x7d, 0x25, 0xe2, 0x39, 0xc6, 0xc8, 0xa3, 0xc1, 0x29, 0x0f, 0x90,
+	0x3f, 0xe7, 0xe8, 0xa5, 0x18, 0x98, 0x3b, 0xb0, 0xc9, 0x84, 0xec, 0x92, 0xc0, 0xd2, 0xf6, 0xb5,
+	0xc3, 0x46, 0xaf, 0x2e, 0xe5, 0x17, 0x81, 0x79, 0x17, 0xaa, 0x31, 0x27, 0x3e, 0x5a, 0xba, 0xc4,
+	0x33, 0xc1, 0xbc, 0x0d, 0x95, 0x01, 0xa2, 0x55, 0x91, 0x98, 0xf8, 0x34, 0x1f, 0x41, 0x8b, 0x24,
+	0xee, 0x60, 0x1c, 0x0e, 0x48, 0x18, 0x62, 0x60, 0x19, 0xfb, 0xda, 0xe1, 0xe6, 0x33, 0xdd, 0xd2,
+	0x7a, 0x4d, 0x92, 0x9c, 0xe4, 0xb0, 0xf9, 0x10, 0x6e, 0xc5, 0x9e, 0xff, 0x06, 0x53, 0x37, 0x0b,
+	0xde, 0xaa, 0x4a, 0x13, 0xad, 0x0c, 0xfc, 0x5e, 0x62, 0xe6, 0x1e, 0x80, 0x52, 0x7a, 0x83, 0x13,
+	0xab, 0x26, 0x35, 0x1a, 0x19, 0xf2, 0x12, 0x27, 0xe2, 0x98, 0xb3, 0x30, 0xf4, 0xe2, 0x58, 0xc4,
+	0x5b, 0xcf, 0x8e, 0x15, 0xf2, 0x22, 0x30, 0x1f, 0x40, 0x83, 0xa3, 0x4f, 0x62, 0x82, 0x34, 0xb5,
+	0x36, 0xd5, 0x69, 0x0e, 0x98, 0x9f, 0x41, 0x53, 0xd9, 0x4e, 0x27, 0x31, 0x5a, 0x0d, 0x79, 0xae,
+	0xdc, 0xbd, 0x9e, 0xc4, 0x68, 0x1e, 0x40, 0x2b, 0xe6, 0x8c, 0x0d, 0xdc, 0x11, 0x92, 0xe1, 0x28,
+	0xb5, 0x60, 0x5f, 0x3b, 0x34, 0x7a, 0x4d, 0x89, 0x7d, 0x23, 0x21, 0x73, 0x1b, 0x6a, 0x5e, 0xc4,
+	0xc6, 0x34, 0xb5, 0x9a, 0xf2, 0xe7, 0x4a, 0xb2, 0x7f, 0xd3, 0xe0, 0x61, 0xb9, 0xc4, 0x67, 0x85,
+	0xc4, 0x7e, 0x88, 0x83, 0x75, 0xe5, 0xfe, 0x0e, 0xee, 0x50, 0xbc, 0x70, 0xe7, 0x6b, 0x24, 0x4a,
+	0xdf, 0xfe, 0xf2, 0x51, 0x77, 0x05, 0x81, 0x32, 0x36, 0x74, 0x33, 0x1f, 0xbd, 0x2d, 0x8a, 0x17,
+	0x45, 0xa7, 0xe6, 0x41, 0xa9, 0x33, 0xa2, 0x69, 0x9b, 0x73, 0x5d, 0xb1, 0x3f, 0x6a, 0xb0, 0x5b,
+	0x0e, 0xfc, 0x04, 0xf1, 0x13, 0xe2, 0xbd, 0x07, 0x75, 0x11, 0xaf, 0x20, 0x43, 0x46, 0x90, 0x1a,
+	0xc5, 0x8b, 0x13, 0xc4, 0x19, 0x6f, 0x2a, 0x45, 0xde, 0x2c, 0xb4, 0xdf, 0x58, 0xde, 0xfe, 0x42,
+	0x7f, 0xab, 0xe5, 0xfe, 0x96, 0x1b, 0x54, 0xbb, 0xae, 0x41, 0xf5, 0xb9, 0x06, 0x7d, 0xd4, 0x60,
+	0x67, 0x21, 0xcf, 0x29, 0x37, 0xff, 0x85, 0x29, 0x38, 0x58, 0x36, 0x05, 0x37, 0x98, 0x80, 0x07,
+	0xd0, 0xc8, 0x8d, 0x70, 0xc5, 0xd1, 0x19, 0x50, 0xe6, 0x30, 0x94, 0x39, 0x6c, 0xff, 0xa9, 0x81,
+	0xbd, 0x48, 0x44, 0x9e, 0x12, 0x2f, 0x0c, 0x27, 0x9f, 0x94, 0xf0, 0x5c, 0x00, 0x7a, 0x39, 0x80,
+	0x59, 0x7d, 0x2b, 0xc5, 0xfa, 0x66, 0xa3, 0x17, 0x79, 0x84, 0x12, 0x3a, 0x54, 0xad, 0x9d, 0x01,
+	0xeb, 0xfa, 0x5a, 0xca, 0xaa, 0xb6, 0x90, 0x55, 0x08, 0x7b, 0x32, 0x29, 0x95, 0x89, 0xca, 0x23,
+	0x92, 0xc8, 0x84, 0x8d, 0xd3, 0xeb, 0xf2, 0xb1, 0xa0, 0xee, 0x05, 0x01, 0xc7, 0x24, 0x51, 0xd9,
+	0xe4, 0xe2, 0xaa, 0x5c, 0xec, 0x9f, 0x2b, 0x8b, 0xc3, 0x3c, 0x2d, 0xdd, 0xd3, 0x71, 0x3a, 0x62,
+	0x9c, 0xbc, 0xfb, 0x2f, 0xb1, 0xc6, 0xfc, 0x1c, 0xb6, 0x7c, 0x71, 0x21, 0x10, 0x46, 0xf3, 0xd9,
+	0x6a, 0xca, 0xd9, 0x6a, 0xe7, 0xb0, 0x1a, 0xaf, 0x3d, 0x80, 0x30, 0x76, 0xf3, 0x7a, 0xb6, 0x32,
+	0x47, 0x61, 0xfc, 0x54, 0x55, 0xf4, 0x0b, 0xb8, 0xcd, 0x62, 0xe4, 0x5e, 0xca, 0xf8, 0x54, 0xe9,
+	0x96, 0x54, 0xda, 0xca, 0xf1, 0x5c, 0xf5, 0x00, 0x5a, 0x53, 0x55, 0x51, 0x94, 0xb6, 0x54, 0x6b,
+	0xe6, 0xd8, 0x09, 0xa2, 0xfd, 0xbb, 0xb6, 0x78, 0x6f, 0x1d, 0x63, 0x88, 0x6b, 0x16, 0xd3, 0xfc,
+	0x1d, 0xa2, 0x97, 0xef, 0x90, 0x85, 0x7a, 0x56, 0xd6, 0x2e, 0x22, 0x63, 0x0d, 0x61, 0xab, 0x0b,
+	0x84, 0xfd, 0x55, 0x03, 0xab, 0x1c, 0x7a, 0x0f, 0xcf, 0x91, 0xaf, 0x8f, 0xbd, 0xe0, 0x57, 0x5f,
+	0xe3, 0xb7, 0xb2, 0xd0, 0xc8, 0x39, 0x1e, 0x18, 0x4b, 0x78, 0xe0, 0x87, 0x1e, 0x89, 0x5c, 0x76,
+	0x41, 0x91, 0xe7, 0x61, 0x4b, 0xe8, 0x54, 0x20, 0xf6, 0x4f, 0x4b, 0x1e, 0x0a, 0x1e, 0xe7, 0x04,
+	0x03, 0xf3, 0x31, 0xdc, 0xe1, 0x2a, 0x01, 0xb7, 0x14, 0xfd, 0x56, 0x7e, 0x70, 0xaa, 0xb2, 0x28,
+	0x26, 0xa8, 0xcf, 0x25, 0x68, 0x8f, 0xa1, 0x23, 0x3d, 0x4c, 0xf9, 0xfd, 0x5c, 0x78, 0x7f, 0xcd,
+	0x3d, 0x9a, 0x0c, 0x90, 0xf3, 0xeb, 0xab, 0x63, 0x82, 0x31, 0xe0, 0x2c, 0x52, 0x36, 0xe5, 0xb7,
+	0xd9, 0x06, 0x3d, 0x65, 0xaa, 0x12, 0x7a, 0xca, 0x66, 0x93, 0x67, 0x14, 0x26, 0xcf, 0x0e, 0x60,
+	0x67, 0x89, 0xdb, 0x57, 0x24, 0x59, 0xd3, 0x8f, 0x6d, 0xa8, 0x25, 0x58, 0xd8, 0x84, 0x4a, 0x5a,
+	0x7e, 0xc7, 0xd9, 0x67, 0x70, 0x7f, 0x89, 0x97, 0x63, 0x0c, 0x6f, 0xea, 0xc7, 0x1e, 0xc0, 0xb6,
+	0xb4, 0xf8, 0xad, 0x97, 0xfa, 0x23, 0x0c, 0x4e, 0x69, 0xd6, 0x99, 0x57, 0x67, 0xd7, 0x19, 0xfb,
+	0x1f, 0x54, 0xc3, 0x29, 0x7f, 0x8c, 0x9e, 0x11, 0xc6, 0xe5, 0xb5, 0x5e, 0x29, 0x31, 0xc3, 0x76,
+	0xe1, 0xff, 0xd2, 0x4f, 0x0f, 0x63, 0x8f, 0xfc, 0x13, 0x37, 0xab, 0x76, 0xea, 0xd7, 0x2a, 0x11,
+	0xf5, 0xee, 0x2c, 0x78, 0x68, 0x83, 0xae, 0x6c, 0x1b, 0x3d, 0x9d, 0xc8, 0x11, 0x18, 0x8c, 0x69,
+	0x90, 0xc8, 0x05, 0x32, 0xbb, 0x80, 0x68, 0x90, 0x88, 0xd5, 0x61, 0xbb, 0xca, 0x90, 0x5a, 0x04,
+	0x37, 0x36, 0x24, 0x22, 0xe5, 0xe8, 0x25, 0x8c, 0xe6, 0x91, 0x66, 0xd2, 0xb3, 0x97, 0x7f, 0x5c,
+	0x76, 0xb4, 0xf7, 0x97, 0x1d, 0xed, 0xc3, 0x65, 0x47, 0xfb, 0xe5, 0xaa, 0xb3, 0xf1, 0xfe, 0xaa,
+	0xb3, 0xf1, 0xd7, 0x55, 0x67, 0xe3, 0xc7, 0xa3, 0x21, 0x49, 0x47, 0xe3, 0xbe, 0x78, 0x75, 0x39,
+	0x2b, 0x9e, 0xe7, 0xe7, 0x4f, 0x9c, 0xb7, 0xd9, 0x1f, 0x00, 0x31, 0xa0, 0x49, 0xbf, 0x26, 0x5f,
+	0xe0, 0x4f, 0xfe, 0x1e, 0x00, 0x68, 0xf1, 0x01, 0x71, 0x2c, 0x0c, 0x00, 0x00,
+}
+
+func (m *EventDemandOrderCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1115,29 +1476,55 @@ func (m *EventDemandOrderFulfilled) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventDemandOrderFulfilled) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDemandOrderCreated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDemandOrderFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDemandOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ProofHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.PacketType) > 0 {
 		i -= len(m.PacketType)
 		copy(dAtA[i:], m.PacketType)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x4a
 	}
-	if len(m.Fulfiller) > 0 {
-		i -= len(m.Fulfiller)
-		copy(dAtA[i:], m.Fulfiller)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x42
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PacketKey) > 0 {
+		i -= len(m.PacketKey)
+		copy(dAtA[i:], m.PacketKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketKey)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if len(m.PacketStatus) > 0 {
 		i -= len(m.PacketStatus)
@@ -1180,7 +1567,7 @@ func (m *EventDemandOrderFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *EventDemandOrderFulfilledAuthorized) Marshal() (dAtA []byte, err error) {
+func (m *EventDemandOrderPacketStatusUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1190,63 +1577,16 @@ func (m *EventDemandOrderFulfilledAuthorized) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *EventDemandOrderFulfilledAuthorized) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDemandOrderPacketStatusUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDemandOrderFulfilledAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDemandOrderPacketStatusUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OperatorFee) > 0 {
-		i -= len(m.OperatorFee)
-		copy(dAtA[i:], m.OperatorFee)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OperatorFee)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.LpAddress) > 0 {
-		i -= len(m.LpAddress)
-		copy(dAtA[i:], m.LpAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.LpAddress)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.CreationHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.CreationHeight))
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.PacketType) > 0 {
-		i -= len(m.PacketType)
-		copy(dAtA[i:], m.PacketType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.Fulfiller) > 0 {
-		i -= len(m.Fulfiller)
-		copy(dAtA[i:], m.Fulfiller)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.PacketStatus) > 0 {
-		i -= len(m.PacketStatus)
-		copy(dAtA[i:], m.PacketStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
-		i--
-		dAtA[i] = 0x2a
-	}
 	if m.IsFulfilled {
 		i--
 		if m.IsFulfilled {
@@ -1255,21 +1595,12 @@ func (m *EventDemandOrderFulfilledAuthorized) MarshalToSizedBuffer(dAtA []byte)
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x18
 	}
-	if len(m.Fee) > 0 {
-		i -= len(m.Fee)
-		copy(dAtA[i:], m.Fee)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+	if m.NewPacketStatus != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewPacketStatus))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.OrderId) > 0 {
 		i -= len(m.OrderId)
@@ -1281,7 +1612,7 @@ func (m *EventDemandOrderFulfilledAuthorized) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *EventDemandOrderDeleted) Marshal() (dAtA []byte, err error) {
+func (m *EventDemandOrderFeeUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1291,41 +1622,53 @@ func (m *EventDemandOrderDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventDemandOrderDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDemandOrderFeeUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDemandOrderDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDemandOrderFeeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PacketType) > 0 {
-		i -= len(m.PacketType)
-		copy(dAtA[i:], m.PacketType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x3a
+	}
+	if m.ProofHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x30
 	}
 	if len(m.RollappId) > 0 {
 		i -= len(m.RollappId)
 		copy(dAtA[i:], m.RollappId)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
 	if len(m.PacketStatus) > 0 {
 		i -= len(m.PacketStatus)
 		copy(dAtA[i:], m.PacketStatus)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.PacketKey) > 0 {
-		i -= len(m.PacketKey)
-		copy(dAtA[i:], m.PacketKey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketKey)))
+	if len(m.NewFee) > 0 {
+		i -= len(m.NewFee)
+		copy(dAtA[i:], m.NewFee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewFee)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1339,7 +1682,7 @@ func (m *EventDemandOrderDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMatchedOnDemandLP) Marshal() (dAtA []byte, err error) {
+func (m *EventDemandOrderFulfilled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1349,27 +1692,60 @@ func (m *EventMatchedOnDemandLP) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMatchedOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDemandOrderFulfilled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMatchedOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDemandOrderFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.PacketType) > 0 {
+		i -= len(m.PacketType)
+		copy(dAtA[i:], m.PacketType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.Fulfiller) > 0 {
 		i -= len(m.Fulfiller)
 		copy(dAtA[i:], m.Fulfiller)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
 		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PacketStatus) > 0 {
+		i -= len(m.PacketStatus)
+		copy(dAtA[i:], m.PacketStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.IsFulfilled {
+		i--
+		if m.IsFulfilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
+		i--
 		dAtA[i] = 0x1a
 	}
-	if m.LpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.LpId))
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.OrderId) > 0 {
 		i -= len(m.OrderId)
@@ -1381,7 +1757,7 @@ func (m *EventMatchedOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCreatedOnDemandLP) Marshal() (dAtA []byte, err error) {
+func (m *EventDemandOrderPartiallyFulfilled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1391,32 +1767,62 @@ func (m *EventCreatedOnDemandLP) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCreatedOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDemandOrderPartiallyFulfilled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCreatedOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDemandOrderPartiallyFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FundsAddr) > 0 {
-		i -= len(m.FundsAddr)
-		copy(dAtA[i:], m.FundsAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundsAddr)))
+	if len(m.PacketType) > 0 {
+		i -= len(m.PacketType)
+		copy(dAtA[i:], m.PacketType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Remaining) > 0 {
+		i -= len(m.Remaining)
+		copy(dAtA[i:], m.Remaining)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Remaining)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventDeletedOnDemandLP) Marshal() (dAtA []byte, err error) {
+func (m *EventPartialFulfillmentPayout) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1426,334 +1832,2087 @@ func (m *EventDeletedOnDemandLP) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventDeletedOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventPartialFulfillmentPayout) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDeletedOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventPartialFulfillmentPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.FundsAddr) > 0 {
-		i -= len(m.FundsAddr)
-		copy(dAtA[i:], m.FundsAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundsAddr)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventDemandOrderFulfilledAuthorized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventDemandOrderCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventDemandOrderFulfilledAuthorized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderFulfilledAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Fee)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OperatorFee) > 0 {
+		i -= len(m.OperatorFee)
+		copy(dAtA[i:], m.OperatorFee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OperatorFee)))
+		i--
+		dAtA[i] = 0x72
 	}
-	if m.IsFulfilled {
-		n += 2
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	l = len(m.PacketStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.LpAddress)))
+		i--
+		dAtA[i] = 0x62
 	}
-	l = len(m.PacketKey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.CreationHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PacketType) > 0 {
+		i -= len(m.PacketType)
+		copy(dAtA[i:], m.PacketType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.PacketType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PacketStatus) > 0 {
+		i -= len(m.PacketStatus)
+		copy(dAtA[i:], m.PacketStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.ProofHeight != 0 {
-		n += 1 + sovEvents(uint64(m.ProofHeight))
+	if m.IsFulfilled {
+		i--
+		if m.IsFulfilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDemandOrderPacketStatusUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventDemandOrderDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PacketType) > 0 {
+		i -= len(m.PacketType)
+		copy(dAtA[i:], m.PacketType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.NewPacketStatus != 0 {
-		n += 1 + sovEvents(uint64(m.NewPacketStatus))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.IsFulfilled {
-		n += 2
+	if len(m.PacketStatus) > 0 {
+		i -= len(m.PacketStatus)
+		copy(dAtA[i:], m.PacketStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.PacketKey) > 0 {
+		i -= len(m.PacketKey)
+		copy(dAtA[i:], m.PacketKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDemandOrderFeeUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventDemandOrderReverted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderReverted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderReverted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.NewFee)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ClaimOwner) > 0 {
+		i -= len(m.ClaimOwner)
+		copy(dAtA[i:], m.ClaimOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ClaimOwner)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.PacketStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PacketType) > 0 {
+		i -= len(m.PacketType)
+		copy(dAtA[i:], m.PacketType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ProofHeight != 0 {
-		n += 1 + sovEvents(uint64(m.ProofHeight))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDemandOrderFulfilled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventDemandOrderCarried) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderCarried) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderCarried) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Fee)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.IsFulfilled {
-		n += 2
-	}
-	l = len(m.PacketStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Fulfiller)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PacketType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.RevertedOrderId) > 0 {
+		i -= len(m.RevertedOrderId)
+		copy(dAtA[i:], m.RevertedOrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RevertedOrderId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDemandOrderFulfilledAuthorized) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventFulfilledClaimTransferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFulfilledClaimTransferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFulfilledClaimTransferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Fee)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.IsFulfilled {
-		n += 2
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PacketStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Fulfiller)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.PacketType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.CreationHeight != 0 {
-		n += 1 + sovEvents(uint64(m.CreationHeight))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFulfilledClaimListed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.LpAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventFulfilledClaimListed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFulfilledClaimListed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.OperatorFee)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDemandOrderDeleted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventFulfilledClaimDelisted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFulfilledClaimDelisted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFulfilledClaimDelisted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PacketKey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.PacketStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMatchedOnDemandLP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventMatchedOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMatchedOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PacketType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.LpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LpId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMatchedOnDemandLP) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventRepaidOnDemandLP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRepaidOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRepaidOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.LpId != 0 {
-		n += 1 + sovEvents(uint64(m.LpId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.LpId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Fulfiller)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCreatedOnDemandLP) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCreatedOnDemandLP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreatedOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreatedOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.FundsAddr) > 0 {
+		i -= len(m.FundsAddr)
+		copy(dAtA[i:], m.FundsAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundsAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.FundsAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeletedOnDemandLP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeletedOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeletedOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FundsAddr) > 0 {
+		i -= len(m.FundsAddr)
+		copy(dAtA[i:], m.FundsAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundsAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventDemandOrderCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsFulfilled {
+		n += 2
+	}
+	l = len(m.PacketStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ProofHeight))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderPacketStatusUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NewPacketStatus != 0 {
+		n += 1 + sovEvents(uint64(m.NewPacketStatus))
+	}
+	if m.IsFulfilled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventDemandOrderFeeUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewFee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ProofHeight))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderFulfilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsFulfilled {
+		n += 2
+	}
+	l = len(m.PacketStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Remaining)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPartialFulfillmentPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderFulfilledAuthorized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsFulfilled {
+		n += 2
+	}
+	l = len(m.PacketStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovEvents(uint64(m.CreationHeight))
+	}
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OperatorFee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderReverted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ClaimOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderCarried) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RevertedOrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFulfilledClaimTransferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFulfilledClaimListed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFulfilledClaimDelisted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMatchedOnDemandLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.LpId != 0 {
+		n += 1 + sovEvents(uint64(m.LpId))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRepaidOnDemandLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.LpId != 0 {
+		n += 1 + sovEvents(uint64(m.LpId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCreatedOnDemandLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.FundsAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDeletedOnDemandLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.FundsAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPacketStatus", wireType)
+			}
+			m.NewPacketStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewPacketStatus |= types.Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFulfilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFulfilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventDeletedOnDemandLP) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
-	}
-	l = len(m.FundsAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
+func (m *EventDemandOrderPartiallyFulfilled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1776,15 +3935,111 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDemandOrderPartiallyFulfilled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDemandOrderPartiallyFulfilled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1812,11 +4067,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.Remaining = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1844,11 +4099,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1876,13 +4131,63 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			m.PacketType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPartialFulfillmentPayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPartialFulfillmentPayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPartialFulfillmentPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1892,15 +4197,27 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFulfilled = bool(v != 0)
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1928,11 +4245,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1960,11 +4277,61 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFulfilledAuthorized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFulfilledAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1992,11 +4359,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2024,11 +4391,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2056,13 +4423,13 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
+			m.Fee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
 			}
-			m.ProofHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2072,14 +4439,15 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProofHeight |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			m.IsFulfilled = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2107,61 +4475,94 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PacketType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2189,13 +4590,13 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPacketStatus", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			m.NewPacketStatus = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2205,16 +4606,29 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewPacketStatus |= types.Status(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFee", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2224,12 +4638,24 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFulfilled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2251,7 +4677,7 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2274,10 +4700,10 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDemandOrderDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDemandOrderDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2314,7 +4740,7 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2342,41 +4768,9 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFee = string(dAtA[iNdEx:postIndex])
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Price = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
 			}
@@ -2408,7 +4802,7 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			}
 			m.PacketStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
@@ -2440,28 +4834,9 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			}
 			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
-			}
-			m.ProofHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProofHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2489,7 +4864,7 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.PacketType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2512,7 +4887,7 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
+func (m *EventDemandOrderReverted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2535,10 +4910,10 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderFulfilled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDemandOrderReverted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderFulfilled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDemandOrderReverted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2575,7 +4950,7 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2603,11 +4978,11 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2635,13 +5010,13 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			m.PacketType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2651,15 +5026,27 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFulfilled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2687,11 +5074,61 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.ClaimOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderCarried) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderCarried: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderCarried: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertedOrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2719,11 +5156,11 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			m.RevertedOrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2751,7 +5188,7 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2774,7 +5211,7 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
+func (m *EventFulfilledClaimTransferred) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2797,10 +5234,10 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderFulfilledAuthorized: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFulfilledClaimTransferred: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderFulfilledAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFulfilledClaimTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2837,7 +5274,7 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2865,11 +5302,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2897,31 +5334,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsFulfilled = bool(v != 0)
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2949,94 +5366,61 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFulfilledClaimListed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 12:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFulfilledClaimListed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFulfilledClaimListed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3064,11 +5448,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3096,11 +5480,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3128,7 +5512,7 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorFee = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3151,7 +5535,7 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventFulfilledClaimDelisted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3174,10 +5558,10 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFulfilledClaimDelisted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFulfilledClaimDelisted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3214,7 +5598,7 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3242,11 +5626,61 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMatchedOnDemandLP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMatchedOnDemandLP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMatchedOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3274,13 +5708,13 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpId", wireType)
 			}
-			var stringLen uint64
+			m.LpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3290,27 +5724,14 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LpId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3338,7 +5759,7 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3361,7 +5782,7 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMatchedOnDemandLP) Unmarshal(dAtA []byte) error {
+func (m *EventRepaidOnDemandLP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3384,10 +5805,10 @@ func (m *EventMatchedOnDemandLP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMatchedOnDemandLP: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRepaidOnDemandLP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMatchedOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRepaidOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3443,7 +5864,7 @@ func (m *EventMatchedOnDemandLP) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3471,7 +5892,7 @@ func (m *EventMatchedOnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21,9 +21,10 @@ func TestGenesisState_Validate(t *testing.T) {
 	}
 
 	validParams := types.Params{
-		EpochIdentifier: "hour",
-		TimeoutFee:      math.LegacyNewDecWithPrec(1, 1),
-		ErrackFee:       math.LegacyNewDecWithPrec(1, 1),
+		EpochIdentifier:              "hour",
+		TimeoutFee:                   math.LegacyNewDecWithPrec(1, 1),
+		ErrackFee:                    math.LegacyNewDecWithPrec(1, 1),
+		RevertedOrderRetentionBlocks: 100,
 	}
 
 	for _, tc := range []struct {
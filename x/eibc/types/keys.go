@@ -39,6 +39,9 @@ var (
 	FinalizedDemandOrderKeyPrefix = []byte{0x00, 0x02}
 
 	_ = []byte{0x00, 0x03} // deprecated key
+
+	// RevertedDemandOrderKeyPrefix is the prefix for fulfilled demand orders whose packet was reverted
+	RevertedDemandOrderKeyPrefix = []byte{0x00, 0x04}
 )
 
 // GetDemandOrderKey constructs a key for a specific DemandOrder.
@@ -55,3 +58,17 @@ func GetDemandOrderKey(packetStatus commontypes.Status, orderId string) ([]byte,
 	}
 	return []byte(fmt.Sprintf("%s%s%s%s%s", prefix, KeySeparator, packetStatus, KeySeparator, orderId)), nil
 }
+
+// GetRevertedDemandOrderKey constructs a key for a specific reverted DemandOrder.
+func GetRevertedDemandOrderKey(orderId string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", RevertedDemandOrderKeyPrefix, KeySeparator, OrderStatus_ORDER_REVERTED, KeySeparator, orderId))
+}
+
+// GetDemandOrderStoreKey constructs the key under which the DemandOrder is stored: reverted orders are kept
+// apart, the others are keyed by the status of their packet.
+func GetDemandOrderStoreKey(order *DemandOrder) ([]byte, error) {
+	if order.IsReverted() {
+		return GetRevertedDemandOrderKey(order.Id), nil
+	}
+	return GetDemandOrderKey(order.TrackingPacketStatus, order.Id)
+}
@@ -39,9 +39,47 @@ func (d OnDemandLP) Validate() error {
 	if d.SpendLimit.IsNil() || !d.SpendLimit.IsPositive() {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend limit")
 	}
+	if err := d.validateWindow(); err != nil {
+		return errorsmod.Wrap(err, "window")
+	}
+	if !d.RollappSpendLimit.IsNil() && d.RollappSpendLimit.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp spend limit")
+	}
+	return nil
+}
+
+func (d OnDemandLP) validateWindow() error {
+	if !d.HasWindow() {
+		if !d.WindowSpendLimit.IsNil() && d.WindowSpendLimit.IsNegative() {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "negative spend limit")
+		}
+		if d.WindowBlocks != 0 || d.WindowEpochIdentifier != "" {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "window without spend limit")
+		}
+		return nil
+	}
+	if (d.WindowBlocks == 0) == (d.WindowEpochIdentifier == "") {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "exactly one of blocks and epoch identifier must be set")
+	}
 	return nil
 }
 
+// HasRollappLimit returns true if the lp limits the amt spent on orders of the rollapp by all the lps of its
+// funds addr.
+func (d OnDemandLP) HasRollappLimit() bool {
+	return !d.RollappSpendLimit.IsNil() && d.RollappSpendLimit.IsPositive()
+}
+
+// HasEpochWindow returns true if the lp counts its windows in epochs.
+func (d OnDemandLP) HasEpochWindow() bool {
+	return d.HasWindow() && d.WindowEpochIdentifier != ""
+}
+
+// HasWindow returns true if the lp limits the amt spent per window of blocks or epoch.
+func (d OnDemandLP) HasWindow() bool {
+	return !d.WindowSpendLimit.IsNil() && d.WindowSpendLimit.IsPositive()
+}
+
 func (r OnDemandLPRecord) Validate() error {
 	if r.Lp == nil {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty lp")
@@ -55,15 +93,59 @@ func (r OnDemandLPRecord) Validate() error {
 	if r.Spent.GT(r.Lp.SpendLimit) {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spent greater than spend limit")
 	}
+	if !r.WindowSpent.IsNil() && r.WindowSpent.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "negative window spent")
+	}
 	return nil
 }
 
-func (r OnDemandLPRecord) MaxSpend() math.Int {
-	return math.MinInt(r.Lp.MaxPrice, r.Lp.SpendLimit.Sub(r.Spent))
+// MaxSpend returns the max price of an order the lp can fulfill at the given height.
+func (r OnDemandLPRecord) MaxSpend(nowHeight uint64) math.Int {
+	ret := math.MinInt(r.Lp.MaxPrice, r.Lp.SpendLimit.Sub(r.Spent))
+	if r.Lp.HasWindow() {
+		ret = math.MinInt(ret, r.Lp.WindowSpendLimit.Sub(r.WindowSpentAt(nowHeight)))
+	}
+	return ret
+}
+
+// WindowSpentAt returns the amt spent in the window of the given height.
+func (r OnDemandLPRecord) WindowSpentAt(nowHeight uint64) math.Int {
+	if r.WindowSpent.IsNil() || r.windowExpired(nowHeight) {
+		return math.ZeroInt()
+	}
+	return r.WindowSpent
+}
+
+func (r OnDemandLPRecord) windowExpired(nowHeight uint64) bool {
+	return 0 < r.Lp.WindowBlocks && r.WindowStart+r.Lp.WindowBlocks <= nowHeight
+}
+
+// Spend records the amt spent on an order at the given height.
+func (r *OnDemandLPRecord) Spend(nowHeight uint64, amt math.Int) {
+	r.Spent = r.Spent.Add(amt)
+	if !r.Lp.HasWindow() {
+		return
+	}
+	if r.WindowSpent.IsNil() || r.windowExpired(nowHeight) {
+		r.WindowStart = nowHeight
+		r.WindowSpent = math.ZeroInt()
+	}
+	r.WindowSpent = r.WindowSpent.Add(amt)
+}
+
+// Repay credits back the amt spent on an order once the order is finalized without error.
+func (r *OnDemandLPRecord) Repay(amt math.Int) {
+	r.Spent = math.MaxInt(math.ZeroInt(), r.Spent.Sub(amt))
+}
+
+// ResetWindow starts a new window, e.g. at the end of the epoch of the lp.
+func (r *OnDemandLPRecord) ResetWindow(nowHeight uint64) {
+	r.WindowStart = nowHeight
+	r.WindowSpent = math.ZeroInt()
 }
 
 func (r OnDemandLPRecord) Accepts(nowHeight uint64, o *DemandOrder) bool {
-	priceOK := o.PriceAmount().LTE(r.MaxSpend())
+	priceOK := o.PriceAmount().LTE(r.MaxSpend(nowHeight))
 	feeOK := r.Lp.MinFee.LTE(o.GetFeePercent())
 	ageOK := r.Lp.OrderMinAgeBlocks <= nowHeight-o.CreationHeight
 	return priceOK && feeOK && ageOK
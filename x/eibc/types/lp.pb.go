@@ -38,12 +38,26 @@ type OnDemandLP struct {
 	// [0,1])
 	MinFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=minFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"minFee"`
 	// will not fulfill if brings amt spent above limit
+	// the amt spent on an order is credited back once the order is finalized
+	// without error
 	SpendLimit cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=spendLimit,proto3,customtype=cosmossdk.io/math.Int" json:"spendLimit"`
 	// will not fulfill orders which were created fewer than this blocks in the
 	// past e.g. compatibility check occurs at height 7, order existed since block
 	// 4,
 	//      then fulfill if this field is 3 or less
 	OrderMinAgeBlocks uint64 `protobuf:"varint,7,opt,name=orderMinAgeBlocks,proto3" json:"orderMinAgeBlocks,omitempty"`
+	// optional, will not fulfill if brings amt spent in the current window above
+	// this limit, regardless of repayments
+	// the window is either windowBlocks long, or an epoch of
+	// windowEpochIdentifier, exactly one of them must be set with the limit
+	WindowSpendLimit      cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=windowSpendLimit,proto3,customtype=cosmossdk.io/math.Int" json:"windowSpendLimit"`
+	WindowBlocks          uint64                `protobuf:"varint,9,opt,name=windowBlocks,proto3" json:"windowBlocks,omitempty"`
+	WindowEpochIdentifier string                `protobuf:"bytes,10,opt,name=windowEpochIdentifier,proto3" json:"windowEpochIdentifier,omitempty"`
+	// optional, will not fulfill if brings the amt spent on orders of the
+	// rollapp which are not finalized yet, by all the lps of funds_addr, above
+	// this limit
+	// (the exposure to a single order is capped by maxPrice)
+	RollappSpendLimit cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=rollappSpendLimit,proto3,customtype=cosmossdk.io/math.Int" json:"rollappSpendLimit"`
 }
 
 func (m *OnDemandLP) Reset()         { *m = OnDemandLP{} }
@@ -107,11 +121,29 @@ func (m *OnDemandLP) GetOrderMinAgeBlocks() uint64 {
 	return 0
 }
 
+func (m *OnDemandLP) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *OnDemandLP) GetWindowEpochIdentifier() string {
+	if m != nil {
+		return m.WindowEpochIdentifier
+	}
+	return ""
+}
+
 type OnDemandLPRecord struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// amt spent so far
+	// amt spent on orders which are not finalized yet
 	Spent cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=spent,proto3,customtype=cosmossdk.io/math.Int" json:"spent"`
 	Lp    *OnDemandLP           `protobuf:"bytes,3,opt,name=lp,proto3" json:"lp,omitempty"`
+	// amt spent in the current window
+	WindowSpent cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=windowSpent,proto3,customtype=cosmossdk.io/math.Int" json:"windowSpent"`
+	// height at which the current window started, for block windows
+	WindowStart uint64 `protobuf:"varint,5,opt,name=windowStart,proto3" json:"windowStart,omitempty"`
 }
 
 func (m *OnDemandLPRecord) Reset()         { *m = OnDemandLPRecord{} }
@@ -161,6 +193,13 @@ func (m *OnDemandLPRecord) GetLp() *OnDemandLP {
 	return nil
 }
 
+func (m *OnDemandLPRecord) GetWindowStart() uint64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*OnDemandLP)(nil), "dymensionxyz.dymension.eibc.OnDemandLP")
 	proto.RegisterType((*OnDemandLPRecord)(nil), "dymensionxyz.dymension.eibc.OnDemandLPRecord")
@@ -171,37 +210,42 @@ func init() {
 }
 
 var fileDescriptor_13de3de2ae42eb80 = []byte{
-	// 469 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x4a, 0x1b, 0x41,
-	0x18, 0xcf, 0x6e, 0x93, 0x58, 0xa7, 0x50, 0xec, 0x60, 0x61, 0xab, 0x74, 0x15, 0x29, 0x54, 0xda,
-	0xba, 0x43, 0xf4, 0xe0, 0x39, 0x41, 0x5a, 0xc4, 0x14, 0x65, 0x8f, 0xbd, 0x84, 0xd9, 0x99, 0xe9,
-	0x3a, 0x64, 0x67, 0xbe, 0x61, 0x67, 0x22, 0x49, 0x9f, 0xa2, 0x4f, 0xd0, 0xa7, 0xf0, 0x21, 0x3c,
-	0x8a, 0xa7, 0xd2, 0x83, 0x94, 0xe4, 0x05, 0xfa, 0x08, 0x25, 0x3b, 0xdb, 0x34, 0x20, 0x0a, 0xde,
-	0xf6, 0xf7, 0xfd, 0xfe, 0xec, 0x8f, 0x6f, 0xbf, 0x45, 0x6f, 0xf8, 0x44, 0x09, 0x6d, 0x25, 0xe8,
-	0xf1, 0xe4, 0x1b, 0x59, 0x00, 0x22, 0x64, 0xc6, 0x48, 0x61, 0x12, 0x53, 0x82, 0x03, 0xbc, 0xb9,
-	0xac, 0x4a, 0x16, 0x20, 0x99, 0xab, 0x36, 0xd6, 0x73, 0xc8, 0xa1, 0xd2, 0x91, 0xf9, 0x93, 0xb7,
-	0x6c, 0xbc, 0xbb, 0x27, 0x98, 0x81, 0x52, 0xa0, 0x89, 0x75, 0xd4, 0x8d, 0x6c, 0xad, 0xdd, 0x7f,
-	0x58, 0x5b, 0x42, 0x51, 0x50, 0x63, 0x06, 0x86, 0xb2, 0xa1, 0x70, 0xb5, 0x27, 0x66, 0x60, 0x15,
-	0x58, 0x92, 0x51, 0x2b, 0xc8, 0x45, 0x27, 0x13, 0x8e, 0x76, 0x08, 0x03, 0xa9, 0x6b, 0xfe, 0x95,
-	0xe7, 0x07, 0xbe, 0x98, 0x07, 0x9e, 0xda, 0xf9, 0x13, 0x22, 0x74, 0xaa, 0x8f, 0x84, 0xa2, 0x9a,
-	0xf7, 0xcf, 0xf0, 0x6b, 0x84, 0xbe, 0x8e, 0x34, 0xb7, 0x03, 0xca, 0x79, 0x19, 0x05, 0xdb, 0xc1,
-	0xee, 0x6a, 0xba, 0x5a, 0x4d, 0xba, 0x9c, 0x97, 0x38, 0x42, 0x2b, 0x75, 0x81, 0x28, 0xac, 0xb8,
-	0x7f, 0x10, 0xaf, 0xa3, 0x16, 0x17, 0x1a, 0x54, 0xf4, 0xa4, 0x9a, 0x7b, 0x80, 0x3f, 0xa1, 0xa7,
-	0x8a, 0x8e, 0xcf, 0x4a, 0xc9, 0x44, 0xd4, 0x9c, 0x13, 0xbd, 0xf7, 0x57, 0xb7, 0x5b, 0x8d, 0x5f,
-	0xb7, 0x5b, 0x2f, 0x7d, 0x0b, 0xcb, 0x87, 0x89, 0x04, 0xa2, 0xa8, 0x3b, 0x4f, 0x8e, 0xb5, 0xbb,
-	0xb9, 0xdc, 0x43, 0x75, 0xbd, 0x63, 0xed, 0xd2, 0x85, 0x19, 0x9f, 0xa2, 0xb6, 0x92, 0xfa, 0xa3,
-	0x10, 0x51, 0xab, 0x8a, 0x39, 0xac, 0x63, 0x36, 0xef, 0xc6, 0xf4, 0x45, 0x4e, 0xd9, 0xe4, 0x48,
-	0xb0, 0x9b, 0xcb, 0xbd, 0xb5, 0x3a, 0x6c, 0x31, 0x4b, 0xeb, 0x18, 0x7c, 0x82, 0x90, 0x35, 0x42,
-	0xf3, 0xbe, 0x54, 0xd2, 0x45, 0xed, 0xc7, 0x77, 0x5b, 0xb2, 0xe3, 0x0f, 0xe8, 0x05, 0x94, 0x5c,
-	0x94, 0x9f, 0xa5, 0xee, 0xe6, 0xa2, 0x57, 0x00, 0x1b, 0xda, 0x68, 0x65, 0x3b, 0xd8, 0x6d, 0xa6,
-	0x77, 0x89, 0x9d, 0x1f, 0x01, 0x5a, 0xfb, 0xbf, 0xf2, 0x54, 0x30, 0x28, 0x39, 0x7e, 0x8e, 0x42,
-	0xc9, 0xab, 0x85, 0x37, 0xd3, 0x50, 0x72, 0xdc, 0x45, 0xad, 0xf9, 0x0b, 0x9c, 0xdf, 0xf3, 0xe3,
-	0xaa, 0x79, 0x27, 0x3e, 0x44, 0x61, 0x61, 0xaa, 0xef, 0xf1, 0x6c, 0xff, 0x6d, 0xf2, 0xc0, 0xd5,
-	0x26, 0x4b, 0x6d, 0xc2, 0xc2, 0xf4, 0x4e, 0xae, 0xa6, 0x71, 0x70, 0x3d, 0x8d, 0x83, 0xdf, 0xd3,
-	0x38, 0xf8, 0x3e, 0x8b, 0x1b, 0xd7, 0xb3, 0xb8, 0xf1, 0x73, 0x16, 0x37, 0xbe, 0x74, 0x72, 0xe9,
-	0xce, 0x47, 0x59, 0xc2, 0x40, 0x91, 0x7b, 0xee, 0xf4, 0xe2, 0x80, 0x8c, 0xfd, 0x1f, 0xe3, 0x26,
-	0x46, 0xd8, 0xac, 0x5d, 0xdd, 0xd9, 0xc1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x5c, 0x11,
-	0xce, 0x5d, 0x03, 0x00, 0x00,
+	// 555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xd1, 0x6a, 0x13, 0x41,
+	0x14, 0x86, 0xb3, 0x6b, 0x9a, 0x36, 0x27, 0x22, 0xe9, 0xd0, 0xc2, 0xda, 0xe2, 0x36, 0x04, 0xc1,
+	0xa2, 0x76, 0x97, 0xb4, 0x42, 0xaf, 0x13, 0xaa, 0x12, 0x9a, 0xd2, 0xb2, 0x5e, 0x88, 0xde, 0x84,
+	0xcd, 0xcc, 0x34, 0x19, 0xb2, 0x3b, 0xb3, 0xec, 0x4e, 0xda, 0xc4, 0x97, 0xd0, 0x87, 0xe9, 0x43,
+	0xf4, 0xb2, 0xf4, 0x4a, 0xbc, 0x28, 0x92, 0xbc, 0x87, 0x48, 0x66, 0xd6, 0x64, 0x25, 0xb6, 0x10,
+	0xef, 0x72, 0xce, 0x7f, 0xfe, 0x3f, 0x1f, 0x3b, 0x67, 0x06, 0x9e, 0x93, 0x51, 0x48, 0x79, 0xc2,
+	0x04, 0x1f, 0x8e, 0xbe, 0xb8, 0xb3, 0xc2, 0xa5, 0xac, 0x83, 0xdd, 0x20, 0x72, 0xa2, 0x58, 0x48,
+	0x81, 0xb6, 0xb3, 0x53, 0xce, 0xac, 0x70, 0xa6, 0x53, 0x5b, 0x1b, 0x5d, 0xd1, 0x15, 0x6a, 0xce,
+	0x9d, 0xfe, 0xd2, 0x96, 0xad, 0x97, 0xf7, 0x04, 0x63, 0x11, 0x86, 0x82, 0xbb, 0x89, 0xf4, 0xe5,
+	0x20, 0x49, 0x67, 0xf7, 0x1f, 0x9e, 0x8d, 0x45, 0x10, 0xf8, 0x51, 0xd4, 0x8e, 0x7c, 0xdc, 0xa7,
+	0x32, 0xf5, 0xd8, 0x58, 0x24, 0xa1, 0x48, 0xdc, 0x8e, 0x9f, 0x50, 0xf7, 0xa2, 0xd6, 0xa1, 0xd2,
+	0xaf, 0xb9, 0x58, 0x30, 0x9e, 0xea, 0x4f, 0xb5, 0xde, 0xd6, 0x60, 0xba, 0xd0, 0x52, 0xf5, 0x57,
+	0x1e, 0xe0, 0x94, 0x1f, 0xd1, 0xd0, 0xe7, 0xa4, 0x75, 0x86, 0x9e, 0x01, 0x9c, 0x0f, 0x38, 0x49,
+	0xda, 0x3e, 0x21, 0xb1, 0x65, 0x54, 0x8c, 0xdd, 0xa2, 0x57, 0x54, 0x9d, 0x3a, 0x21, 0x31, 0xb2,
+	0x60, 0x35, 0x05, 0xb0, 0x4c, 0xa5, 0xfd, 0x29, 0xd1, 0x06, 0xac, 0x10, 0xca, 0x45, 0x68, 0x3d,
+	0x52, 0x7d, 0x5d, 0xa0, 0xf7, 0xb0, 0x16, 0xfa, 0xc3, 0xb3, 0x98, 0x61, 0x6a, 0xe5, 0xa7, 0x42,
+	0xe3, 0xd5, 0xf5, 0xdd, 0x4e, 0xee, 0xc7, 0xdd, 0xce, 0xa6, 0xa6, 0x48, 0x48, 0xdf, 0x61, 0xc2,
+	0x0d, 0x7d, 0xd9, 0x73, 0x9a, 0x5c, 0xde, 0x5e, 0xed, 0x41, 0x8a, 0xd7, 0xe4, 0xd2, 0x9b, 0x99,
+	0xd1, 0x29, 0x14, 0x42, 0xc6, 0xdf, 0x51, 0x6a, 0xad, 0xa8, 0x98, 0xc3, 0x34, 0x66, 0x7b, 0x31,
+	0xa6, 0x45, 0xbb, 0x3e, 0x1e, 0x1d, 0x51, 0x7c, 0x7b, 0xb5, 0x57, 0x4e, 0xc3, 0x66, 0x3d, 0x2f,
+	0x8d, 0x41, 0xc7, 0x00, 0x49, 0x44, 0x39, 0x69, 0xb1, 0x90, 0x49, 0xab, 0xb0, 0x3c, 0x5b, 0xc6,
+	0x8e, 0x5e, 0xc3, 0xba, 0x88, 0x09, 0x8d, 0x4f, 0x18, 0xaf, 0x77, 0x69, 0x23, 0x10, 0xb8, 0x9f,
+	0x58, 0xab, 0x15, 0x63, 0x37, 0xef, 0x2d, 0x0a, 0xe8, 0x23, 0x94, 0x2f, 0x19, 0x27, 0xe2, 0xf2,
+	0xc3, 0x1c, 0x60, 0x6d, 0x79, 0x80, 0x85, 0x10, 0x54, 0x85, 0xc7, 0xba, 0x97, 0x12, 0x14, 0x15,
+	0xc1, 0x5f, 0x3d, 0xf4, 0x06, 0x36, 0x75, 0xfd, 0x36, 0x12, 0xb8, 0xd7, 0x24, 0x94, 0x4b, 0x76,
+	0xce, 0x68, 0x6c, 0x81, 0x3a, 0xb7, 0x7f, 0x8b, 0xe8, 0x13, 0xac, 0xa7, 0x07, 0x9d, 0x61, 0x2e,
+	0x2d, 0xcf, 0xbc, 0x98, 0x52, 0xfd, 0x6a, 0x42, 0x79, 0xbe, 0x80, 0x1e, 0xc5, 0x22, 0x26, 0xe8,
+	0x09, 0x98, 0x8c, 0xa8, 0xf5, 0xcb, 0x7b, 0x26, 0x23, 0xa8, 0x0e, 0x2b, 0xd3, 0xcf, 0x2d, 0x2d,
+	0x73, 0xf9, 0xff, 0xd4, 0x4e, 0x74, 0x08, 0x66, 0x10, 0xa9, 0xed, 0x2c, 0xed, 0xbf, 0x70, 0x1e,
+	0xb8, 0xc3, 0x4e, 0x86, 0xc6, 0x0c, 0x22, 0x74, 0x02, 0xa5, 0xf9, 0x97, 0x96, 0xff, 0xb3, 0xc6,
+	0x59, 0x3f, 0xaa, 0xcc, 0xe2, 0xa4, 0x1f, 0x4b, 0xb5, 0xce, 0x79, 0x2f, 0xdb, 0x6a, 0x1c, 0x5f,
+	0x8f, 0x6d, 0xe3, 0x66, 0x6c, 0x1b, 0x3f, 0xc7, 0xb6, 0xf1, 0x6d, 0x62, 0xe7, 0x6e, 0x26, 0x76,
+	0xee, 0xfb, 0xc4, 0xce, 0x7d, 0xae, 0x75, 0x99, 0xec, 0x0d, 0x3a, 0x0e, 0x16, 0xa1, 0x7b, 0xcf,
+	0x33, 0x71, 0x71, 0xe0, 0x0e, 0xf5, 0x83, 0x25, 0x47, 0x11, 0x4d, 0x3a, 0x05, 0x75, 0xcd, 0x0f,
+	0x7e, 0x0f, 0x00, 0x63, 0xa9, 0x92, 0xd1, 0xdc, 0x04, 0x00, 0x00,
 }
 
 func (m *OnDemandLP) Marshal() (dAtA []byte, err error) {
@@ -224,6 +268,38 @@ func (m *OnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.RollappSpendLimit.Size()
+		i -= size
+		if _, err := m.RollappSpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.WindowEpochIdentifier) > 0 {
+		i -= len(m.WindowEpochIdentifier)
+		copy(dAtA[i:], m.WindowEpochIdentifier)
+		i = encodeVarintLp(dAtA, i, uint64(len(m.WindowEpochIdentifier)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.WindowSpendLimit.Size()
+		i -= size
+		if _, err := m.WindowSpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if m.OrderMinAgeBlocks != 0 {
 		i = encodeVarintLp(dAtA, i, uint64(m.OrderMinAgeBlocks))
 		i--
@@ -303,6 +379,21 @@ func (m *OnDemandLPRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WindowStart != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.WindowSpent.Size()
+		i -= size
+		if _, err := m.WindowSpent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.Lp != nil {
 		{
 			size, err := m.Lp.MarshalToSizedBuffer(dAtA[:i])
@@ -371,6 +462,17 @@ func (m *OnDemandLP) Size() (n int) {
 	if m.OrderMinAgeBlocks != 0 {
 		n += 1 + sovLp(uint64(m.OrderMinAgeBlocks))
 	}
+	l = m.WindowSpendLimit.Size()
+	n += 1 + l + sovLp(uint64(l))
+	if m.WindowBlocks != 0 {
+		n += 1 + sovLp(uint64(m.WindowBlocks))
+	}
+	l = len(m.WindowEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	l = m.RollappSpendLimit.Size()
+	n += 1 + l + sovLp(uint64(l))
 	return n
 }
 
@@ -389,6 +491,11 @@ func (m *OnDemandLPRecord) Size() (n int) {
 		l = m.Lp.Size()
 		n += 1 + l + sovLp(uint64(l))
 	}
+	l = m.WindowSpent.Size()
+	n += 1 + l + sovLp(uint64(l))
+	if m.WindowStart != 0 {
+		n += 1 + sovLp(uint64(m.WindowStart))
+	}
 	return n
 }
 
@@ -644,6 +751,125 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowSpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindowEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappSpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RollappSpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLp(dAtA[iNdEx:])
@@ -783,6 +1009,59 @@ func (m *OnDemandLPRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSpent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowSpent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLp(dAtA[iNdEx:])
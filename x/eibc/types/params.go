@@ -11,6 +11,8 @@ const (
 	defaultEpochIdentifier = "hour"
 	defaultTimeoutFee      = "0.0015"
 	defaultErrAckFee       = "0.0015"
+
+	defaultRevertedOrderRetentionBlocks = 201_600 // 2 weeks worth of blocks at 1 block per 6 seconds
 )
 
 // NewParams creates a new Params instance
@@ -24,7 +26,9 @@ func NewParams(epochIdentifier string, timeoutFee math.LegacyDec, errAckFee math
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(defaultEpochIdentifier, math.LegacyMustNewDecFromStr(defaultTimeoutFee), math.LegacyMustNewDecFromStr(defaultErrAckFee))
+	p := NewParams(defaultEpochIdentifier, math.LegacyMustNewDecFromStr(defaultTimeoutFee), math.LegacyMustNewDecFromStr(defaultErrAckFee))
+	p.RevertedOrderRetentionBlocks = defaultRevertedOrderRetentionBlocks
+	return p
 }
 
 // Validate validates the set of params
@@ -38,6 +42,9 @@ func (p Params) ValidateBasic() error {
 	if err := validateErrAckFee(p.ErrackFee); err != nil {
 		return fmt.Errorf("error acknowledgement fee: %w", err)
 	}
+	if err := validateRevertedOrderRetentionBlocks(p.RevertedOrderRetentionBlocks); err != nil {
+		return fmt.Errorf("reverted order retention blocks: %w", err)
+	}
 	return nil
 }
 
@@ -83,3 +90,10 @@ func validateErrAckFee(v math.LegacyDec) error {
 
 	return nil
 }
+
+func validateRevertedOrderRetentionBlocks(v uint64) error {
+	if v == 0 {
+		return fmt.Errorf("must be positive")
+	}
+	return nil
+}
@@ -30,6 +30,10 @@ type Params struct {
 	EpochIdentifier string                      `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
 	TimeoutFee      cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=timeout_fee,json=timeoutFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"timeout_fee" yaml:"timeout_fee"`
 	ErrackFee       cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=errack_fee,json=errackFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"errack_fee" yaml:"errack_fee"`
+	// reverted_order_retention_blocks is the number of hub blocks a reverted
+	// order is kept for. The packet must be re-submitted within this period for
+	// the fulfillment to be carried.
+	RevertedOrderRetentionBlocks uint64 `protobuf:"varint,4,opt,name=reverted_order_retention_blocks,json=revertedOrderRetentionBlocks,proto3" json:"reverted_order_retention_blocks,omitempty" yaml:"reverted_order_retention_blocks"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -71,6 +75,13 @@ func (m *Params) GetEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetRevertedOrderRetentionBlocks() uint64 {
+	if m != nil {
+		return m.RevertedOrderRetentionBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.eibc.Params")
 }
@@ -80,28 +91,32 @@ func init() {
 }
 
 var fileDescriptor_fa18b53f607a3f90 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x33, 0x93, 0x92,
-	0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x91,
-	0x55, 0xea, 0xc1, 0x39, 0x7a, 0x20, 0x95, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x75, 0xfa,
-	0x20, 0x16, 0x44, 0x8b, 0x94, 0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x44, 0x02, 0xc2,
-	0x81, 0x48, 0x29, 0x6d, 0x66, 0xe2, 0x62, 0x0b, 0x00, 0x1b, 0x2f, 0xe4, 0xc6, 0x25, 0x90, 0x5a,
-	0x90, 0x9f, 0x9c, 0x11, 0x9f, 0x99, 0x92, 0x9a, 0x57, 0x92, 0x99, 0x96, 0x99, 0x5a, 0x24, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xfd, 0xe9, 0x9e, 0xbc, 0x78, 0x65, 0x62, 0x6e, 0x8e, 0x95,
-	0x12, 0xba, 0x0a, 0xa5, 0x20, 0x7e, 0xb0, 0x90, 0x27, 0x5c, 0x44, 0x28, 0x8f, 0x8b, 0xbb, 0x24,
-	0x33, 0x37, 0x35, 0xbf, 0xb4, 0x24, 0x3e, 0x2d, 0x35, 0x55, 0x82, 0x09, 0x6c, 0x84, 0xef, 0x89,
-	0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0x4b, 0x43, 0x6c, 0x2f, 0x4e, 0xc9, 0xd6, 0xcb, 0xcc, 0xd7,
-	0xcf, 0x4d, 0x2c, 0xc9, 0xd0, 0xf3, 0x49, 0x4d, 0x4f, 0x4c, 0xae, 0x74, 0x49, 0x4d, 0xfe, 0x74,
-	0x4f, 0x5e, 0x08, 0x62, 0x0b, 0x92, 0x7e, 0xa5, 0x4b, 0x5b, 0x74, 0x05, 0xa0, 0x4e, 0x86, 0xab,
-	0x0c, 0xe2, 0x82, 0xaa, 0x70, 0x4b, 0x4d, 0x15, 0xca, 0xe6, 0xe2, 0x4a, 0x2d, 0x2a, 0x4a, 0x4c,
-	0xce, 0x06, 0x5b, 0xc7, 0x0c, 0xb6, 0xce, 0x87, 0x38, 0xeb, 0x04, 0xa1, 0x9e, 0x82, 0x6b, 0xc7,
-	0x6e, 0x1b, 0x27, 0x44, 0x81, 0x5b, 0x6a, 0xaa, 0x15, 0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0xde,
-	0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
-	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x98, 0x9e, 0x59, 0x92, 0x51,
-	0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0x23, 0x4a, 0xcb, 0x8c, 0xf5, 0x2b, 0x20, 0xf1, 0x5a,
-	0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e, 0x09, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xd1, 0xfa, 0x51, 0xb8, 0x03, 0x02, 0x00, 0x00,
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x8b, 0xda, 0x40,
+	0x14, 0xc7, 0x93, 0x2a, 0x82, 0xd3, 0x43, 0x6d, 0x28, 0xd4, 0x6a, 0x49, 0x24, 0x87, 0x22, 0x85,
+	0x26, 0x14, 0x6f, 0x1e, 0x43, 0x11, 0x4a, 0x2d, 0x2d, 0x39, 0xf6, 0x12, 0x92, 0xc9, 0x33, 0x0e,
+	0x31, 0x99, 0x74, 0x32, 0x8a, 0xe9, 0xa7, 0xe8, 0xb1, 0xc7, 0x9e, 0xf6, 0x13, 0xec, 0x87, 0xf0,
+	0x28, 0x7b, 0x5a, 0xf6, 0x10, 0x16, 0xfd, 0x06, 0x7e, 0x82, 0x25, 0x33, 0x1a, 0x64, 0xd9, 0x65,
+	0xf7, 0x96, 0xf7, 0xfe, 0xbf, 0xff, 0xfb, 0xe7, 0x31, 0x0f, 0x0d, 0xc3, 0x22, 0x81, 0x34, 0x27,
+	0x34, 0x5d, 0x17, 0x7f, 0xec, 0xba, 0xb0, 0x81, 0x04, 0xd8, 0xce, 0x7c, 0xe6, 0x27, 0xb9, 0x95,
+	0x31, 0xca, 0xa9, 0xd6, 0x3f, 0x27, 0xad, 0xba, 0xb0, 0x2a, 0xb2, 0xf7, 0x26, 0xa2, 0x11, 0x15,
+	0x9c, 0x5d, 0x7d, 0x49, 0x4b, 0xef, 0x1d, 0xa6, 0x79, 0x42, 0x73, 0x4f, 0x0a, 0xb2, 0x90, 0x92,
+	0x79, 0xd1, 0x40, 0xad, 0x9f, 0x62, 0xbc, 0x36, 0x41, 0x1d, 0xc8, 0x28, 0x9e, 0x7b, 0x24, 0x84,
+	0x94, 0x93, 0x19, 0x01, 0xd6, 0x55, 0x07, 0xea, 0xb0, 0xed, 0xf4, 0x0f, 0xa5, 0xf1, 0xb6, 0xf0,
+	0x93, 0xc5, 0xd8, 0xbc, 0x4f, 0x98, 0xee, 0x2b, 0xd1, 0xfa, 0x5a, 0x77, 0xb4, 0x14, 0xbd, 0xe4,
+	0x24, 0x01, 0xba, 0xe4, 0xde, 0x0c, 0xa0, 0xfb, 0x42, 0x8c, 0xf8, 0xbe, 0x29, 0x0d, 0xe5, 0xa6,
+	0x34, 0xfa, 0x32, 0x3d, 0x0f, 0x63, 0x8b, 0x50, 0x3b, 0xf1, 0xf9, 0xdc, 0x9a, 0x42, 0xe4, 0xe3,
+	0xe2, 0x0b, 0xe0, 0x43, 0x69, 0x68, 0x32, 0xe5, 0xcc, 0x6f, 0x5e, 0x5d, 0x7e, 0xea, 0x1c, 0x7f,
+	0xb9, 0x26, 0x5d, 0x74, 0x24, 0x26, 0x00, 0x5a, 0x8c, 0x10, 0x30, 0xe6, 0xe3, 0x58, 0xc4, 0x35,
+	0x44, 0xdc, 0xf4, 0x79, 0x71, 0xaf, 0x8f, 0x4b, 0xd5, 0xf6, 0x87, 0xd3, 0xda, 0x12, 0xa8, 0xc2,
+	0x7e, 0x23, 0x83, 0xc1, 0x0a, 0x18, 0x87, 0xd0, 0xa3, 0x2c, 0x04, 0xe6, 0x31, 0xe0, 0xd5, 0xea,
+	0x34, 0xf5, 0x82, 0x05, 0xc5, 0x71, 0xde, 0x6d, 0x0e, 0xd4, 0x61, 0xd3, 0xf9, 0x78, 0x28, 0x8d,
+	0x0f, 0x72, 0xfc, 0x13, 0x06, 0xd3, 0x7d, 0x7f, 0x22, 0x7e, 0x54, 0x80, 0x7b, 0xd2, 0x1d, 0x21,
+	0x8f, 0x9b, 0xff, 0xfe, 0x1b, 0x8a, 0xf3, 0x6d, 0xb3, 0xd3, 0xd5, 0xed, 0x4e, 0x57, 0x6f, 0x77,
+	0xba, 0xfa, 0x77, 0xaf, 0x2b, 0xdb, 0xbd, 0xae, 0x5c, 0xef, 0x75, 0xe5, 0xd7, 0xe7, 0x88, 0xf0,
+	0xf9, 0x32, 0xb0, 0x30, 0x4d, 0xec, 0x47, 0xae, 0x68, 0x35, 0xb2, 0xd7, 0xf2, 0x94, 0x78, 0x91,
+	0x41, 0x1e, 0xb4, 0xc4, 0xe3, 0x8f, 0xee, 0x06, 0x00, 0x57, 0xdc, 0x2d, 0xa3, 0x76, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,6 +139,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RevertedOrderRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RevertedOrderRetentionBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.ErrackFee.Size()
 		i -= size
@@ -179,6 +199,9 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.ErrackFee.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.RevertedOrderRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.RevertedOrderRetentionBlocks))
+	}
 	return n
 }
 
@@ -317,6 +340,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertedOrderRetentionBlocks", wireType)
+			}
+			m.RevertedOrderRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevertedOrderRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
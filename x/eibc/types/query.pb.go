@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -312,6 +314,12 @@ func (m *QueryDemandOrdersByStatusRequest) GetPagination() *query.PageRequest {
 type QueryGetDemandOrderResponse struct {
 	// demand order with the given id
 	DemandOrder *DemandOrder `protobuf:"bytes,1,opt,name=demand_order,json=demandOrder,proto3" json:"demand_order,omitempty"`
+	// effective_fee is the fee currently offered by the order, taking the fee
+	// decay into account.
+	EffectiveFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=effective_fee,json=effectiveFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"effective_fee"`
+	// effective_price is the price currently asked from a fulfiller, taking the
+	// fee decay into account.
+	EffectivePrice github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=effective_price,json=effectivePrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"effective_price"`
 }
 
 func (m *QueryGetDemandOrderResponse) Reset()         { *m = QueryGetDemandOrderResponse{} }
@@ -354,6 +362,20 @@ func (m *QueryGetDemandOrderResponse) GetDemandOrder() *DemandOrder {
 	return nil
 }
 
+func (m *QueryGetDemandOrderResponse) GetEffectiveFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EffectiveFee
+	}
+	return nil
+}
+
+func (m *QueryGetDemandOrderResponse) GetEffectivePrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EffectivePrice
+	}
+	return nil
+}
+
 // QueryDemandOrdersByStatusResponse is the response type for the
 // Query/GetDemandOrdersByStatus RPC method.
 type QueryDemandOrdersByStatusResponse struct {
@@ -409,22 +431,39 @@ func (m *QueryDemandOrdersByStatusResponse) GetPagination() *query.PageResponse
 	return nil
 }
 
-type QueryOnDemandLPsRequest struct {
-	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+// QueryDemandOrdersRequest is the request type for the Query/DemandOrders RPC
+// method.
+type QueryDemandOrdersRequest struct {
+	// optional rollapp_id
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// optional denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// optional min fee, as a percentage of the price expressed in [0,1], taking
+	// the fee decay into account
+	MinFeePercent string `protobuf:"bytes,3,opt,name=min_fee_percent,json=minFeePercent,proto3" json:"min_fee_percent,omitempty"`
+	// optional type, UNDEFINED matches any type
+	Type types.RollappPacket_Type `protobuf:"varint,4,opt,name=type,proto3,enum=dymensionxyz.dymension.common.RollappPacket_Type" json:"type,omitempty"`
+	// optional recipient address
+	Recipient string `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// optional fulfiller address, including partial fulfillers
+	Fulfiller string `protobuf:"bytes,6,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// optional fulfillment state
+	FulfillmentState FulfillmentState   `protobuf:"varint,7,opt,name=fulfillment_state,json=fulfillmentState,proto3,enum=dymensionxyz.dymension.eibc.FulfillmentState" json:"fulfillment_state,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOnDemandLPsRequest) Reset()         { *m = QueryOnDemandLPsRequest{} }
-func (m *QueryOnDemandLPsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryOnDemandLPsRequest) ProtoMessage()    {}
-func (*QueryOnDemandLPsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryDemandOrdersRequest) Reset()         { *m = QueryDemandOrdersRequest{} }
+func (m *QueryDemandOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDemandOrdersRequest) ProtoMessage()    {}
+func (*QueryDemandOrdersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d85bfe71ceb5f8dc, []int{6}
 }
-func (m *QueryOnDemandLPsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDemandOrdersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOnDemandLPsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDemandOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOnDemandLPsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDemandOrdersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -434,41 +473,93 @@ func (m *QueryOnDemandLPsRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryOnDemandLPsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOnDemandLPsRequest.Merge(m, src)
+func (m *QueryDemandOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDemandOrdersRequest.Merge(m, src)
 }
-func (m *QueryOnDemandLPsRequest) XXX_Size() int {
+func (m *QueryDemandOrdersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOnDemandLPsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOnDemandLPsRequest.DiscardUnknown(m)
+func (m *QueryDemandOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDemandOrdersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOnDemandLPsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDemandOrdersRequest proto.InternalMessageInfo
 
-func (m *QueryOnDemandLPsRequest) GetIds() []uint64 {
+func (m *QueryDemandOrdersRequest) GetRollappId() string {
 	if m != nil {
-		return m.Ids
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *QueryDemandOrdersRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryDemandOrdersRequest) GetMinFeePercent() string {
+	if m != nil {
+		return m.MinFeePercent
+	}
+	return ""
+}
+
+func (m *QueryDemandOrdersRequest) GetType() types.RollappPacket_Type {
+	if m != nil {
+		return m.Type
+	}
+	return types.RollappPacket_ON_RECV
+}
+
+func (m *QueryDemandOrdersRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *QueryDemandOrdersRequest) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
+	}
+	return ""
+}
+
+func (m *QueryDemandOrdersRequest) GetFulfillmentState() FulfillmentState {
+	if m != nil {
+		return m.FulfillmentState
+	}
+	return FulfillmentState_UNDEFINED
+}
+
+func (m *QueryDemandOrdersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
 	return nil
 }
 
-type QueryOnDemandLPsResponse struct {
-	Lps []*OnDemandLPRecord `protobuf:"bytes,1,rep,name=lps,proto3" json:"lps,omitempty"`
+// QueryDemandOrdersResponse is the response type for the Query/DemandOrders RPC
+// method.
+type QueryDemandOrdersResponse struct {
+	DemandOrders []*DemandOrder      `protobuf:"bytes,1,rep,name=demand_orders,json=demandOrders,proto3" json:"demand_orders,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOnDemandLPsResponse) Reset()         { *m = QueryOnDemandLPsResponse{} }
-func (m *QueryOnDemandLPsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryOnDemandLPsResponse) ProtoMessage()    {}
-func (*QueryOnDemandLPsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDemandOrdersResponse) Reset()         { *m = QueryDemandOrdersResponse{} }
+func (m *QueryDemandOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDemandOrdersResponse) ProtoMessage()    {}
+func (*QueryDemandOrdersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d85bfe71ceb5f8dc, []int{7}
 }
-func (m *QueryOnDemandLPsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDemandOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOnDemandLPsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDemandOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOnDemandLPsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDemandOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -478,41 +569,52 @@ func (m *QueryOnDemandLPsResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryOnDemandLPsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOnDemandLPsResponse.Merge(m, src)
+func (m *QueryDemandOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDemandOrdersResponse.Merge(m, src)
 }
-func (m *QueryOnDemandLPsResponse) XXX_Size() int {
+func (m *QueryDemandOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOnDemandLPsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOnDemandLPsResponse.DiscardUnknown(m)
+func (m *QueryDemandOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDemandOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOnDemandLPsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDemandOrdersResponse proto.InternalMessageInfo
 
-func (m *QueryOnDemandLPsResponse) GetLps() []*OnDemandLPRecord {
+func (m *QueryDemandOrdersResponse) GetDemandOrders() []*DemandOrder {
 	if m != nil {
-		return m.Lps
+		return m.DemandOrders
 	}
 	return nil
 }
 
-type QueryOnDemandLPsByAddrRequest struct {
-	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+func (m *QueryDemandOrdersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryOnDemandLPsByAddrRequest) Reset()         { *m = QueryOnDemandLPsByAddrRequest{} }
-func (m *QueryOnDemandLPsByAddrRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryOnDemandLPsByAddrRequest) ProtoMessage()    {}
-func (*QueryOnDemandLPsByAddrRequest) Descriptor() ([]byte, []int) {
+// QueryRevertedDemandOrdersRequest is the request type for the
+// Query/RevertedDemandOrders RPC method.
+type QueryRevertedDemandOrdersRequest struct {
+	// optional rollapp_id
+	RollappId  string             `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRevertedDemandOrdersRequest) Reset()         { *m = QueryRevertedDemandOrdersRequest{} }
+func (m *QueryRevertedDemandOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRevertedDemandOrdersRequest) ProtoMessage()    {}
+func (*QueryRevertedDemandOrdersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d85bfe71ceb5f8dc, []int{8}
 }
-func (m *QueryOnDemandLPsByAddrRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRevertedDemandOrdersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOnDemandLPsByAddrRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRevertedDemandOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOnDemandLPsByAddrRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRevertedDemandOrdersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -522,41 +624,51 @@ func (m *QueryOnDemandLPsByAddrRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryOnDemandLPsByAddrRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOnDemandLPsByAddrRequest.Merge(m, src)
+func (m *QueryRevertedDemandOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevertedDemandOrdersRequest.Merge(m, src)
 }
-func (m *QueryOnDemandLPsByAddrRequest) XXX_Size() int {
+func (m *QueryRevertedDemandOrdersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOnDemandLPsByAddrRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOnDemandLPsByAddrRequest.DiscardUnknown(m)
+func (m *QueryRevertedDemandOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevertedDemandOrdersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOnDemandLPsByAddrRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRevertedDemandOrdersRequest proto.InternalMessageInfo
 
-func (m *QueryOnDemandLPsByAddrRequest) GetAddr() string {
+func (m *QueryRevertedDemandOrdersRequest) GetRollappId() string {
 	if m != nil {
-		return m.Addr
+		return m.RollappId
 	}
 	return ""
 }
 
-type QueryOnDemandLPsByAddrResponse struct {
-	Lps []*OnDemandLPRecord `protobuf:"bytes,1,rep,name=lps,proto3" json:"lps,omitempty"`
+func (m *QueryRevertedDemandOrdersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryOnDemandLPsByAddrResponse) Reset()         { *m = QueryOnDemandLPsByAddrResponse{} }
-func (m *QueryOnDemandLPsByAddrResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryOnDemandLPsByAddrResponse) ProtoMessage()    {}
-func (*QueryOnDemandLPsByAddrResponse) Descriptor() ([]byte, []int) {
+// QueryRevertedDemandOrdersResponse is the response type for the
+// Query/RevertedDemandOrders RPC method.
+type QueryRevertedDemandOrdersResponse struct {
+	DemandOrders []*DemandOrder      `protobuf:"bytes,1,rep,name=demand_orders,json=demandOrders,proto3" json:"demand_orders,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRevertedDemandOrdersResponse) Reset()         { *m = QueryRevertedDemandOrdersResponse{} }
+func (m *QueryRevertedDemandOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRevertedDemandOrdersResponse) ProtoMessage()    {}
+func (*QueryRevertedDemandOrdersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d85bfe71ceb5f8dc, []int{9}
 }
-func (m *QueryOnDemandLPsByAddrResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRevertedDemandOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOnDemandLPsByAddrResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRevertedDemandOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOnDemandLPsByAddrResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRevertedDemandOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -566,916 +678,3053 @@ func (m *QueryOnDemandLPsByAddrResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryOnDemandLPsByAddrResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOnDemandLPsByAddrResponse.Merge(m, src)
+func (m *QueryRevertedDemandOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevertedDemandOrdersResponse.Merge(m, src)
 }
-func (m *QueryOnDemandLPsByAddrResponse) XXX_Size() int {
+func (m *QueryRevertedDemandOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOnDemandLPsByAddrResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOnDemandLPsByAddrResponse.DiscardUnknown(m)
+func (m *QueryRevertedDemandOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevertedDemandOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOnDemandLPsByAddrResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRevertedDemandOrdersResponse proto.InternalMessageInfo
 
-func (m *QueryOnDemandLPsByAddrResponse) GetLps() []*OnDemandLPRecord {
+func (m *QueryRevertedDemandOrdersResponse) GetDemandOrders() []*DemandOrder {
 	if m != nil {
-		return m.Lps
+		return m.DemandOrders
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("dymensionxyz.dymension.eibc.FulfillmentState", FulfillmentState_name, FulfillmentState_value)
-	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.eibc.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.eibc.QueryParamsResponse")
-	proto.RegisterType((*QueryGetDemandOrderRequest)(nil), "dymensionxyz.dymension.eibc.QueryGetDemandOrderRequest")
-	proto.RegisterType((*QueryDemandOrdersByStatusRequest)(nil), "dymensionxyz.dymension.eibc.QueryDemandOrdersByStatusRequest")
-	proto.RegisterType((*QueryGetDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.QueryGetDemandOrderResponse")
-	proto.RegisterType((*QueryDemandOrdersByStatusResponse)(nil), "dymensionxyz.dymension.eibc.QueryDemandOrdersByStatusResponse")
-	proto.RegisterType((*QueryOnDemandLPsRequest)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsRequest")
-	proto.RegisterType((*QueryOnDemandLPsResponse)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsResponse")
-	proto.RegisterType((*QueryOnDemandLPsByAddrRequest)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsByAddrRequest")
-	proto.RegisterType((*QueryOnDemandLPsByAddrResponse)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsByAddrResponse")
+func (m *QueryRevertedDemandOrdersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/eibc/query.proto", fileDescriptor_d85bfe71ceb5f8dc)
+// QueryClaimListingsRequest is the request type for the Query/ClaimListings
+// RPC method.
+type QueryClaimListingsRequest struct {
+	// optional order_id
+	OrderId    string             `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-var fileDescriptor_d85bfe71ceb5f8dc = []byte{
-	// 928 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x6f, 0x1b, 0x45,
-	0x14, 0xf5, 0xda, 0x8e, 0x8b, 0x6f, 0xfa, 0x61, 0xa6, 0x91, 0x58, 0xb9, 0xad, 0x09, 0x5b, 0x4a,
-	0xa3, 0x16, 0x76, 0x12, 0x87, 0x96, 0x88, 0xaa, 0x45, 0xb1, 0x12, 0x57, 0x51, 0x43, 0x1a, 0x16,
-	0x22, 0xa1, 0xf2, 0x60, 0xad, 0x3d, 0x13, 0x33, 0xb0, 0xbb, 0xb3, 0xdd, 0x5d, 0x57, 0x35, 0x51,
-	0x5e, 0xf8, 0x05, 0x48, 0xbc, 0xf0, 0x43, 0x78, 0x03, 0xf1, 0xdc, 0x27, 0x54, 0xd1, 0x17, 0x9e,
-	0x10, 0x4a, 0xf8, 0x21, 0x68, 0x3e, 0x6c, 0xaf, 0xf3, 0xb1, 0x8e, 0xab, 0xbe, 0x44, 0x3b, 0xd7,
-	0xe7, 0xcc, 0x3d, 0xe7, 0xce, 0xbd, 0x33, 0x81, 0x9b, 0xa4, 0xef, 0xd3, 0x20, 0x66, 0x3c, 0x78,
-	0xde, 0xff, 0x01, 0x0f, 0x17, 0x98, 0xb2, 0x76, 0x07, 0x3f, 0xed, 0xd1, 0xa8, 0x6f, 0x87, 0x11,
-	0x4f, 0x38, 0xba, 0x92, 0x06, 0xda, 0xc3, 0x85, 0x2d, 0x80, 0xd5, 0xb9, 0x2e, 0xef, 0x72, 0x89,
-	0xc3, 0xe2, 0x4b, 0x51, 0xaa, 0x57, 0xbb, 0x9c, 0x77, 0x3d, 0x8a, 0xdd, 0x90, 0x61, 0x37, 0x08,
-	0x78, 0xe2, 0x26, 0x8c, 0x07, 0xb1, 0xfe, 0xf5, 0x56, 0x87, 0xc7, 0x3e, 0x8f, 0x71, 0xdb, 0x8d,
-	0xa9, 0xca, 0x84, 0x9f, 0x2d, 0xb5, 0x69, 0xe2, 0x2e, 0xe1, 0xd0, 0xed, 0xb2, 0x40, 0x82, 0x35,
-	0x76, 0x21, 0x4b, 0x65, 0xe8, 0x46, 0xae, 0x3f, 0xdc, 0xf5, 0x14, 0x64, 0x87, 0xfb, 0x3e, 0x0f,
-	0x70, 0x9c, 0xb8, 0x49, 0x6f, 0x80, 0xad, 0x67, 0x63, 0x23, 0xee, 0x79, 0x6e, 0x18, 0xb6, 0x42,
-	0xb7, 0xf3, 0x3d, 0x4d, 0x34, 0xc7, 0xce, 0x52, 0x42, 0xa8, 0xef, 0x06, 0xa4, 0xc5, 0x23, 0x42,
-	0x23, 0x8d, 0x7f, 0x3f, 0x0b, 0xef, 0x85, 0x0a, 0x65, 0xcd, 0x01, 0xfa, 0x42, 0x54, 0x60, 0x5b,
-	0x5a, 0x71, 0xe8, 0xd3, 0x1e, 0x8d, 0x13, 0xeb, 0x6b, 0xb8, 0x3c, 0x16, 0x8d, 0x43, 0x1e, 0xc4,
-	0x14, 0xad, 0x42, 0x49, 0x59, 0x36, 0x8d, 0x79, 0x63, 0x61, 0xb6, 0x7e, 0xdd, 0xce, 0x38, 0x1a,
-	0x5b, 0x91, 0x1b, 0xc5, 0x17, 0xff, 0xbc, 0x9b, 0x73, 0x34, 0xd1, 0xfa, 0x10, 0xaa, 0x72, 0xe7,
-	0x87, 0x34, 0x59, 0x93, 0x9a, 0x1f, 0x0b, 0xc9, 0x3a, 0x2f, 0xba, 0x08, 0x79, 0x46, 0xe4, 0xe6,
-	0x65, 0x27, 0xcf, 0x88, 0xf5, 0xaa, 0x00, 0xf3, 0x12, 0x9e, 0xc2, 0xc6, 0x8d, 0xfe, 0x97, 0xb2,
-	0x96, 0x03, 0xd2, 0x7d, 0x28, 0xa9, 0xe2, 0x4a, 0xe2, 0xc5, 0xfa, 0x8d, 0xd3, 0x54, 0xa9, 0xea,
-	0xda, 0x9a, 0xad, 0x49, 0x68, 0x1d, 0x8a, 0x49, 0x3f, 0xa4, 0x66, 0x5e, 0x92, 0x97, 0x26, 0x90,
-	0x1d, 0x75, 0x34, 0xdb, 0xea, 0x64, 0xbe, 0xea, 0x87, 0xd4, 0x91, 0x74, 0x74, 0x0d, 0x60, 0x70,
-	0x6c, 0x8c, 0x98, 0x05, 0x69, 0xa1, 0xac, 0x23, 0x1b, 0x04, 0xcd, 0xc1, 0x8c, 0xc7, 0x7c, 0x96,
-	0x98, 0xc5, 0x79, 0x63, 0x61, 0xc6, 0x51, 0x0b, 0xf4, 0x04, 0xde, 0xde, 0xed, 0x79, 0xbb, 0xcc,
-	0xf3, 0x7c, 0x1a, 0x24, 0x2d, 0xa1, 0x88, 0x9a, 0x33, 0x52, 0xc8, 0x47, 0x99, 0xb5, 0x6d, 0x8e,
-	0x58, 0xc2, 0x0e, 0x75, 0x2a, 0xbb, 0x47, 0x22, 0xe8, 0x2a, 0x94, 0x75, 0x8c, 0x46, 0x66, 0x49,
-	0xe9, 0x19, 0x06, 0x84, 0x1e, 0x42, 0x03, 0xee, 0x9b, 0xe7, 0xe4, 0x2f, 0x6a, 0x21, 0x38, 0x11,
-	0xed, 0xb0, 0x90, 0xd1, 0x20, 0x31, 0xdf, 0xd2, 0x1e, 0x06, 0x01, 0xd4, 0x04, 0x18, 0xcd, 0x87,
-	0x59, 0x96, 0x2d, 0xf0, 0x81, 0xad, 0x86, 0xc9, 0x16, 0xc3, 0x64, 0xab, 0xb1, 0xd5, 0xc3, 0x64,
-	0x6f, 0xbb, 0x5d, 0xaa, 0x0f, 0xc9, 0x49, 0x31, 0xad, 0xef, 0xe0, 0xca, 0x89, 0x3d, 0xa0, 0xbb,
-	0xec, 0x11, 0x9c, 0x4f, 0xb7, 0xb3, 0xee, 0xb5, 0x85, 0xcc, 0x7a, 0xa4, 0xf7, 0x99, 0x25, 0xa3,
-	0x85, 0xf5, 0x9b, 0x01, 0xef, 0x65, 0x74, 0x90, 0x4e, 0xf9, 0x39, 0x5c, 0x48, 0xa7, 0x14, 0x9d,
-	0x54, 0x98, 0x2a, 0xe7, 0xf9, 0x54, 0xce, 0x18, 0x3d, 0x1c, 0x2b, 0x54, 0x5e, 0xea, 0xbf, 0x39,
-	0xb1, 0x50, 0x4a, 0xcb, 0x58, 0xa5, 0x6e, 0xc3, 0x3b, 0x52, 0xfc, 0xe3, 0x40, 0x25, 0xdb, 0xdc,
-	0x1e, 0x76, 0x7d, 0x05, 0x0a, 0x8c, 0x28, 0xa1, 0x45, 0x47, 0x7c, 0x5a, 0xdf, 0x80, 0x79, 0x1c,
-	0xac, 0x0d, 0x7e, 0x06, 0x05, 0x2f, 0x1c, 0xd8, 0xca, 0x6e, 0xad, 0x11, 0xdd, 0xa1, 0x1d, 0x1e,
-	0x11, 0x47, 0x30, 0xad, 0x65, 0xb8, 0x76, 0x74, 0xf3, 0x46, 0x7f, 0x95, 0x90, 0xe1, 0xe8, 0x22,
-	0x28, 0xba, 0x84, 0x44, 0x7a, 0x78, 0xe5, 0xb7, 0xe5, 0x42, 0xed, 0x34, 0xd2, 0x1b, 0xd2, 0x75,
-	0x6b, 0x15, 0x2a, 0x47, 0x67, 0x01, 0x5d, 0x80, 0xf2, 0xce, 0xd6, 0xda, 0x7a, 0x73, 0x63, 0x6b,
-	0x7d, 0xad, 0x92, 0x13, 0xcb, 0xe6, 0xce, 0x66, 0x73, 0x63, 0x73, 0x73, 0x7d, 0xad, 0x62, 0xa0,
-	0x4b, 0x30, 0xbb, 0xb3, 0x35, 0x0a, 0xe4, 0xeb, 0x7f, 0x9c, 0x83, 0x19, 0x29, 0x13, 0xfd, 0x62,
-	0x40, 0x49, 0xdd, 0x5a, 0x08, 0x67, 0x6a, 0x39, 0x7e, 0x65, 0x56, 0x17, 0xcf, 0x4e, 0x50, 0xde,
-	0xad, 0xdb, 0x3f, 0xbe, 0xfa, 0xef, 0xe7, 0xfc, 0x0d, 0x74, 0x1d, 0x4f, 0x7e, 0x63, 0xd0, 0xef,
-	0x06, 0x5c, 0x4a, 0x35, 0x5c, 0xa3, 0xbf, 0x41, 0xd0, 0x27, 0x93, 0x53, 0x9e, 0x78, 0xcd, 0x56,
-	0x57, 0xa6, 0x27, 0x6a, 0xcd, 0x77, 0xa5, 0xe6, 0x45, 0x64, 0xe3, 0xb3, 0xbe, 0x46, 0x78, 0x8f,
-	0x91, 0x7d, 0xf4, 0x97, 0x01, 0x73, 0x27, 0x4d, 0x20, 0xba, 0x3f, 0x59, 0x4a, 0xc6, 0xdd, 0x5f,
-	0x7d, 0xf0, 0xba, 0x74, 0xed, 0xe7, 0x9e, 0xf4, 0x73, 0x07, 0x2d, 0x9f, 0xd9, 0x4f, 0x8c, 0xf7,
-	0xd4, 0xc3, 0xb1, 0x8f, 0x7e, 0x35, 0x60, 0x36, 0xd5, 0xda, 0xe8, 0xe3, 0xc9, 0x62, 0x8e, 0x0f,
-	0x72, 0xf5, 0xce, 0x94, 0x2c, 0xad, 0x7c, 0x45, 0x2a, 0xaf, 0xa3, 0xc5, 0x4c, 0xe5, 0x3c, 0x68,
-	0x69, 0xf1, 0x5e, 0x18, 0x8b, 0xa3, 0x88, 0xf7, 0xd1, 0x9f, 0x06, 0x5c, 0x1e, 0x9b, 0x48, 0x35,
-	0x93, 0xe8, 0xd3, 0xa9, 0x84, 0x8c, 0x4d, 0x7f, 0xf5, 0xde, 0x6b, 0x71, 0xb5, 0x95, 0x07, 0xd2,
-	0xca, 0x0a, 0xba, 0x7b, 0x76, 0x2b, 0x2d, 0x71, 0xbf, 0xe0, 0x3d, 0xf1, 0x77, 0xbf, 0xf1, 0xe8,
-	0xc5, 0x41, 0xcd, 0x78, 0x79, 0x50, 0x33, 0xfe, 0x3d, 0xa8, 0x19, 0x3f, 0x1d, 0xd6, 0x72, 0x2f,
-	0x0f, 0x6b, 0xb9, 0xbf, 0x0f, 0x6b, 0xb9, 0x27, 0x4b, 0x5d, 0x96, 0x7c, 0xdb, 0x6b, 0x8b, 0xc7,
-	0xfb, 0xb4, 0xbd, 0x9f, 0x2d, 0xe3, 0xe7, 0x2a, 0x81, 0x78, 0xc6, 0xe3, 0x76, 0x49, 0xfe, 0x5f,
-	0xb4, 0xfc, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x9e, 0x50, 0x94, 0x9f, 0x0a, 0x00, 0x00,
+func (m *QueryClaimListingsRequest) Reset()         { *m = QueryClaimListingsRequest{} }
+func (m *QueryClaimListingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimListingsRequest) ProtoMessage()    {}
+func (*QueryClaimListingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{10}
+}
+func (m *QueryClaimListingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimListingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimListingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimListingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimListingsRequest.Merge(m, src)
+}
+func (m *QueryClaimListingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimListingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimListingsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryClaimListingsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a Demand Order by id.
-	DemandOrderById(ctx context.Context, in *QueryGetDemandOrderRequest, opts ...grpc.CallOption) (*QueryGetDemandOrderResponse, error)
-	// Queries a list of demand orders by status.
-	DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error)
-	OnDemandLPs(ctx context.Context, in *QueryOnDemandLPsRequest, opts ...grpc.CallOption) (*QueryOnDemandLPsResponse, error)
-	OnDemandLPsByByAddr(ctx context.Context, in *QueryOnDemandLPsByAddrRequest, opts ...grpc.CallOption) (*QueryOnDemandLPsByAddrResponse, error)
+func (m *QueryClaimListingsRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryClaimListingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryClaimListingsResponse is the response type for the Query/ClaimListings
+// RPC method.
+type QueryClaimListingsResponse struct {
+	Listings   []ClaimListing      `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryClaimListingsResponse) Reset()         { *m = QueryClaimListingsResponse{} }
+func (m *QueryClaimListingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimListingsResponse) ProtoMessage()    {}
+func (*QueryClaimListingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{11}
+}
+func (m *QueryClaimListingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimListingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimListingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryClaimListingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimListingsResponse.Merge(m, src)
+}
+func (m *QueryClaimListingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimListingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimListingsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DemandOrderById(ctx context.Context, in *QueryGetDemandOrderRequest, opts ...grpc.CallOption) (*QueryGetDemandOrderResponse, error) {
-	out := new(QueryGetDemandOrderResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/DemandOrderById", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClaimListingsResponse proto.InternalMessageInfo
+
+func (m *QueryClaimListingsResponse) GetListings() []ClaimListing {
+	if m != nil {
+		return m.Listings
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error) {
-	out := new(QueryDemandOrdersByStatusResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/DemandOrdersByStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryClaimListingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OnDemandLPs(ctx context.Context, in *QueryOnDemandLPsRequest, opts ...grpc.CallOption) (*QueryOnDemandLPsResponse, error) {
-	out := new(QueryOnDemandLPsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/OnDemandLPs", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryFulfillerStatsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (c *queryClient) OnDemandLPsByByAddr(ctx context.Context, in *QueryOnDemandLPsByAddrRequest, opts ...grpc.CallOption) (*QueryOnDemandLPsByAddrResponse, error) {
-	out := new(QueryOnDemandLPsByAddrResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/OnDemandLPsByByAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFulfillerStatsRequest) Reset()         { *m = QueryFulfillerStatsRequest{} }
+func (m *QueryFulfillerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFulfillerStatsRequest) ProtoMessage()    {}
+func (*QueryFulfillerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{12}
+}
+func (m *QueryFulfillerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFulfillerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFulfillerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFulfillerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFulfillerStatsRequest.Merge(m, src)
+}
+func (m *QueryFulfillerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFulfillerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFulfillerStatsRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a Demand Order by id.
-	DemandOrderById(context.Context, *QueryGetDemandOrderRequest) (*QueryGetDemandOrderResponse, error)
-	// Queries a list of demand orders by status.
-	DemandOrdersByStatus(context.Context, *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error)
-	OnDemandLPs(context.Context, *QueryOnDemandLPsRequest) (*QueryOnDemandLPsResponse, error)
-	OnDemandLPsByByAddr(context.Context, *QueryOnDemandLPsByAddrRequest) (*QueryOnDemandLPsByAddrResponse, error)
+var xxx_messageInfo_QueryFulfillerStatsRequest proto.InternalMessageInfo
+
+func (m *QueryFulfillerStatsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryFulfillerStatsResponse struct {
+	Stats FulfillmentStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryFulfillerStatsResponse) Reset()         { *m = QueryFulfillerStatsResponse{} }
+func (m *QueryFulfillerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFulfillerStatsResponse) ProtoMessage()    {}
+func (*QueryFulfillerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{13}
 }
-func (*UnimplementedQueryServer) DemandOrderById(ctx context.Context, req *QueryGetDemandOrderRequest) (*QueryGetDemandOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DemandOrderById not implemented")
+func (m *QueryFulfillerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DemandOrdersByStatus(ctx context.Context, req *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DemandOrdersByStatus not implemented")
+func (m *QueryFulfillerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFulfillerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) OnDemandLPs(ctx context.Context, req *QueryOnDemandLPsRequest) (*QueryOnDemandLPsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OnDemandLPs not implemented")
+func (m *QueryFulfillerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFulfillerStatsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OnDemandLPsByByAddr(ctx context.Context, req *QueryOnDemandLPsByAddrRequest) (*QueryOnDemandLPsByAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OnDemandLPsByByAddr not implemented")
+func (m *QueryFulfillerStatsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryFulfillerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFulfillerStatsResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryFulfillerStatsResponse proto.InternalMessageInfo
 
-func _Query_DemandOrderById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDemandOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DemandOrderById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Query/DemandOrderById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DemandOrderById(ctx, req.(*QueryGetDemandOrderRequest))
+func (m *QueryFulfillerStatsResponse) GetStats() FulfillmentStats {
+	if m != nil {
+		return m.Stats
 	}
-	return interceptor(ctx, in, info, handler)
+	return FulfillmentStats{}
 }
 
-func _Query_DemandOrdersByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDemandOrdersByStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DemandOrdersByStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Query/DemandOrdersByStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DemandOrdersByStatus(ctx, req.(*QueryDemandOrdersByStatusRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryOnDemandLPStatsRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Query_OnDemandLPs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOnDemandLPsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OnDemandLPs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Query/OnDemandLPs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OnDemandLPs(ctx, req.(*QueryOnDemandLPsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryOnDemandLPStatsRequest) Reset()         { *m = QueryOnDemandLPStatsRequest{} }
+func (m *QueryOnDemandLPStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOnDemandLPStatsRequest) ProtoMessage()    {}
+func (*QueryOnDemandLPStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{14}
 }
-
-func _Query_OnDemandLPsByByAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOnDemandLPsByAddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OnDemandLPsByByAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Query/OnDemandLPsByByAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OnDemandLPsByByAddr(ctx, req.(*QueryOnDemandLPsByAddrRequest))
+func (m *QueryOnDemandLPStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOnDemandLPStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOnDemandLPStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.eibc.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DemandOrderById",
-			Handler:    _Query_DemandOrderById_Handler,
-		},
-		{
-			MethodName: "DemandOrdersByStatus",
-			Handler:    _Query_DemandOrdersByStatus_Handler,
-		},
-		{
-			MethodName: "OnDemandLPs",
-			Handler:    _Query_OnDemandLPs_Handler,
-		},
-		{
-			MethodName: "OnDemandLPsByByAddr",
-			Handler:    _Query_OnDemandLPsByByAddr_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/eibc/query.proto",
+func (m *QueryOnDemandLPStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOnDemandLPStatsRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryOnDemandLPStatsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOnDemandLPStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOnDemandLPStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_QueryOnDemandLPStatsRequest proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOnDemandLPStatsRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryOnDemandLPStatsResponse struct {
+	Stats FulfillmentStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryOnDemandLPStatsResponse) Reset()         { *m = QueryOnDemandLPStatsResponse{} }
+func (m *QueryOnDemandLPStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOnDemandLPStatsResponse) ProtoMessage()    {}
+func (*QueryOnDemandLPStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{15}
+}
+func (m *QueryOnDemandLPStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOnDemandLPStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOnDemandLPStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryOnDemandLPStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOnDemandLPStatsResponse.Merge(m, src)
+}
+func (m *QueryOnDemandLPStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOnDemandLPStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOnDemandLPStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetDemandOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOnDemandLPStatsResponse proto.InternalMessageInfo
+
+func (m *QueryOnDemandLPStatsResponse) GetStats() FulfillmentStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return FulfillmentStats{}
 }
 
-func (m *QueryGetDemandOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryOnDemandLPsRequest struct {
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (m *QueryGetDemandOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryOnDemandLPsRequest) Reset()         { *m = QueryOnDemandLPsRequest{} }
+func (m *QueryOnDemandLPsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOnDemandLPsRequest) ProtoMessage()    {}
+func (*QueryOnDemandLPsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{16}
+}
+func (m *QueryOnDemandLPsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOnDemandLPsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOnDemandLPsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOnDemandLPsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOnDemandLPsRequest.Merge(m, src)
+}
+func (m *QueryOnDemandLPsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOnDemandLPsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOnDemandLPsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDemandOrdersByStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOnDemandLPsRequest proto.InternalMessageInfo
+
+func (m *QueryOnDemandLPsRequest) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDemandOrdersByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryOnDemandLPsResponse struct {
+	Lps []*OnDemandLPRecord `protobuf:"bytes,1,rep,name=lps,proto3" json:"lps,omitempty"`
 }
 
-func (m *QueryDemandOrdersByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryOnDemandLPsResponse) Reset()         { *m = QueryOnDemandLPsResponse{} }
+func (m *QueryOnDemandLPsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOnDemandLPsResponse) ProtoMessage()    {}
+func (*QueryOnDemandLPsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{17}
+}
+func (m *QueryOnDemandLPsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOnDemandLPsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOnDemandLPsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Fulfiller) > 0 {
-		i -= len(m.Fulfiller)
-		copy(dAtA[i:], m.Fulfiller)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fulfiller)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.FulfillmentState != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FulfillmentState))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Type != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOnDemandLPsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOnDemandLPsResponse.Merge(m, src)
+}
+func (m *QueryOnDemandLPsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOnDemandLPsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOnDemandLPsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetDemandOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOnDemandLPsResponse proto.InternalMessageInfo
+
+func (m *QueryOnDemandLPsResponse) GetLps() []*OnDemandLPRecord {
+	if m != nil {
+		return m.Lps
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryOnDemandLPsByAddrRequest struct {
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 }
 
-func (m *QueryGetDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DemandOrder != nil {
-		{
-			size, err := m.DemandOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryOnDemandLPsByAddrRequest) Reset()         { *m = QueryOnDemandLPsByAddrRequest{} }
+func (m *QueryOnDemandLPsByAddrRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOnDemandLPsByAddrRequest) ProtoMessage()    {}
+func (*QueryOnDemandLPsByAddrRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{18}
+}
+func (m *QueryOnDemandLPsByAddrRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOnDemandLPsByAddrRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOnDemandLPsByAddrRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOnDemandLPsByAddrRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOnDemandLPsByAddrRequest.Merge(m, src)
+}
+func (m *QueryOnDemandLPsByAddrRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOnDemandLPsByAddrRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOnDemandLPsByAddrRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDemandOrdersByStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOnDemandLPsByAddrRequest proto.InternalMessageInfo
+
+func (m *QueryOnDemandLPsByAddrRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDemandOrdersByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryOnDemandLPsByAddrResponse struct {
+	Lps []*OnDemandLPRecord `protobuf:"bytes,1,rep,name=lps,proto3" json:"lps,omitempty"`
 }
 
-func (m *QueryDemandOrdersByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DemandOrders) > 0 {
-		for iNdEx := len(m.DemandOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DemandOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryOnDemandLPsByAddrResponse) Reset()         { *m = QueryOnDemandLPsByAddrResponse{} }
+func (m *QueryOnDemandLPsByAddrResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOnDemandLPsByAddrResponse) ProtoMessage()    {}
+func (*QueryOnDemandLPsByAddrResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{19}
+}
+func (m *QueryOnDemandLPsByAddrResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOnDemandLPsByAddrResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOnDemandLPsByAddrResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOnDemandLPsByAddrResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOnDemandLPsByAddrResponse.Merge(m, src)
+}
+func (m *QueryOnDemandLPsByAddrResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOnDemandLPsByAddrResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOnDemandLPsByAddrResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOnDemandLPsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOnDemandLPsByAddrResponse proto.InternalMessageInfo
+
+func (m *QueryOnDemandLPsByAddrResponse) GetLps() []*OnDemandLPRecord {
+	if m != nil {
+		return m.Lps
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOnDemandLPsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.eibc.FulfillmentState", FulfillmentState_name, FulfillmentState_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.eibc.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.eibc.QueryParamsResponse")
+	proto.RegisterType((*QueryGetDemandOrderRequest)(nil), "dymensionxyz.dymension.eibc.QueryGetDemandOrderRequest")
+	proto.RegisterType((*QueryDemandOrdersByStatusRequest)(nil), "dymensionxyz.dymension.eibc.QueryDemandOrdersByStatusRequest")
+	proto.RegisterType((*QueryGetDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.QueryGetDemandOrderResponse")
+	proto.RegisterType((*QueryDemandOrdersByStatusResponse)(nil), "dymensionxyz.dymension.eibc.QueryDemandOrdersByStatusResponse")
+	proto.RegisterType((*QueryDemandOrdersRequest)(nil), "dymensionxyz.dymension.eibc.QueryDemandOrdersRequest")
+	proto.RegisterType((*QueryDemandOrdersResponse)(nil), "dymensionxyz.dymension.eibc.QueryDemandOrdersResponse")
+	proto.RegisterType((*QueryRevertedDemandOrdersRequest)(nil), "dymensionxyz.dymension.eibc.QueryRevertedDemandOrdersRequest")
+	proto.RegisterType((*QueryRevertedDemandOrdersResponse)(nil), "dymensionxyz.dymension.eibc.QueryRevertedDemandOrdersResponse")
+	proto.RegisterType((*QueryClaimListingsRequest)(nil), "dymensionxyz.dymension.eibc.QueryClaimListingsRequest")
+	proto.RegisterType((*QueryClaimListingsResponse)(nil), "dymensionxyz.dymension.eibc.QueryClaimListingsResponse")
+	proto.RegisterType((*QueryFulfillerStatsRequest)(nil), "dymensionxyz.dymension.eibc.QueryFulfillerStatsRequest")
+	proto.RegisterType((*QueryFulfillerStatsResponse)(nil), "dymensionxyz.dymension.eibc.QueryFulfillerStatsResponse")
+	proto.RegisterType((*QueryOnDemandLPStatsRequest)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPStatsRequest")
+	proto.RegisterType((*QueryOnDemandLPStatsResponse)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPStatsResponse")
+	proto.RegisterType((*QueryOnDemandLPsRequest)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsRequest")
+	proto.RegisterType((*QueryOnDemandLPsResponse)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsResponse")
+	proto.RegisterType((*QueryOnDemandLPsByAddrRequest)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsByAddrRequest")
+	proto.RegisterType((*QueryOnDemandLPsByAddrResponse)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsByAddrResponse")
 }
 
-func (m *QueryOnDemandLPsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		dAtA6 := make([]byte, len(m.Ids)*10)
-		var j5 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintQuery(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/query.proto", fileDescriptor_d85bfe71ceb5f8dc)
 }
 
-func (m *QueryOnDemandLPsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_d85bfe71ceb5f8dc = []byte{
+	// 1395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x6f, 0xd4, 0x56,
+	0x14, 0x8e, 0x67, 0x26, 0xaf, 0x93, 0x67, 0x2f, 0x91, 0x6a, 0x06, 0x18, 0xe8, 0x50, 0x20, 0x05,
+	0x62, 0x27, 0x13, 0x08, 0x69, 0x29, 0x54, 0x84, 0x64, 0x50, 0x44, 0x0a, 0xa9, 0x5b, 0xa4, 0x8a,
+	0x2e, 0x46, 0xce, 0xf8, 0x66, 0xb8, 0xc2, 0x2f, 0x6c, 0x27, 0x62, 0x8a, 0xd2, 0x45, 0x77, 0x95,
+	0xba, 0xa8, 0xd4, 0x4d, 0x57, 0xfd, 0x01, 0xdd, 0xf5, 0xb5, 0x6a, 0x77, 0xed, 0x82, 0x55, 0x85,
+	0x60, 0xd3, 0x55, 0x5b, 0x41, 0x7f, 0x48, 0x75, 0x1f, 0xf6, 0xd8, 0xc1, 0x71, 0xc6, 0xd3, 0x2c,
+	0x58, 0xcd, 0xdc, 0xeb, 0xf3, 0xdd, 0xf3, 0x9d, 0xd7, 0x3d, 0xc7, 0x86, 0x33, 0x46, 0xdb, 0xc2,
+	0xb6, 0x4f, 0x1c, 0xfb, 0x61, 0xfb, 0x53, 0x35, 0x5a, 0xa8, 0x98, 0x6c, 0x34, 0xd5, 0x07, 0x5b,
+	0xd8, 0x6b, 0x2b, 0xae, 0xe7, 0x04, 0x0e, 0x3a, 0x12, 0x17, 0x54, 0xa2, 0x85, 0x42, 0x05, 0xcb,
+	0x53, 0x2d, 0xa7, 0xe5, 0x30, 0x39, 0x95, 0xfe, 0xe3, 0x90, 0xf2, 0xd1, 0x96, 0xe3, 0xb4, 0x4c,
+	0xac, 0xea, 0x2e, 0x51, 0x75, 0xdb, 0x76, 0x02, 0x3d, 0x20, 0x8e, 0xed, 0x8b, 0xa7, 0x67, 0x9b,
+	0x8e, 0x6f, 0x39, 0xbe, 0xba, 0xa1, 0xfb, 0x98, 0x6b, 0x52, 0xb7, 0xe7, 0x36, 0x70, 0xa0, 0xcf,
+	0xa9, 0xae, 0xde, 0x22, 0x36, 0x13, 0x16, 0xb2, 0x95, 0xb8, 0x6c, 0x28, 0xd5, 0x74, 0x48, 0xf8,
+	0x7c, 0x3a, 0xcb, 0x0a, 0x57, 0xf7, 0x74, 0x2b, 0xd2, 0xba, 0x87, 0x64, 0xd3, 0xb1, 0x2c, 0xc7,
+	0x56, 0xfd, 0x40, 0x0f, 0xb6, 0x42, 0xd9, 0x5a, 0xb6, 0xac, 0xe7, 0x98, 0xa6, 0xee, 0xba, 0x0d,
+	0x57, 0x6f, 0xde, 0xc7, 0x81, 0xc0, 0x28, 0x59, 0x4c, 0x0c, 0x6c, 0xe9, 0xb6, 0xd1, 0x70, 0x3c,
+	0x03, 0x7b, 0x42, 0xfe, 0xcd, 0x2c, 0x79, 0xd3, 0x15, 0x52, 0x99, 0x51, 0x6a, 0x9a, 0x3a, 0xb1,
+	0xba, 0x11, 0xa4, 0xc6, 0x09, 0xdb, 0xaa, 0x53, 0x80, 0x3e, 0xa0, 0x3e, 0x5f, 0x67, 0xce, 0xd1,
+	0xf0, 0x83, 0x2d, 0xec, 0x07, 0xd5, 0x8f, 0xe1, 0x50, 0x62, 0xd7, 0x77, 0x1d, 0xdb, 0xc7, 0xe8,
+	0x1a, 0x0c, 0x70, 0x27, 0xca, 0xd2, 0x09, 0x69, 0x7a, 0xa4, 0x76, 0x52, 0xc9, 0x48, 0x06, 0x85,
+	0x83, 0x97, 0x4a, 0x8f, 0xff, 0x3a, 0xde, 0xa7, 0x09, 0x60, 0xf5, 0x3c, 0x94, 0xd9, 0xc9, 0x37,
+	0x70, 0xb0, 0xcc, 0xbc, 0x70, 0x9b, 0x3a, 0x41, 0xe8, 0x45, 0xe3, 0x50, 0x20, 0x06, 0x3b, 0x7c,
+	0x58, 0x2b, 0x10, 0xa3, 0xfa, 0xac, 0x08, 0x27, 0x98, 0x78, 0x4c, 0xd6, 0x5f, 0x6a, 0x7f, 0xc8,
+	0xa2, 0x13, 0x82, 0xae, 0xc0, 0x00, 0x0f, 0x17, 0x03, 0x8e, 0xd7, 0x4e, 0xed, 0xc5, 0x8a, 0xc7,
+	0x4b, 0x11, 0x68, 0x01, 0x42, 0x2b, 0x50, 0x0a, 0xda, 0x2e, 0x96, 0x0b, 0x0c, 0x3c, 0xb7, 0x0f,
+	0x58, 0xe3, 0xc1, 0x5e, 0xe7, 0xb1, 0xfe, 0xa8, 0xed, 0x62, 0x8d, 0xc1, 0xd1, 0x31, 0x80, 0x30,
+	0x11, 0x88, 0x21, 0x17, 0x99, 0x09, 0xc3, 0x62, 0x67, 0xd5, 0x40, 0x53, 0xd0, 0x6f, 0x12, 0x8b,
+	0x04, 0x72, 0xe9, 0x84, 0x34, 0xdd, 0xaf, 0xf1, 0x05, 0xba, 0x0b, 0xaf, 0x6d, 0x6e, 0x99, 0x9b,
+	0xc4, 0x34, 0x2d, 0x6c, 0x07, 0x0d, 0xca, 0x08, 0xcb, 0xfd, 0x8c, 0xc8, 0x4c, 0xa6, 0x6f, 0xeb,
+	0x1d, 0x14, 0x35, 0x07, 0x6b, 0x93, 0x9b, 0xbb, 0x76, 0xd0, 0x51, 0x18, 0x16, 0x7b, 0xd8, 0x93,
+	0x07, 0x38, 0x9f, 0x68, 0x83, 0xf2, 0x31, 0xb0, 0xed, 0x58, 0xf2, 0x20, 0x7b, 0xc2, 0x17, 0x14,
+	0xe3, 0xe1, 0x26, 0x71, 0x09, 0xb6, 0x03, 0x79, 0x48, 0xd8, 0x10, 0x6e, 0xa0, 0x3a, 0x40, 0xa7,
+	0x22, 0xe5, 0x61, 0x96, 0x02, 0xa7, 0x15, 0x5e, 0x92, 0x0a, 0x2d, 0x49, 0x85, 0x5f, 0x14, 0xa2,
+	0x30, 0x95, 0x75, 0xbd, 0x85, 0x45, 0x90, 0xb4, 0x18, 0xb2, 0xfa, 0xb4, 0x00, 0x47, 0x52, 0x93,
+	0x40, 0xa4, 0xd9, 0x4d, 0x18, 0x8d, 0x57, 0x88, 0x48, 0xb6, 0xe9, 0x4c, 0x87, 0xc4, 0xcf, 0x19,
+	0x31, 0x3a, 0x0b, 0xe4, 0xc2, 0x18, 0xde, 0xdc, 0xc4, 0xcd, 0x80, 0x6c, 0xe3, 0xc6, 0x26, 0xa6,
+	0x71, 0x2e, 0x4e, 0x8f, 0xd4, 0x0e, 0x27, 0x78, 0x87, 0x8c, 0xaf, 0x3b, 0xc4, 0x5e, 0x9a, 0xa5,
+	0x09, 0xfb, 0xdd, 0xdf, 0xc7, 0xa7, 0x5b, 0x24, 0xb8, 0xb7, 0xb5, 0x41, 0x23, 0xaf, 0x8a, 0x7b,
+	0x87, 0xff, 0xcc, 0xf8, 0xc6, 0x7d, 0x95, 0x06, 0xdd, 0x67, 0x00, 0x5f, 0x1b, 0x8d, 0x34, 0xd4,
+	0x31, 0x46, 0x01, 0x4c, 0x74, 0x34, 0xba, 0x1e, 0x69, 0x62, 0xb9, 0x78, 0xf0, 0x3a, 0xc7, 0x23,
+	0x1d, 0xeb, 0x54, 0x45, 0xf5, 0x17, 0x09, 0xde, 0xc8, 0x28, 0x15, 0xe1, 0xda, 0xf7, 0x61, 0x2c,
+	0xee, 0x5a, 0x5a, 0x32, 0xc5, 0x5c, 0xbe, 0x1d, 0x8d, 0xf9, 0xd6, 0x47, 0x37, 0x12, 0x19, 0x51,
+	0x60, 0x71, 0x3a, 0xb3, 0x6f, 0x46, 0x70, 0x2e, 0x89, 0x94, 0xf8, 0xb6, 0x08, 0xf2, 0x4b, 0xec,
+	0xc3, 0x02, 0x4f, 0x96, 0x96, 0x94, 0x52, 0x5a, 0x3c, 0x95, 0x0b, 0xf1, 0x54, 0x3e, 0x0d, 0x13,
+	0x16, 0xb1, 0x69, 0xc4, 0x1b, 0x2e, 0xf6, 0x9a, 0x34, 0xa1, 0x79, 0x51, 0x8e, 0x59, 0xc4, 0xae,
+	0x63, 0xbc, 0xce, 0x37, 0xa3, 0xf2, 0x2f, 0xfd, 0xbf, 0xf2, 0x4f, 0x54, 0x4e, 0xff, 0xee, 0xca,
+	0xc9, 0xae, 0xc5, 0xd4, 0x5b, 0x60, 0xf0, 0x60, 0x6e, 0x81, 0x64, 0xcd, 0x0e, 0xf5, 0x5c, 0xb3,
+	0x3f, 0x4a, 0x70, 0x38, 0x25, 0x40, 0xaf, 0x78, 0x5a, 0x7d, 0x21, 0x89, 0xfe, 0xa1, 0xe1, 0x6d,
+	0xec, 0x05, 0xd8, 0xe8, 0x21, 0xbd, 0xea, 0x29, 0x64, 0x7a, 0xf1, 0x60, 0x54, 0xa0, 0xe9, 0x5c,
+	0x5e, 0x71, 0x4f, 0x7e, 0x26, 0xc2, 0x7f, 0x9d, 0x0e, 0x19, 0x6b, 0xc4, 0x0f, 0x88, 0xdd, 0x8a,
+	0x3c, 0x78, 0x18, 0x86, 0x18, 0xdb, 0x8e, 0xff, 0x06, 0xd9, 0xfa, 0x00, 0xbd, 0xf7, 0x83, 0x04,
+	0xe5, 0x34, 0x02, 0x51, 0xcb, 0x18, 0x32, 0xc5, 0x9e, 0xf0, 0xd8, 0x5b, 0x99, 0x1e, 0x8b, 0x9f,
+	0x22, 0x26, 0x94, 0xe8, 0x80, 0x83, 0x73, 0xda, 0x82, 0xe0, 0x5c, 0x0f, 0x4b, 0x9d, 0xd6, 0x64,
+	0xe4, 0x35, 0x19, 0x06, 0x75, 0xc3, 0xf0, 0xb0, 0xef, 0x87, 0x4e, 0x13, 0xcb, 0xea, 0x3d, 0x38,
+	0x92, 0x8a, 0x13, 0xc6, 0xae, 0x42, 0x3f, 0x1b, 0xe1, 0x44, 0x63, 0xcc, 0x75, 0x47, 0x84, 0xf3,
+	0x18, 0x3f, 0xa1, 0x3a, 0x23, 0x34, 0xdd, 0xb6, 0x79, 0x0e, 0xad, 0xad, 0x27, 0x28, 0x76, 0xe6,
+	0xb1, 0x12, 0x9b, 0xc7, 0x08, 0x1c, 0x4d, 0x17, 0x3f, 0x78, 0x66, 0xe7, 0xe0, 0xf5, 0x5d, 0xaa,
+	0x22, 0x56, 0x93, 0x50, 0x24, 0x06, 0x8f, 0x73, 0x49, 0xa3, 0x7f, 0xab, 0x9f, 0x80, 0xfc, 0xb2,
+	0xb0, 0xe0, 0xf4, 0x1e, 0x14, 0x4d, 0x37, 0xcc, 0x8a, 0x6c, 0x46, 0x1d, 0xb8, 0x86, 0x9b, 0x8e,
+	0x67, 0x68, 0x14, 0x59, 0x9d, 0x87, 0x63, 0xbb, 0x0f, 0x5f, 0x6a, 0x5f, 0x33, 0x8c, 0x68, 0x6a,
+	0x45, 0x50, 0xa2, 0x91, 0x13, 0x51, 0x64, 0xff, 0xab, 0x3a, 0x54, 0xf6, 0x02, 0x1d, 0x10, 0xaf,
+	0xb3, 0xd7, 0x60, 0x72, 0x77, 0x03, 0x40, 0x63, 0x30, 0x7c, 0xe7, 0xd6, 0xf2, 0x4a, 0x7d, 0xf5,
+	0xd6, 0xca, 0xf2, 0x64, 0x1f, 0x5d, 0xd6, 0xef, 0xac, 0xd5, 0x57, 0xd7, 0xd6, 0x56, 0x96, 0x27,
+	0x25, 0x34, 0x01, 0x23, 0x77, 0x6e, 0x75, 0x36, 0x0a, 0xb5, 0x2f, 0x27, 0xa0, 0x9f, 0xd1, 0x44,
+	0xdf, 0x48, 0x30, 0xc0, 0x07, 0x76, 0xa4, 0x66, 0x72, 0x79, 0xf9, 0x6d, 0xa1, 0x3c, 0xdb, 0x3d,
+	0x80, 0xdb, 0x5e, 0x3d, 0xf7, 0xf9, 0xb3, 0x7f, 0xbf, 0x2e, 0x9c, 0x42, 0x27, 0xd5, 0xfd, 0x5f,
+	0xd8, 0xd0, 0xaf, 0x12, 0x4c, 0xc4, 0x6e, 0xb8, 0xa5, 0xf6, 0xaa, 0x81, 0x2e, 0xed, 0xaf, 0x32,
+	0xf5, 0x0d, 0xa3, 0xbc, 0x98, 0x1f, 0x28, 0x38, 0x2f, 0x30, 0xce, 0xb3, 0x48, 0x51, 0xbb, 0x7d,
+	0xb5, 0x53, 0x1f, 0x11, 0x63, 0x07, 0x3d, 0x95, 0x60, 0x2a, 0x6d, 0x26, 0x43, 0x57, 0xf6, 0xa7,
+	0x92, 0xf1, 0xda, 0x53, 0xbe, 0xda, 0x2b, 0x5c, 0xd8, 0x73, 0x99, 0xd9, 0x73, 0x11, 0xcd, 0x77,
+	0x6d, 0x8f, 0xaf, 0x3e, 0xe2, 0xef, 0x4c, 0x3b, 0xe8, 0x7b, 0x09, 0x46, 0xe3, 0xa7, 0xa3, 0x8b,
+	0xf9, 0xd8, 0x84, 0x46, 0x2c, 0xe4, 0x85, 0x09, 0xf2, 0x35, 0x46, 0xfe, 0x3c, 0x3a, 0xdb, 0x3d,
+	0x79, 0x16, 0x88, 0xb4, 0xde, 0xdb, 0x4d, 0x20, 0x32, 0xe6, 0x87, 0xf2, 0xd5, 0x5e, 0xe1, 0xb9,
+	0x02, 0xe1, 0x89, 0x23, 0x1a, 0x49, 0xa3, 0x7e, 0x96, 0x60, 0x2c, 0xd1, 0x12, 0x51, 0x17, 0x2e,
+	0x4d, 0x6b, 0xe2, 0xe5, 0x4b, 0xb9, 0x71, 0x82, 0xff, 0x3c, 0xe3, 0x3f, 0x83, 0xce, 0xa9, 0xfb,
+	0x7e, 0x9d, 0x68, 0x44, 0x3d, 0xf6, 0x37, 0x09, 0xc6, 0x93, 0xed, 0xad, 0x9b, 0x9a, 0x4e, 0x6d,
+	0xa4, 0xe5, 0xc5, 0xfc, 0x40, 0x41, 0xfd, 0x2a, 0xa3, 0xbe, 0x88, 0x16, 0x32, 0xa9, 0x47, 0x93,
+	0x3a, 0x1b, 0xcd, 0x7d, 0xf5, 0x91, 0xe8, 0xd3, 0x3b, 0xe8, 0x77, 0x09, 0x26, 0x76, 0xf5, 0x42,
+	0xd4, 0x05, 0x9b, 0xf4, 0x6e, 0x5b, 0x7e, 0xbb, 0x07, 0xa4, 0x30, 0xe4, 0x5d, 0x66, 0xc8, 0x02,
+	0xba, 0x90, 0x69, 0x88, 0x63, 0x87, 0xd9, 0x63, 0xba, 0xa1, 0x2d, 0xf4, 0x8a, 0xfa, 0x49, 0x82,
+	0x91, 0xce, 0xc9, 0x3e, 0xba, 0x90, 0x87, 0x48, 0x44, 0xff, 0x62, 0x4e, 0x94, 0xa0, 0xbe, 0xc8,
+	0xa8, 0xd7, 0xd0, 0x6c, 0xd7, 0xd4, 0x19, 0x6b, 0x7f, 0x07, 0xfd, 0x21, 0xc1, 0xa1, 0x44, 0x7f,
+	0xe5, 0x1d, 0x16, 0xbd, 0x93, 0x8b, 0x48, 0xa2, 0x97, 0x97, 0x2f, 0xf7, 0x84, 0xcd, 0x95, 0x4e,
+	0x09, 0x53, 0x1a, 0x34, 0x97, 0x78, 0x46, 0xed, 0x2c, 0xdd, 0x7c, 0xfc, 0xbc, 0x22, 0x3d, 0x79,
+	0x5e, 0x91, 0xfe, 0x79, 0x5e, 0x91, 0xbe, 0x7a, 0x51, 0xe9, 0x7b, 0xf2, 0xa2, 0xd2, 0xf7, 0xe7,
+	0x8b, 0x4a, 0xdf, 0xdd, 0xb9, 0xd8, 0x77, 0x81, 0x3d, 0xce, 0xde, 0x9e, 0x57, 0x1f, 0x72, 0x05,
+	0xec, 0x33, 0xc1, 0xc6, 0x00, 0xfb, 0xc0, 0x37, 0xff, 0xdf, 0x00, 0xa4, 0x26, 0xca, 0x07, 0xda,
+	0x15, 0x00, 0x00,
 }
 
-func (m *QueryOnDemandLPsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Demand Order by id.
+	DemandOrderById(ctx context.Context, in *QueryGetDemandOrderRequest, opts ...grpc.CallOption) (*QueryGetDemandOrderResponse, error)
+	// Queries a list of demand orders by status.
+	DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error)
+	// Queries a list of demand orders in any status, with optional filters.
+	// Results are ordered by id when filtering by rollapp, denom, recipient or
+	// fulfiller, and by status then id otherwise.
+	DemandOrders(ctx context.Context, in *QueryDemandOrdersRequest, opts ...grpc.CallOption) (*QueryDemandOrdersResponse, error)
+	// Queries the fulfilled demand orders whose packet was reverted by a hard
+	// fork, ordered by id.
+	RevertedDemandOrders(ctx context.Context, in *QueryRevertedDemandOrdersRequest, opts ...grpc.CallOption) (*QueryRevertedDemandOrdersResponse, error)
+	// Queries the claims of fulfilled orders offered for sale, ordered by order
+	// id.
+	ClaimListings(ctx context.Context, in *QueryClaimListingsRequest, opts ...grpc.CallOption) (*QueryClaimListingsResponse, error)
+	// Queries the aggregate stats of a fulfiller.
+	FulfillerStats(ctx context.Context, in *QueryFulfillerStatsRequest, opts ...grpc.CallOption) (*QueryFulfillerStatsResponse, error)
+	// Queries the aggregate stats of an on-demand lp.
+	OnDemandLPStats(ctx context.Context, in *QueryOnDemandLPStatsRequest, opts ...grpc.CallOption) (*QueryOnDemandLPStatsResponse, error)
+	OnDemandLPs(ctx context.Context, in *QueryOnDemandLPsRequest, opts ...grpc.CallOption) (*QueryOnDemandLPsResponse, error)
+	OnDemandLPsByByAddr(ctx context.Context, in *QueryOnDemandLPsByAddrRequest, opts ...grpc.CallOption) (*QueryOnDemandLPsByAddrResponse, error)
 }
 
-func (m *QueryOnDemandLPsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Lps) > 0 {
-		for iNdEx := len(m.Lps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Lps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryOnDemandLPsByAddrRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOnDemandLPsByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DemandOrderById(ctx context.Context, in *QueryGetDemandOrderRequest, opts ...grpc.CallOption) (*QueryGetDemandOrderResponse, error) {
+	out := new(QueryGetDemandOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/DemandOrderById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOnDemandLPsByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error) {
+	out := new(QueryDemandOrdersByStatusResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/DemandOrdersByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOnDemandLPsByAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DemandOrders(ctx context.Context, in *QueryDemandOrdersRequest, opts ...grpc.CallOption) (*QueryDemandOrdersResponse, error) {
+	out := new(QueryDemandOrdersResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/DemandOrders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOnDemandLPsByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RevertedDemandOrders(ctx context.Context, in *QueryRevertedDemandOrdersRequest, opts ...grpc.CallOption) (*QueryRevertedDemandOrdersResponse, error) {
+	out := new(QueryRevertedDemandOrdersResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/RevertedDemandOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOnDemandLPsByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Lps) > 0 {
-		for iNdEx := len(m.Lps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Lps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ClaimListings(ctx context.Context, in *QueryClaimListingsRequest, opts ...grpc.CallOption) (*QueryClaimListingsResponse, error) {
+	out := new(QueryClaimListingsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/ClaimListings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) FulfillerStats(ctx context.Context, in *QueryFulfillerStatsRequest, opts ...grpc.CallOption) (*QueryFulfillerStatsResponse, error) {
+	out := new(QueryFulfillerStatsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/FulfillerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) OnDemandLPStats(ctx context.Context, in *QueryOnDemandLPStatsRequest, opts ...grpc.CallOption) (*QueryOnDemandLPStatsResponse, error) {
+	out := new(QueryOnDemandLPStatsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/OnDemandLPStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) OnDemandLPs(ctx context.Context, in *QueryOnDemandLPsRequest, opts ...grpc.CallOption) (*QueryOnDemandLPsResponse, error) {
+	out := new(QueryOnDemandLPsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/OnDemandLPs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryGetDemandOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) OnDemandLPsByByAddr(ctx context.Context, in *QueryOnDemandLPsByAddrRequest, opts ...grpc.CallOption) (*QueryOnDemandLPsByAddrResponse, error) {
+	out := new(QueryOnDemandLPsByAddrResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/OnDemandLPsByByAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDemandOrdersByStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Demand Order by id.
+	DemandOrderById(context.Context, *QueryGetDemandOrderRequest) (*QueryGetDemandOrderResponse, error)
+	// Queries a list of demand orders by status.
+	DemandOrdersByStatus(context.Context, *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error)
+	// Queries a list of demand orders in any status, with optional filters.
+	// Results are ordered by id when filtering by rollapp, denom, recipient or
+	// fulfiller, and by status then id otherwise.
+	DemandOrders(context.Context, *QueryDemandOrdersRequest) (*QueryDemandOrdersResponse, error)
+	// Queries the fulfilled demand orders whose packet was reverted by a hard
+	// fork, ordered by id.
+	RevertedDemandOrders(context.Context, *QueryRevertedDemandOrdersRequest) (*QueryRevertedDemandOrdersResponse, error)
+	// Queries the claims of fulfilled orders offered for sale, ordered by order
+	// id.
+	ClaimListings(context.Context, *QueryClaimListingsRequest) (*QueryClaimListingsResponse, error)
+	// Queries the aggregate stats of a fulfiller.
+	FulfillerStats(context.Context, *QueryFulfillerStatsRequest) (*QueryFulfillerStatsResponse, error)
+	// Queries the aggregate stats of an on-demand lp.
+	OnDemandLPStats(context.Context, *QueryOnDemandLPStatsRequest) (*QueryOnDemandLPStatsResponse, error)
+	OnDemandLPs(context.Context, *QueryOnDemandLPsRequest) (*QueryOnDemandLPsResponse, error)
+	OnDemandLPsByByAddr(context.Context, *QueryOnDemandLPsByAddrRequest) (*QueryOnDemandLPsByAddrResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DemandOrderById(ctx context.Context, req *QueryGetDemandOrderRequest) (*QueryGetDemandOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemandOrderById not implemented")
+}
+func (*UnimplementedQueryServer) DemandOrdersByStatus(ctx context.Context, req *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemandOrdersByStatus not implemented")
+}
+func (*UnimplementedQueryServer) DemandOrders(ctx context.Context, req *QueryDemandOrdersRequest) (*QueryDemandOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemandOrders not implemented")
+}
+func (*UnimplementedQueryServer) RevertedDemandOrders(ctx context.Context, req *QueryRevertedDemandOrdersRequest) (*QueryRevertedDemandOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertedDemandOrders not implemented")
+}
+func (*UnimplementedQueryServer) ClaimListings(ctx context.Context, req *QueryClaimListingsRequest) (*QueryClaimListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimListings not implemented")
+}
+func (*UnimplementedQueryServer) FulfillerStats(ctx context.Context, req *QueryFulfillerStatsRequest) (*QueryFulfillerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillerStats not implemented")
+}
+func (*UnimplementedQueryServer) OnDemandLPStats(ctx context.Context, req *QueryOnDemandLPStatsRequest) (*QueryOnDemandLPStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnDemandLPStats not implemented")
+}
+func (*UnimplementedQueryServer) OnDemandLPs(ctx context.Context, req *QueryOnDemandLPsRequest) (*QueryOnDemandLPsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnDemandLPs not implemented")
+}
+func (*UnimplementedQueryServer) OnDemandLPsByByAddr(ctx context.Context, req *QueryOnDemandLPsByAddrRequest) (*QueryOnDemandLPsByAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnDemandLPsByByAddr not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/Params",
 	}
-	if m.FulfillmentState != 0 {
-		n += 1 + sovQuery(uint64(m.FulfillmentState))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	l = len(m.Fulfiller)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DemandOrderById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDemandOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).DemandOrderById(ctx, in)
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/DemandOrderById",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DemandOrderById(ctx, req.(*QueryGetDemandOrderRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetDemandOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_DemandOrdersByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDemandOrdersByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.DemandOrder != nil {
-		l = m.DemandOrder.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).DemandOrdersByStatus(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/DemandOrdersByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DemandOrdersByStatus(ctx, req.(*QueryDemandOrdersByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDemandOrdersByStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_DemandOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDemandOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.DemandOrders) > 0 {
-		for _, e := range m.DemandOrders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).DemandOrders(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/DemandOrders",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DemandOrders(ctx, req.(*QueryDemandOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOnDemandLPsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RevertedDemandOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRevertedDemandOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
+	if interceptor == nil {
+		return srv.(QueryServer).RevertedDemandOrders(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/RevertedDemandOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RevertedDemandOrders(ctx, req.(*QueryRevertedDemandOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOnDemandLPsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ClaimListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Lps) > 0 {
-		for _, e := range m.Lps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimListings(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/ClaimListings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimListings(ctx, req.(*QueryClaimListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOnDemandLPsByAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FulfillerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFulfillerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).FulfillerStats(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/FulfillerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FulfillerStats(ctx, req.(*QueryFulfillerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOnDemandLPsByAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OnDemandLPStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOnDemandLPStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Lps) > 0 {
-		for _, e := range m.Lps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).OnDemandLPStats(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/OnDemandLPStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OnDemandLPStats(ctx, req.(*QueryOnDemandLPStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Query_OnDemandLPs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOnDemandLPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OnDemandLPs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/OnDemandLPs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OnDemandLPs(ctx, req.(*QueryOnDemandLPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Query_OnDemandLPsByByAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOnDemandLPsByAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OnDemandLPsByByAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/OnDemandLPsByByAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OnDemandLPsByByAddr(ctx, req.(*QueryOnDemandLPsByAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.eibc.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DemandOrderById",
+			Handler:    _Query_DemandOrderById_Handler,
+		},
+		{
+			MethodName: "DemandOrdersByStatus",
+			Handler:    _Query_DemandOrdersByStatus_Handler,
+		},
+		{
+			MethodName: "DemandOrders",
+			Handler:    _Query_DemandOrders_Handler,
+		},
+		{
+			MethodName: "RevertedDemandOrders",
+			Handler:    _Query_RevertedDemandOrders_Handler,
+		},
+		{
+			MethodName: "ClaimListings",
+			Handler:    _Query_ClaimListings_Handler,
+		},
+		{
+			MethodName: "FulfillerStats",
+			Handler:    _Query_FulfillerStats_Handler,
+		},
+		{
+			MethodName: "OnDemandLPStats",
+			Handler:    _Query_OnDemandLPStats_Handler,
+		},
+		{
+			MethodName: "OnDemandLPs",
+			Handler:    _Query_OnDemandLPs_Handler,
+		},
+		{
+			MethodName: "OnDemandLPsByByAddr",
+			Handler:    _Query_OnDemandLPsByByAddr_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/eibc/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDemandOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDemandOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDemandOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDemandOrdersByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDemandOrdersByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDemandOrdersByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FulfillmentState != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FulfillmentState))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDemandOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EffectivePrice) > 0 {
+		for iNdEx := len(m.EffectivePrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EffectivePrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EffectiveFee) > 0 {
+		for iNdEx := len(m.EffectiveFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EffectiveFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DemandOrder != nil {
+		{
+			size, err := m.DemandOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDemandOrdersByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDemandOrdersByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDemandOrdersByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DemandOrders) > 0 {
+		for iNdEx := len(m.DemandOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DemandOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDemandOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDemandOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDemandOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.FulfillmentState != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FulfillmentState))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MinFeePercent) > 0 {
+		i -= len(m.MinFeePercent)
+		copy(dAtA[i:], m.MinFeePercent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinFeePercent)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDemandOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDemandOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDemandOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DemandOrders) > 0 {
+		for iNdEx := len(m.DemandOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DemandOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevertedDemandOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevertedDemandOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevertedDemandOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevertedDemandOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevertedDemandOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevertedDemandOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DemandOrders) > 0 {
+		for iNdEx := len(m.DemandOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DemandOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimListingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimListingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimListingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimListingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimListingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimListingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Listings) > 0 {
+		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFulfillerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFulfillerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFulfillerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFulfillerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFulfillerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFulfillerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOnDemandLPStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOnDemandLPStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOnDemandLPStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOnDemandLPStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOnDemandLPStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOnDemandLPStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOnDemandLPsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOnDemandLPsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOnDemandLPsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA14 := make([]byte, len(m.Ids)*10)
+		var j13 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintQuery(dAtA, i, uint64(j13))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOnDemandLPsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOnDemandLPsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOnDemandLPsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lps) > 0 {
+		for iNdEx := len(m.Lps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOnDemandLPsByAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOnDemandLPsByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOnDemandLPsByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOnDemandLPsByAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOnDemandLPsByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOnDemandLPsByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lps) > 0 {
+		for iNdEx := len(m.Lps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetDemandOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDemandOrdersByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	if m.FulfillmentState != 0 {
+		n += 1 + sovQuery(uint64(m.FulfillmentState))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDemandOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DemandOrder != nil {
+		l = m.DemandOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.EffectiveFee) > 0 {
+		for _, e := range m.EffectiveFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.EffectivePrice) > 0 {
+		for _, e := range m.EffectivePrice {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDemandOrdersByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DemandOrders) > 0 {
+		for _, e := range m.DemandOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDemandOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MinFeePercent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FulfillmentState != 0 {
+		n += 1 + sovQuery(uint64(m.FulfillmentState))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDemandOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DemandOrders) > 0 {
+		for _, e := range m.DemandOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevertedDemandOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevertedDemandOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DemandOrders) > 0 {
+		for _, e := range m.DemandOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimListingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimListingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Listings) > 0 {
+		for _, e := range m.Listings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFulfillerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFulfillerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOnDemandLPStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryOnDemandLPStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOnDemandLPsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryOnDemandLPsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lps) > 0 {
+		for _, e := range m.Lps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOnDemandLPsByAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOnDemandLPsByAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lps) > 0 {
+		for _, e := range m.Lps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDemandOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDemandOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentState", wireType)
+			}
+			m.FulfillmentState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FulfillmentState |= FulfillmentState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDemandOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DemandOrder == nil {
+				m.DemandOrder = &DemandOrder{}
+			}
+			if err := m.DemandOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveFee = append(m.EffectiveFee, types1.Coin{})
+			if err := m.EffectiveFee[len(m.EffectiveFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectivePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectivePrice = append(m.EffectivePrice, types1.Coin{})
+			if err := m.EffectivePrice[len(m.EffectivePrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemandOrders = append(m.DemandOrders, &DemandOrder{})
+			if err := m.DemandOrders[len(m.DemandOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDemandOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1485,25 +3734,259 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDemandOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDemandOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFeePercent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentState", wireType)
+			}
+			m.FulfillmentState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FulfillmentState |= FulfillmentState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1525,7 +4008,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDemandOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1548,15 +4031,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDemandOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDemandOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1583,7 +4066,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DemandOrders = append(m.DemandOrders, &DemandOrder{})
+			if err := m.DemandOrders[len(m.DemandOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1608,7 +4128,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRevertedDemandOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1631,15 +4151,15 @@ func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDemandOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRevertedDemandOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDemandOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRevertedDemandOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1667,7 +4187,43 @@ func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1690,7 +4246,7 @@ func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRevertedDemandOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1713,17 +4269,17 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRevertedDemandOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRevertedDemandOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrders", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1733,35 +4289,31 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= types.Status(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemandOrders = append(m.DemandOrders, &DemandOrder{})
+			if err := m.DemandOrders[len(m.DemandOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1771,65 +4323,81 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentState", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.FulfillmentState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FulfillmentState |= FulfillmentState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimListingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimListingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimListingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1857,13 +4425,13 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1873,29 +4441,83 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimListingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimListingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimListingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1905,25 +4527,27 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Listings = append(m.Listings, ClaimListing{})
+			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1953,7 +4577,7 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1980,7 +4604,7 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFulfillerStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2003,17 +4627,17 @@ func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDemandOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFulfillerStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFulfillerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2023,27 +4647,23 @@ func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DemandOrder == nil {
-				m.DemandOrder = &DemandOrder{}
-			}
-			if err := m.DemandOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2066,7 +4686,7 @@ func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFulfillerStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2089,15 +4709,15 @@ func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFulfillerStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFulfillerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2124,14 +4744,132 @@ func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DemandOrders = append(m.DemandOrders, &DemandOrder{})
-			if err := m.DemandOrders[len(m.DemandOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOnDemandLPStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOnDemandLPStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOnDemandLPStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOnDemandLPStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOnDemandLPStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOnDemandLPStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2158,10 +4896,7 @@ func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,483 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/eibc/stats.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FulfillmentStats aggregates the activity of a fulfiller or of an on-demand
+// lp.
+type FulfillmentStats struct {
+	// orders_filled is the number of orders filled. Every share of a partially
+	// fulfilled order counts as one.
+	OrdersFilled uint64 `protobuf:"varint,1,opt,name=orders_filled,json=ordersFilled,proto3" json:"orders_filled,omitempty"`
+	// volume is the total price paid for the filled orders, per denom.
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume"`
+	// fees_earned is the total fee of the filled orders which were finalized,
+	// per denom. For a partially fulfilled order, this is the share of the fee
+	// matching the share of the price.
+	FeesEarned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fees_earned,json=feesEarned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_earned"`
+	// orders_reverted is the number of filled orders which were reverted by a
+	// hard fork of the rollapp.
+	OrdersReverted uint64 `protobuf:"varint,4,opt,name=orders_reverted,json=ordersReverted,proto3" json:"orders_reverted,omitempty"`
+}
+
+func (m *FulfillmentStats) Reset()         { *m = FulfillmentStats{} }
+func (m *FulfillmentStats) String() string { return proto.CompactTextString(m) }
+func (*FulfillmentStats) ProtoMessage()    {}
+func (*FulfillmentStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9538e406f979252d, []int{0}
+}
+func (m *FulfillmentStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FulfillmentStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FulfillmentStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FulfillmentStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FulfillmentStats.Merge(m, src)
+}
+func (m *FulfillmentStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *FulfillmentStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FulfillmentStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FulfillmentStats proto.InternalMessageInfo
+
+func (m *FulfillmentStats) GetOrdersFilled() uint64 {
+	if m != nil {
+		return m.OrdersFilled
+	}
+	return 0
+}
+
+func (m *FulfillmentStats) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *FulfillmentStats) GetFeesEarned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesEarned
+	}
+	return nil
+}
+
+func (m *FulfillmentStats) GetOrdersReverted() uint64 {
+	if m != nil {
+		return m.OrdersReverted
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FulfillmentStats)(nil), "dymensionxyz.dymension.eibc.FulfillmentStats")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/stats.proto", fileDescriptor_9538e406f979252d)
+}
+
+var fileDescriptor_9538e406f979252d = []byte{
+	// 321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0xbf, 0x4e, 0x32, 0x41,
+	0x14, 0xc5, 0x77, 0x81, 0x50, 0x0c, 0xdf, 0xa7, 0x66, 0x63, 0xb1, 0x62, 0x32, 0x10, 0x2d, 0xa0,
+	0x71, 0x46, 0xe4, 0x0d, 0x30, 0xd2, 0xd8, 0x61, 0x67, 0x43, 0xf6, 0xcf, 0x05, 0x37, 0xee, 0xee,
+	0x25, 0x33, 0xc3, 0x06, 0x7c, 0x0a, 0x9f, 0xc3, 0xd6, 0x97, 0xa0, 0xa4, 0xb4, 0x52, 0xc3, 0xbe,
+	0x88, 0x99, 0x99, 0x0d, 0xa1, 0xb1, 0xb3, 0xda, 0xbd, 0x67, 0xee, 0x3d, 0xbf, 0x7b, 0x73, 0x48,
+	0x2f, 0x5e, 0x67, 0x90, 0xcb, 0x04, 0xf3, 0xd5, 0xfa, 0x85, 0xef, 0x0b, 0x0e, 0x49, 0x18, 0x71,
+	0xa9, 0x02, 0x25, 0xd9, 0x42, 0xa0, 0x42, 0xef, 0xfc, 0xb0, 0x91, 0xed, 0x0b, 0xa6, 0x1b, 0xdb,
+	0xa7, 0x73, 0x9c, 0xa3, 0xe9, 0xe3, 0xfa, 0xcf, 0x8e, 0xb4, 0x69, 0x84, 0x32, 0x43, 0xc9, 0xc3,
+	0x40, 0x02, 0x2f, 0x06, 0x21, 0xa8, 0x60, 0xc0, 0x23, 0x4c, 0x72, 0xfb, 0x7e, 0xf1, 0x5e, 0x23,
+	0x27, 0xe3, 0x65, 0x3a, 0x4b, 0xd2, 0x34, 0x83, 0x5c, 0x3d, 0x68, 0x9a, 0x77, 0x49, 0xfe, 0xa3,
+	0x88, 0x41, 0xc8, 0xa9, 0xd6, 0x21, 0xf6, 0xdd, 0xae, 0xdb, 0x6f, 0x4c, 0xfe, 0x59, 0x71, 0x6c,
+	0x34, 0x2f, 0x22, 0xcd, 0x02, 0xd3, 0x65, 0x06, 0x7e, 0xad, 0x5b, 0xef, 0xb7, 0x6e, 0xce, 0x98,
+	0x45, 0x31, 0x8d, 0x62, 0x15, 0x8a, 0xdd, 0x62, 0x92, 0x8f, 0xae, 0x37, 0x9f, 0x1d, 0xe7, 0xed,
+	0xab, 0xd3, 0x9f, 0x27, 0xea, 0x69, 0x19, 0xb2, 0x08, 0x33, 0x5e, 0xed, 0x65, 0x3f, 0x57, 0x32,
+	0x7e, 0xe6, 0x6a, 0xbd, 0x00, 0x69, 0x06, 0xe4, 0xa4, 0xb2, 0xf6, 0x52, 0xd2, 0x9a, 0x01, 0xc8,
+	0x29, 0x04, 0x22, 0x87, 0xd8, 0xaf, 0xff, 0x3d, 0x89, 0x68, 0xff, 0x3b, 0x63, 0xef, 0xf5, 0xc8,
+	0x71, 0x75, 0xb7, 0x80, 0x02, 0x84, 0x82, 0xd8, 0x6f, 0x98, 0xcb, 0x8f, 0xac, 0x3c, 0xa9, 0xd4,
+	0xd1, 0xfd, 0x66, 0x47, 0xdd, 0xed, 0x8e, 0xba, 0xdf, 0x3b, 0xea, 0xbe, 0x96, 0xd4, 0xd9, 0x96,
+	0xd4, 0xf9, 0x28, 0xa9, 0xf3, 0x38, 0x38, 0x00, 0xff, 0x12, 0x6b, 0x31, 0xe4, 0x2b, 0x9b, 0xad,
+	0xd9, 0x23, 0x6c, 0x9a, 0x24, 0x86, 0x3f, 0x03, 0x00, 0xfc, 0x1e, 0xe8, 0xc6, 0x07, 0x02, 0x00,
+	0x00,
+}
+
+func (m *FulfillmentStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FulfillmentStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FulfillmentStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrdersReverted != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.OrdersReverted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FeesEarned) > 0 {
+		for iNdEx := len(m.FeesEarned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesEarned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.OrdersFilled != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.OrdersFilled))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FulfillmentStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrdersFilled != 0 {
+		n += 1 + sovStats(uint64(m.OrdersFilled))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	if len(m.FeesEarned) > 0 {
+		for _, e := range m.FeesEarned {
+			l = e.Size()
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	if m.OrdersReverted != 0 {
+		n += 1 + sovStats(uint64(m.OrdersReverted))
+	}
+	return n
+}
+
+func sovStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStats(x uint64) (n int) {
+	return sovStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FulfillmentStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FulfillmentStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FulfillmentStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersFilled", wireType)
+			}
+			m.OrdersFilled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersFilled |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesEarned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesEarned = append(m.FeesEarned, types.Coin{})
+			if err := m.FeesEarned[len(m.FeesEarned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersReverted", wireType)
+			}
+			m.OrdersReverted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersReverted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStats = fmt.Errorf("proto: unexpected end of group")
+)
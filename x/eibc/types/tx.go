@@ -18,11 +18,16 @@ import (
 var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgFulfillOrder{}
+	_ sdk.Msg = &MsgFulfillOrderPartial{}
 	_ sdk.Msg = &MsgFulfillOrderAuthorized{}
 	_ sdk.Msg = &MsgUpdateDemandOrder{}
 	_ sdk.Msg = &MsgTryFulfillOnDemand{}
 	_ sdk.Msg = &MsgCreateOnDemandLP{}
 	_ sdk.Msg = &MsgDeleteOnDemandLP{}
+	_ sdk.Msg = &MsgTransferFulfilledClaim{}
+	_ sdk.Msg = &MsgListFulfilledClaim{}
+	_ sdk.Msg = &MsgDelistFulfilledClaim{}
+	_ sdk.Msg = &MsgBuyFulfilledClaim{}
 )
 
 func NewMsgFulfillOrder(fulfillerAddress, orderId, expectedFee string) *MsgFulfillOrder {
@@ -45,6 +50,30 @@ func (msg *MsgFulfillOrder) GetFulfillerBech32Address() []byte {
 	return sdk.MustAccAddressFromBech32(msg.FulfillerAddress)
 }
 
+func NewMsgFulfillOrderPartial(fulfillerAddress, orderId, expectedFee string, amount math.Int) *MsgFulfillOrderPartial {
+	return &MsgFulfillOrderPartial{
+		FulfillerAddress: fulfillerAddress,
+		OrderId:          orderId,
+		ExpectedFee:      expectedFee,
+		Amount:           amount,
+	}
+}
+
+func (msg *MsgFulfillOrderPartial) ValidateBasic() error {
+	err := validateCommon(msg.OrderId, msg.ExpectedFee, msg.FulfillerAddress)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	return nil
+}
+
+func (msg *MsgFulfillOrderPartial) GetFulfillerBech32Address() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.FulfillerAddress)
+}
+
 func NewMsgFulfillOrderAuthorized(
 	orderId,
 	rollappId,
@@ -216,6 +245,64 @@ func (m *MsgDeleteOnDemandLP) MustAcc() sdk.AccAddress {
 	return a
 }
 
+func (m *MsgTransferFulfilledClaim) ValidateBasic() error {
+	if !isValidOrderId(m.OrderId) {
+		return errorsmod.Wrapf(ErrInvalidOrderID, "%s", m.OrderId)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.NewOwner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "new owner")
+	}
+	if m.Owner == m.NewOwner {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "new owner is the owner")
+	}
+	return nil
+}
+
+func (m *MsgListFulfilledClaim) ValidateBasic() error {
+	if !isValidOrderId(m.OrderId) {
+		return errorsmod.Wrapf(ErrInvalidOrderID, "%s", m.OrderId)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner")
+	}
+	if !m.Price.IsValid() || !m.Price.IsPositive() {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "price: %s", m.Price)
+	}
+	return nil
+}
+
+func (m *MsgDelistFulfilledClaim) ValidateBasic() error {
+	if !isValidOrderId(m.OrderId) {
+		return errorsmod.Wrapf(ErrInvalidOrderID, "%s", m.OrderId)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner")
+	}
+	return nil
+}
+
+func (m *MsgBuyFulfilledClaim) ValidateBasic() error {
+	if !isValidOrderId(m.OrderId) {
+		return errorsmod.Wrapf(ErrInvalidOrderID, "%s", m.OrderId)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "buyer")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Seller); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "seller")
+	}
+	if m.Buyer == m.Seller {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "buyer is the seller")
+	}
+	if !m.ExpectedPrice.IsValid() || !m.ExpectedPrice.IsPositive() {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "expected price: %s", m.ExpectedPrice)
+	}
+	return nil
+}
+
 func (m MsgUpdateParams) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(m.Authority)
 	if err != nil {
@@ -227,6 +227,114 @@ func (m *MsgFulfillOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFulfillOrderResponse proto.InternalMessageInfo
 
+// MsgFulfillOrderPartial defines the FulfillOrderPartial request type.
+type MsgFulfillOrderPartial struct {
+	// fulfiller_address is the bech32-encoded address of the account which the
+	// message was sent from.
+	FulfillerAddress string `protobuf:"bytes,1,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// order_id is the unique identifier of the order to be fulfilled.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// expected_fee is the nominal fee set in the order.
+	ExpectedFee string `protobuf:"bytes,3,opt,name=expected_fee,json=expectedFee,proto3" json:"expected_fee,omitempty"`
+	// amount is the part of the order price to escrow. It must not exceed the
+	// unfilled part of the order.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MsgFulfillOrderPartial) Reset()         { *m = MsgFulfillOrderPartial{} }
+func (m *MsgFulfillOrderPartial) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillOrderPartial) ProtoMessage()    {}
+func (*MsgFulfillOrderPartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{4}
+}
+func (m *MsgFulfillOrderPartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillOrderPartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillOrderPartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillOrderPartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillOrderPartial.Merge(m, src)
+}
+func (m *MsgFulfillOrderPartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillOrderPartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillOrderPartial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillOrderPartial proto.InternalMessageInfo
+
+func (m *MsgFulfillOrderPartial) GetFulfillerAddress() string {
+	if m != nil {
+		return m.FulfillerAddress
+	}
+	return ""
+}
+
+func (m *MsgFulfillOrderPartial) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *MsgFulfillOrderPartial) GetExpectedFee() string {
+	if m != nil {
+		return m.ExpectedFee
+	}
+	return ""
+}
+
+// MsgFulfillOrderPartialResponse defines the FulfillOrderPartial response
+// type.
+type MsgFulfillOrderPartialResponse struct {
+	// remaining is the unfilled part of the order price.
+	Remaining cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=remaining,proto3,customtype=cosmossdk.io/math.Int" json:"remaining"`
+}
+
+func (m *MsgFulfillOrderPartialResponse) Reset()         { *m = MsgFulfillOrderPartialResponse{} }
+func (m *MsgFulfillOrderPartialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillOrderPartialResponse) ProtoMessage()    {}
+func (*MsgFulfillOrderPartialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{5}
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillOrderPartialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillOrderPartialResponse.Merge(m, src)
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillOrderPartialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillOrderPartialResponse proto.InternalMessageInfo
+
 // MsgFulfillOrderAuthorized defines the FulfillOrderAuthorized request type.
 type MsgFulfillOrderAuthorized struct {
 	// order_id is the unique identifier of the order to be fulfilled.
@@ -261,7 +369,7 @@ func (m *MsgFulfillOrderAuthorized) Reset()         { *m = MsgFulfillOrderAuthor
 func (m *MsgFulfillOrderAuthorized) String() string { return proto.CompactTextString(m) }
 func (*MsgFulfillOrderAuthorized) ProtoMessage()    {}
 func (*MsgFulfillOrderAuthorized) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{4}
+	return fileDescriptor_47537f11f512b254, []int{6}
 }
 func (m *MsgFulfillOrderAuthorized) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +454,7 @@ func (m *MsgFulfillOrderAuthorizedResponse) Reset()         { *m = MsgFulfillOrd
 func (m *MsgFulfillOrderAuthorizedResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFulfillOrderAuthorizedResponse) ProtoMessage()    {}
 func (*MsgFulfillOrderAuthorizedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{5}
+	return fileDescriptor_47537f11f512b254, []int{7}
 }
 func (m *MsgFulfillOrderAuthorizedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,7 +497,7 @@ func (m *MsgUpdateDemandOrder) Reset()         { *m = MsgUpdateDemandOrder{} }
 func (m *MsgUpdateDemandOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDemandOrder) ProtoMessage()    {}
 func (*MsgUpdateDemandOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{6}
+	return fileDescriptor_47537f11f512b254, []int{8}
 }
 func (m *MsgUpdateDemandOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +554,7 @@ func (m *MsgUpdateDemandOrderResponse) Reset()         { *m = MsgUpdateDemandOrd
 func (m *MsgUpdateDemandOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDemandOrderResponse) ProtoMessage()    {}
 func (*MsgUpdateDemandOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{7}
+	return fileDescriptor_47537f11f512b254, []int{9}
 }
 func (m *MsgUpdateDemandOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +595,7 @@ func (m *MsgTryFulfillOnDemand) Reset()         { *m = MsgTryFulfillOnDemand{} }
 func (m *MsgTryFulfillOnDemand) String() string { return proto.CompactTextString(m) }
 func (*MsgTryFulfillOnDemand) ProtoMessage()    {}
 func (*MsgTryFulfillOnDemand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{8}
+	return fileDescriptor_47537f11f512b254, []int{10}
 }
 func (m *MsgTryFulfillOnDemand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,7 +652,7 @@ func (m *MsgTryFulfillOnDemandResponse) Reset()         { *m = MsgTryFulfillOnDe
 func (m *MsgTryFulfillOnDemandResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTryFulfillOnDemandResponse) ProtoMessage()    {}
 func (*MsgTryFulfillOnDemandResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{9}
+	return fileDescriptor_47537f11f512b254, []int{11}
 }
 func (m *MsgTryFulfillOnDemandResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,7 +690,7 @@ func (m *MsgCreateOnDemandLP) Reset()         { *m = MsgCreateOnDemandLP{} }
 func (m *MsgCreateOnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateOnDemandLP) ProtoMessage()    {}
 func (*MsgCreateOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{10}
+	return fileDescriptor_47537f11f512b254, []int{12}
 }
 func (m *MsgCreateOnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,7 +741,7 @@ func (m *MsgCreateOnDemandLPResponse) Reset()         { *m = MsgCreateOnDemandLP
 func (m *MsgCreateOnDemandLPResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateOnDemandLPResponse) ProtoMessage()    {}
 func (*MsgCreateOnDemandLPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{11}
+	return fileDescriptor_47537f11f512b254, []int{13}
 }
 func (m *MsgCreateOnDemandLPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,7 +786,7 @@ func (m *MsgDeleteOnDemandLP) Reset()         { *m = MsgDeleteOnDemandLP{} }
 func (m *MsgDeleteOnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteOnDemandLP) ProtoMessage()    {}
 func (*MsgDeleteOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{12}
+	return fileDescriptor_47537f11f512b254, []int{14}
 }
 func (m *MsgDeleteOnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -728,7 +836,7 @@ func (m *MsgDeleteOnDemandLPResponse) Reset()         { *m = MsgDeleteOnDemandLP
 func (m *MsgDeleteOnDemandLPResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteOnDemandLPResponse) ProtoMessage()    {}
 func (*MsgDeleteOnDemandLPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{13}
+	return fileDescriptor_47537f11f512b254, []int{15}
 }
 func (m *MsgDeleteOnDemandLPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,823 +865,1008 @@ func (m *MsgDeleteOnDemandLPResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteOnDemandLPResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.eibc.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgFulfillOrder)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrder")
-	proto.RegisterType((*MsgFulfillOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderResponse")
-	proto.RegisterType((*MsgFulfillOrderAuthorized)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAuthorized")
-	proto.RegisterType((*MsgFulfillOrderAuthorizedResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAuthorizedResponse")
-	proto.RegisterType((*MsgUpdateDemandOrder)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrder")
-	proto.RegisterType((*MsgUpdateDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrderResponse")
-	proto.RegisterType((*MsgTryFulfillOnDemand)(nil), "dymensionxyz.dymension.eibc.MsgTryFulfillOnDemand")
-	proto.RegisterType((*MsgTryFulfillOnDemandResponse)(nil), "dymensionxyz.dymension.eibc.MsgTryFulfillOnDemandResponse")
-	proto.RegisterType((*MsgCreateOnDemandLP)(nil), "dymensionxyz.dymension.eibc.MsgCreateOnDemandLP")
-	proto.RegisterType((*MsgCreateOnDemandLPResponse)(nil), "dymensionxyz.dymension.eibc.MsgCreateOnDemandLPResponse")
-	proto.RegisterType((*MsgDeleteOnDemandLP)(nil), "dymensionxyz.dymension.eibc.MsgDeleteOnDemandLP")
-	proto.RegisterType((*MsgDeleteOnDemandLPResponse)(nil), "dymensionxyz.dymension.eibc.MsgDeleteOnDemandLPResponse")
+// MsgTransferFulfilledClaim hands over the claim on the proceeds of a
+// fulfilled order to another account. The new owner gets paid when the
+// underlying packet is finalized. For a partially fulfilled order, the claim
+// is the share of the owner.
+type MsgTransferFulfilledClaim struct {
+	// owner is the bech32-encoded address of the current owner of the claim.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// order_id is the unique identifier of the fulfilled order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// new_owner is the bech32-encoded address of the account which gets the
+	// claim.
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/eibc/tx.proto", fileDescriptor_47537f11f512b254)
+func (m *MsgTransferFulfilledClaim) Reset()         { *m = MsgTransferFulfilledClaim{} }
+func (m *MsgTransferFulfilledClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferFulfilledClaim) ProtoMessage()    {}
+func (*MsgTransferFulfilledClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{16}
 }
-
-var fileDescriptor_47537f11f512b254 = []byte{
-	// 1015 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0xc7, 0x45, 0x4b, 0x96, 0xad, 0x63, 0x7f, 0x89, 0xc2, 0x28, 0xb6, 0x24, 0x7f, 0x96, 0x1d,
-	0xb9, 0x40, 0x85, 0xb4, 0x26, 0x2d, 0xa7, 0x48, 0x5b, 0x2d, 0x0a, 0xc4, 0x11, 0x8c, 0x1a, 0xb5,
-	0x51, 0x83, 0x69, 0xbb, 0x28, 0x0a, 0x08, 0x94, 0x78, 0x4c, 0x13, 0xa1, 0x38, 0x04, 0x67, 0x7c,
-	0x51, 0x16, 0x45, 0xd0, 0x00, 0x5d, 0x17, 0x45, 0x1f, 0xa0, 0x40, 0x77, 0x5e, 0x65, 0x91, 0x87,
-	0xc8, 0xaa, 0x08, 0xb2, 0x2a, 0xba, 0x88, 0x0b, 0x7b, 0x91, 0xd7, 0x28, 0x86, 0x1c, 0x51, 0xa4,
-	0xae, 0x51, 0x57, 0xe4, 0xcc, 0xb9, 0xcc, 0xef, 0xcf, 0x39, 0x67, 0x86, 0xf0, 0x81, 0xd1, 0x69,
-	0xa3, 0x43, 0x2d, 0xe2, 0x9c, 0x77, 0x9e, 0xaa, 0xe1, 0x40, 0x45, 0xab, 0xd9, 0x52, 0xd9, 0xb9,
-	0xe2, 0x7a, 0x84, 0x11, 0x79, 0x25, 0xea, 0xa5, 0x84, 0x03, 0x85, 0x7b, 0x15, 0x97, 0x5b, 0x84,
-	0xb6, 0x09, 0x55, 0xdb, 0xd4, 0x54, 0x4f, 0xab, 0xfc, 0x11, 0x44, 0x15, 0x0b, 0x81, 0xa1, 0xe1,
-	0x8f, 0xd4, 0x60, 0x20, 0x4c, 0x39, 0x93, 0x98, 0x24, 0x98, 0xe7, 0x6f, 0x62, 0xb6, 0x24, 0x32,
-	0x35, 0x75, 0x8a, 0xea, 0x69, 0xb5, 0x89, 0x4c, 0xaf, 0xaa, 0x2d, 0x62, 0x39, 0xc2, 0x3e, 0x16,
-	0xd6, 0x76, 0x85, 0x57, 0x65, 0x9c, 0x97, 0xab, 0x7b, 0x7a, 0x5b, 0x50, 0x94, 0xff, 0x90, 0xe0,
-	0xe6, 0x01, 0x35, 0xbf, 0x75, 0x0d, 0x9d, 0xe1, 0xa1, 0x6f, 0x91, 0x1f, 0x40, 0x46, 0x3f, 0x61,
-	0xc7, 0xc4, 0xb3, 0x58, 0x27, 0x2f, 0xad, 0x4b, 0x95, 0xcc, 0x4e, 0xfe, 0xcd, 0xcb, 0xcd, 0x9c,
-	0xc0, 0x7f, 0x68, 0x18, 0x1e, 0x52, 0xfa, 0x98, 0x79, 0x96, 0x63, 0x6a, 0x3d, 0x57, 0xf9, 0x4b,
-	0x00, 0x07, 0xcf, 0x1a, 0x41, 0xfe, 0xfc, 0xcc, 0xba, 0x54, 0x59, 0xd8, 0xde, 0x50, 0xc6, 0x7c,
-	0x37, 0x25, 0x58, 0x70, 0x27, 0xf5, 0xea, 0xed, 0x5a, 0x42, 0xcb, 0x38, 0x78, 0x16, 0x4c, 0xd4,
-	0x6e, 0xfc, 0xf4, 0xee, 0xc5, 0xbd, 0x5e, 0xe6, 0x72, 0x01, 0x96, 0xfb, 0x20, 0x35, 0xa4, 0x2e,
-	0x71, 0x28, 0x96, 0x7f, 0x0b, 0x04, 0xec, 0x9e, 0xd8, 0x47, 0x96, 0x6d, 0x7f, 0xed, 0x19, 0xe8,
-	0xc9, 0x1f, 0xc1, 0xad, 0xa3, 0x60, 0x8c, 0x5e, 0x43, 0x0f, 0x70, 0x03, 0x21, 0x5a, 0x36, 0x34,
-	0x08, 0x19, 0x72, 0x01, 0xe6, 0x09, 0x8f, 0x6a, 0x58, 0x86, 0xcf, 0x9c, 0xd1, 0xe6, 0xfc, 0xf1,
-	0x9e, 0x21, 0xdf, 0x85, 0x45, 0x3c, 0x77, 0xb1, 0xc5, 0xd0, 0x68, 0x1c, 0x21, 0xe6, 0x93, 0xbe,
-	0x79, 0xa1, 0x3b, 0xb7, 0x8b, 0x58, 0x5b, 0xe2, 0xa4, 0x83, 0xab, 0x09, 0xe2, 0x28, 0x55, 0x48,
-	0xfc, 0x67, 0x0a, 0x0a, 0x7d, 0xb6, 0x87, 0x81, 0xd2, 0xa7, 0x68, 0xc4, 0x70, 0xa4, 0x38, 0xce,
-	0x2a, 0x80, 0x47, 0x6c, 0x5b, 0x77, 0xdd, 0x1e, 0x6b, 0x46, 0xcc, 0xec, 0x19, 0xb2, 0x0e, 0xb3,
-	0xae, 0x67, 0xb5, 0x38, 0x66, 0xb2, 0xb2, 0xb0, 0x5d, 0x50, 0xc4, 0x7e, 0xf1, 0x52, 0x52, 0x44,
-	0x29, 0x29, 0x8f, 0x88, 0xe5, 0xec, 0x6c, 0xf1, 0xef, 0x7d, 0x71, 0xb9, 0x56, 0x31, 0x2d, 0x76,
-	0x7c, 0xd2, 0x54, 0x5a, 0xa4, 0x2d, 0x6a, 0x53, 0x3c, 0x36, 0xa9, 0xf1, 0x44, 0x65, 0x1d, 0x17,
-	0xa9, 0x1f, 0x40, 0xb5, 0x20, 0xb3, 0xfc, 0x03, 0xa4, 0xf5, 0x36, 0x39, 0x71, 0x58, 0x3e, 0xe5,
-	0x97, 0x45, 0x9d, 0x27, 0xfa, 0xfb, 0xed, 0xda, 0x9d, 0x20, 0x8c, 0x1a, 0x4f, 0x14, 0x8b, 0xa8,
-	0x6d, 0x9d, 0x1d, 0x2b, 0x7b, 0x0e, 0xbb, 0xb8, 0x1c, 0x61, 0x78, 0xf3, 0x72, 0x13, 0x04, 0xdc,
-	0x9e, 0xc3, 0x34, 0x91, 0x93, 0xeb, 0xb3, 0xdd, 0x70, 0xbf, 0x66, 0x03, 0x7d, 0xb6, 0xdb, 0xdd,
-	0xa8, 0x2d, 0xc8, 0x11, 0x17, 0x3d, 0x9d, 0x11, 0x8f, 0xef, 0x46, 0xe8, 0x98, 0xf6, 0x1d, 0xe5,
-	0xae, 0x6d, 0x17, 0xb1, 0x1b, 0xd1, 0xbf, 0x7f, 0x73, 0x03, 0xfb, 0x27, 0xff, 0x08, 0x72, 0x2c,
-	0x29, 0x3d, 0xd6, 0x3d, 0xcc, 0xcf, 0xfb, 0xea, 0x0e, 0x85, 0xba, 0x95, 0x41, 0x11, 0xfb, 0x68,
-	0xea, 0xad, 0x4e, 0x1d, 0x5b, 0x17, 0x97, 0x63, 0xcd, 0x11, 0xa5, 0x75, 0x6c, 0x69, 0xd9, 0x08,
-	0xe4, 0x63, 0xbe, 0x92, 0x5c, 0x85, 0x1c, 0x45, 0xc6, 0x6c, 0x6c, 0xa3, 0xc3, 0x1a, 0xa7, 0xba,
-	0x6d, 0xf1, 0x1a, 0x37, 0xf2, 0x99, 0x75, 0xa9, 0x32, 0xaf, 0xdd, 0xee, 0xd9, 0xbe, 0xeb, 0x9a,
-	0x6a, 0x37, 0x79, 0xc9, 0x45, 0xbe, 0x54, 0x79, 0x03, 0xee, 0x8e, 0xac, 0xa7, 0xb0, 0xea, 0x9e,
-	0x4b, 0x90, 0x0b, 0x7b, 0xa8, 0x8e, 0x6d, 0xdd, 0x31, 0x82, 0x66, 0xd9, 0x80, 0xff, 0x91, 0x33,
-	0x67, 0xa0, 0x51, 0x16, 0xfd, 0xc9, 0xf7, 0x68, 0x92, 0x65, 0x98, 0xe3, 0x5d, 0xdf, 0xeb, 0x8f,
-	0xb4, 0x83, 0x67, 0xbc, 0x35, 0x64, 0xce, 0x19, 0xcf, 0x5d, 0x2e, 0xc1, 0xff, 0x87, 0x41, 0x84,
-	0x94, 0x16, 0xdc, 0x39, 0xa0, 0xe6, 0x37, 0x5e, 0xa7, 0xab, 0xc6, 0x09, 0xbc, 0xe4, 0x25, 0x48,
-	0x53, 0xcb, 0x74, 0xd0, 0x13, 0xcb, 0x8b, 0xd1, 0xb8, 0x76, 0xc9, 0x42, 0xd2, 0x73, 0x4c, 0x1f,
-	0x2a, 0xa9, 0xf1, 0xd7, 0xda, 0x02, 0x27, 0x12, 0x91, 0xe5, 0x35, 0x58, 0x1d, 0xba, 0x54, 0xc8,
-	0x42, 0xe1, 0xf6, 0x01, 0x35, 0x1f, 0x79, 0xa8, 0x33, 0xec, 0x1a, 0xf7, 0x0f, 0x23, 0x24, 0xc9,
-	0x18, 0xc9, 0xa7, 0x30, 0x63, 0xbb, 0xe2, 0xd4, 0xfb, 0x70, 0xec, 0xa9, 0xd7, 0x4b, 0xa6, 0xcd,
-	0xd8, 0x6e, 0x9c, 0x6a, 0x13, 0x56, 0x86, 0x2c, 0xda, 0x65, 0x92, 0x6f, 0xc0, 0x8c, 0x10, 0x9a,
-	0xd2, 0x66, 0x2c, 0xa3, 0xbc, 0xef, 0x33, 0xd6, 0xd1, 0xc6, 0x11, 0x8c, 0x52, 0x8c, 0x31, 0x0b,
-	0x49, 0xcb, 0xe0, 0x47, 0x73, 0xb2, 0x92, 0xd2, 0xf8, 0x6b, 0x7c, 0xf1, 0x55, 0x7f, 0xf1, 0xfe,
-	0x6c, 0xdd, 0xc5, 0xb7, 0x7f, 0x9f, 0x83, 0xe4, 0x01, 0x35, 0x65, 0x0f, 0x16, 0x63, 0xf7, 0xc5,
-	0xc7, 0x63, 0xd5, 0xf6, 0x1d, 0xdc, 0xc5, 0x4f, 0xa6, 0xf1, 0x0e, 0x85, 0xff, 0x2c, 0x81, 0x3c,
-	0xa4, 0x2c, 0xb6, 0x27, 0x25, 0x1b, 0x8c, 0x29, 0xd6, 0xa6, 0x8f, 0x09, 0x6b, 0x22, 0x21, 0x33,
-	0x58, 0x8c, 0xdd, 0x35, 0x13, 0xc5, 0x47, 0xbd, 0x27, 0x8b, 0x1f, 0x7a, 0x63, 0x24, 0xe4, 0x5f,
-	0x25, 0x58, 0x1a, 0x71, 0x61, 0x3c, 0x98, 0x26, 0x65, 0x2f, 0xae, 0xf8, 0xc5, 0x7f, 0x8b, 0x8b,
-	0x40, 0x3d, 0x97, 0xe0, 0xd6, 0xe0, 0x79, 0x52, 0x7d, 0xbf, 0xfd, 0x8d, 0x84, 0x14, 0x3f, 0x9f,
-	0x3a, 0x24, 0x42, 0xf1, 0x4c, 0x82, 0xec, 0x40, 0x93, 0x6e, 0x4d, 0xca, 0xd8, 0x1f, 0x51, 0xfc,
-	0x6c, 0xda, 0x88, 0x3e, 0x84, 0x81, 0x1e, 0x9c, 0x88, 0xd0, 0x1f, 0x31, 0x19, 0x61, 0x54, 0x67,
-	0x96, 0x13, 0xc5, 0xd9, 0x67, 0xef, 0x5e, 0xdc, 0x93, 0x76, 0xbe, 0x7a, 0x75, 0x55, 0x92, 0x5e,
-	0x5f, 0x95, 0xa4, 0x7f, 0xae, 0x4a, 0xd2, 0x2f, 0xd7, 0xa5, 0xc4, 0xeb, 0xeb, 0x52, 0xe2, 0xaf,
-	0xeb, 0x52, 0xe2, 0xfb, 0x6a, 0xe4, 0xae, 0x1f, 0xf1, 0x77, 0x78, 0x7a, 0x5f, 0x3d, 0x17, 0x7f,
-	0xbd, 0xfc, 0xea, 0x6f, 0xa6, 0xfd, 0x5f, 0xc4, 0xfb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x26,
-	0x33, 0xd9, 0xee, 0x21, 0x0b, 0x00, 0x00,
+func (m *MsgTransferFulfilledClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferFulfilledClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferFulfilledClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferFulfilledClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferFulfilledClaim.Merge(m, src)
+}
+func (m *MsgTransferFulfilledClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferFulfilledClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferFulfilledClaim.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgTransferFulfilledClaim proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams is used for updating module params.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	TryFulfillOnDemand(ctx context.Context, in *MsgTryFulfillOnDemand, opts ...grpc.CallOption) (*MsgTryFulfillOnDemandResponse, error)
-	FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error)
-	FulfillOrderAuthorized(ctx context.Context, in *MsgFulfillOrderAuthorized, opts ...grpc.CallOption) (*MsgFulfillOrderAuthorizedResponse, error)
-	UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error)
-	CreateOnDemandLP(ctx context.Context, in *MsgCreateOnDemandLP, opts ...grpc.CallOption) (*MsgCreateOnDemandLPResponse, error)
-	DeleteOnDemandLP(ctx context.Context, in *MsgDeleteOnDemandLP, opts ...grpc.CallOption) (*MsgDeleteOnDemandLPResponse, error)
+func (m *MsgTransferFulfilledClaim) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgTransferFulfilledClaim) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgTransferFulfilledClaim) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgTransferFulfilledClaimResponse struct {
 }
 
-func (c *msgClient) TryFulfillOnDemand(ctx context.Context, in *MsgTryFulfillOnDemand, opts ...grpc.CallOption) (*MsgTryFulfillOnDemandResponse, error) {
-	out := new(MsgTryFulfillOnDemandResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/TryFulfillOnDemand", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferFulfilledClaimResponse) Reset()         { *m = MsgTransferFulfilledClaimResponse{} }
+func (m *MsgTransferFulfilledClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferFulfilledClaimResponse) ProtoMessage()    {}
+func (*MsgTransferFulfilledClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{17}
+}
+func (m *MsgTransferFulfilledClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferFulfilledClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferFulfilledClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgTransferFulfilledClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferFulfilledClaimResponse.Merge(m, src)
+}
+func (m *MsgTransferFulfilledClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferFulfilledClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferFulfilledClaimResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error) {
-	out := new(MsgFulfillOrderResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgTransferFulfilledClaimResponse proto.InternalMessageInfo
+
+// MsgListFulfilledClaim offers the claim of the owner for sale. Listing an
+// already listed claim updates its price.
+type MsgListFulfilledClaim struct {
+	// owner is the bech32-encoded address of the current owner of the claim.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// order_id is the unique identifier of the fulfilled order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// price is what the buyer pays to the owner for the claim.
+	Price types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
 }
 
-func (c *msgClient) FulfillOrderAuthorized(ctx context.Context, in *MsgFulfillOrderAuthorized, opts ...grpc.CallOption) (*MsgFulfillOrderAuthorizedResponse, error) {
-	out := new(MsgFulfillOrderAuthorizedResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAuthorized", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgListFulfilledClaim) Reset()         { *m = MsgListFulfilledClaim{} }
+func (m *MsgListFulfilledClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgListFulfilledClaim) ProtoMessage()    {}
+func (*MsgListFulfilledClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{18}
+}
+func (m *MsgListFulfilledClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgListFulfilledClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgListFulfilledClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgListFulfilledClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgListFulfilledClaim.Merge(m, src)
+}
+func (m *MsgListFulfilledClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgListFulfilledClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgListFulfilledClaim.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error) {
-	out := new(MsgUpdateDemandOrderResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgListFulfilledClaim proto.InternalMessageInfo
+
+func (m *MsgListFulfilledClaim) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateOnDemandLP(ctx context.Context, in *MsgCreateOnDemandLP, opts ...grpc.CallOption) (*MsgCreateOnDemandLPResponse, error) {
-	out := new(MsgCreateOnDemandLPResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/CreateOnDemandLP", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgListFulfilledClaim) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeleteOnDemandLP(ctx context.Context, in *MsgDeleteOnDemandLP, opts ...grpc.CallOption) (*MsgDeleteOnDemandLPResponse, error) {
-	out := new(MsgDeleteOnDemandLPResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/DeleteOnDemandLP", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgListFulfilledClaim) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams is used for updating module params.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	TryFulfillOnDemand(context.Context, *MsgTryFulfillOnDemand) (*MsgTryFulfillOnDemandResponse, error)
-	FulfillOrder(context.Context, *MsgFulfillOrder) (*MsgFulfillOrderResponse, error)
-	FulfillOrderAuthorized(context.Context, *MsgFulfillOrderAuthorized) (*MsgFulfillOrderAuthorizedResponse, error)
-	UpdateDemandOrder(context.Context, *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error)
-	CreateOnDemandLP(context.Context, *MsgCreateOnDemandLP) (*MsgCreateOnDemandLPResponse, error)
-	DeleteOnDemandLP(context.Context, *MsgDeleteOnDemandLP) (*MsgDeleteOnDemandLPResponse, error)
+type MsgListFulfilledClaimResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgListFulfilledClaimResponse) Reset()         { *m = MsgListFulfilledClaimResponse{} }
+func (m *MsgListFulfilledClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgListFulfilledClaimResponse) ProtoMessage()    {}
+func (*MsgListFulfilledClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{19}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgListFulfilledClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) TryFulfillOnDemand(ctx context.Context, req *MsgTryFulfillOnDemand) (*MsgTryFulfillOnDemandResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TryFulfillOnDemand not implemented")
-}
-func (*UnimplementedMsgServer) FulfillOrder(ctx context.Context, req *MsgFulfillOrder) (*MsgFulfillOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrder not implemented")
-}
-func (*UnimplementedMsgServer) FulfillOrderAuthorized(ctx context.Context, req *MsgFulfillOrderAuthorized) (*MsgFulfillOrderAuthorizedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrderAuthorized not implemented")
+func (m *MsgListFulfilledClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgListFulfilledClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateDemandOrder(ctx context.Context, req *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDemandOrder not implemented")
+func (m *MsgListFulfilledClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgListFulfilledClaimResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CreateOnDemandLP(ctx context.Context, req *MsgCreateOnDemandLP) (*MsgCreateOnDemandLPResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOnDemandLP not implemented")
+func (m *MsgListFulfilledClaimResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) DeleteOnDemandLP(ctx context.Context, req *MsgDeleteOnDemandLP) (*MsgDeleteOnDemandLPResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOnDemandLP not implemented")
+func (m *MsgListFulfilledClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgListFulfilledClaimResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgListFulfilledClaimResponse proto.InternalMessageInfo
+
+// MsgDelistFulfilledClaim withdraws the claim of the owner from sale.
+type MsgDelistFulfilledClaim struct {
+	// owner is the bech32-encoded address of the current owner of the claim.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// order_id is the unique identifier of the fulfilled order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgDelistFulfilledClaim) Reset()         { *m = MsgDelistFulfilledClaim{} }
+func (m *MsgDelistFulfilledClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgDelistFulfilledClaim) ProtoMessage()    {}
+func (*MsgDelistFulfilledClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{20}
+}
+func (m *MsgDelistFulfilledClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelistFulfilledClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelistFulfilledClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDelistFulfilledClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelistFulfilledClaim.Merge(m, src)
+}
+func (m *MsgDelistFulfilledClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelistFulfilledClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelistFulfilledClaim.DiscardUnknown(m)
 }
 
-func _Msg_TryFulfillOnDemand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTryFulfillOnDemand)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TryFulfillOnDemand(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/TryFulfillOnDemand",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TryFulfillOnDemand(ctx, req.(*MsgTryFulfillOnDemand))
+var xxx_messageInfo_MsgDelistFulfilledClaim proto.InternalMessageInfo
+
+func (m *MsgDelistFulfilledClaim) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_FulfillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFulfillOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FulfillOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FulfillOrder(ctx, req.(*MsgFulfillOrder))
+func (m *MsgDelistFulfilledClaim) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_FulfillOrderAuthorized_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFulfillOrderAuthorized)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FulfillOrderAuthorized(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAuthorized",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FulfillOrderAuthorized(ctx, req.(*MsgFulfillOrderAuthorized))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgDelistFulfilledClaimResponse struct {
 }
 
-func _Msg_UpdateDemandOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateDemandOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateDemandOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateDemandOrder(ctx, req.(*MsgUpdateDemandOrder))
+func (m *MsgDelistFulfilledClaimResponse) Reset()         { *m = MsgDelistFulfilledClaimResponse{} }
+func (m *MsgDelistFulfilledClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelistFulfilledClaimResponse) ProtoMessage()    {}
+func (*MsgDelistFulfilledClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{21}
+}
+func (m *MsgDelistFulfilledClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelistFulfilledClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelistFulfilledClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDelistFulfilledClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelistFulfilledClaimResponse.Merge(m, src)
+}
+func (m *MsgDelistFulfilledClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelistFulfilledClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelistFulfilledClaimResponse.DiscardUnknown(m)
 }
 
-func _Msg_CreateOnDemandLP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateOnDemandLP)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateOnDemandLP(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/CreateOnDemandLP",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateOnDemandLP(ctx, req.(*MsgCreateOnDemandLP))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgDelistFulfilledClaimResponse proto.InternalMessageInfo
+
+// MsgBuyFulfilledClaim buys a listed claim at its listed price.
+type MsgBuyFulfilledClaim struct {
+	// buyer is the bech32-encoded address of the buyer.
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// order_id is the unique identifier of the fulfilled order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// seller is the bech32-encoded address of the owner of the listed claim.
+	Seller string `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	// expected_price must match the listed price, so that the buyer is not
+	// affected by a price update of the seller.
+	ExpectedPrice types.Coin `protobuf:"bytes,4,opt,name=expected_price,json=expectedPrice,proto3" json:"expected_price"`
 }
 
-func _Msg_DeleteOnDemandLP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteOnDemandLP)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteOnDemandLP(ctx, in)
+func (m *MsgBuyFulfilledClaim) Reset()         { *m = MsgBuyFulfilledClaim{} }
+func (m *MsgBuyFulfilledClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyFulfilledClaim) ProtoMessage()    {}
+func (*MsgBuyFulfilledClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{22}
+}
+func (m *MsgBuyFulfilledClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyFulfilledClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyFulfilledClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/DeleteOnDemandLP",
+}
+func (m *MsgBuyFulfilledClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyFulfilledClaim.Merge(m, src)
+}
+func (m *MsgBuyFulfilledClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyFulfilledClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyFulfilledClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyFulfilledClaim proto.InternalMessageInfo
+
+func (m *MsgBuyFulfilledClaim) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteOnDemandLP(ctx, req.(*MsgDeleteOnDemandLP))
+	return ""
+}
+
+func (m *MsgBuyFulfilledClaim) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.eibc.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "TryFulfillOnDemand",
-			Handler:    _Msg_TryFulfillOnDemand_Handler,
-		},
-		{
-			MethodName: "FulfillOrder",
-			Handler:    _Msg_FulfillOrder_Handler,
-		},
-		{
-			MethodName: "FulfillOrderAuthorized",
-			Handler:    _Msg_FulfillOrderAuthorized_Handler,
-		},
-		{
-			MethodName: "UpdateDemandOrder",
-			Handler:    _Msg_UpdateDemandOrder_Handler,
-		},
-		{
-			MethodName: "CreateOnDemandLP",
-			Handler:    _Msg_CreateOnDemandLP_Handler,
-		},
-		{
-			MethodName: "DeleteOnDemandLP",
-			Handler:    _Msg_DeleteOnDemandLP_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/eibc/tx.proto",
+func (m *MsgBuyFulfilledClaim) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBuyFulfilledClaim) GetExpectedPrice() types.Coin {
+	if m != nil {
+		return m.ExpectedPrice
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgBuyFulfilledClaimResponse struct {
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgBuyFulfilledClaimResponse) Reset()         { *m = MsgBuyFulfilledClaimResponse{} }
+func (m *MsgBuyFulfilledClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyFulfilledClaimResponse) ProtoMessage()    {}
+func (*MsgBuyFulfilledClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{23}
+}
+func (m *MsgBuyFulfilledClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyFulfilledClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyFulfilledClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgBuyFulfilledClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyFulfilledClaimResponse.Merge(m, src)
+}
+func (m *MsgBuyFulfilledClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyFulfilledClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyFulfilledClaimResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgBuyFulfilledClaimResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.eibc.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgFulfillOrder)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrder")
+	proto.RegisterType((*MsgFulfillOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderResponse")
+	proto.RegisterType((*MsgFulfillOrderPartial)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderPartial")
+	proto.RegisterType((*MsgFulfillOrderPartialResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderPartialResponse")
+	proto.RegisterType((*MsgFulfillOrderAuthorized)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAuthorized")
+	proto.RegisterType((*MsgFulfillOrderAuthorizedResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAuthorizedResponse")
+	proto.RegisterType((*MsgUpdateDemandOrder)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrder")
+	proto.RegisterType((*MsgUpdateDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrderResponse")
+	proto.RegisterType((*MsgTryFulfillOnDemand)(nil), "dymensionxyz.dymension.eibc.MsgTryFulfillOnDemand")
+	proto.RegisterType((*MsgTryFulfillOnDemandResponse)(nil), "dymensionxyz.dymension.eibc.MsgTryFulfillOnDemandResponse")
+	proto.RegisterType((*MsgCreateOnDemandLP)(nil), "dymensionxyz.dymension.eibc.MsgCreateOnDemandLP")
+	proto.RegisterType((*MsgCreateOnDemandLPResponse)(nil), "dymensionxyz.dymension.eibc.MsgCreateOnDemandLPResponse")
+	proto.RegisterType((*MsgDeleteOnDemandLP)(nil), "dymensionxyz.dymension.eibc.MsgDeleteOnDemandLP")
+	proto.RegisterType((*MsgDeleteOnDemandLPResponse)(nil), "dymensionxyz.dymension.eibc.MsgDeleteOnDemandLPResponse")
+	proto.RegisterType((*MsgTransferFulfilledClaim)(nil), "dymensionxyz.dymension.eibc.MsgTransferFulfilledClaim")
+	proto.RegisterType((*MsgTransferFulfilledClaimResponse)(nil), "dymensionxyz.dymension.eibc.MsgTransferFulfilledClaimResponse")
+	proto.RegisterType((*MsgListFulfilledClaim)(nil), "dymensionxyz.dymension.eibc.MsgListFulfilledClaim")
+	proto.RegisterType((*MsgListFulfilledClaimResponse)(nil), "dymensionxyz.dymension.eibc.MsgListFulfilledClaimResponse")
+	proto.RegisterType((*MsgDelistFulfilledClaim)(nil), "dymensionxyz.dymension.eibc.MsgDelistFulfilledClaim")
+	proto.RegisterType((*MsgDelistFulfilledClaimResponse)(nil), "dymensionxyz.dymension.eibc.MsgDelistFulfilledClaimResponse")
+	proto.RegisterType((*MsgBuyFulfilledClaim)(nil), "dymensionxyz.dymension.eibc.MsgBuyFulfilledClaim")
+	proto.RegisterType((*MsgBuyFulfilledClaimResponse)(nil), "dymensionxyz.dymension.eibc.MsgBuyFulfilledClaimResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/tx.proto", fileDescriptor_47537f11f512b254)
+}
+
+var fileDescriptor_47537f11f512b254 = []byte{
+	// 1302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4b, 0x4f, 0x1b, 0xd7,
+	0x17, 0x67, 0xb0, 0x79, 0xf8, 0x40, 0x08, 0x19, 0x1c, 0x30, 0xc3, 0x1f, 0x03, 0xe6, 0x2f, 0xd5,
+	0x4a, 0x8a, 0x8d, 0x81, 0xd2, 0xc4, 0xad, 0x22, 0x05, 0x2c, 0x54, 0x54, 0x50, 0xd0, 0x24, 0xcd,
+	0xa2, 0xaa, 0x64, 0x8d, 0x3d, 0x97, 0x61, 0xc4, 0x78, 0x66, 0x34, 0x77, 0x8c, 0x71, 0x16, 0x55,
+	0xd4, 0x48, 0x95, 0xaa, 0x76, 0x51, 0x55, 0xfd, 0x06, 0xdd, 0xb1, 0x8a, 0xd4, 0x7c, 0x88, 0xac,
+	0xaa, 0x28, 0xab, 0xaa, 0x8b, 0x10, 0xc1, 0x22, 0x5f, 0xa3, 0xba, 0x73, 0xaf, 0xe7, 0xe1, 0xf1,
+	0x83, 0x21, 0x52, 0x57, 0xf6, 0x9d, 0x73, 0x7e, 0xe7, 0xfc, 0xce, 0xb9, 0xe7, 0x31, 0x36, 0xfc,
+	0x5f, 0x6e, 0xd6, 0x90, 0x8e, 0x55, 0x43, 0x3f, 0x6d, 0x3e, 0xcb, 0xbb, 0x87, 0x3c, 0x52, 0x2b,
+	0xd5, 0xbc, 0x7d, 0x9a, 0x33, 0x2d, 0xc3, 0x36, 0xf8, 0x39, 0xbf, 0x56, 0xce, 0x3d, 0xe4, 0x88,
+	0x96, 0x30, 0x53, 0x35, 0x70, 0xcd, 0xc0, 0xf9, 0x1a, 0x56, 0xf2, 0x27, 0x05, 0xf2, 0x41, 0x51,
+	0xc2, 0x2c, 0x15, 0x94, 0x9d, 0x53, 0x9e, 0x1e, 0x98, 0x28, 0xa9, 0x18, 0x8a, 0x41, 0x9f, 0x93,
+	0x6f, 0xec, 0x69, 0x9a, 0x59, 0xaa, 0x48, 0x18, 0xe5, 0x4f, 0x0a, 0x15, 0x64, 0x4b, 0x85, 0x7c,
+	0xd5, 0x50, 0x75, 0x26, 0xef, 0x49, 0x56, 0x33, 0x99, 0x56, 0xb6, 0x97, 0x96, 0x29, 0x59, 0x52,
+	0x8d, 0xb1, 0xc8, 0xfc, 0xc1, 0xc1, 0xcd, 0x7d, 0xac, 0x7c, 0x63, 0xca, 0x92, 0x8d, 0x0e, 0x1c,
+	0x09, 0xbf, 0x09, 0x09, 0xa9, 0x6e, 0x1f, 0x19, 0x96, 0x6a, 0x37, 0x53, 0xdc, 0x22, 0x97, 0x4d,
+	0x6c, 0xa5, 0xde, 0xbe, 0x5a, 0x49, 0x32, 0xfa, 0x0f, 0x65, 0xd9, 0x42, 0x18, 0x3f, 0xb6, 0x2d,
+	0x55, 0x57, 0x44, 0x4f, 0x95, 0xff, 0x0a, 0x40, 0x47, 0x8d, 0x32, 0xb5, 0x9f, 0x1a, 0x5c, 0xe4,
+	0xb2, 0x63, 0x6b, 0xcb, 0xb9, 0x1e, 0x79, 0xcb, 0x51, 0x87, 0x5b, 0xf1, 0xd7, 0xef, 0x16, 0x06,
+	0xc4, 0x84, 0x8e, 0x1a, 0xf4, 0x41, 0x71, 0xe2, 0x87, 0x0f, 0x2f, 0xef, 0x78, 0x96, 0x33, 0xb3,
+	0x30, 0xd3, 0x46, 0x52, 0x44, 0xd8, 0x34, 0x74, 0x8c, 0x32, 0xbf, 0xd3, 0x00, 0x76, 0xea, 0xda,
+	0xa1, 0xaa, 0x69, 0x8f, 0x2c, 0x19, 0x59, 0xfc, 0x5d, 0xb8, 0x75, 0x48, 0xcf, 0xc8, 0x2a, 0x4b,
+	0x94, 0x2e, 0x0d, 0x44, 0x9c, 0x74, 0x05, 0x2c, 0x0c, 0x7e, 0x16, 0x46, 0x0d, 0x82, 0x2a, 0xab,
+	0xb2, 0xc3, 0x39, 0x21, 0x8e, 0x38, 0xe7, 0x5d, 0x99, 0x5f, 0x82, 0x71, 0x74, 0x6a, 0xa2, 0xaa,
+	0x8d, 0xe4, 0xf2, 0x21, 0x42, 0xa9, 0x98, 0x23, 0x1e, 0x6b, 0x3d, 0xdb, 0x41, 0xa8, 0x38, 0x4d,
+	0x98, 0x86, 0xbd, 0x31, 0xc6, 0x7e, 0x56, 0x2e, 0xe3, 0xf7, 0x1c, 0x4c, 0xb7, 0xc9, 0x0e, 0x24,
+	0xcb, 0x56, 0x25, 0xed, 0x3f, 0x24, 0xce, 0x6f, 0xc3, 0xb0, 0x54, 0x33, 0xea, 0xba, 0x9d, 0x8a,
+	0x3b, 0x37, 0x7c, 0x97, 0xdc, 0xc1, 0x3f, 0xef, 0x16, 0x6e, 0xd3, 0x5b, 0xc6, 0xf2, 0x71, 0x4e,
+	0x35, 0xf2, 0x35, 0xc9, 0x3e, 0xca, 0xed, 0xea, 0xf6, 0xdb, 0x57, 0x2b, 0xc0, 0xae, 0x7f, 0x57,
+	0xb7, 0x45, 0x06, 0xed, 0x1a, 0xfd, 0x31, 0xa4, 0x3b, 0x47, 0xd8, 0x4a, 0x02, 0xbf, 0x0b, 0x09,
+	0x0b, 0xd5, 0x24, 0x55, 0x57, 0x75, 0x25, 0xc5, 0x45, 0x67, 0xe0, 0xa1, 0x33, 0x7f, 0xc5, 0x61,
+	0xb6, 0xcd, 0xdb, 0x43, 0x5a, 0x39, 0xcf, 0x90, 0x1c, 0xc8, 0x12, 0x17, 0xcc, 0xd2, 0x3c, 0x80,
+	0x65, 0x68, 0x9a, 0x64, 0x9a, 0x5e, 0x0a, 0x13, 0xec, 0xc9, 0xae, 0xcc, 0x4b, 0x30, 0x64, 0x5a,
+	0x6a, 0x95, 0x64, 0x2f, 0x96, 0x1d, 0x5b, 0x9b, 0xcd, 0x31, 0xf7, 0xa4, 0x35, 0x73, 0xac, 0x35,
+	0x73, 0xdb, 0x86, 0xaa, 0x6f, 0xad, 0x12, 0xe6, 0x67, 0xe7, 0x0b, 0x59, 0x45, 0xb5, 0x8f, 0xea,
+	0x95, 0x5c, 0xd5, 0xa8, 0xb1, 0x5e, 0x67, 0x1f, 0x2b, 0x58, 0x3e, 0xce, 0xdb, 0x4d, 0x13, 0x61,
+	0x07, 0x80, 0x45, 0x6a, 0x99, 0xff, 0xae, 0xed, 0x12, 0x4a, 0x3d, 0x53, 0x70, 0x76, 0x1e, 0xe9,
+	0x76, 0x48, 0x7c, 0x9a, 0xe9, 0x96, 0xd1, 0x10, 0x8d, 0x4f, 0x33, 0x5b, 0xf5, 0xb3, 0x0a, 0x49,
+	0xc3, 0x44, 0x96, 0x64, 0x1b, 0x16, 0x29, 0x12, 0x57, 0x71, 0xd8, 0x51, 0xe4, 0x5b, 0xb2, 0x1d,
+	0x84, 0x5a, 0x88, 0xf6, 0xb2, 0x1a, 0x09, 0x97, 0xd5, 0xf7, 0xc0, 0x07, 0x8c, 0xe2, 0x23, 0xc9,
+	0x42, 0xa9, 0x51, 0x27, 0xba, 0x03, 0x16, 0xdd, 0x5c, 0x38, 0x88, 0x3d, 0xa4, 0x48, 0xd5, 0x66,
+	0x09, 0x55, 0xcf, 0xce, 0x7b, 0x8a, 0x7d, 0x91, 0x96, 0x50, 0x55, 0x9c, 0xf4, 0x91, 0x7c, 0x4c,
+	0x3c, 0xf1, 0x05, 0x48, 0x62, 0x64, 0xdb, 0x1a, 0xaa, 0x21, 0xdd, 0x2e, 0x9f, 0x48, 0x9a, 0x4a,
+	0x66, 0x86, 0x9c, 0x4a, 0x2c, 0x72, 0xd9, 0x51, 0x71, 0xca, 0x93, 0x3d, 0x6d, 0x89, 0x8a, 0x37,
+	0x49, 0x11, 0xfb, 0x32, 0x95, 0x59, 0x86, 0xa5, 0xae, 0xf5, 0xe4, 0x76, 0xf1, 0x0b, 0x0e, 0x92,
+	0xee, 0x4c, 0x2a, 0xa1, 0x9a, 0xa4, 0xcb, 0x74, 0xf8, 0x2c, 0xc3, 0x0d, 0xa3, 0xa1, 0x87, 0xfa,
+	0x77, 0xdc, 0x79, 0x78, 0x85, 0xde, 0x9d, 0x81, 0x11, 0x32, 0x45, 0xbd, 0xb6, 0x1d, 0xd6, 0x51,
+	0x83, 0x8c, 0x1a, 0x9e, 0xf0, 0x0c, 0xda, 0xce, 0xa4, 0xe1, 0x7f, 0x9d, 0x48, 0xb8, 0x2c, 0x55,
+	0xb8, 0xbd, 0x8f, 0x95, 0x27, 0x56, 0xb3, 0x15, 0x8d, 0x4e, 0xb5, 0xf8, 0x69, 0x18, 0xc6, 0xaa,
+	0xa2, 0x23, 0x8b, 0xb9, 0x67, 0xa7, 0x5e, 0xed, 0x32, 0x09, 0x31, 0x4b, 0x57, 0x1c, 0x52, 0x31,
+	0x91, 0x7c, 0x2d, 0x8e, 0x11, 0x46, 0x0c, 0x99, 0x59, 0x80, 0xf9, 0x8e, 0xae, 0x5c, 0x2e, 0x18,
+	0xa6, 0xf6, 0xb1, 0xb2, 0x6d, 0x21, 0xc9, 0x46, 0x2d, 0xe1, 0xde, 0x81, 0x8f, 0x49, 0x2c, 0xc0,
+	0xe4, 0x73, 0x18, 0xd4, 0x4c, 0xb6, 0x45, 0x3e, 0xe9, 0xb9, 0x45, 0x3c, 0x63, 0xe2, 0xa0, 0x66,
+	0x06, 0x59, 0xad, 0xc0, 0x5c, 0x07, 0xa7, 0xee, 0x18, 0x9a, 0x80, 0x41, 0x16, 0x68, 0x5c, 0x1c,
+	0x54, 0xe5, 0xcc, 0x9e, 0xc3, 0xb1, 0x84, 0x34, 0xd4, 0x85, 0x23, 0x17, 0xe0, 0x38, 0x09, 0x31,
+	0x55, 0x26, 0xab, 0x2e, 0x96, 0x8d, 0x8b, 0xe4, 0x6b, 0xd0, 0xf9, 0x3c, 0xcc, 0x75, 0xb0, 0xe6,
+	0x26, 0xa4, 0xee, 0xcc, 0xad, 0x27, 0x96, 0xa4, 0xe3, 0x43, 0x64, 0xb1, 0xb4, 0x21, 0x79, 0x5b,
+	0x93, 0xd4, 0x1a, 0x9f, 0x84, 0x21, 0xa3, 0xe1, 0x79, 0xa4, 0x87, 0x5e, 0x75, 0x33, 0x07, 0x64,
+	0x81, 0x96, 0x8d, 0x86, 0x97, 0xca, 0x51, 0x1d, 0x35, 0x1e, 0x91, 0x73, 0x11, 0x08, 0x2d, 0x6a,
+	0x83, 0x95, 0x77, 0x67, 0xb7, 0x2e, 0xb7, 0x9f, 0x39, 0xa7, 0x72, 0xf6, 0x54, 0x6c, 0x7f, 0x2c,
+	0xb1, 0xcf, 0xbc, 0x39, 0xca, 0xf5, 0x9e, 0xa3, 0xf4, 0x3d, 0x80, 0x6a, 0x07, 0x28, 0xd3, 0xda,
+	0x0a, 0x93, 0x71, 0xe9, 0x3e, 0x75, 0xd6, 0x6d, 0x09, 0x69, 0x1f, 0xcf, 0x37, 0xe0, 0x78, 0x09,
+	0x16, 0xba, 0xd8, 0x75, 0x5d, 0xff, 0x49, 0x07, 0xc1, 0x56, 0xbd, 0x19, 0x76, 0x5c, 0xa9, 0x37,
+	0x3d, 0xc7, 0xce, 0xa1, 0x57, 0xa2, 0x48, 0x95, 0x21, 0xb2, 0x47, 0xdd, 0x4e, 0x70, 0x4e, 0xfc,
+	0x0e, 0x4c, 0xb8, 0x63, 0x97, 0x66, 0x32, 0x7e, 0xb5, 0x4c, 0xde, 0x68, 0xc1, 0x0e, 0x7c, 0x19,
+	0x75, 0x68, 0xb0, 0xc1, 0x11, 0x22, 0xdd, 0x8a, 0x6a, 0xed, 0x7c, 0x1c, 0x62, 0xfb, 0x58, 0xe1,
+	0x2d, 0x18, 0x0f, 0xbc, 0x1b, 0x7e, 0xda, 0xb3, 0x13, 0xdb, 0x5e, 0xd2, 0x84, 0x8d, 0x28, 0xda,
+	0x6e, 0x53, 0xfe, 0xc8, 0x01, 0xdf, 0x61, 0x64, 0xad, 0xf5, 0x33, 0x16, 0xc6, 0x08, 0xc5, 0xe8,
+	0x18, 0xf7, 0x62, 0x07, 0x78, 0x1b, 0xc6, 0x03, 0xef, 0x95, 0x7d, 0x83, 0xf7, 0x6b, 0x0b, 0x1b,
+	0x51, 0xb4, 0x7d, 0x5e, 0x7f, 0xe2, 0x60, 0xaa, 0xd3, 0xcb, 0xe1, 0x7a, 0x14, 0x7b, 0x0c, 0x24,
+	0x7c, 0x71, 0x0d, 0x90, 0x8f, 0xcb, 0x6f, 0x1c, 0x4c, 0x77, 0x79, 0xb1, 0xda, 0x8c, 0x62, 0xd9,
+	0xc3, 0x09, 0x0f, 0xae, 0x87, 0xf3, 0x91, 0x7a, 0xc1, 0xc1, 0xad, 0xf0, 0xde, 0x2d, 0x5c, 0xad,
+	0xd6, 0x7c, 0x10, 0xe1, 0x7e, 0x64, 0x88, 0x8f, 0xc5, 0x73, 0x0e, 0x26, 0x43, 0xcb, 0x6c, 0xb5,
+	0x9f, 0xc5, 0x76, 0x84, 0x70, 0x2f, 0x2a, 0xa2, 0x8d, 0x42, 0x68, 0x57, 0xf5, 0xa5, 0xd0, 0x8e,
+	0x10, 0xee, 0x45, 0x45, 0xb4, 0x15, 0x48, 0x97, 0x0d, 0xb6, 0xd9, 0xbf, 0xf7, 0x3a, 0xe1, 0x84,
+	0x07, 0xd7, 0xc3, 0xf9, 0x48, 0x91, 0x01, 0xd2, 0x61, 0x73, 0xf5, 0x1d, 0x20, 0x61, 0x8c, 0x50,
+	0x8c, 0x8e, 0xf1, 0x11, 0xf9, 0x85, 0x83, 0x64, 0xc7, 0xa5, 0xb4, 0x71, 0x85, 0x94, 0x87, 0xc9,
+	0x7c, 0x79, 0x1d, 0x54, 0x5b, 0xe3, 0x84, 0xf7, 0x54, 0xdf, 0xc6, 0x09, 0x41, 0x84, 0xfb, 0x91,
+	0x21, 0x1e, 0x0b, 0x61, 0xe8, 0xf9, 0x87, 0x97, 0x77, 0xb8, 0xad, 0xaf, 0x5f, 0x5f, 0xa4, 0xb9,
+	0x37, 0x17, 0x69, 0xee, 0xfd, 0x45, 0x9a, 0xfb, 0xf5, 0x32, 0x3d, 0xf0, 0xe6, 0x32, 0x3d, 0xf0,
+	0xf7, 0x65, 0x7a, 0xe0, 0xdb, 0x82, 0xef, 0x67, 0x54, 0x97, 0x3f, 0x32, 0x4e, 0xd6, 0xf3, 0xa7,
+	0xec, 0x0f, 0x1a, 0xf2, 0xab, 0xaa, 0x32, 0xec, 0xfc, 0x9b, 0xb1, 0xfe, 0xef, 0x00, 0xc7, 0x4f,
+	0xf2, 0x99, 0xcc, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams is used for updating module params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	TryFulfillOnDemand(ctx context.Context, in *MsgTryFulfillOnDemand, opts ...grpc.CallOption) (*MsgTryFulfillOnDemandResponse, error)
+	FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error)
+	FulfillOrderPartial(ctx context.Context, in *MsgFulfillOrderPartial, opts ...grpc.CallOption) (*MsgFulfillOrderPartialResponse, error)
+	FulfillOrderAuthorized(ctx context.Context, in *MsgFulfillOrderAuthorized, opts ...grpc.CallOption) (*MsgFulfillOrderAuthorizedResponse, error)
+	UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error)
+	CreateOnDemandLP(ctx context.Context, in *MsgCreateOnDemandLP, opts ...grpc.CallOption) (*MsgCreateOnDemandLPResponse, error)
+	DeleteOnDemandLP(ctx context.Context, in *MsgDeleteOnDemandLP, opts ...grpc.CallOption) (*MsgDeleteOnDemandLPResponse, error)
+	TransferFulfilledClaim(ctx context.Context, in *MsgTransferFulfilledClaim, opts ...grpc.CallOption) (*MsgTransferFulfilledClaimResponse, error)
+	ListFulfilledClaim(ctx context.Context, in *MsgListFulfilledClaim, opts ...grpc.CallOption) (*MsgListFulfilledClaimResponse, error)
+	DelistFulfilledClaim(ctx context.Context, in *MsgDelistFulfilledClaim, opts ...grpc.CallOption) (*MsgDelistFulfilledClaimResponse, error)
+	BuyFulfilledClaim(ctx context.Context, in *MsgBuyFulfilledClaim, opts ...grpc.CallOption) (*MsgBuyFulfilledClaimResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TryFulfillOnDemand(ctx context.Context, in *MsgTryFulfillOnDemand, opts ...grpc.CallOption) (*MsgTryFulfillOnDemandResponse, error) {
+	out := new(MsgTryFulfillOnDemandResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/TryFulfillOnDemand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error) {
+	out := new(MsgFulfillOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFulfillOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FulfillOrderPartial(ctx context.Context, in *MsgFulfillOrderPartial, opts ...grpc.CallOption) (*MsgFulfillOrderPartialResponse, error) {
+	out := new(MsgFulfillOrderPartialResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrderPartial", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgFulfillOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FulfillOrderAuthorized(ctx context.Context, in *MsgFulfillOrderAuthorized, opts ...grpc.CallOption) (*MsgFulfillOrderAuthorizedResponse, error) {
+	out := new(MsgFulfillOrderAuthorizedResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAuthorized", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFulfillOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExpectedFee) > 0 {
-		i -= len(m.ExpectedFee)
-		copy(dAtA[i:], m.ExpectedFee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
-		i--
-		dAtA[i] = 0x1a
+func (c *msgClient) UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error) {
+	out := new(MsgUpdateDemandOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) CreateOnDemandLP(ctx context.Context, in *MsgCreateOnDemandLP, opts ...grpc.CallOption) (*MsgCreateOnDemandLPResponse, error) {
+	out := new(MsgCreateOnDemandLPResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/CreateOnDemandLP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.FulfillerAddress) > 0 {
-		i -= len(m.FulfillerAddress)
-		copy(dAtA[i:], m.FulfillerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) DeleteOnDemandLP(ctx context.Context, in *MsgDeleteOnDemandLP, opts ...grpc.CallOption) (*MsgDeleteOnDemandLPResponse, error) {
+	out := new(MsgDeleteOnDemandLPResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/DeleteOnDemandLP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgFulfillOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferFulfilledClaim(ctx context.Context, in *MsgTransferFulfilledClaim, opts ...grpc.CallOption) (*MsgTransferFulfilledClaimResponse, error) {
+	out := new(MsgTransferFulfilledClaimResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/TransferFulfilledClaim", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgFulfillOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ListFulfilledClaim(ctx context.Context, in *MsgListFulfilledClaim, opts ...grpc.CallOption) (*MsgListFulfilledClaimResponse, error) {
+	out := new(MsgListFulfilledClaimResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/ListFulfilledClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFulfillOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) DelistFulfilledClaim(ctx context.Context, in *MsgDelistFulfilledClaim, opts ...grpc.CallOption) (*MsgDelistFulfilledClaimResponse, error) {
+	out := new(MsgDelistFulfilledClaimResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/DelistFulfilledClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFulfillOrderAuthorized) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BuyFulfilledClaim(ctx context.Context, in *MsgBuyFulfilledClaim, opts ...grpc.CallOption) (*MsgBuyFulfilledClaimResponse, error) {
+	out := new(MsgBuyFulfilledClaimResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/BuyFulfilledClaim", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgFulfillOrderAuthorized) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams is used for updating module params.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	TryFulfillOnDemand(context.Context, *MsgTryFulfillOnDemand) (*MsgTryFulfillOnDemandResponse, error)
+	FulfillOrder(context.Context, *MsgFulfillOrder) (*MsgFulfillOrderResponse, error)
+	FulfillOrderPartial(context.Context, *MsgFulfillOrderPartial) (*MsgFulfillOrderPartialResponse, error)
+	FulfillOrderAuthorized(context.Context, *MsgFulfillOrderAuthorized) (*MsgFulfillOrderAuthorizedResponse, error)
+	UpdateDemandOrder(context.Context, *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error)
+	CreateOnDemandLP(context.Context, *MsgCreateOnDemandLP) (*MsgCreateOnDemandLPResponse, error)
+	DeleteOnDemandLP(context.Context, *MsgDeleteOnDemandLP) (*MsgDeleteOnDemandLPResponse, error)
+	TransferFulfilledClaim(context.Context, *MsgTransferFulfilledClaim) (*MsgTransferFulfilledClaimResponse, error)
+	ListFulfilledClaim(context.Context, *MsgListFulfilledClaim) (*MsgListFulfilledClaimResponse, error)
+	DelistFulfilledClaim(context.Context, *MsgDelistFulfilledClaim) (*MsgDelistFulfilledClaimResponse, error)
+	BuyFulfilledClaim(context.Context, *MsgBuyFulfilledClaim) (*MsgBuyFulfilledClaimResponse, error)
 }
 
-func (m *MsgFulfillOrderAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SettlementValidated {
-		i--
-		if m.SettlementValidated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	{
-		size := m.OperatorFeeShare.Size()
-		i -= size
-		if _, err := m.OperatorFeeShare.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if len(m.ExpectedFee) > 0 {
-		i -= len(m.ExpectedFee)
-		copy(dAtA[i:], m.ExpectedFee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.OperatorFeeAddress) > 0 {
-		i -= len(m.OperatorFeeAddress)
-		copy(dAtA[i:], m.OperatorFeeAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorFeeAddress)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.LpAddress) > 0 {
-		i -= len(m.LpAddress)
-		copy(dAtA[i:], m.LpAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LpAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Price) > 0 {
-		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgFulfillOrderAuthorizedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
-
-func (m *MsgFulfillOrderAuthorizedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) TryFulfillOnDemand(ctx context.Context, req *MsgTryFulfillOnDemand) (*MsgTryFulfillOnDemandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TryFulfillOnDemand not implemented")
 }
-
-func (m *MsgFulfillOrderAuthorizedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) FulfillOrder(ctx context.Context, req *MsgFulfillOrder) (*MsgFulfillOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrder not implemented")
 }
-
-func (m *MsgUpdateDemandOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) FulfillOrderPartial(ctx context.Context, req *MsgFulfillOrderPartial) (*MsgFulfillOrderPartialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrderPartial not implemented")
+}
+func (*UnimplementedMsgServer) FulfillOrderAuthorized(ctx context.Context, req *MsgFulfillOrderAuthorized) (*MsgFulfillOrderAuthorizedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrderAuthorized not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDemandOrder(ctx context.Context, req *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDemandOrder not implemented")
+}
+func (*UnimplementedMsgServer) CreateOnDemandLP(ctx context.Context, req *MsgCreateOnDemandLP) (*MsgCreateOnDemandLPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOnDemandLP not implemented")
+}
+func (*UnimplementedMsgServer) DeleteOnDemandLP(ctx context.Context, req *MsgDeleteOnDemandLP) (*MsgDeleteOnDemandLPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOnDemandLP not implemented")
+}
+func (*UnimplementedMsgServer) TransferFulfilledClaim(ctx context.Context, req *MsgTransferFulfilledClaim) (*MsgTransferFulfilledClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferFulfilledClaim not implemented")
+}
+func (*UnimplementedMsgServer) ListFulfilledClaim(ctx context.Context, req *MsgListFulfilledClaim) (*MsgListFulfilledClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFulfilledClaim not implemented")
+}
+func (*UnimplementedMsgServer) DelistFulfilledClaim(ctx context.Context, req *MsgDelistFulfilledClaim) (*MsgDelistFulfilledClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelistFulfilledClaim not implemented")
+}
+func (*UnimplementedMsgServer) BuyFulfilledClaim(ctx context.Context, req *MsgBuyFulfilledClaim) (*MsgBuyFulfilledClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyFulfilledClaim not implemented")
 }
 
-func (m *MsgUpdateDemandOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgUpdateDemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewFee) > 0 {
-		i -= len(m.NewFee)
-		copy(dAtA[i:], m.NewFee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFee)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/UpdateParams",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateDemandOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_TryFulfillOnDemand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTryFulfillOnDemand)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).TryFulfillOnDemand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/TryFulfillOnDemand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TryFulfillOnDemand(ctx, req.(*MsgTryFulfillOnDemand))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTryFulfillOnDemand) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_FulfillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillOrder)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTryFulfillOnDemand) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgTryFulfillOnDemand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Rng != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Rng))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillOrder(ctx, in)
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrder",
 	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillOrder(ctx, req.(*MsgFulfillOrder))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTryFulfillOnDemandResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_FulfillOrderPartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillOrderPartial)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTryFulfillOnDemandResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgTryFulfillOnDemandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillOrderPartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrderPartial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillOrderPartial(ctx, req.(*MsgFulfillOrderPartial))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateOnDemandLP) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_FulfillOrderAuthorized_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillOrderAuthorized)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillOrderAuthorized(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAuthorized",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillOrderAuthorized(ctx, req.(*MsgFulfillOrderAuthorized))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (m *MsgCreateOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_UpdateDemandOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDemandOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDemandOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDemandOrder(ctx, req.(*MsgUpdateDemandOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_CreateOnDemandLP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateOnDemandLP)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Lp != nil {
-		{
-			size, err := m.Lp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).CreateOnDemandLP(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/CreateOnDemandLP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateOnDemandLP(ctx, req.(*MsgCreateOnDemandLP))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateOnDemandLPResponse) Marshal() (dAtA []byte, err error) {
+func _Msg_DeleteOnDemandLP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteOnDemandLP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteOnDemandLP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/DeleteOnDemandLP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteOnDemandLP(ctx, req.(*MsgDeleteOnDemandLP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferFulfilledClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferFulfilledClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferFulfilledClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/TransferFulfilledClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferFulfilledClaim(ctx, req.(*MsgTransferFulfilledClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ListFulfilledClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgListFulfilledClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ListFulfilledClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/ListFulfilledClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ListFulfilledClaim(ctx, req.(*MsgListFulfilledClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelistFulfilledClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelistFulfilledClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelistFulfilledClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/DelistFulfilledClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelistFulfilledClaim(ctx, req.(*MsgDelistFulfilledClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuyFulfilledClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyFulfilledClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyFulfilledClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/BuyFulfilledClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyFulfilledClaim(ctx, req.(*MsgBuyFulfilledClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.eibc.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "TryFulfillOnDemand",
+			Handler:    _Msg_TryFulfillOnDemand_Handler,
+		},
+		{
+			MethodName: "FulfillOrder",
+			Handler:    _Msg_FulfillOrder_Handler,
+		},
+		{
+			MethodName: "FulfillOrderPartial",
+			Handler:    _Msg_FulfillOrderPartial_Handler,
+		},
+		{
+			MethodName: "FulfillOrderAuthorized",
+			Handler:    _Msg_FulfillOrderAuthorized_Handler,
+		},
+		{
+			MethodName: "UpdateDemandOrder",
+			Handler:    _Msg_UpdateDemandOrder_Handler,
+		},
+		{
+			MethodName: "CreateOnDemandLP",
+			Handler:    _Msg_CreateOnDemandLP_Handler,
+		},
+		{
+			MethodName: "DeleteOnDemandLP",
+			Handler:    _Msg_DeleteOnDemandLP_Handler,
+		},
+		{
+			MethodName: "TransferFulfilledClaim",
+			Handler:    _Msg_TransferFulfilledClaim_Handler,
+		},
+		{
+			MethodName: "ListFulfilledClaim",
+			Handler:    _Msg_ListFulfilledClaim_Handler,
+		},
+		{
+			MethodName: "DelistFulfilledClaim",
+			Handler:    _Msg_DelistFulfilledClaim_Handler,
+		},
+		{
+			MethodName: "BuyFulfilledClaim",
+			Handler:    _Msg_BuyFulfilledClaim_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/eibc/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1583,25 +1876,37 @@ func (m *MsgCreateOnDemandLPResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateOnDemandLPResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateOnDemandLPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+	{
+		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOnDemandLP) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1611,45 +1916,20 @@ func (m *MsgDeleteOnDemandLP) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Ids) > 0 {
-		dAtA4 := make([]byte, len(m.Ids)*10)
-		var j3 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOnDemandLPResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgFulfillOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1659,290 +1939,2064 @@ func (m *MsgDeleteOnDemandLPResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteOnDemandLPResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFulfillOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteOnDemandLPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFulfillOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.ExpectedFee) > 0 {
+		i -= len(m.ExpectedFee)
+		copy(dAtA[i:], m.ExpectedFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.NewParams.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFulfillOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgFulfillOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FulfillerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExpectedFee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgFulfillOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFulfillOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgFulfillOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFulfillOrderAuthorized) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Price) > 0 {
-		for _, e := range m.Price {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.LpAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OperatorFeeAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExpectedFee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.OperatorFeeShare.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.SettlementValidated {
-		n += 2
+func (m *MsgFulfillOrderPartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgFulfillOrderAuthorizedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgFulfillOrderPartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateDemandOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgFulfillOrderPartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ExpectedFee) > 0 {
+		i -= len(m.ExpectedFee)
+		copy(dAtA[i:], m.ExpectedFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.NewFee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateDemandOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFulfillOrderPartialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgTryFulfillOnDemand) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgFulfillOrderPartialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderPartialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Rng != 0 {
-		n += 1 + sovTx(uint64(m.Rng))
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTryFulfillOnDemandResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFulfillOrderAuthorized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateOnDemandLP) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Lp != nil {
-		l = m.Lp.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgFulfillOrderAuthorized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateOnDemandLPResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgFulfillOrderAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if m.SettlementValidated {
+		i--
+		if m.SettlementValidated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	return n
+	{
+		size := m.OperatorFeeShare.Size()
+		i -= size
+		if _, err := m.OperatorFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.ExpectedFee) > 0 {
+		i -= len(m.ExpectedFee)
+		copy(dAtA[i:], m.ExpectedFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OperatorFeeAddress) > 0 {
+		i -= len(m.OperatorFeeAddress)
+		copy(dAtA[i:], m.OperatorFeeAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorFeeAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LpAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOnDemandLP) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFulfillOrderAuthorizedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderAuthorizedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderAuthorizedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDemandOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDemandOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewFee) > 0 {
+		i -= len(m.NewFee)
+		copy(dAtA[i:], m.NewFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFee)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteOnDemandLPResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateDemandOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgTryFulfillOnDemand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgTryFulfillOnDemand) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+
+func (m *MsgTryFulfillOnDemand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rng != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Rng))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTryFulfillOnDemandResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTryFulfillOnDemandResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTryFulfillOnDemandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOnDemandLP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Lp != nil {
+		{
+			size, err := m.Lp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOnDemandLPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOnDemandLPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOnDemandLPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteOnDemandLP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA4 := make([]byte, len(m.Ids)*10)
+		var j3 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteOnDemandLPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteOnDemandLPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOnDemandLPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferFulfilledClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferFulfilledClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferFulfilledClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferFulfilledClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferFulfilledClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferFulfilledClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgListFulfilledClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgListFulfilledClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgListFulfilledClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgListFulfilledClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgListFulfilledClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgListFulfilledClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelistFulfilledClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelistFulfilledClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelistFulfilledClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelistFulfilledClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelistFulfilledClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelistFulfilledClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyFulfilledClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyFulfilledClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyFulfilledClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExpectedPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyFulfilledClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyFulfilledClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyFulfilledClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NewParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFulfillOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFulfillOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFulfillOrderPartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFulfillOrderPartialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Remaining.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFulfillOrderAuthorized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorFeeAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OperatorFeeShare.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SettlementValidated {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgFulfillOrderAuthorizedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDemandOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDemandOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTryFulfillOnDemand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Rng != 0 {
+		n += 1 + sovTx(uint64(m.Rng))
+	}
+	return n
+}
+
+func (m *MsgTryFulfillOnDemandResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateOnDemandLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lp != nil {
+		l = m.Lp.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateOnDemandLPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDeleteOnDemandLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgDeleteOnDemandLPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferFulfilledClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferFulfilledClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgListFulfilledClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgListFulfilledClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelistFulfilledClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelistFulfilledClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBuyFulfilledClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ExpectedPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBuyFulfilledClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderPartial) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderPartial: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderPartial: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderPartialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderPartialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderPartialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFeeAddress", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorFeeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1970,13 +4024,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFeeShare", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1986,25 +4040,46 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OperatorFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementValidated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SettlementValidated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2026,7 +4101,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFulfillOrderAuthorizedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2049,10 +4124,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFulfillOrderAuthorizedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFulfillOrderAuthorizedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2076,7 +4151,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2099,15 +4174,15 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDemandOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDemandOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2135,7 +4210,7 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2171,7 +4246,7 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2199,7 +4274,7 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			m.NewFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2222,7 +4297,7 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2245,10 +4320,10 @@ func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2272,7 +4347,7 @@ func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
+func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2295,10 +4370,10 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTryFulfillOnDemand: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTryFulfillOnDemand: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2335,7 +4410,7 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2363,13 +4438,13 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rng", wireType)
 			}
-			var msglen int
+			m.Rng = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2379,31 +4454,116 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rng |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = append(m.Price, types.Coin{})
-			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTryFulfillOnDemandResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTryFulfillOnDemandResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTryFulfillOnDemandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateOnDemandLP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOnDemandLP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2413,29 +4573,31 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Lp == nil {
+				m.Lp = &OnDemandLP{}
+			}
+			if err := m.Lp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2463,45 +4625,63 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFeeAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateOnDemandLPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorFeeAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOnDemandLPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOnDemandLPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2511,27 +4691,64 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteOnDemandLP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteOnDemandLP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFeeShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2559,30 +4776,84 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OperatorFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementValidated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			m.SettlementValidated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2604,7 +4875,7 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillOrderAuthorizedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteOnDemandLPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2627,10 +4898,10 @@ func (m *MsgFulfillOrderAuthorizedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrderAuthorizedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteOnDemandLPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrderAuthorizedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteOnDemandLPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2654,7 +4925,7 @@ func (m *MsgFulfillOrderAuthorizedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferFulfilledClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2677,15 +4948,15 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferFulfilledClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferFulfilledClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2713,7 +4984,7 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2749,7 +5020,7 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2777,7 +5048,7 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFee = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2800,7 +5071,7 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferFulfilledClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2823,10 +5094,10 @@ func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferFulfilledClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferFulfilledClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2850,7 +5121,7 @@ func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
+func (m *MsgListFulfilledClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2873,15 +5144,15 @@ func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTryFulfillOnDemand: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgListFulfilledClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTryFulfillOnDemand: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgListFulfilledClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2909,11 +5180,11 @@ func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2941,13 +5212,13 @@ func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rng", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.Rng = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2957,11 +5228,25 @@ func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rng |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2983,7 +5268,7 @@ func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTryFulfillOnDemandResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgListFulfilledClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3006,10 +5291,10 @@ func (m *MsgTryFulfillOnDemandResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTryFulfillOnDemandResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgListFulfilledClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTryFulfillOnDemandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgListFulfilledClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3033,7 +5318,7 @@ func (m *MsgTryFulfillOnDemandResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOnDemandLP) Unmarshal(dAtA []byte) error {
+func (m *MsgDelistFulfilledClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3056,17 +5341,17 @@ func (m *MsgCreateOnDemandLP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOnDemandLP: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelistFulfilledClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelistFulfilledClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3076,31 +5361,27 @@ func (m *MsgCreateOnDemandLP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Lp == nil {
-				m.Lp = &OnDemandLP{}
-			}
-			if err := m.Lp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3128,7 +5409,7 @@ func (m *MsgCreateOnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3151,7 +5432,7 @@ func (m *MsgCreateOnDemandLP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOnDemandLPResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelistFulfilledClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3174,31 +5455,12 @@ func (m *MsgCreateOnDemandLPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOnDemandLPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelistFulfilledClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOnDemandLPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelistFulfilledClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3220,7 +5482,7 @@ func (m *MsgCreateOnDemandLPResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteOnDemandLP) Unmarshal(dAtA []byte) error {
+func (m *MsgBuyFulfilledClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3243,15 +5505,15 @@ func (m *MsgDeleteOnDemandLP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteOnDemandLP: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBuyFulfilledClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBuyFulfilledClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3279,84 +5541,105 @@ func (m *MsgDeleteOnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpectedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3378,7 +5661,7 @@ func (m *MsgDeleteOnDemandLP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteOnDemandLPResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBuyFulfilledClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3401,10 +5684,10 @@ func (m *MsgDeleteOnDemandLPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteOnDemandLPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBuyFulfilledClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteOnDemandLPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBuyFulfilledClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
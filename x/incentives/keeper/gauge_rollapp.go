@@ -41,6 +41,12 @@ func (k Keeper) calculateRollappGaugeRewards(ctx sdk.Context, gauge types.Gauge,
 		return sdk.Coins{}, fmt.Errorf("gauge %d: rollapp %s not found", gauge.Id, gauge.GetRollapp().RollappId)
 	}
 
+	// paused and retired rollapps are not rewarded, the coins stay in the gauge
+	if !rollapp.IsActive() {
+		ctx.Logger().Debug("rollapp is paused or retired, skipping")
+		return sdk.Coins{}, nil
+	}
+
 	// if we are in active only mode, endorse only active rollapps
 	// - proposer is not sentinel
 	// - proposer is not kickable (the rollapp is active)
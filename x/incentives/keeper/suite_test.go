@@ -245,4 +245,7 @@ func (suite *KeeperTestSuite) TransferRollappOwnership(currentOwner, newOwner sd
 	})
 	suite.Require().NoError(err)
 	suite.Require().NotNil(resp)
+
+	_, err = rollappMsgServer.AcceptOwnership(suite.Ctx, rollapptypes.NewMsgAcceptOwnership(newOwner.String(), rollappID))
+	suite.Require().NoError(err)
 }
@@ -14,6 +14,7 @@ import (
 
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
 	lockuptypes "github.com/dymensionxyz/dymension/v3/x/lockup/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
 // AfterTransfersEnabled called by the genesis transfer IBC module when a transfer is handled
@@ -22,6 +23,26 @@ func (k Keeper) AfterTransfersEnabled(ctx sdk.Context, rollappId, rollappIBCDeno
 	return k.Settle(ctx, rollappId, rollappIBCDenom)
 }
 
+// AfterLifecycleStateChanged is a rollapp module hook. The plan of a sunsetting rollapp will never settle, so
+// it is closed: buying is disabled, while the holders can still sell their tokens back to the plan.
+func (k Keeper) AfterLifecycleStateChanged(ctx sdk.Context, rollappId string, _, to rollapptypes.Rollapp_LifecycleState) error {
+	if to != rollapptypes.Rollapp_SUNSETTING {
+		return nil
+	}
+	plan, found := k.GetPlanByRollapp(ctx, rollappId)
+	if !found || plan.IsSettled() || plan.Closed {
+		return nil
+	}
+
+	plan.Closed = true
+	k.SetPlan(ctx, plan)
+
+	return uevent.EmitTypedEvent(ctx, &types.EventClosePlan{
+		PlanId:    fmt.Sprintf("%d", plan.Id),
+		RollappId: rollappId,
+	})
+}
+
 // Settle settles the iro plan with the given rollappId
 //
 // This function performs the following steps:
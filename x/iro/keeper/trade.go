@@ -54,6 +54,9 @@ func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amount
 	if err != nil {
 		return err
 	}
+	if plan.Closed {
+		return errorsmod.Wrapf(types.ErrPlanClosed, "planId: %d", plan.Id)
+	}
 
 	// validate the IRO have enough tokens to sell
 	if plan.SoldAmt.Add(amountTokensToBuy).GT(plan.MaxAmountToSell) {
@@ -120,6 +123,9 @@ func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddre
 	if err != nil {
 		return err
 	}
+	if plan.Closed {
+		return errorsmod.Wrapf(types.ErrPlanClosed, "planId: %d", plan.Id)
+	}
 
 	// deduct taker fee from the amount to spend
 	toSpendMinusTakerFeeAmt, takerFeeAmt, err := k.ApplyTakerFee(amountToSpend, k.GetParams(ctx).TakerFee, false)
@@ -28,4 +28,5 @@ var (
 	ErrInsufficientTokens           = errorsmod.Register(ModuleName, 1118, "insufficient tokens")
 	ErrRollappGenesisInfoNotSet     = errorsmod.Register(ModuleName, 1119, "rollapp genesis info not set")
 	ErrInvalidIncentivePlanParams   = errorsmod.Register(ModuleName, 1120, "invalid incentive plan params")
+	ErrPlanClosed                   = errorsmod.Register(ModuleName, 1121, "plan is closed")
 )
@@ -539,6 +539,58 @@ func (m *EventSettle) GetGaugeId() uint64 {
 	return 0
 }
 
+type EventClosePlan struct {
+	PlanId    string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *EventClosePlan) Reset()         { *m = EventClosePlan{} }
+func (m *EventClosePlan) String() string { return proto.CompactTextString(m) }
+func (*EventClosePlan) ProtoMessage()    {}
+func (*EventClosePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7833031285167c, []int{7}
+}
+func (m *EventClosePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClosePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClosePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClosePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClosePlan.Merge(m, src)
+}
+func (m *EventClosePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClosePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClosePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClosePlan proto.InternalMessageInfo
+
+func (m *EventClosePlan) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *EventClosePlan) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventUpdateParams)(nil), "dymensionxyz.dymension.iro.EventUpdateParams")
 	proto.RegisterType((*EventNewIROPlan)(nil), "dymensionxyz.dymension.iro.EventNewIROPlan")
@@ -547,6 +599,7 @@ func init() {
 	proto.RegisterType((*EventClaim)(nil), "dymensionxyz.dymension.iro.EventClaim")
 	proto.RegisterType((*EventClaimVested)(nil), "dymensionxyz.dymension.iro.EventClaimVested")
 	proto.RegisterType((*EventSettle)(nil), "dymensionxyz.dymension.iro.EventSettle")
+	proto.RegisterType((*EventClosePlan)(nil), "dymensionxyz.dymension.iro.EventClosePlan")
 }
 
 func init() {
@@ -554,54 +607,55 @@ func init() {
 }
 
 var fileDescriptor_9d7833031285167c = []byte{
-	// 740 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x4d, 0x4f, 0xd4, 0x5c,
-	0x14, 0x9e, 0x0e, 0xf3, 0xd5, 0xc3, 0xcb, 0xfb, 0xd1, 0xf0, 0xe6, 0x1d, 0x20, 0xef, 0x40, 0x26,
-	0x26, 0x92, 0x18, 0x5a, 0x3e, 0xa2, 0xc6, 0xe8, 0x86, 0x19, 0x94, 0xd4, 0x18, 0x25, 0x25, 0xb2,
-	0x70, 0x33, 0xb9, 0xd3, 0x1e, 0x4a, 0x43, 0x7b, 0x6f, 0xd3, 0xde, 0x0e, 0x8c, 0x89, 0xff, 0xc1,
-	0x7f, 0xe2, 0x86, 0x1f, 0xc1, 0x92, 0xb0, 0x32, 0x26, 0x12, 0x03, 0x3b, 0x97, 0x2e, 0xdc, 0x6a,
-	0xee, 0xed, 0x1d, 0x20, 0x1a, 0x60, 0x64, 0x61, 0x74, 0x37, 0xa7, 0xe7, 0x39, 0xcf, 0x79, 0xce,
-	0x73, 0xcf, 0x9d, 0x0b, 0x37, 0xbd, 0x7e, 0x84, 0x34, 0x0d, 0x18, 0xdd, 0xed, 0xbf, 0xb4, 0x4e,
-	0x03, 0x2b, 0x48, 0x98, 0x85, 0x3d, 0xa4, 0x3c, 0x35, 0xe3, 0x84, 0x71, 0x66, 0x4c, 0x9e, 0x07,
-	0x9a, 0xa7, 0x81, 0x19, 0x24, 0x6c, 0x72, 0xdc, 0x67, 0x3e, 0x93, 0x30, 0x4b, 0xfc, 0xca, 0x2b,
-	0x26, 0x27, 0x5c, 0x96, 0x46, 0x2c, 0xed, 0xe4, 0x89, 0x3c, 0x50, 0xa9, 0x69, 0x9f, 0x31, 0x3f,
-	0x44, 0x4b, 0x46, 0xdd, 0x6c, 0xd3, 0xe2, 0x41, 0x84, 0x29, 0x27, 0x51, 0xac, 0x00, 0x8d, 0x1c,
-	0x6e, 0x75, 0x49, 0x8a, 0x56, 0x6f, 0xa1, 0x8b, 0x9c, 0x2c, 0x58, 0x2e, 0x0b, 0xa8, 0xca, 0xdf,
-	0xb8, 0x44, 0x76, 0x90, 0x0c, 0x14, 0x5c, 0x36, 0x5c, 0x4c, 0x12, 0x12, 0x29, 0x3d, 0xcd, 0xf7,
-	0x1a, 0xfc, 0xf3, 0x50, 0x4c, 0xfb, 0x3c, 0xf6, 0x08, 0xc7, 0x35, 0x99, 0x33, 0xee, 0x80, 0x4e,
-	0x32, 0xbe, 0xc5, 0x92, 0x80, 0xf7, 0xeb, 0xda, 0x8c, 0x36, 0xab, 0xb7, 0xea, 0x87, 0x7b, 0x73,
-	0xe3, 0x6a, 0x94, 0x65, 0xcf, 0x4b, 0x30, 0x4d, 0xd7, 0x79, 0x12, 0x50, 0xdf, 0x39, 0x83, 0x1a,
-	0xab, 0x00, 0x14, 0x77, 0x3a, 0x79, 0x87, 0x7a, 0x71, 0x46, 0x9b, 0x1d, 0x5d, 0x6c, 0x9a, 0x17,
-	0xfb, 0x67, 0xe6, 0xfd, 0x5a, 0xa5, 0xfd, 0xa3, 0xe9, 0x82, 0xa3, 0x53, 0xdc, 0x51, 0x02, 0x56,
-	0x01, 0x58, 0xe8, 0x0d, 0x88, 0x46, 0x7e, 0x94, 0x88, 0x85, 0x5e, 0xfe, 0xa1, 0xf9, 0x0a, 0xfe,
-	0x92, 0xe3, 0x3d, 0xc5, 0x1d, 0xdb, 0x79, 0xb6, 0x16, 0x12, 0x6a, 0x2c, 0x42, 0xd5, 0x4d, 0x90,
-	0x70, 0x96, 0x5c, 0x39, 0xda, 0x00, 0x68, 0xfc, 0x07, 0xd5, 0x38, 0x24, 0xb4, 0x13, 0x78, 0x72,
-	0x2a, 0xdd, 0xa9, 0x88, 0xd0, 0xf6, 0x8c, 0xff, 0x01, 0x12, 0x16, 0x86, 0x24, 0x8e, 0x45, 0x6e,
-	0x44, 0xe6, 0x74, 0xf5, 0xc5, 0xf6, 0x9a, 0x9f, 0x8b, 0x50, 0x93, 0xfd, 0x5b, 0x59, 0xdf, 0x30,
-	0xa1, 0xdc, 0xcd, 0xfa, 0x78, 0x75, 0xdb, 0x1c, 0x76, 0xdd, 0xa6, 0xc6, 0x5d, 0xa8, 0x90, 0x88,
-	0x65, 0x94, 0xd7, 0x4b, 0xd2, 0xb8, 0x09, 0x53, 0x75, 0x11, 0x3b, 0x65, 0xaa, 0x9d, 0x32, 0xdb,
-	0x2c, 0xa0, 0xca, 0x2f, 0x05, 0x37, 0x96, 0xa0, 0xe4, 0xb2, 0x94, 0xd7, 0xcb, 0xc3, 0x95, 0x49,
-	0xb0, 0xf1, 0x00, 0x74, 0x4e, 0xb6, 0x31, 0xe9, 0x6c, 0x22, 0xd6, 0x2b, 0xc3, 0x55, 0xd6, 0x64,
-	0xc5, 0x23, 0x44, 0x63, 0x03, 0xc6, 0xdc, 0x90, 0xa5, 0x01, 0xf5, 0x3b, 0x71, 0x12, 0xb8, 0x58,
-	0xaf, 0x4a, 0x6f, 0x16, 0x04, 0xec, 0xdd, 0xd1, 0xf4, 0x54, 0x4e, 0x94, 0x7a, 0xdb, 0x66, 0xc0,
-	0xac, 0x88, 0xf0, 0x2d, 0xf3, 0x09, 0xfa, 0xc4, 0xed, 0xaf, 0xa0, 0x7b, 0xb8, 0x37, 0x07, 0xaa,
-	0xcf, 0x0a, 0xba, 0xce, 0x1f, 0x8a, 0x67, 0x4d, 0xd0, 0x34, 0xbf, 0x14, 0x41, 0x97, 0xc6, 0xaf,
-	0x63, 0x18, 0x1a, 0xf3, 0x50, 0x49, 0x31, 0x0c, 0x87, 0xb0, 0x5e, 0xe1, 0x7e, 0xbe, 0xf7, 0xf7,
-	0xa0, 0x9a, 0x88, 0xbf, 0x9d, 0x0c, 0x87, 0xb5, 0x7f, 0x80, 0xff, 0x45, 0x4f, 0xe0, 0x8d, 0x06,
-	0x20, 0x4f, 0xa0, 0x1d, 0x92, 0x20, 0x92, 0xb7, 0x4e, 0xfc, 0xc0, 0x61, 0x6e, 0x5d, 0x0e, 0xbc,
-	0xf6, 0x21, 0xdc, 0x86, 0xb2, 0xa4, 0x18, 0xf6, 0x0c, 0x72, 0x74, 0xf3, 0x93, 0x06, 0x7f, 0x9f,
-	0x29, 0xde, 0xc0, 0x94, 0xa3, 0xf7, 0x1b, 0xe8, 0x36, 0xee, 0x43, 0x2d, 0xa3, 0x3d, 0x29, 0x77,
-	0xd8, 0xdd, 0x39, 0x2d, 0x68, 0x7e, 0xd4, 0x60, 0x54, 0x5d, 0x14, 0xce, 0x43, 0x3c, 0xaf, 0x5d,
-	0xbb, 0x44, 0x7b, 0xf1, 0x5b, 0xed, 0x53, 0xa0, 0xdb, 0xad, 0x76, 0xc7, 0x43, 0xca, 0x22, 0x35,
-	0x59, 0xcd, 0x6e, 0xb5, 0x57, 0x44, 0x2c, 0x49, 0x19, 0x0b, 0x45, 0xa1, 0x18, 0xad, 0xe4, 0x54,
-	0x44, 0x68, 0x7b, 0xc6, 0x04, 0xd4, 0x7c, 0x92, 0xf9, 0x28, 0x32, 0x65, 0x99, 0xa9, 0xca, 0xd8,
-	0xf6, 0x0c, 0x07, 0xfe, 0x14, 0x12, 0xc5, 0x5e, 0xaa, 0x1b, 0x55, 0x91, 0xfe, 0xdf, 0x52, 0x8b,
-	0xf9, 0xef, 0xf7, 0x8b, 0x69, 0x53, 0x7e, 0x6e, 0x25, 0x6d, 0xca, 0x9d, 0x31, 0x45, 0xb1, 0x2c,
-	0x19, 0x5a, 0x8f, 0xf7, 0x8f, 0x1b, 0xda, 0xc1, 0x71, 0x43, 0xfb, 0x70, 0xdc, 0xd0, 0x5e, 0x9f,
-	0x34, 0x0a, 0x07, 0x27, 0x8d, 0xc2, 0xdb, 0x93, 0x46, 0xe1, 0xc5, 0xbc, 0x1f, 0xf0, 0xad, 0xac,
-	0x6b, 0xba, 0x2c, 0xb2, 0x2e, 0x78, 0x3b, 0x7b, 0x4b, 0xd6, 0xae, 0x7c, 0x40, 0x79, 0x3f, 0xc6,
-	0xb4, 0x5b, 0x91, 0x0f, 0xe8, 0xd2, 0xd7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x83, 0x0c, 0x07, 0x86,
-	0x48, 0x08, 0x00, 0x00,
+	// 753 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcd, 0x4e, 0xdc, 0x48,
+	0x10, 0x1e, 0x0f, 0xc3, 0xcc, 0xb8, 0x58, 0xd8, 0x5d, 0x8b, 0xd5, 0x0e, 0xa0, 0x1d, 0x90, 0xb5,
+	0xd2, 0x22, 0xad, 0xb0, 0xf9, 0xd1, 0xee, 0x6a, 0xb5, 0x7b, 0x61, 0x86, 0x84, 0x38, 0x8a, 0x12,
+	0x64, 0x14, 0x0e, 0xb9, 0x8c, 0x7a, 0xec, 0xc2, 0xb4, 0xb0, 0xbb, 0x2d, 0xbb, 0x3d, 0x30, 0x91,
+	0xf2, 0x0e, 0x79, 0x93, 0x5c, 0x78, 0x08, 0x8e, 0x88, 0x53, 0x14, 0x29, 0x28, 0x82, 0x5b, 0x8e,
+	0x39, 0xe4, 0x9a, 0xc8, 0xed, 0x1e, 0x40, 0x89, 0x80, 0x09, 0x87, 0x28, 0xb9, 0xb9, 0xba, 0xbe,
+	0xaa, 0xfa, 0xea, 0xab, 0x6a, 0x37, 0xfc, 0xe1, 0xf7, 0x23, 0x64, 0x29, 0xe5, 0x6c, 0xbf, 0xff,
+	0xd4, 0x3e, 0x37, 0x6c, 0x9a, 0x70, 0x1b, 0x7b, 0xc8, 0x44, 0x6a, 0xc5, 0x09, 0x17, 0xdc, 0x98,
+	0xbe, 0x0c, 0xb4, 0xce, 0x0d, 0x8b, 0x26, 0x7c, 0x7a, 0x32, 0xe0, 0x01, 0x97, 0x30, 0x3b, 0xff,
+	0x2a, 0x22, 0xa6, 0xa7, 0x3c, 0x9e, 0x46, 0x3c, 0xed, 0x14, 0x8e, 0xc2, 0x50, 0xae, 0xd9, 0x80,
+	0xf3, 0x20, 0x44, 0x5b, 0x5a, 0xdd, 0x6c, 0xdb, 0x16, 0x34, 0xc2, 0x54, 0x90, 0x28, 0x56, 0x80,
+	0x66, 0x01, 0xb7, 0xbb, 0x24, 0x45, 0xbb, 0xb7, 0xd4, 0x45, 0x41, 0x96, 0x6c, 0x8f, 0x53, 0xa6,
+	0xfc, 0xbf, 0x5f, 0x43, 0x9b, 0x26, 0x03, 0x06, 0xd7, 0x35, 0x17, 0x93, 0x84, 0x44, 0x8a, 0x8f,
+	0xf9, 0x5a, 0x83, 0x9f, 0xef, 0xe4, 0xdd, 0x3e, 0x8e, 0x7d, 0x22, 0x70, 0x43, 0xfa, 0x8c, 0xbf,
+	0x41, 0x27, 0x99, 0xd8, 0xe1, 0x09, 0x15, 0xfd, 0x86, 0x36, 0xa7, 0xcd, 0xeb, 0xad, 0xc6, 0xf1,
+	0xc1, 0xc2, 0xa4, 0x6a, 0x65, 0xd5, 0xf7, 0x13, 0x4c, 0xd3, 0x4d, 0x91, 0x50, 0x16, 0xb8, 0x17,
+	0x50, 0x63, 0x1d, 0x80, 0xe1, 0x5e, 0xa7, 0xa8, 0xd0, 0x28, 0xcf, 0x69, 0xf3, 0x63, 0xcb, 0xa6,
+	0x75, 0xb5, 0x7e, 0x56, 0x51, 0xaf, 0x55, 0x39, 0x3c, 0x99, 0x2d, 0xb9, 0x3a, 0xc3, 0x3d, 0x45,
+	0x60, 0x1d, 0x80, 0x87, 0xfe, 0x20, 0xd1, 0xc8, 0x97, 0x26, 0xe2, 0xa1, 0x5f, 0x1c, 0x98, 0xcf,
+	0xe0, 0x47, 0xd9, 0xde, 0x43, 0xdc, 0x73, 0xdc, 0x47, 0x1b, 0x21, 0x61, 0xc6, 0x32, 0xd4, 0xbc,
+	0x04, 0x89, 0xe0, 0xc9, 0x8d, 0xad, 0x0d, 0x80, 0xc6, 0xaf, 0x50, 0x8b, 0x43, 0xc2, 0x3a, 0xd4,
+	0x97, 0x5d, 0xe9, 0x6e, 0x35, 0x37, 0x1d, 0xdf, 0xf8, 0x0d, 0x20, 0xe1, 0x61, 0x48, 0xe2, 0x38,
+	0xf7, 0x8d, 0x48, 0x9f, 0xae, 0x4e, 0x1c, 0xdf, 0x7c, 0x5f, 0x86, 0xba, 0xac, 0xdf, 0xca, 0xfa,
+	0x86, 0x05, 0xa3, 0xdd, 0xac, 0x8f, 0x37, 0x97, 0x2d, 0x60, 0xb7, 0x2d, 0x6a, 0xfc, 0x03, 0x55,
+	0x12, 0xf1, 0x8c, 0x89, 0x46, 0x45, 0x0a, 0x37, 0x65, 0xa9, 0x2a, 0xf9, 0x4e, 0x59, 0x6a, 0xa7,
+	0xac, 0x36, 0xa7, 0x4c, 0xe9, 0xa5, 0xe0, 0xc6, 0x0a, 0x54, 0x3c, 0x9e, 0x8a, 0xc6, 0xe8, 0x70,
+	0x61, 0x12, 0x6c, 0xfc, 0x0f, 0xba, 0x20, 0xbb, 0x98, 0x74, 0xb6, 0x11, 0x1b, 0xd5, 0xe1, 0x22,
+	0xeb, 0x32, 0xe2, 0x2e, 0xa2, 0xb1, 0x05, 0xe3, 0x5e, 0xc8, 0x53, 0xca, 0x82, 0x4e, 0x9c, 0x50,
+	0x0f, 0x1b, 0x35, 0xa9, 0xcd, 0x52, 0x0e, 0x7b, 0x75, 0x32, 0x3b, 0x53, 0x24, 0x4a, 0xfd, 0x5d,
+	0x8b, 0x72, 0x3b, 0x22, 0x62, 0xc7, 0x7a, 0x80, 0x01, 0xf1, 0xfa, 0x6b, 0xe8, 0x1d, 0x1f, 0x2c,
+	0x80, 0xaa, 0xb3, 0x86, 0x9e, 0xfb, 0x83, 0xca, 0xb3, 0x91, 0xa7, 0x31, 0x3f, 0x94, 0x41, 0x97,
+	0xc2, 0x6f, 0x62, 0x18, 0x1a, 0x8b, 0x50, 0x4d, 0x31, 0x0c, 0x87, 0x90, 0x5e, 0xe1, 0xbe, 0xbe,
+	0xf6, 0xff, 0x42, 0x2d, 0xc9, 0x7f, 0x3b, 0x19, 0x0e, 0x2b, 0xff, 0x00, 0xff, 0x8d, 0x4e, 0xe0,
+	0x85, 0x06, 0x20, 0x27, 0xd0, 0x0e, 0x09, 0x8d, 0xe4, 0xad, 0xcb, 0x3f, 0x70, 0x98, 0x5b, 0x57,
+	0x00, 0x6f, 0x3d, 0x84, 0xbf, 0x60, 0x54, 0xa6, 0x18, 0x76, 0x06, 0x05, 0xda, 0x7c, 0xa7, 0xc1,
+	0x4f, 0x17, 0x8c, 0xb7, 0x30, 0x15, 0xe8, 0x7f, 0x07, 0xbc, 0x8d, 0xff, 0xa0, 0x9e, 0xb1, 0x9e,
+	0xa4, 0x3b, 0xec, 0xee, 0x9c, 0x07, 0x98, 0x6f, 0x35, 0x18, 0x53, 0x17, 0x45, 0x88, 0x10, 0x2f,
+	0x73, 0xd7, 0xae, 0xe1, 0x5e, 0xfe, 0x94, 0xfb, 0x0c, 0xe8, 0x4e, 0xab, 0xdd, 0xf1, 0x91, 0xf1,
+	0x48, 0x75, 0x56, 0x77, 0x5a, 0xed, 0xb5, 0xdc, 0x96, 0x49, 0x39, 0x0f, 0xf3, 0xc0, 0xbc, 0xb5,
+	0x8a, 0x5b, 0xcd, 0x4d, 0xc7, 0x37, 0xa6, 0xa0, 0x1e, 0x90, 0x2c, 0xc0, 0x0e, 0x2d, 0xa8, 0x57,
+	0xdc, 0x9a, 0xb4, 0x1d, 0xdf, 0x70, 0x61, 0x22, 0xa7, 0x98, 0xef, 0xa5, 0xba, 0x51, 0x55, 0xa9,
+	0xff, 0x9f, 0x6a, 0x31, 0x7f, 0xf9, 0x7c, 0x31, 0x1d, 0x26, 0x2e, 0xad, 0xa4, 0xc3, 0x84, 0x3b,
+	0xae, 0x52, 0xac, 0xca, 0x0c, 0xe6, 0x3d, 0x98, 0x50, 0x03, 0xe6, 0x29, 0xca, 0xc7, 0xe0, 0x96,
+	0xed, 0xb6, 0xee, 0x1f, 0x9e, 0x36, 0xb5, 0xa3, 0xd3, 0xa6, 0xf6, 0xe6, 0xb4, 0xa9, 0x3d, 0x3f,
+	0x6b, 0x96, 0x8e, 0xce, 0x9a, 0xa5, 0x97, 0x67, 0xcd, 0xd2, 0x93, 0xc5, 0x80, 0x8a, 0x9d, 0xac,
+	0x6b, 0x79, 0x3c, 0xb2, 0xaf, 0x78, 0x85, 0x7b, 0x2b, 0xf6, 0xbe, 0x7c, 0x8a, 0x45, 0x3f, 0xc6,
+	0xb4, 0x5b, 0x95, 0x4f, 0xf1, 0xca, 0xc7, 0x01, 0x00, 0xb3, 0xc7, 0x7f, 0xa4, 0x92, 0x08, 0x00,
+	0x00,
 }
 
 func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
@@ -1048,6 +1102,43 @@ func (m *EventSettle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventClosePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClosePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClosePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1232,6 +1323,23 @@ func (m *EventSettle) Size() (n int) {
 	return n
 }
 
+func (m *EventClosePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2699,6 +2807,120 @@ func (m *EventSettle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventClosePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClosePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClosePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
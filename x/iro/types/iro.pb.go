@@ -128,6 +128,9 @@ type Plan struct {
 	IroPlanDuration time.Duration `protobuf:"bytes,16,opt,name=iro_plan_duration,json=iroPlanDuration,proto3,stdduration" json:"iro_plan_duration"`
 	// the denom used for raising liquidity
 	LiquidityDenom string `protobuf:"bytes,17,opt,name=liquidity_denom,json=liquidityDenom,proto3" json:"liquidity_denom,omitempty"`
+	// closed is set when the rollapp starts its sunset. The plan will never
+	// settle, so buying is disabled while holders can still sell.
+	Closed bool `protobuf:"varint,18,opt,name=closed,proto3" json:"closed,omitempty"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
@@ -254,6 +257,13 @@ func (m *Plan) GetLiquidityDenom() string {
 	return ""
 }
 
+func (m *Plan) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
 type IncentivePlanParams struct {
 	// start_time_after_settlement is the time after IRO settlement when the
 	// distribution of the remaining tokens as incentives will start
@@ -366,71 +376,71 @@ func init() {
 }
 
 var fileDescriptor_e7d27cc6b5064d3f = []byte{
-	// 1009 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3b, 0x6f, 0x1b, 0x47,
-	0x17, 0xd5, 0x4a, 0xb4, 0x1e, 0x23, 0x89, 0x94, 0xc6, 0xb2, 0xbf, 0xb5, 0x8c, 0x8f, 0x14, 0xe8,
-	0x00, 0x16, 0x12, 0x78, 0x37, 0xb2, 0x53, 0x04, 0x6e, 0x0c, 0x8a, 0x92, 0x01, 0x19, 0xb2, 0x25,
-	0xac, 0x8c, 0xc0, 0x48, 0xb3, 0x18, 0xee, 0x8c, 0xa9, 0x41, 0xe6, 0xb1, 0x99, 0x9d, 0x25, 0xc4,
-	0xb4, 0x69, 0x52, 0xba, 0x4c, 0x99, 0x3a, 0xb5, 0xff, 0x40, 0x3a, 0x97, 0x86, 0xab, 0x20, 0x85,
-	0x12, 0x48, 0xff, 0x20, 0x4d, 0xda, 0x60, 0x1e, 0xa4, 0x24, 0x3a, 0x96, 0x43, 0x21, 0x05, 0x01,
-	0xce, 0xbd, 0x73, 0xce, 0xdd, 0xb9, 0xf7, 0x9c, 0xd9, 0x05, 0x9f, 0xe0, 0x3e, 0x27, 0xa2, 0xa0,
-	0x52, 0x1c, 0xf5, 0xbf, 0x8b, 0x87, 0x8b, 0x98, 0x2a, 0x69, 0x7e, 0x51, 0xae, 0xa4, 0x96, 0x70,
-	0xf5, 0xfc, 0xae, 0x68, 0xb8, 0x88, 0xa8, 0x92, 0xab, 0x2b, 0x5d, 0xd9, 0x95, 0x76, 0x5b, 0x6c,
-	0xfe, 0x39, 0xc4, 0x6a, 0xa3, 0x2b, 0x65, 0x97, 0x91, 0xd8, 0xae, 0x3a, 0xe5, 0xcb, 0x58, 0x53,
-	0x4e, 0x0a, 0x8d, 0x78, 0xee, 0x37, 0xd4, 0x47, 0x37, 0xe0, 0x52, 0x21, 0x6d, 0x48, 0x7d, 0x3e,
-	0x93, 0x05, 0x97, 0x45, 0xdc, 0x41, 0x05, 0x89, 0x7b, 0x1b, 0x1d, 0xa2, 0xd1, 0x46, 0x9c, 0x49,
-	0x3a, 0xc8, 0xdf, 0x72, 0xf9, 0xd4, 0x55, 0x76, 0x0b, 0x9f, 0xba, 0x7b, 0xc9, 0x99, 0x72, 0xa4,
-	0x10, 0xf7, 0x1b, 0x9b, 0xbf, 0x4c, 0x82, 0x85, 0x4d, 0x29, 0x30, 0x15, 0xdd, 0x76, 0xa9, 0x7a,
-	0x04, 0x3e, 0x02, 0xc1, 0xd3, 0x30, 0x58, 0x0b, 0xd6, 0xe7, 0x36, 0x37, 0xde, 0x1c, 0x37, 0x26,
-	0x7e, 0x3b, 0x6e, 0xdc, 0x76, 0xd4, 0x05, 0xfe, 0x26, 0xa2, 0x32, 0xe6, 0x48, 0x1f, 0x46, 0xbb,
-	0xa4, 0x8b, 0xb2, 0xfe, 0x16, 0xc9, 0xde, 0xbd, 0xbe, 0x07, 0x7c, 0xe5, 0x2d, 0x92, 0x25, 0xc1,
-	0x53, 0x43, 0xf0, 0x2c, 0x9c, 0xbc, 0x32, 0xc1, 0x33, 0x43, 0xd0, 0x0e, 0xa7, 0xae, 0x4c, 0xd0,
-	0x86, 0x5f, 0x80, 0x9b, 0x4a, 0x32, 0x86, 0xf2, 0x3c, 0xc5, 0x44, 0x48, 0x9e, 0x62, 0x92, 0x51,
-	0x8e, 0x58, 0x11, 0x56, 0xd6, 0x82, 0xf5, 0x4a, 0xb2, 0xe2, 0xb3, 0x5b, 0x26, 0xb9, 0xe5, 0x73,
-	0xf0, 0x4b, 0x10, 0x32, 0xfa, 0x6d, 0x49, 0x31, 0xd5, 0xfd, 0x51, 0xdc, 0x35, 0x8b, 0xbb, 0x39,
-	0xcc, 0x5f, 0x40, 0x36, 0xbf, 0x9f, 0x03, 0x95, 0x7d, 0x86, 0x04, 0xac, 0x82, 0x49, 0x8a, 0x6d,
-	0xf3, 0x2a, 0xc9, 0x24, 0xc5, 0xf0, 0xff, 0x00, 0x0c, 0x1e, 0x84, 0x62, 0xd7, 0x93, 0x64, 0xce,
-	0x47, 0x76, 0x30, 0x7c, 0x0c, 0x20, 0x97, 0xb8, 0x64, 0x24, 0x45, 0x59, 0x96, 0x22, 0x8c, 0x15,
-	0x29, 0x0a, 0x7f, 0xf2, 0xf0, 0xdd, 0xeb, 0x7b, 0x2b, 0xfe, 0x58, 0x2d, 0x97, 0x39, 0xd0, 0x8a,
-	0x8a, 0x6e, 0xb2, 0xe4, 0x30, 0xad, 0x2c, 0xf3, 0x71, 0xf8, 0x04, 0x2c, 0x69, 0xa9, 0x11, 0x4b,
-	0x11, 0x63, 0x32, 0xb3, 0x0a, 0xb2, 0x27, 0x9d, 0xbf, 0x7f, 0x2b, 0xf2, 0x14, 0x46, 0x42, 0x91,
-	0x97, 0x50, 0xd4, 0x96, 0x54, 0x6c, 0x56, 0x4c, 0x6b, 0x93, 0x9a, 0x05, 0xb6, 0x86, 0x38, 0x78,
-	0x00, 0x16, 0x3b, 0x4e, 0x0e, 0x69, 0x66, 0xf4, 0x60, 0x8f, 0x3e, 0x7f, 0x7f, 0x3d, 0xfa, 0xb0,
-	0xfc, 0xa3, 0xf3, 0xfa, 0xf1, 0xbc, 0x0b, 0x9d, 0xf3, 0x9a, 0xba, 0x03, 0x16, 0x0b, 0xa2, 0x35,
-	0x23, 0xd8, 0x35, 0x36, 0x9c, 0xb6, 0xad, 0x58, 0xf0, 0x41, 0xdb, 0x4d, 0xd8, 0x06, 0xa0, 0xd0,
-	0x48, 0xe9, 0xd4, 0xd8, 0x24, 0x9c, 0xb1, 0x65, 0x57, 0x23, 0x67, 0x91, 0x68, 0x60, 0x91, 0xe8,
-	0xf9, 0xc0, 0x43, 0x9b, 0xb3, 0xa6, 0xd0, 0xab, 0xdf, 0x1b, 0x41, 0x32, 0x67, 0x71, 0x26, 0x03,
-	0x77, 0x41, 0x2d, 0x57, 0x24, 0x65, 0xa8, 0x14, 0xd9, 0xa1, 0x63, 0x9a, 0x1d, 0x83, 0x69, 0x31,
-	0x57, 0x64, 0xd7, 0x62, 0x2d, 0xdb, 0x63, 0x30, 0x5b, 0x48, 0x86, 0x53, 0xc4, 0x75, 0x38, 0x67,
-	0xc7, 0xf2, 0x99, 0x17, 0xe4, 0x8d, 0xf7, 0x05, 0xb9, 0x23, 0xf4, 0x39, 0x29, 0xee, 0x08, 0x9d,
-	0xcc, 0x18, 0x70, 0x8b, 0x6b, 0xb8, 0x0b, 0xe6, 0x33, 0x86, 0x28, 0x27, 0x8e, 0x0a, 0x8c, 0x4f,
-	0x05, 0x3c, 0xde, 0xb0, 0x51, 0x70, 0x83, 0x8a, 0x8c, 0x08, 0x4d, 0x7b, 0x24, 0xcd, 0x19, 0x12,
-	0xa9, 0x73, 0x74, 0x38, 0x6f, 0x4f, 0x1a, 0x5f, 0x36, 0xaa, 0x9d, 0x01, 0xd0, 0xe8, 0x75, 0xdf,
-	0xc2, 0xfc, 0xc4, 0xae, 0xd3, 0xf7, 0x53, 0xf0, 0x05, 0x80, 0x1c, 0x1d, 0xa5, 0x88, 0xcb, 0x52,
-	0xe8, 0x54, 0xcb, 0xb4, 0x20, 0x8c, 0x85, 0x0b, 0xe3, 0x3f, 0x7f, 0x8d, 0xa3, 0xa3, 0x96, 0x65,
-	0x79, 0x2e, 0x0f, 0x08, 0x63, 0xf0, 0x05, 0xa8, 0x9e, 0xb9, 0x2d, 0x47, 0x4a, 0x87, 0x8b, 0x57,
-	0x75, 0xfc, 0xe2, 0x90, 0x68, 0x1f, 0x29, 0x0d, 0x0f, 0xc0, 0x42, 0x8f, 0x14, 0xda, 0x28, 0xd8,
-	0x34, 0x27, 0xac, 0xda, 0xae, 0x7c, 0x7a, 0x69, 0x57, 0x92, 0xbd, 0xaf, 0x1c, 0xc4, 0x9c, 0xdd,
-	0x37, 0x64, 0xbe, 0x77, 0x16, 0x82, 0x77, 0x41, 0x4d, 0x2b, 0x64, 0x6d, 0x41, 0x04, 0xea, 0x30,
-	0x82, 0xc3, 0xda, 0x5a, 0xb0, 0x3e, 0x9b, 0x54, 0x7d, 0x78, 0xdb, 0x45, 0xe1, 0x1e, 0x58, 0xa6,
-	0x4a, 0xba, 0xb1, 0x0c, 0xae, 0xf3, 0x70, 0xc9, 0x9b, 0x71, 0x54, 0x82, 0x5b, 0x7e, 0x83, 0x53,
-	0xe0, 0x8f, 0x46, 0x81, 0x35, 0xaa, 0xa4, 0xa9, 0x38, 0x48, 0x99, 0xca, 0x23, 0xd7, 0x52, 0xb8,
-	0x6c, 0xdd, 0x53, 0xbd, 0x78, 0x1b, 0x35, 0x7f, 0x0e, 0xc0, 0xf5, 0x7f, 0x18, 0x2f, 0xec, 0x80,
-	0xdb, 0x67, 0xbe, 0x4a, 0xd1, 0x4b, 0x4d, 0x54, 0xea, 0x8c, 0xc7, 0x89, 0xd0, 0xf6, 0xb6, 0xfa,
-	0x97, 0xcf, 0x16, 0x0e, 0x7d, 0xd6, 0x32, 0x2c, 0x07, 0x43, 0x12, 0x18, 0x83, 0x15, 0x51, 0xf2,
-	0x94, 0xe4, 0x32, 0x3b, 0x2c, 0xd2, 0x1c, 0x51, 0x9c, 0xca, 0x1e, 0x51, 0xf6, 0xca, 0xab, 0x24,
-	0xcb, 0xa2, 0xe4, 0xdb, 0x36, 0xb5, 0x8f, 0x28, 0xde, 0xeb, 0x11, 0xd5, 0xfc, 0x6b, 0x0a, 0x54,
-	0x2f, 0x76, 0x1d, 0xb6, 0xc1, 0xb4, 0xd3, 0x99, 0x7f, 0xfb, 0x8c, 0xa5, 0x2f, 0x0f, 0x85, 0xdb,
-	0x60, 0xc6, 0x3b, 0xc5, 0xbf, 0x82, 0xc6, 0x33, 0xac, 0xc7, 0x42, 0x0a, 0x96, 0x06, 0x1a, 0x1a,
-	0x0e, 0x71, 0xea, 0x63, 0x8d, 0xba, 0x63, 0x4a, 0xfd, 0x79, 0xdc, 0xf8, 0x5f, 0x1f, 0x71, 0xf6,
-	0xb0, 0x39, 0x4a, 0xd0, 0x74, 0xf3, 0xf5, 0xe1, 0xe1, 0x7c, 0x3f, 0x32, 0x9e, 0xca, 0x7f, 0x31,
-	0x9e, 0x8b, 0x57, 0xeb, 0xb5, 0xab, 0x5d, 0xad, 0x8f, 0xc0, 0x2c, 0x11, 0xd8, 0x51, 0x4c, 0x8f,
-	0x41, 0x31, 0x43, 0x04, 0x36, 0xf1, 0x87, 0x95, 0x1f, 0x7e, 0x6a, 0x4c, 0x6c, 0x3e, 0x79, 0x73,
-	0x52, 0x0f, 0xde, 0x9e, 0xd4, 0x83, 0x3f, 0x4e, 0xea, 0xc1, 0xab, 0xd3, 0xfa, 0xc4, 0xdb, 0xd3,
-	0xfa, 0xc4, 0xaf, 0xa7, 0xf5, 0x89, 0xaf, 0x3f, 0xef, 0x52, 0x7d, 0x58, 0x76, 0xa2, 0x4c, 0xf2,
-	0xf8, 0x03, 0x9f, 0x2f, 0xbd, 0x07, 0xf1, 0x91, 0xfd, 0x86, 0xd1, 0xfd, 0x9c, 0x14, 0x9d, 0x69,
-	0x5b, 0xf8, 0xc1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x36, 0x30, 0x64, 0x27, 0xc2, 0x09, 0x00,
-	0x00,
+	// 1014 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3d, 0x6f, 0x1b, 0x37,
+	0x18, 0xf6, 0xd9, 0x8a, 0x3f, 0x68, 0x5b, 0xb2, 0x19, 0xc7, 0x65, 0x1c, 0x54, 0x32, 0x94, 0x02,
+	0x31, 0x5a, 0xe4, 0xae, 0x4e, 0x3a, 0x14, 0x59, 0x02, 0x59, 0x76, 0x00, 0x07, 0x4e, 0x6c, 0x9c,
+	0x83, 0x22, 0xe8, 0x72, 0xa0, 0x8e, 0x8c, 0x4c, 0x94, 0x47, 0x5e, 0x79, 0x3c, 0xc1, 0xea, 0x2f,
+	0xe8, 0x98, 0xb1, 0x40, 0x97, 0xce, 0x9d, 0xf3, 0x07, 0xba, 0x65, 0x0c, 0x32, 0x15, 0x1d, 0xdc,
+	0xc2, 0xfe, 0x07, 0x5d, 0xba, 0x16, 0xfc, 0x90, 0xfc, 0x91, 0xc6, 0xa9, 0x8c, 0x0c, 0x02, 0xc4,
+	0xf7, 0xe5, 0xf3, 0xbc, 0xe4, 0xfb, 0x3e, 0x0f, 0x71, 0xe0, 0x33, 0xd2, 0xcf, 0xa8, 0x28, 0x98,
+	0x14, 0x87, 0xfd, 0x1f, 0xa2, 0xe1, 0x22, 0x62, 0x4a, 0x9a, 0x5f, 0x98, 0x2b, 0xa9, 0x25, 0x5c,
+	0x39, 0xbb, 0x2b, 0x1c, 0x2e, 0x42, 0xa6, 0xe4, 0xca, 0x52, 0x57, 0x76, 0xa5, 0xdd, 0x16, 0x99,
+	0x7f, 0x0e, 0xb1, 0xd2, 0xe8, 0x4a, 0xd9, 0xe5, 0x34, 0xb2, 0xab, 0x4e, 0xf9, 0x22, 0xd2, 0x2c,
+	0xa3, 0x85, 0xc6, 0x59, 0xee, 0x37, 0xd4, 0x2f, 0x6e, 0x20, 0xa5, 0xc2, 0xda, 0x90, 0xfa, 0x7c,
+	0x2a, 0x8b, 0x4c, 0x16, 0x51, 0x07, 0x17, 0x34, 0xea, 0xad, 0x77, 0xa8, 0xc6, 0xeb, 0x51, 0x2a,
+	0xd9, 0x20, 0x7f, 0xd3, 0xe5, 0x13, 0x57, 0xd9, 0x2d, 0x7c, 0xea, 0xce, 0x25, 0x77, 0xca, 0xb1,
+	0xc2, 0x99, 0xdf, 0xd8, 0xfc, 0x6d, 0x1c, 0xcc, 0x6d, 0x48, 0x41, 0x98, 0xe8, 0xb6, 0x4b, 0xd5,
+	0xa3, 0xf0, 0x21, 0x08, 0x9e, 0xa0, 0x60, 0x35, 0x58, 0x9b, 0xd9, 0x58, 0x7f, 0x7d, 0xd4, 0x18,
+	0xfb, 0xe3, 0xa8, 0x71, 0xcb, 0x51, 0x17, 0xe4, 0xbb, 0x90, 0xc9, 0x28, 0xc3, 0xfa, 0x20, 0xdc,
+	0xa1, 0x5d, 0x9c, 0xf6, 0x37, 0x69, 0xfa, 0xf6, 0xd5, 0x5d, 0xe0, 0x2b, 0x6f, 0xd2, 0x34, 0x0e,
+	0x9e, 0x18, 0x82, 0xa7, 0x68, 0xfc, 0xca, 0x04, 0x4f, 0x0d, 0x41, 0x1b, 0x4d, 0x5c, 0x99, 0xa0,
+	0x0d, 0xbf, 0x02, 0xcb, 0x4a, 0x72, 0x8e, 0xf3, 0x3c, 0x21, 0x54, 0xc8, 0x2c, 0x21, 0x34, 0x65,
+	0x19, 0xe6, 0x05, 0xaa, 0xac, 0x06, 0x6b, 0x95, 0x78, 0xc9, 0x67, 0x37, 0x4d, 0x72, 0xd3, 0xe7,
+	0xe0, 0xd7, 0x00, 0x71, 0xf6, 0x7d, 0xc9, 0x08, 0xd3, 0xfd, 0x8b, 0xb8, 0x6b, 0x16, 0xb7, 0x3c,
+	0xcc, 0x9f, 0x43, 0x36, 0x7f, 0x9e, 0x01, 0x95, 0x3d, 0x8e, 0x05, 0xac, 0x82, 0x71, 0x46, 0x6c,
+	0xf3, 0x2a, 0xf1, 0x38, 0x23, 0xf0, 0x53, 0x00, 0x06, 0x07, 0x61, 0xc4, 0xf5, 0x24, 0x9e, 0xf1,
+	0x91, 0x6d, 0x02, 0x1f, 0x01, 0x98, 0x49, 0x52, 0x72, 0x9a, 0xe0, 0x34, 0x4d, 0x30, 0x21, 0x8a,
+	0x16, 0x85, 0xbf, 0x39, 0x7a, 0xfb, 0xea, 0xee, 0x92, 0xbf, 0x56, 0xcb, 0x65, 0xf6, 0xb5, 0x62,
+	0xa2, 0x1b, 0x2f, 0x38, 0x4c, 0x2b, 0x4d, 0x7d, 0x1c, 0x3e, 0x06, 0x0b, 0x5a, 0x6a, 0xcc, 0x13,
+	0xcc, 0xb9, 0x4c, 0xad, 0x82, 0xec, 0x4d, 0x67, 0xef, 0xdd, 0x0c, 0x3d, 0x85, 0x91, 0x50, 0xe8,
+	0x25, 0x14, 0xb6, 0x25, 0x13, 0x1b, 0x15, 0xd3, 0xda, 0xb8, 0x66, 0x81, 0xad, 0x21, 0x0e, 0xee,
+	0x83, 0xf9, 0x8e, 0x93, 0x43, 0x92, 0x1a, 0x3d, 0xd8, 0xab, 0xcf, 0xde, 0x5b, 0x0b, 0xdf, 0x2f,
+	0xff, 0xf0, 0xac, 0x7e, 0x3c, 0xef, 0x5c, 0xe7, 0xac, 0xa6, 0x6e, 0x83, 0xf9, 0x82, 0x6a, 0xcd,
+	0x29, 0x71, 0x8d, 0x45, 0x93, 0xb6, 0x15, 0x73, 0x3e, 0x68, 0xbb, 0x09, 0xdb, 0x00, 0x14, 0x1a,
+	0x2b, 0x9d, 0x18, 0x9b, 0xa0, 0x29, 0x5b, 0x76, 0x25, 0x74, 0x16, 0x09, 0x07, 0x16, 0x09, 0x9f,
+	0x0d, 0x3c, 0xb4, 0x31, 0x6d, 0x0a, 0xbd, 0xfc, 0xb3, 0x11, 0xc4, 0x33, 0x16, 0x67, 0x32, 0x70,
+	0x07, 0xd4, 0x72, 0x45, 0x13, 0x8e, 0x4b, 0x91, 0x1e, 0x38, 0xa6, 0xe9, 0x11, 0x98, 0xe6, 0x73,
+	0x45, 0x77, 0x2c, 0xd6, 0xb2, 0x3d, 0x02, 0xd3, 0x85, 0xe4, 0x24, 0xc1, 0x99, 0x46, 0x33, 0x76,
+	0x2c, 0x5f, 0x78, 0x41, 0xde, 0x78, 0x57, 0x90, 0xdb, 0x42, 0x9f, 0x91, 0xe2, 0xb6, 0xd0, 0xf1,
+	0x94, 0x01, 0xb7, 0x32, 0x0d, 0x77, 0xc0, 0x6c, 0xca, 0x31, 0xcb, 0xa8, 0xa3, 0x02, 0xa3, 0x53,
+	0x01, 0x8f, 0x37, 0x6c, 0x0c, 0xdc, 0x60, 0x22, 0xa5, 0x42, 0xb3, 0x1e, 0x4d, 0x72, 0x8e, 0x45,
+	0xe2, 0x1c, 0x8d, 0x66, 0xed, 0x4d, 0xa3, 0xcb, 0x46, 0xb5, 0x3d, 0x00, 0x1a, 0xbd, 0xee, 0x59,
+	0x98, 0x9f, 0xd8, 0x75, 0xf6, 0x6e, 0x0a, 0x3e, 0x07, 0x30, 0xc3, 0x87, 0x09, 0xce, 0x64, 0x29,
+	0x74, 0xa2, 0x65, 0x52, 0x50, 0xce, 0xd1, 0xdc, 0xe8, 0xe7, 0xaf, 0x65, 0xf8, 0xb0, 0x65, 0x59,
+	0x9e, 0xc9, 0x7d, 0xca, 0x39, 0x7c, 0x0e, 0xaa, 0xa7, 0x6e, 0xcb, 0xb1, 0xd2, 0x68, 0xfe, 0xaa,
+	0x8e, 0x9f, 0x1f, 0x12, 0xed, 0x61, 0xa5, 0xe1, 0x3e, 0x98, 0xeb, 0xd1, 0x42, 0x1b, 0x05, 0x9b,
+	0xe6, 0xa0, 0xaa, 0xed, 0xca, 0xe7, 0x97, 0x76, 0x25, 0xde, 0xfd, 0xc6, 0x41, 0xcc, 0xdd, 0x7d,
+	0x43, 0x66, 0x7b, 0xa7, 0x21, 0x78, 0x07, 0xd4, 0xb4, 0xc2, 0xd6, 0x16, 0x54, 0xe0, 0x0e, 0xa7,
+	0x04, 0xd5, 0x56, 0x83, 0xb5, 0xe9, 0xb8, 0xea, 0xc3, 0x5b, 0x2e, 0x0a, 0x77, 0xc1, 0x22, 0x53,
+	0xd2, 0x8d, 0x65, 0xf0, 0x9c, 0xa3, 0x05, 0x6f, 0xc6, 0x8b, 0x12, 0xdc, 0xf4, 0x1b, 0x9c, 0x02,
+	0x7f, 0x32, 0x0a, 0xac, 0x31, 0x25, 0x4d, 0xc5, 0x41, 0xca, 0x54, 0xbe, 0xf0, 0x2c, 0xa1, 0x45,
+	0xeb, 0x9e, 0xea, 0xf9, 0xd7, 0x08, 0x2e, 0x83, 0xc9, 0x94, 0xcb, 0x82, 0x12, 0x04, 0xed, 0xc9,
+	0xfc, 0xaa, 0xf9, 0x6b, 0x00, 0xae, 0xff, 0xc7, 0xd8, 0x61, 0x07, 0xdc, 0x3a, 0xf5, 0x5b, 0x82,
+	0x5f, 0x68, 0xaa, 0x12, 0x67, 0xc8, 0x8c, 0x0a, 0x8d, 0x82, 0xff, 0x7f, 0x66, 0x34, 0xf4, 0x5f,
+	0xcb, 0xb0, 0xec, 0x0f, 0x49, 0x60, 0x04, 0x96, 0x44, 0x99, 0x25, 0x34, 0x97, 0xe9, 0x41, 0x91,
+	0xe4, 0x98, 0x91, 0x44, 0xf6, 0xa8, 0xb2, 0x4f, 0x61, 0x25, 0x5e, 0x14, 0x65, 0xb6, 0x65, 0x53,
+	0x7b, 0x98, 0x91, 0xdd, 0x1e, 0x55, 0xcd, 0x7f, 0x26, 0x40, 0xf5, 0xfc, 0x34, 0x60, 0x1b, 0x4c,
+	0x3a, 0xfd, 0xa1, 0x60, 0x74, 0xdd, 0x79, 0x28, 0xdc, 0x02, 0x53, 0xde, 0x41, 0x68, 0x7c, 0x74,
+	0x96, 0x01, 0x16, 0x32, 0xb0, 0x30, 0xd0, 0xd6, 0x70, 0xb8, 0x13, 0x1f, 0x6a, 0xd4, 0x6d, 0x53,
+	0xea, 0xef, 0xa3, 0xc6, 0x27, 0x7d, 0x9c, 0xf1, 0x07, 0xcd, 0x8b, 0x04, 0x4d, 0x37, 0x77, 0x1f,
+	0x1e, 0xce, 0xfd, 0x03, 0xe3, 0xa9, 0x7c, 0x8c, 0xf1, 0x9c, 0x7f, 0x72, 0xaf, 0x5d, 0xed, 0xc9,
+	0x7d, 0x08, 0xa6, 0xa9, 0x20, 0x8e, 0x62, 0x72, 0x04, 0x8a, 0x29, 0x2a, 0x88, 0x89, 0x3f, 0xa8,
+	0xfc, 0xf8, 0x4b, 0x63, 0x6c, 0xe3, 0xf1, 0xeb, 0xe3, 0x7a, 0xf0, 0xe6, 0xb8, 0x1e, 0xfc, 0x75,
+	0x5c, 0x0f, 0x5e, 0x9e, 0xd4, 0xc7, 0xde, 0x9c, 0xd4, 0xc7, 0x7e, 0x3f, 0xa9, 0x8f, 0x7d, 0xfb,
+	0x65, 0x97, 0xe9, 0x83, 0xb2, 0x13, 0xa6, 0x32, 0x8b, 0xde, 0xf3, 0x59, 0xd3, 0xbb, 0x1f, 0x1d,
+	0xda, 0x6f, 0x1b, 0xdd, 0xcf, 0x69, 0xd1, 0x99, 0xb4, 0x85, 0xef, 0xff, 0x3b, 0x00, 0x40, 0xbf,
+	0x20, 0xc8, 0xda, 0x09, 0x00, 0x00,
 }
 
 func (m *BondingCurve) Marshal() (dAtA []byte, err error) {
@@ -516,6 +526,18 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Closed {
+		i--
+		if m.Closed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
 	if len(m.LiquidityDenom) > 0 {
 		i -= len(m.LiquidityDenom)
 		copy(dAtA[i:], m.LiquidityDenom)
@@ -863,6 +885,9 @@ func (m *Plan) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovIro(uint64(l))
 	}
+	if m.Closed {
+		n += 3
+	}
 	return n
 }
 
@@ -1660,6 +1685,26 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			}
 			m.LiquidityDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Closed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIro(dAtA[iNdEx:])
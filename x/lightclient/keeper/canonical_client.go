@@ -156,7 +156,10 @@ func (k Keeper) validClient(ctx sdk.Context, clientID string, cs *ibctm.ClientSt
 }
 
 func (k Keeper) ValidateHeaderAgainstStateInfo(ctx sdk.Context, sInfo *rollapptypes.StateInfo, consState *ibctm.ConsensusState, h uint64) error {
-	bd, ok := sInfo.GetBlockDescriptor(h)
+	bd, ok := k.rollappKeeper.GetBlockDescriptor(ctx, sInfo, h)
+	if !ok && sInfo.IsCompressed() {
+		return errorsmod.Wrapf(types.ErrBDNotProven, "height %d", h)
+	}
 	if !ok {
 		return errorsmod.Wrapf(gerrc.ErrInternal, "no block descriptor found for height %d", h)
 	}
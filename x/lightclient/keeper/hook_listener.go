@@ -1,11 +1,15 @@
 package keeper
 
 import (
+	"errors"
+
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
@@ -61,6 +65,11 @@ func (hook rollappHook) AfterUpdateState(ctx sdk.Context, stateInfoM *rollapptyp
 		}
 	}
 
+	if stateInfo.IsCompressed() {
+		// the headers of unproven heights are not verified yet, their signers are kept until then
+		return nil
+	}
+
 	// we now verified everything up to and including stateInfo.GetLatestHeight()
 	// this removes the unbonding condition for the sequencers
 	if err := hook.k.PruneSignersBelow(ctx, client, stateInfo.GetLatestHeight()+1); err != nil {
@@ -83,6 +92,10 @@ func (k Keeper) ValidateStateInfoAgainstConsensusStates(
 		}
 
 		err := k.ValidateHeaderAgainstStateInfo(ctx, stateInfo, got, h)
+		if errorsmod.IsOf(err, types.ErrBDNotProven) {
+			// validated once the block descriptor is proven
+			continue
+		}
 		if err != nil {
 			return false, errorsmod.Wrapf(err, "validate pessimistic h: %d", h)
 		}
@@ -110,3 +123,35 @@ func (k Keeper) getConsensusState(ctx sdk.Context,
 	}
 	return tmConsensusState, true
 }
+
+// AfterBlockDescriptorProven is called after a block descriptor of a compressed state update is proven.
+// The optimistic header of the height, if any, can only be validated now.
+func (hook rollappHook) AfterBlockDescriptorProven(ctx sdk.Context, stateInfo *rollapptypes.StateInfo, bd rollapptypes.BlockDescriptor) error {
+	if !hook.k.Enabled() {
+		return nil
+	}
+
+	client, ok := hook.k.GetCanonicalClient(ctx, stateInfo.GetRollappId())
+	if !ok {
+		return nil
+	}
+
+	got, ok := hook.k.getConsensusState(ctx, client, bd.Height)
+	if !ok {
+		return nil
+	}
+	err := hook.k.ValidateHeaderAgainstStateInfo(ctx, stateInfo, got, bd.Height)
+	if err != nil {
+		return errorsmod.Wrapf(err, "validate pessimistic h: %d", bd.Height)
+	}
+
+	// the header is verified, this removes the unbonding condition for its signer
+	signer, err := hook.k.GetSigner(ctx, client, bd.Height)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get signer")
+	}
+	return errorsmod.Wrap(hook.k.RemoveSigner(ctx, signer, client, bd.Height), "remove signer")
+}
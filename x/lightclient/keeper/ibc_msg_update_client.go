@@ -10,6 +10,7 @@ import (
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 )
 
@@ -89,6 +90,14 @@ func (i IBCMessagesDecorator) HandleMsgUpdateClient(ctx sdk.Context, msg *ibccli
 	}
 
 	err = i.k.ValidateHeaderAgainstStateInfo(ctx, sInfo, header.ConsensusState(), h)
+	if errorsmod.IsOf(err, types.ErrBDNotProven) {
+		// the state update is compressed and the block descriptor is not proven yet, so we save optimistically
+		err := i.k.SaveSigner(ctx, seq.Address, msg.ClientId, h)
+		if err != nil {
+			return errorsmod.Wrap(err, "save signer")
+		}
+		return nil
+	}
 	if err != nil {
 		return errorsmod.Wrap(err, "validate pessimistic")
 	}
@@ -73,6 +73,10 @@ func (m *MockRollappKeeper) GetStateInfo(ctx sdk.Context, rollappId string, inde
 	return val, found
 }
 
+func (m *MockRollappKeeper) GetBlockDescriptor(ctx sdk.Context, stateInfo *rollapptypes.StateInfo, height uint64) (rollapptypes.BlockDescriptor, bool) {
+	return stateInfo.GetBlockDescriptor(height)
+}
+
 func (m *MockRollappKeeper) HardFork(ctx sdk.Context, rollappID string, fraudHeight uint64) error {
 	return nil
 }
@@ -10,4 +10,5 @@ var (
 	ErrNextValHashMismatch  = errorsmod.Wrap(gerrc.ErrFault, "next validator hash on light client cons state does not match the sequencer for h+1 from the state info")
 	ErrTimestampMismatch    = errorsmod.Wrap(gerrc.ErrFault, "block descriptor timestamp does not match tendermint header timestamp")
 	ErrorHardForkInProgress = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "update light client while fork in progress")
+	ErrBDNotProven          = errorsmod.Wrap(gerrc.ErrNotFound, "block descriptor of compressed state update is not proven")
 )
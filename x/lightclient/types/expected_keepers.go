@@ -27,6 +27,7 @@ type RollappKeeperExpected interface {
 
 	GetLatestStateInfoIndex(ctx sdk.Context, rollappId string) (rollapptypes.StateInfoIndex, bool)
 	GetStateInfo(ctx sdk.Context, rollappId string, index uint64) (sInfo rollapptypes.StateInfo, found bool)
+	GetBlockDescriptor(ctx sdk.Context, stateInfo *rollapptypes.StateInfo, height uint64) (rollapptypes.BlockDescriptor, bool)
 }
 
 type IBCClientKeeperExpected interface {
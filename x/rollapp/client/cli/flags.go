@@ -13,6 +13,7 @@ const (
 	FlagMetadata         = "metadata"
 	FlagBech32Prefix     = "bech32-prefix"
 	FlagGenesisAccounts  = "genesis-accounts"
+	FlagDisputePeriod    = "dispute-period"
 )
 
 // FlagSetUpdateRollapp returns flags for updating rollapps.
@@ -27,6 +28,7 @@ func FlagSetUpdateRollapp() *flag.FlagSet {
 	fs.String(FlagMetadata, "", "The metadata of the rollapp")
 	fs.String(FlagBech32Prefix, "", "Bech32 prefix of the rollapp")
 	fs.String(FlagGenesisAccounts, "", "<address>:<amount>,<address>:<amount>")
+	fs.Uint64(FlagDisputePeriod, 0, "The dispute period of the rollapp in hub blocks, within the bounds of the params")
 
 	return fs
 }
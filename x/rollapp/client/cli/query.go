@@ -27,6 +27,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowLatestHeight())
 	cmd.AddCommand(CmdShowLatestStateIndex())
 	cmd.AddCommand(CmdQueryRegisteredDenoms())
+	cmd.AddCommand(CmdShowDispute())
+	cmd.AddCommand(CmdListDisputes())
+	cmd.AddCommand(CmdShowLivenessEvent())
+	cmd.AddCommand(CmdListLivenessHistory())
+	cmd.AddCommand(CmdSearchRollapps())
 
 	return cmd
 }
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+const (
+	FlagVMType            = "vm-type"
+	FlagLaunched          = "launched"
+	FlagDRSVersion        = "drs-version"
+	FlagHasIRO            = "has-iro"
+	FlagHasChannel        = "has-channel"
+	FlagOwner             = "owner"
+	FlagCreatedAfter      = "created-after"
+	FlagCreatedBefore     = "created-before"
+	FlagDisplayNamePrefix = "name-prefix"
+)
+
+func CmdSearchRollapps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "search",
+		Short:   "Search the rollapps registry. All the given filters must match",
+		Example: "dymd q rollapp search --vm-type evm --launched true --name-prefix dym",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := searchRequestFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			req.Pagination, err = client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RollappSearch(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagVMType, "", "Filter by VM type: evm or wasm")
+	cmd.Flags().String(FlagLaunched, "", "Filter by launched status: true or false")
+	cmd.Flags().Uint32(FlagDRSVersion, 0, "Filter by the DRS version of the latest state update")
+	cmd.Flags().String(FlagHasIRO, "", "Filter by having an IRO: true or false")
+	cmd.Flags().String(FlagHasChannel, "", "Filter by having a canonical channel: true or false")
+	cmd.Flags().String(FlagOwner, "", "Filter by owner address")
+	cmd.Flags().String(FlagCreatedAfter, "", "Filter the rollapps created at or after the time (RFC3339)")
+	cmd.Flags().String(FlagCreatedBefore, "", "Filter the rollapps created before the time (RFC3339)")
+	cmd.Flags().String(FlagDisplayNamePrefix, "", "Filter by a case-insensitive prefix of the display name")
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func searchRequestFromFlags(cmd *cobra.Command) (*types.QueryRollappSearchRequest, error) {
+	fs := cmd.Flags()
+	req := new(types.QueryRollappSearchRequest)
+
+	if vmType, _ := fs.GetString(FlagVMType); vmType != "" {
+		v, ok := types.Rollapp_VMType_value[strings.ToUpper(vmType)]
+		if !ok || v == 0 {
+			return nil, types.ErrInvalidVMType
+		}
+		req.VmType = types.Rollapp_VMType(v)
+	}
+
+	var err error
+	if req.Launched, err = boolFilterFlag(cmd, FlagLaunched); err != nil {
+		return nil, err
+	}
+	if req.HasIro, err = boolFilterFlag(cmd, FlagHasIRO); err != nil {
+		return nil, err
+	}
+	if req.HasCanonicalChannel, err = boolFilterFlag(cmd, FlagHasChannel); err != nil {
+		return nil, err
+	}
+	if req.CreatedAfter, err = timeFlag(cmd, FlagCreatedAfter); err != nil {
+		return nil, err
+	}
+	if req.CreatedBefore, err = timeFlag(cmd, FlagCreatedBefore); err != nil {
+		return nil, err
+	}
+
+	req.DrsVersion, _ = fs.GetUint32(FlagDRSVersion)
+	req.Owner, _ = fs.GetString(FlagOwner)
+	req.DisplayNamePrefix, _ = fs.GetString(FlagDisplayNamePrefix)
+	return req, nil
+}
+
+func boolFilterFlag(cmd *cobra.Command, name string) (types.BoolFilter, error) {
+	s, _ := cmd.Flags().GetString(name)
+	if s == "" {
+		return types.BoolFilter_BOOL_FILTER_ANY, nil
+	}
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	if v {
+		return types.BoolFilter_BOOL_FILTER_TRUE, nil
+	}
+	return types.BoolFilter_BOOL_FILTER_FALSE, nil
+}
+
+func timeFlag(cmd *cobra.Command, name string) (*time.Time, error) {
+	s, _ := cmd.Flags().GetString(name)
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return &t, nil
+}
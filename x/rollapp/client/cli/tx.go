@@ -22,9 +22,16 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateRollapp())
 	cmd.AddCommand(CmdUpdateRollapp())
 	cmd.AddCommand(CmdTransferOwnership())
+	cmd.AddCommand(CmdAcceptOwnership())
+	cmd.AddCommand(CmdAssignRole())
+	cmd.AddCommand(CmdUpdateLifecycleState())
+	cmd.AddCommand(CmdDeclareMaintenance())
 	cmd.AddCommand(CmdAddApp())
 	cmd.AddCommand(CmdUpdateApp())
 	cmd.AddCommand(CmdRemoveApp())
+	cmd.AddCommand(CmdOpenDispute())
+	cmd.AddCommand(CmdBisectDispute())
+	cmd.AddCommand(CmdSubmitDisputeProof())
 
 	return cmd
 }
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdOpenDispute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "open-dispute [rollapp-id] [state-index] [height] [state-root-hex]",
+		Short:   "Challenge a pending state, claiming the state root posted for the height is wrong",
+		Example: "dymd tx rollapp open-dispute ROLLAPP_CHAIN_ID 12 1530 <state_root_hex>",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stateIndex, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("state index: %w", err)
+			}
+			height, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("height: %w", err)
+			}
+			root, err := hex.DecodeString(args[3])
+			if err != nil {
+				return fmt.Errorf("state root: %w", err)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgOpenDispute{
+				Challenger:     clientCtx.GetFromAddress().String(),
+				RollappId:      args[0],
+				StateInfoIndex: stateIndex,
+				Height:         height,
+				StateRoot:      root,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBisectDispute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bisect-dispute [dispute-id] [state-root-hex]",
+		Short:   "Submit the state root claimed at the middle of the disputed range",
+		Example: "dymd tx rollapp bisect-dispute 3 <state_root_hex>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("dispute id: %w", err)
+			}
+			root, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("state root: %w", err)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBisectDispute{
+				Challenger: clientCtx.GetFromAddress().String(),
+				DisputeId:  id,
+				StateRoot:  root,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSubmitDisputeProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "submit-dispute-proof [dispute-id] [proof-hex]",
+		Short:   "Submit the proof of the last step of a dispute, as the proposer",
+		Example: "dymd tx rollapp submit-dispute-proof 3 <proof_hex>",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("dispute id: %w", err)
+			}
+			proof, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("proof: %w", err)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSubmitDisputeProof{
+				Proposer:  clientCtx.GetFromAddress().String(),
+				DisputeId: id,
+				Proof:     proof,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdUpdateLifecycleState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-lifecycle-state [rollapp-id] [active|paused|sunsetting]",
+		Short:   "Pause, resume or sunset a rollapp",
+		Example: "dymd tx rollapp update-lifecycle-state ROLLAPP_CHAIN_ID paused",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argRollappId := args[0]
+			state, ok := types.Rollapp_LifecycleState_value[strings.ToUpper(args[1])]
+			if !ok {
+				return fmt.Errorf("invalid lifecycle state: %s", args[1])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateLifecycleState(
+				clientCtx.GetFromAddress().String(),
+				argRollappId,
+				types.Rollapp_LifecycleState(state),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdDeclareMaintenance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "declare-maintenance [rollapp-id] [duration-blocks]",
+		Short:   "Pause the liveness clock of a rollapp during a planned maintenance",
+		Example: "dymd tx rollapp declare-maintenance ROLLAPP_CHAIN_ID 600",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argRollappId := args[0]
+			duration, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeclareMaintenance(
+				clientCtx.GetFromAddress().String(),
+				argRollappId,
+				duration,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
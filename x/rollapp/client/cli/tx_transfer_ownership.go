@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -12,7 +15,7 @@ import (
 func CmdTransferOwnership() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "transfer-ownership [rollapp-id] [new-owner]",
-		Short:   "Transfer ownership of a rollapp to a new owner",
+		Short:   "Propose to transfer ownership of a rollapp to a new owner, who must accept it",
 		Example: "dymd tx rollapp transfer-ownership ROLLAPP_CHAIN_ID <new_owner_address>",
 		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -38,3 +41,66 @@ func CmdTransferOwnership() *cobra.Command {
 
 	return cmd
 }
+
+func CmdAcceptOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept-ownership [rollapp-id]",
+		Short:   "Accept the ownership of a rollapp proposed by its current owner",
+		Example: "dymd tx rollapp accept-ownership ROLLAPP_CHAIN_ID",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptOwnership(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAssignRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "assign-role [rollapp-id] [metadata-editor|app-manager|sequencer-whitelister] [address]",
+		Short:   "Delegate a role of the owner to an address. Omit the address to revoke the role",
+		Example: "dymd tx rollapp assign-role ROLLAPP_CHAIN_ID app-manager <address>",
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			role, ok := types.RollappRole_value["ROLLAPP_ROLE_"+strings.ToUpper(strings.ReplaceAll(args[1], "-", "_"))]
+			if !ok || role == int32(types.RollappRole_ROLLAPP_ROLE_OWNER) {
+				return fmt.Errorf("invalid role: %s", args[1])
+			}
+			var addr string
+			if len(args) == 3 {
+				addr = args[2]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAssignRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				types.RollappRole(role),
+				addr,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
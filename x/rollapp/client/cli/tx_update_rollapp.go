@@ -28,7 +28,8 @@ func CmdUpdateRollapp() *cobra.Command {
 		--initial-supply 1000000
 		--native-denom native_denom.json
 		--genesis-accounts '<acc1>:1000000,<acc2>:1000000'
-		--metadata metadata.json`,
+		--metadata metadata.json
+		--dispute-period 120960`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argRollappId := args[0]
@@ -62,6 +63,11 @@ func CmdUpdateRollapp() *cobra.Command {
 				return
 			}
 
+			disputePeriod, err := cmd.Flags().GetUint64(FlagDisputePeriod)
+			if err != nil {
+				return
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return
@@ -75,6 +81,7 @@ func CmdUpdateRollapp() *cobra.Command {
 				metadata,
 				genesisInfo,
 			)
+			msg.DisputePeriodInBlocks = disputePeriod
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
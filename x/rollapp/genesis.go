@@ -12,6 +12,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set all the rollapp
 	for _, elem := range genState.RollappList {
 		k.SetRollapp(ctx, elem)
+		if elem.LifecycleState == types.Rollapp_SUNSETTING {
+			if err := k.SetSunsettingRollapp(ctx, elem.RollappId); err != nil {
+				panic(err)
+			}
+		}
+		if elem.InMaintenance() {
+			if err := k.SetMaintenanceEnd(ctx, elem); err != nil {
+				panic(err)
+			}
+		}
 	}
 	// Set all the stateInfo
 	for _, elem := range genState.StateInfoList {
@@ -21,6 +31,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.LatestStateInfoIndexList {
 		k.SetLatestStateInfoIndex(ctx, elem)
 	}
+	// Index the DRS version of the rollapps, from their latest state update
+	for _, elem := range genState.RollappList {
+		if err := k.RefreshRegistryDRSVersion(ctx, elem.RollappId); err != nil {
+			panic(err)
+		}
+	}
 	// Set all the latestFinalizedStateIndex
 	for _, elem := range genState.LatestFinalizedStateIndexList {
 		k.SetLatestFinalizedStateIndex(ctx, elem)
@@ -59,6 +75,20 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	if err := k.InitDisputes(ctx, genState.Disputes); err != nil {
+		panic(err)
+	}
+	for _, elem := range genState.ProvenBlockDescriptors {
+		if err := k.SetProvenBlockDescriptor(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.LivenessHistory {
+		if err := k.SaveLivenessRecord(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+
 	k.SetParams(ctx, genState.Params)
 }
 
@@ -108,5 +138,20 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	genesis.ObsoleteDrsVersions = drsVersions
 
+	genesis.Disputes, err = k.GetAllDisputes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.ProvenBlockDescriptors, err = k.GetAllProvenBlockDescriptors(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.LivenessHistory, err = k.GetAllLivenessRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return genesis
 }
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// GetBlockDescriptor returns the block descriptor of the height in the state info. For compressed state updates, only
+// the first and last descriptors, and those proven with MsgProveBlockDescriptor, are known.
+func (k Keeper) GetBlockDescriptor(ctx sdk.Context, stateInfo *types.StateInfo, height uint64) (types.BlockDescriptor, bool) {
+	bd, ok := stateInfo.GetBlockDescriptor(height)
+	if ok || !stateInfo.IsCompressed() || !stateInfo.ContainsHeight(height) {
+		return bd, ok
+	}
+	bd, err := k.provenBDs.Get(ctx, collections.Join(stateInfo.GetRollappId(), height))
+	return bd, err == nil
+}
+
+func (k Keeper) proveBlockDescriptor(ctx sdk.Context, msg *types.MsgProveBlockDescriptor) error {
+	stateInfo, found := k.GetStateInfo(ctx, msg.RollappId, msg.StateInfoIndex)
+	if !found {
+		return errorsmod.Wrapf(gerrc.ErrNotFound, "state info: index: %d", msg.StateInfoIndex)
+	}
+	if _, ok := k.GetBlockDescriptor(ctx, &stateInfo, msg.Bd.Height); ok {
+		return errorsmod.Wrapf(gerrc.ErrAlreadyExists, "block descriptor: height: %d", msg.Bd.Height)
+	}
+	if err := stateInfo.VerifyBlockDescriptor(msg.Bd, msg.Proof); err != nil {
+		return errorsmod.Wrap(err, "verify block descriptor")
+	}
+	if err := k.provenBDs.Set(ctx, collections.Join(msg.RollappId, msg.Bd.Height), msg.Bd); err != nil {
+		return err
+	}
+	if err := k.disputeMidpointProven(ctx, msg.RollappId, msg.StateInfoIndex, msg.Bd.Height); err != nil {
+		return errorsmod.Wrap(err, "dispute midpoint proven")
+	}
+
+	// currently used by `x/lightclient` to validate the optimistic headers of the height
+	if err := k.hooks.AfterBlockDescriptorProven(ctx, &stateInfo, msg.Bd); err != nil {
+		return errorsmod.Wrap(err, "hook: after block descriptor proven")
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventBlockDescriptorProven{
+		RollappId:      msg.RollappId,
+		StateInfoIndex: msg.StateInfoIndex,
+		Bd:             msg.Bd,
+	})
+}
+
+// pruneProvenBlockDescriptors removes the proven block descriptors of the state info.
+func (k Keeper) pruneProvenBlockDescriptors(ctx sdk.Context, stateInfo types.StateInfo) error {
+	rng := collections.NewPrefixedPairRange[string, uint64](stateInfo.GetRollappId()).
+		StartInclusive(stateInfo.StartHeight).
+		EndInclusive(stateInfo.GetLatestHeight())
+	return k.provenBDs.Clear(ctx, rng)
+}
+
+func (k Keeper) GetAllProvenBlockDescriptors(ctx sdk.Context) ([]types.ProvenBlockDescriptor, error) {
+	var ret []types.ProvenBlockDescriptor
+	err := k.provenBDs.Walk(ctx, nil, func(key collections.Pair[string, uint64], bd types.BlockDescriptor) (bool, error) {
+		ret = append(ret, types.ProvenBlockDescriptor{RollappId: key.K1(), Bd: bd})
+		return false, nil
+	})
+	return ret, err
+}
+
+func (k Keeper) SetProvenBlockDescriptor(ctx sdk.Context, pbd types.ProvenBlockDescriptor) error {
+	return k.provenBDs.Set(ctx, collections.Join(pbd.RollappId, pbd.Bd.Height), pbd.Bd)
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"time"
+
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestCompressedStateUpdate() {
+	s.k().SetHooks(nil)
+	rollappID, proposer := s.CreateDefaultRollappAndProposer()
+	ra := s.k().MustGetRollapp(s.Ctx, rollappID)
+	ra.GenesisState.TransferProofHeight = 1
+	s.k().SetRollapp(s.Ctx, ra)
+
+	lastHeight, err := s.PostStateUpdate(s.Ctx, rollappID, proposer, 1, 5)
+	s.Require().NoError(err)
+
+	bds := make([]types.BlockDescriptor, 0, 10)
+	for h := lastHeight; h < lastHeight+10; h++ {
+		root := make([]byte, 32)
+		root[0] = byte(h)
+		bds = append(bds, types.BlockDescriptor{Height: h, StateRoot: root, Timestamp: time.Unix(int64(h), 0).UTC()})
+	}
+	proofs := types.BlockDescriptorsProofs(bds)
+	update := func(last types.BlockDescriptor) error {
+		_, err := s.msgServer.UpdateState(s.Ctx, &types.MsgUpdateState{
+			Creator:     proposer,
+			RollappId:   rollappID,
+			StartHeight: lastHeight,
+			NumBlocks:   10,
+			DAPath:      "",
+			BDs:         types.BlockDescriptors{BD: []types.BlockDescriptor{bds[0], last}},
+			BdsRoot:     types.BlockDescriptorsRoot(bds),
+			BdProofs:    []*cmtcrypto.Proof{proofs[0], proofs[9]},
+		})
+		return err
+	}
+
+	// the last block descriptor does not match the root
+	forged := bds[9]
+	forged.StateRoot = bds[8].StateRoot
+	s.Require().ErrorIs(update(forged), gerrc.ErrInvalidArgument)
+
+	s.Require().NoError(update(bds[9]))
+	s.Require().Equal(bds[9].Height, s.GetRollappLastHeight(rollappID))
+
+	stateInfo := s.k().MustGetStateInfo(s.Ctx, rollappID, 2)
+	s.Require().True(stateInfo.IsCompressed())
+	_, ok := s.k().GetBlockDescriptor(s.Ctx, &stateInfo, bds[3].Height)
+	s.Require().False(ok)
+
+	prove := func(i int, proofIdx int) error {
+		_, err := s.msgServer.ProveBlockDescriptor(s.Ctx, &types.MsgProveBlockDescriptor{
+			Creator:        proposer,
+			RollappId:      rollappID,
+			StateInfoIndex: 2,
+			Bd:             bds[i],
+			Proof:          proofs[proofIdx],
+		})
+		return err
+	}
+	s.Require().ErrorIs(prove(3, 4), gerrc.ErrInvalidArgument)
+	s.Require().NoError(prove(3, 3))
+	s.Require().ErrorIs(prove(3, 3), gerrc.ErrAlreadyExists)
+
+	bd, ok := s.k().GetBlockDescriptor(s.Ctx, &stateInfo, bds[3].Height)
+	s.Require().True(ok)
+	s.Require().Equal(bds[3], bd)
+
+	// the compressed state info cannot be truncated, so it is reverted whole
+	err = s.k().HardFork(s.Ctx, rollappID, bds[5].Height)
+	s.Require().NoError(err)
+	s.Require().Equal(lastHeight-1, s.GetRollappLastHeight(rollappID))
+	_, ok = s.k().GetBlockDescriptor(s.Ctx, &stateInfo, bds[3].Height)
+	s.Require().False(ok)
+}
@@ -60,27 +60,22 @@ func (k Keeper) AllSequencerHeightPairs(ctx sdk.Context) ([]types.SequencerHeigh
 // FinalizeRollappStates is called every block to finalize states when their dispute period over.
 func (k Keeper) FinalizeRollappStates(ctx sdk.Context) {
 	h := uint64(ctx.BlockHeight()) //nolint:gosec
-	if h < k.DisputePeriodInBlocks(ctx) {
-		// hub just started
-		return
-	}
-	// check to see if there are pending  states to be finalized
-	finalizationHeight := h - k.DisputePeriodInBlocks(ctx)
-	queue, err := k.GetFinalizationQueueUntilHeightInclusive(ctx, finalizationHeight)
+	queues, err := k.GetDueFinalizationQueues(ctx, h)
 	if err != nil {
 		// The error is returned only if there is an internal issue with the store iterator or encoding.
 		// This should never happen in practice.
-		k.Logger(ctx).With("error", err, "height", finalizationHeight).
-			Error("failed to get finalization queue until height")
+		k.Logger(ctx).With("error", err, "height", h).
+			Error("failed to get due finalization queues")
 		return
 	}
 
-	k.FinalizeAllPending(ctx, queue)
+	k.FinalizeAllPending(ctx, queues)
 }
 
 // FinalizeAllPending is called every block to finalize all pending states in the queue.
-// pendingQueues contains queues in ascending order of creation height. There may be multiple queues for the same
-// creation height since multiple rollapps may have pending states. In that case, the queues are ordered by rollappID.
+// pendingQueues contains queues in ascending order of due height, and the queues of a rollapp in ascending order
+// of creation height. There may be multiple queues for the same due height since multiple rollapps may have
+// pending states. In that case, the queues are ordered by rollappID.
 // If one of rollapps states fails to finalize, the rest of the states are not finalized as well. This is achieved by
 // using a set of failed rollapps.
 func (k Keeper) FinalizeAllPending(ctx sdk.Context, pendingQueues []types.BlockHeightToFinalizationQueue) {
@@ -105,9 +100,23 @@ func (k Keeper) FinalizeAllPending(ctx sdk.Context, pendingQueues []types.BlockH
 }
 
 // FinalizeStates finalizes all the pending states in the queue. Returns true if all the states are finalized successfully.
+// The states from the lowest one under an open dispute are held back until the disputes are over.
 // Queue is for one rollapp
 func (k Keeper) FinalizeStates(ctx sdk.Context, queue types.BlockHeightToFinalizationQueue) bool {
+	dispute, disputed, err := k.GetLowestOpenDispute(ctx, queue.RollappId)
+	if err != nil {
+		k.Logger(ctx).With("rollapp_id", queue.RollappId, "err", err.Error()).Error("failed to get open dispute")
+		return false
+	}
 	for i, stateInfoIndex := range queue.FinalizationQueue {
+		if disputed && dispute.StateInfoIndex <= stateInfoIndex.Index {
+			if 0 < i {
+				// keep the held back states in the queue, like for a failure below
+				queue.FinalizationQueue = slices.Delete(queue.FinalizationQueue, 0, i)
+				k.MustSetFinalizationQueue(ctx, queue)
+			}
+			return false
+		}
 		// if this fails, no state change will happen
 		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
 			return k.finalizePending(ctx, stateInfoIndex) // (actual function here is k.finalizePendingState, see below)
@@ -178,10 +187,83 @@ func (k *Keeper) finalizePendingState(ctx sdk.Context, stateInfoIndex types.Stat
 }
 
 // SetFinalizationQueue set types.BlockHeightToFinalizationQueue for a specific height and rollappID.
+// The due height of a new queue is set from the dispute period of its rollapp.
 func (k Keeper) SetFinalizationQueue(ctx sdk.Context, queue types.BlockHeightToFinalizationQueue) error {
+	if err := k.setFinalizationDueHeight(ctx, queue.RollappId, queue.CreationHeight); err != nil {
+		return errorsmod.Wrap(err, "set finalization due height")
+	}
 	return k.finalizationQueue.Set(ctx, collections.Join(queue.CreationHeight, queue.RollappId), queue)
 }
 
+// setFinalizationDueHeight sets the height at which the dispute period of the queue is over, unless it is
+// already set. The period is the one in effect when the queue is created. A queue is never due before an
+// earlier queue of the same rollapp, so that the states of the rollapp are finalized in order.
+func (k Keeper) setFinalizationDueHeight(ctx sdk.Context, rollappID string, creationHeight uint64) error {
+	key := collections.Join(rollappID, creationHeight)
+	found, err := k.finalizationDueHeights.Has(ctx, key)
+	if err != nil || found {
+		return err
+	}
+
+	var period uint64
+	if ra, ok := k.GetRollapp(ctx, rollappID); ok {
+		period = k.DisputePeriod(ctx, ra)
+	} else {
+		period = k.DisputePeriodInBlocks(ctx)
+	}
+	due := creationHeight + period
+
+	rng := collections.NewPrefixedPairRange[string, uint64](rollappID).EndExclusive(creationHeight).Descending()
+	iter, err := k.finalizationDueHeights.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	defer iter.Close() // nolint: errcheck
+	if iter.Valid() {
+		prev, err := iter.Value()
+		if err != nil {
+			return err
+		}
+		due = max(due, prev)
+	}
+	return k.finalizationDueHeights.Set(ctx, key, due)
+}
+
+// GetFinalizationDueHeight returns the height at which the queue of the rollapp created at height is due.
+func (k Keeper) GetFinalizationDueHeight(ctx sdk.Context, rollappID string, creationHeight uint64) (uint64, bool, error) {
+	due, err := k.finalizationDueHeights.Get(ctx, collections.Join(rollappID, creationHeight))
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return due, true, nil
+}
+
+// GetDueFinalizationQueues returns the queues which are due at the height, ordered by due height.
+func (k Keeper) GetDueFinalizationQueues(ctx sdk.Context, height uint64) ([]types.BlockHeightToFinalizationQueue, error) {
+	rng := new(collections.Range[collections.Pair[uint64, collections.Pair[string, uint64]]]).
+		EndExclusive(collections.PairPrefix[uint64, collections.Pair[string, uint64]](height + 1))
+	iter, err := k.finalizationDueHeights.Indexes.ByDueHeight.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.PrimaryKeys()
+	if err != nil {
+		return nil, err
+	}
+	queues := make([]types.BlockHeightToFinalizationQueue, 0, len(keys))
+	for _, key := range keys {
+		q, err := k.finalizationQueue.Get(ctx, collections.Join(key.K2(), key.K1()))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "get finalization queue: rollapp: %s: height: %d", key.K1(), key.K2())
+		}
+		queues = append(queues, q)
+	}
+	return queues, nil
+}
+
 // MustSetFinalizationQueue is a wrapper for SetFinalizationQueue that panics on error.
 // Panics only on encoding errors (this implies from the internal implementation).
 func (k Keeper) MustSetFinalizationQueue(ctx sdk.Context, queue types.BlockHeightToFinalizationQueue) {
@@ -204,6 +286,9 @@ func (k Keeper) GetFinalizationQueue(ctx sdk.Context, height uint64, rollappID s
 
 // RemoveFinalizationQueue removes types.BlockHeightToFinalizationQueue.
 func (k Keeper) RemoveFinalizationQueue(ctx sdk.Context, height uint64, rollappID string) error {
+	if err := k.finalizationDueHeights.Remove(ctx, collections.Join(rollappID, height)); err != nil {
+		return errorsmod.Wrap(err, "remove finalization due height")
+	}
 	return k.finalizationQueue.Remove(ctx, collections.Join(height, rollappID))
 }
 
@@ -0,0 +1,461 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	common "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+const (
+	disputeReasonTimeout    = "timeout"
+	disputeReasonVerifier   = "verifier"
+	disputeReasonGovernance = "governance"
+	disputeReasonHardFork   = "hard fork"
+	disputeReasonNoVerdict  = "no verdict"
+)
+
+// SetDisputeVerifier sets the verifier which judges the last step of the disputes. Without a verifier, every
+// dispute which reaches the last step is judged by governance.
+func (k *Keeper) SetDisputeVerifier(v types.DisputeVerifier) {
+	k.disputeVerifier = v
+}
+
+// openDispute escrows the challenger bond and starts a dispute game against a pending state info.
+// Only one dispute per state info can be open at a time. While it is, the disputed state info and the ones after it
+// are not finalized.
+func (k Keeper) openDispute(ctx sdk.Context, msg *types.MsgOpenDispute) (types.Dispute, error) {
+	params := k.GetParams(ctx)
+	if params.DisputeMoveBlocks == 0 {
+		return types.Dispute{}, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "disputes are disabled")
+	}
+	if _, found := k.GetRollapp(ctx, msg.RollappId); !found {
+		return types.Dispute{}, types.ErrRollappNotFound
+	}
+	if _, open, err := k.GetOpenDispute(ctx, msg.RollappId, msg.StateInfoIndex); err != nil {
+		return types.Dispute{}, err
+	} else if open {
+		return types.Dispute{}, errorsmod.Wrap(gerrc.ErrAlreadyExists, "open dispute for state info")
+	}
+
+	stateInfo, found := k.GetStateInfo(ctx, msg.RollappId, msg.StateInfoIndex)
+	if !found {
+		return types.Dispute{}, errorsmod.Wrapf(gerrc.ErrNotFound, "state info: index: %d", msg.StateInfoIndex)
+	}
+	if stateInfo.Status != common.Status_PENDING {
+		return types.Dispute{}, errorsmod.Wrapf(types.ErrDisputeAlreadyFinalized, "state info status: %s", stateInfo.Status)
+	}
+	if !stateInfo.ContainsHeight(msg.Height) {
+		return types.Dispute{}, errorsmod.Wrapf(gerrc.ErrOutOfRange, "height is not in the state info: %d", msg.Height)
+	}
+	bd, ok := k.GetBlockDescriptor(ctx, &stateInfo, msg.Height)
+	if !ok {
+		return types.Dispute{}, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "block descriptor is not proven: height: %d", msg.Height)
+	}
+	if bytes.Equal(bd.StateRoot, msg.StateRoot) {
+		return types.Dispute{}, errorsmod.Wrap(gerrc.ErrInvalidArgument, "state root matches the posted one")
+	}
+
+	challenger := sdk.MustAccAddressFromBech32(msg.Challenger)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, challenger, types.ModuleName, sdk.NewCoins(params.DisputeChallengerBond)); err != nil {
+		return types.Dispute{}, errorsmod.Wrap(err, "escrow bond")
+	}
+
+	id, err := k.disputeID.Next(ctx)
+	if err != nil {
+		return types.Dispute{}, err
+	}
+	d := types.Dispute{
+		Id:                  id,
+		RollappId:           msg.RollappId,
+		StateInfoIndex:      msg.StateInfoIndex,
+		Challenger:          msg.Challenger,
+		Proposer:            stateInfo.Sequencer,
+		Bond:                params.DisputeChallengerBond,
+		StartHeight:         stateInfo.StartHeight - 1,
+		EndHeight:           msg.Height,
+		ChallengerStateRoot: msg.StateRoot,
+		Status:              types.DisputeStatus_DISPUTE_BISECTING,
+	}
+	k.nextDisputeMove(ctx, &d)
+
+	if err := k.SetDispute(ctx, d); err != nil {
+		return types.Dispute{}, err
+	}
+	if err := k.openDisputes.Set(ctx, collections.Join(d.RollappId, d.StateInfoIndex), d.Id); err != nil {
+		return types.Dispute{}, err
+	}
+	return d, uevent.EmitTypedEvent(ctx, &types.EventDisputeOpened{Dispute: &d})
+}
+
+// bisectDispute halves the disputed range with the state root the challenger claims at its middle. The
+// proposer's claim at the middle is the state root of the block descriptor there.
+func (k Keeper) bisectDispute(ctx sdk.Context, msg *types.MsgBisectDispute) error {
+	d, err := k.GetDispute(ctx, msg.DisputeId)
+	if err != nil {
+		return err
+	}
+	if d.Challenger != msg.Challenger {
+		return errorsmod.Wrap(gerrc.ErrPermissionDenied, "not the challenger")
+	}
+	if err := k.checkDisputeMove(ctx, d, types.DisputeStatus_DISPUTE_BISECTING); err != nil {
+		return err
+	}
+
+	stateInfo := k.MustGetStateInfo(ctx, d.RollappId, d.StateInfoIndex)
+	mid := d.Midpoint()
+	bd, ok := k.GetBlockDescriptor(ctx, &stateInfo, mid)
+	if !ok {
+		// not reachable: the turn is the proposer's until the block descriptor is known
+		return errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "block descriptor is not proven: height: %d", mid)
+	}
+	if bytes.Equal(bd.StateRoot, msg.StateRoot) {
+		d.StartHeight = mid
+	} else {
+		d.EndHeight = mid
+		d.ChallengerStateRoot = msg.StateRoot
+	}
+	k.nextDisputeMove(ctx, &d)
+
+	if err := k.SetDispute(ctx, d); err != nil {
+		return err
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventDisputeBisected{Dispute: &d})
+}
+
+// submitDisputeProof records the proof of the last step and has it judged by the verifier. If the verifier
+// cannot judge it, the dispute waits for governance, at most for the verdict blocks param. Without a verdict,
+// the dispute is cancelled.
+func (k Keeper) submitDisputeProof(ctx sdk.Context, msg *types.MsgSubmitDisputeProof) error {
+	d, err := k.GetDispute(ctx, msg.DisputeId)
+	if err != nil {
+		return err
+	}
+	if d.Proposer != msg.Proposer {
+		return errorsmod.Wrap(gerrc.ErrPermissionDenied, "not the proposer")
+	}
+	if err := k.checkDisputeMove(ctx, d, types.DisputeStatus_DISPUTE_AWAITING_PROOF); err != nil {
+		return err
+	}
+
+	d.Proof = msg.Proof
+	d.Status = types.DisputeStatus_DISPUTE_AWAITING_VERDICT
+	d.Deadline = ctx.BlockHeight() + int64(k.GetParams(ctx).DisputeVerdictBlocks) //nolint:gosec
+
+	verdict := types.StepUndecided
+	if k.disputeVerifier != nil {
+		verdict, err = k.disputeVerifier.VerifyStep(ctx, d, k.stateRootAt(ctx, d.RollappId, d.StartHeight), k.stateRootAt(ctx, d.RollappId, d.EndHeight))
+		if err != nil {
+			return errorsmod.Wrap(err, "verify step")
+		}
+	}
+	switch verdict {
+	case types.StepFraud:
+		return k.challengerWins(ctx, d, disputeReasonVerifier)
+	case types.StepValid:
+		return k.proposerWins(ctx, d, disputeReasonVerifier)
+	default:
+		return k.SetDispute(ctx, d)
+	}
+}
+
+// ResolveDispute is the governance verdict on the last step of a dispute.
+func (k Keeper) ResolveDispute(goCtx context.Context, msg *types.MsgResolveDispute) (*types.MsgResolveDisputeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrap(gerrc.ErrUnauthenticated, "only the gov module can resolve disputes")
+	}
+
+	d, err := k.GetDispute(ctx, msg.DisputeId)
+	if err != nil {
+		return nil, err
+	}
+	if d.Status != types.DisputeStatus_DISPUTE_AWAITING_VERDICT {
+		return nil, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "dispute is not awaiting a verdict: %s", d.Status)
+	}
+
+	if msg.ChallengerWon {
+		err = k.challengerWins(ctx, d, disputeReasonGovernance)
+	} else {
+		err = k.proposerWins(ctx, d, disputeReasonGovernance)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgResolveDisputeResponse{}, nil
+}
+
+// ProcessDisputeDeadlines ends the open disputes whose side to move missed its deadline. It is called every block.
+// The proposer loses when it does not back its states, and wins when the challenger does not show fraud. When
+// governance does not judge the last step, nobody is at fault and the dispute is cancelled.
+func (k Keeper) ProcessDisputeDeadlines(ctx sdk.Context) {
+	var expired []types.Dispute
+	err := k.openDisputes.Walk(ctx, nil, func(_ collections.Pair[string, uint64], id uint64) (bool, error) {
+		d, err := k.GetDispute(ctx, id)
+		if err != nil {
+			return true, err
+		}
+		if d.Deadline != 0 && d.Deadline < ctx.BlockHeight() {
+			expired = append(expired, d)
+		}
+		return false, nil
+	})
+	if err != nil {
+		k.Logger(ctx).Error("Walk open disputes.", "error", err)
+		return
+	}
+
+	for _, d := range expired {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			switch d.Status {
+			case types.DisputeStatus_DISPUTE_AWAITING_MIDPOINT, types.DisputeStatus_DISPUTE_AWAITING_PROOF:
+				return k.challengerWins(ctx, d, disputeReasonTimeout)
+			case types.DisputeStatus_DISPUTE_AWAITING_VERDICT:
+				return k.cancelDispute(ctx, d, disputeReasonNoVerdict)
+			default:
+				return k.proposerWins(ctx, d, disputeReasonTimeout)
+			}
+		})
+		if err != nil {
+			k.Logger(ctx).Error("End expired dispute.", "dispute", d.Id, "error", err)
+			// do not retry every block
+			if err := k.cancelDispute(ctx, d, disputeReasonTimeout); err != nil {
+				k.Logger(ctx).Error("Cancel expired dispute.", "dispute", d.Id, "error", err)
+			}
+		}
+	}
+}
+
+// CancelRevertedDisputes ends the open disputes against the state infos of the rollapp which a hard fork to
+// lastValidHeight reverts, and returns their challenger bonds. The disputes against earlier state infos go on.
+func (k Keeper) CancelRevertedDisputes(ctx sdk.Context, rollappID string, lastValidHeight uint64) error {
+	disputes, err := k.GetOpenDisputes(ctx, rollappID)
+	if err != nil {
+		return err
+	}
+	for _, d := range disputes {
+		stateInfo := k.MustGetStateInfo(ctx, d.RollappId, d.StateInfoIndex)
+		if stateInfo.GetLatestHeight() <= lastValidHeight {
+			continue
+		}
+		if err := k.cancelDispute(ctx, d, disputeReasonHardFork); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// challengerWins returns the challenger bond, punishes the proposer to the benefit of the challenger and hard
+// forks the rollapp to the last agreed height.
+func (k Keeper) challengerWins(ctx sdk.Context, d types.Dispute, reason string) error {
+	d.Status = types.DisputeStatus_DISPUTE_CHALLENGER_WON
+	if err := k.closeDispute(ctx, d); err != nil {
+		return err
+	}
+
+	challenger := sdk.MustAccAddressFromBech32(d.Challenger)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, challenger, sdk.NewCoins(d.Bond)); err != nil {
+		return errorsmod.Wrap(err, "return bond")
+	}
+	if err := k.SequencerK.PunishSequencer(ctx, d.Proposer, &challenger); err != nil {
+		return errorsmod.Wrap(err, "punish sequencer")
+	}
+	if err := k.HardFork(ctx, d.RollappId, d.EndHeight-1); err != nil {
+		return errorsmod.Wrap(err, "hard fork")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventDisputeResolved{Dispute: &d, Reason: reason})
+}
+
+// proposerWins burns the challenger bond.
+func (k Keeper) proposerWins(ctx sdk.Context, d types.Dispute, reason string) error {
+	d.Status = types.DisputeStatus_DISPUTE_PROPOSER_WON
+	if err := k.closeDispute(ctx, d); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(d.Bond)); err != nil {
+		return errorsmod.Wrap(err, "burn bond")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventDisputeResolved{Dispute: &d, Reason: reason})
+}
+
+func (k Keeper) cancelDispute(ctx sdk.Context, d types.Dispute, reason string) error {
+	d.Status = types.DisputeStatus_DISPUTE_CANCELLED
+	if err := k.closeDispute(ctx, d); err != nil {
+		return err
+	}
+	challenger := sdk.MustAccAddressFromBech32(d.Challenger)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, challenger, sdk.NewCoins(d.Bond)); err != nil {
+		return errorsmod.Wrap(err, "return bond")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventDisputeResolved{Dispute: &d, Reason: reason})
+}
+
+func (k Keeper) closeDispute(ctx sdk.Context, d types.Dispute) error {
+	d.Deadline = 0
+	if err := k.SetDispute(ctx, d); err != nil {
+		return err
+	}
+	return k.openDisputes.Remove(ctx, collections.Join(d.RollappId, d.StateInfoIndex))
+}
+
+// stateRootAt returns the posted state root of the rollapp at height h, or nil if there is none (e.g. at genesis).
+func (k Keeper) stateRootAt(ctx sdk.Context, rollappID string, h uint64) []byte {
+	stateInfo, err := k.FindStateInfoByHeight(ctx, rollappID, h)
+	if err != nil {
+		return nil
+	}
+	bd, _ := k.GetBlockDescriptor(ctx, stateInfo, h)
+	return bd.StateRoot
+}
+
+// nextDisputeMove gives the turn to the proposer once one step is left, or while the block descriptor at the
+// middle of the range is not known because the state update is compressed. Otherwise, the challenger bisects.
+// It sets the deadline of the move.
+func (k Keeper) nextDisputeMove(ctx sdk.Context, d *types.Dispute) {
+	switch {
+	case d.EndHeight-d.StartHeight <= 1:
+		d.Status = types.DisputeStatus_DISPUTE_AWAITING_PROOF
+	case !k.hasDisputeMidpoint(ctx, *d):
+		d.Status = types.DisputeStatus_DISPUTE_AWAITING_MIDPOINT
+	default:
+		d.Status = types.DisputeStatus_DISPUTE_BISECTING
+	}
+	d.Deadline = ctx.BlockHeight() + int64(k.GetParams(ctx).DisputeMoveBlocks) //nolint:gosec
+}
+
+func (k Keeper) hasDisputeMidpoint(ctx sdk.Context, d types.Dispute) bool {
+	stateInfo := k.MustGetStateInfo(ctx, d.RollappId, d.StateInfoIndex)
+	_, ok := k.GetBlockDescriptor(ctx, &stateInfo, d.Midpoint())
+	return ok
+}
+
+// disputeMidpointProven gives the turn back to the challenger once the block descriptor the open dispute of the
+// state info waits for is proven.
+func (k Keeper) disputeMidpointProven(ctx sdk.Context, rollappID string, stateInfoIndex, height uint64) error {
+	d, open, err := k.GetOpenDispute(ctx, rollappID, stateInfoIndex)
+	if err != nil || !open {
+		return err
+	}
+	if d.Midpoint() != height ||
+		k.checkDisputeMove(ctx, d, types.DisputeStatus_DISPUTE_AWAITING_MIDPOINT) != nil {
+		return nil
+	}
+	k.nextDisputeMove(ctx, &d)
+
+	if err := k.SetDispute(ctx, d); err != nil {
+		return err
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventDisputeBisected{Dispute: &d})
+}
+
+func (k Keeper) checkDisputeMove(ctx sdk.Context, d types.Dispute, status types.DisputeStatus) error {
+	if d.Status != status {
+		return errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "dispute status: %s", d.Status)
+	}
+	if d.Deadline < ctx.BlockHeight() {
+		return errorsmod.Wrapf(gerrc.ErrDeadlineExceeded, "deadline: %d", d.Deadline)
+	}
+	return nil
+}
+
+func (k Keeper) SetDispute(ctx sdk.Context, d types.Dispute) error {
+	if err := k.disputes.Set(ctx, collections.Join(d.RollappId, d.Id), d); err != nil {
+		return err
+	}
+	return k.disputeRollapps.Set(ctx, d.Id, d.RollappId)
+}
+
+func (k Keeper) GetDispute(ctx sdk.Context, id uint64) (types.Dispute, error) {
+	rollappID, err := k.disputeRollapps.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Dispute{}, errorsmod.Wrapf(gerrc.ErrNotFound, "dispute: %d", id)
+	}
+	if err != nil {
+		return types.Dispute{}, err
+	}
+	return k.disputes.Get(ctx, collections.Join(rollappID, id))
+}
+
+// GetOpenDispute returns the dispute against the state info which is not over yet, if any.
+func (k Keeper) GetOpenDispute(ctx sdk.Context, rollappID string, stateInfoIndex uint64) (types.Dispute, bool, error) {
+	id, err := k.openDisputes.Get(ctx, collections.Join(rollappID, stateInfoIndex))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Dispute{}, false, nil
+	}
+	if err != nil {
+		return types.Dispute{}, false, err
+	}
+	d, err := k.GetDispute(ctx, id)
+	return d, err == nil, err
+}
+
+// GetOpenDisputes returns the disputes of the rollapp which are not over yet, by state info index.
+func (k Keeper) GetOpenDisputes(ctx sdk.Context, rollappID string) ([]types.Dispute, error) {
+	var disputes []types.Dispute
+	rng := collections.NewPrefixedPairRange[string, uint64](rollappID)
+	err := k.openDisputes.Walk(ctx, rng, func(_ collections.Pair[string, uint64], id uint64) (bool, error) {
+		d, err := k.GetDispute(ctx, id)
+		if err != nil {
+			return true, err
+		}
+		disputes = append(disputes, d)
+		return false, nil
+	})
+	return disputes, err
+}
+
+// GetLowestOpenDispute returns the open dispute of the rollapp against the lowest state info index, if any.
+func (k Keeper) GetLowestOpenDispute(ctx sdk.Context, rollappID string) (types.Dispute, bool, error) {
+	var (
+		d     types.Dispute
+		found bool
+	)
+	rng := collections.NewPrefixedPairRange[string, uint64](rollappID)
+	err := k.openDisputes.Walk(ctx, rng, func(_ collections.Pair[string, uint64], id uint64) (bool, error) {
+		var err error
+		d, err = k.GetDispute(ctx, id)
+		found = err == nil
+		return true, err
+	})
+	return d, found, err
+}
+
+func (k Keeper) HasOpenDispute(ctx sdk.Context, rollappID string) (bool, error) {
+	_, open, err := k.GetLowestOpenDispute(ctx, rollappID)
+	return open, err
+}
+
+func (k Keeper) GetAllDisputes(ctx sdk.Context) ([]types.Dispute, error) {
+	iter, err := k.disputes.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// InitDisputes sets the disputes from genesis, and which of them are open.
+func (k Keeper) InitDisputes(ctx sdk.Context, disputes []types.Dispute) error {
+	var next uint64
+	for _, d := range disputes {
+		if err := k.SetDispute(ctx, d); err != nil {
+			return err
+		}
+		if d.IsOpen() {
+			if err := k.openDisputes.Set(ctx, collections.Join(d.RollappId, d.StateInfoIndex), d.Id); err != nil {
+				return err
+			}
+		}
+		next = max(next, d.Id+1)
+	}
+	return k.disputeID.Set(ctx, next)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// DisputePeriod returns the number of hub blocks the states of the rollapp wait before they are finalized.
+// It is the period chosen by the owner or, if there is none, the global period shortened by how much the
+// proposer bond exceeds the rollapp min bond. Every hard fork of the rollapp adds a penalty on top.
+// The result is kept within the params bounds.
+func (k Keeper) DisputePeriod(ctx sdk.Context, ra types.Rollapp) uint64 {
+	params := k.GetParams(ctx)
+
+	period := ra.DisputePeriodInBlocks
+	if period == 0 {
+		period = k.bondedDisputePeriod(ctx, ra, params.DisputePeriodInBlocks)
+		// the bond never cuts the fraud window below the min
+		period = max(period, params.MinDisputePeriodInBlocks)
+	}
+	period += ra.LatestRevision().Number * params.DisputePeriodFraudPenaltyBlocks
+
+	return params.ClampDisputePeriod(period)
+}
+
+// bondedDisputePeriod scales the period by min bond / proposer bond, so that a proposer with more at stake
+// gets its states finalized sooner.
+func (k Keeper) bondedDisputePeriod(ctx sdk.Context, ra types.Rollapp, period uint64) uint64 {
+	if len(ra.MinSequencerBond) != 1 {
+		return period
+	}
+	proposer := k.SequencerK.GetProposer(ctx, ra.RollappId)
+	if proposer.Sentinel() || proposer.Tokens.Len() != 1 {
+		return period
+	}
+	minBond := ra.MinSequencerBond[0]
+	bond := proposer.TokensCoin()
+	if bond.Denom != minBond.Denom || !bond.Amount.GT(minBond.Amount) {
+		return period
+	}
+	return math.NewIntFromUint64(period).Mul(minBond.Amount).Quo(bond.Amount).Uint64()
+}
+
+func (k Keeper) validDisputePeriod(ctx sdk.Context, period uint64) error {
+	params := k.GetParams(ctx)
+	if params.ClampDisputePeriod(period) != period {
+		return errorsmod.Wrapf(gerrc.ErrOutOfRange, "dispute period is out of bounds: min: %d: max: %d: got: %d",
+			params.MinDisputePeriodInBlocks, params.MaxDisputePeriodInBlocks, period)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/common/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestDisputePeriod() {
+	k := s.k()
+	k.SetParams(s.Ctx, rollapptypes.DefaultParams().
+		WithDisputePeriodInBlocks(4).
+		WithDisputePeriodBounds(2, 20))
+
+	rollappID, proposer := s.CreateDefaultRollappAndProposer()
+	otherID, otherProposer := s.CreateDefaultRollappAndProposer()
+
+	s.Run("owner sets the dispute period within bounds", func() {
+		for _, period := range []uint64{1, 21} {
+			_, err := s.msgServer.UpdateRollappInformation(s.Ctx, &rollapptypes.MsgUpdateRollappInformation{
+				Owner:                 apptesting.Alice,
+				RollappId:             rollappID,
+				DisputePeriodInBlocks: period,
+			})
+			s.Require().ErrorIs(err, gerrc.ErrOutOfRange)
+		}
+
+		_, err := s.msgServer.UpdateRollappInformation(s.Ctx, &rollapptypes.MsgUpdateRollappInformation{
+			Owner:                 apptesting.Alice,
+			RollappId:             rollappID,
+			DisputePeriodInBlocks: 10,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(10), k.DisputePeriod(s.Ctx, k.MustGetRollapp(s.Ctx, rollappID)))
+		s.Require().Equal(uint64(4), k.DisputePeriod(s.Ctx, k.MustGetRollapp(s.Ctx, otherID)))
+	})
+
+	s.Run("states finalize after the dispute period of their rollapp", func() {
+		ctx := s.Ctx.WithBlockHeight(100)
+		_, err := s.PostStateUpdate(ctx, rollappID, proposer, 1, 5)
+		s.Require().NoError(err)
+		_, err = s.PostStateUpdate(ctx, otherID, otherProposer, 1, 5)
+		s.Require().NoError(err)
+
+		k.FinalizeRollappStates(ctx.WithBlockHeight(104))
+		s.Require().Equal(types.Status_PENDING, k.MustGetStateInfo(ctx, rollappID, 1).Status)
+		s.Require().Equal(types.Status_FINALIZED, k.MustGetStateInfo(ctx, otherID, 1).Status)
+
+		k.FinalizeRollappStates(ctx.WithBlockHeight(109))
+		s.Require().Equal(types.Status_PENDING, k.MustGetStateInfo(ctx, rollappID, 1).Status)
+
+		k.FinalizeRollappStates(ctx.WithBlockHeight(110))
+		s.Require().Equal(types.Status_FINALIZED, k.MustGetStateInfo(ctx, rollappID, 1).Status)
+	})
+
+	s.Run("larger proposer bond shortens the derived dispute period", func() {
+		seq := s.App.SequencerKeeper.GetSequencer(s.Ctx, otherProposer)
+		seq.Tokens = seq.Tokens.Add(seq.Tokens...)
+		s.App.SequencerKeeper.SetSequencer(s.Ctx, seq)
+		s.Require().Equal(uint64(2), k.DisputePeriod(s.Ctx, k.MustGetRollapp(s.Ctx, otherID)))
+
+		// the owner choice is not affected by the bond
+		seq = s.App.SequencerKeeper.GetSequencer(s.Ctx, proposer)
+		seq.Tokens = seq.Tokens.Add(seq.Tokens...)
+		s.App.SequencerKeeper.SetSequencer(s.Ctx, seq)
+		s.Require().Equal(uint64(10), k.DisputePeriod(s.Ctx, k.MustGetRollapp(s.Ctx, rollappID)))
+
+		// the bond never cuts the period below the min
+		k.SetParams(s.Ctx, k.GetParams(s.Ctx).WithDisputePeriodBounds(3, 20))
+		s.Require().Equal(uint64(3), k.DisputePeriod(s.Ctx, k.MustGetRollapp(s.Ctx, otherID)))
+		k.SetParams(s.Ctx, k.GetParams(s.Ctx).WithDisputePeriodBounds(2, 20))
+	})
+
+	s.Run("states are never due before earlier states of the rollapp", func() {
+		ctx := s.Ctx.WithBlockHeight(200)
+		_, err := s.PostStateUpdate(ctx, rollappID, proposer, 6, 5)
+		s.Require().NoError(err)
+
+		// the owner shortens the period, the states submitted before keep theirs
+		_, err = s.msgServer.UpdateRollappInformation(ctx, &rollapptypes.MsgUpdateRollappInformation{
+			Owner:                 apptesting.Alice,
+			RollappId:             rollappID,
+			DisputePeriodInBlocks: 2,
+		})
+		s.Require().NoError(err)
+		_, err = s.PostStateUpdate(ctx.WithBlockHeight(201), rollappID, proposer, 11, 5)
+		s.Require().NoError(err)
+
+		due, found, err := k.GetFinalizationDueHeight(ctx, rollappID, 201)
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Require().Equal(uint64(210), due)
+
+		k.FinalizeRollappStates(ctx.WithBlockHeight(209))
+		s.Require().Equal(types.Status_PENDING, k.MustGetStateInfo(ctx, rollappID, 2).Status)
+		s.Require().Equal(types.Status_PENDING, k.MustGetStateInfo(ctx, rollappID, 3).Status)
+		k.FinalizeRollappStates(ctx.WithBlockHeight(210))
+		s.Require().Equal(types.Status_FINALIZED, k.MustGetStateInfo(ctx, rollappID, 2).Status)
+		s.Require().Equal(types.Status_FINALIZED, k.MustGetStateInfo(ctx, rollappID, 3).Status)
+	})
+
+	s.Run("hard forks add a penalty, up to the max", func() {
+		ra := k.MustGetRollapp(s.Ctx, rollappID)
+		ra.DisputePeriodInBlocks = 10
+		ra.BumpRevision(10)
+		s.Require().Equal(10+rollapptypes.DefaultDisputePeriodFraudPenaltyBlocks, k.DisputePeriod(s.Ctx, ra))
+		for range 5 {
+			ra.BumpRevision(10)
+		}
+		s.Require().Equal(uint64(20), k.DisputePeriod(s.Ctx, ra))
+	})
+
+	s.Run("the default bounds let the bond shorten the global dispute period", func() {
+		k.SetParams(s.Ctx, rollapptypes.DefaultParams())
+		// the proposer of the other rollapp has twice the min bond
+		s.Require().Equal(rollapptypes.DefaultDisputePeriodInBlocks/2, k.DisputePeriod(s.Ctx, k.MustGetRollapp(s.Ctx, otherID)))
+	})
+}
@@ -0,0 +1,349 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	common "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+type mockDisputeVerifier struct {
+	verdict types.StepVerdict
+}
+
+func (v mockDisputeVerifier) VerifyStep(sdk.Context, types.Dispute, []byte, []byte) (types.StepVerdict, error) {
+	return v.verdict, nil
+}
+
+// setupDispute posts two batches of 8 blocks, the state root of each block being its height
+func (s *RollappTestSuite) setupDispute() (string, string, sdk.AccAddress) {
+	s.k().SetHooks(nil)
+	s.k().SetParams(s.Ctx, s.k().GetParams(s.Ctx).WithDisputePeriodInBlocks(100))
+	s.Ctx = s.Ctx.WithBlockHeight(1)
+
+	rollappID, proposer := s.CreateDefaultRollappAndProposer()
+	ra := s.k().MustGetRollapp(s.Ctx, rollappID)
+	ra.GenesisState.TransferProofHeight = 1
+	s.k().SetRollapp(s.Ctx, ra)
+
+	lastHeight := uint64(1)
+	for idx := uint64(1); idx <= 2; idx++ {
+		var err error
+		lastHeight, err = s.PostStateUpdate(s.Ctx, rollappID, proposer, lastHeight, 8)
+		s.Require().NoError(err)
+		stateInfo := s.k().MustGetStateInfo(s.Ctx, rollappID, idx)
+		for i := range stateInfo.BDs.BD {
+			stateInfo.BDs.BD[i].StateRoot = []byte{byte(stateInfo.BDs.BD[i].Height)}
+		}
+		s.k().SetStateInfo(s.Ctx, stateInfo)
+	}
+
+	challenger := apptesting.CreateRandomAccounts(1)[0]
+	apptesting.FundAccount(s.App, s.Ctx, challenger, sdk.NewCoins(s.k().GetParams(s.Ctx).DisputeChallengerBond))
+	return rollappID, proposer, challenger
+}
+
+func (s *RollappTestSuite) TestDisputeGame() {
+	s.Run("bisection and governance verdict for the challenger", func() {
+		s.SetupTest()
+		rollappID, proposer, challenger := s.setupDispute()
+
+		res, err := s.msgServer.OpenDispute(s.Ctx, &types.MsgOpenDispute{
+			Challenger:     challenger.String(),
+			RollappId:      rollappID,
+			StateInfoIndex: 2,
+			Height:         16,
+			StateRoot:      []byte{99},
+		})
+		s.Require().NoError(err)
+		s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, challenger).IsZero())
+
+		_, err = s.msgServer.OpenDispute(s.Ctx, &types.MsgOpenDispute{
+			Challenger:     challenger.String(),
+			RollappId:      rollappID,
+			StateInfoIndex: 2,
+			Height:         10,
+			StateRoot:      []byte{99},
+		})
+		s.Require().ErrorIs(err, gerrc.ErrAlreadyExists)
+
+		// only the disputed state is held back
+		s.k().FinalizeRollappStates(s.Ctx.WithBlockHeight(1000))
+		s.Require().Equal(common.Status_FINALIZED, s.k().MustGetStateInfo(s.Ctx, rollappID, 1).Status)
+		s.Require().Equal(common.Status_PENDING, s.k().MustGetStateInfo(s.Ctx, rollappID, 2).Status)
+
+		// agree at 12, disagree at 14 and 13
+		for _, root := range [][]byte{{12}, {98}, {97}} {
+			_, err = s.msgServer.BisectDispute(s.Ctx, &types.MsgBisectDispute{
+				Challenger: challenger.String(),
+				DisputeId:  res.DisputeId,
+				StateRoot:  root,
+			})
+			s.Require().NoError(err)
+		}
+		d, err := s.k().GetDispute(s.Ctx, res.DisputeId)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DisputeStatus_DISPUTE_AWAITING_PROOF, d.Status)
+		s.Require().Equal(uint64(12), d.StartHeight)
+		s.Require().Equal(uint64(13), d.EndHeight)
+
+		_, err = s.msgServer.SubmitDisputeProof(s.Ctx, &types.MsgSubmitDisputeProof{
+			Proposer:  challenger.String(),
+			DisputeId: res.DisputeId,
+			Proof:     []byte("proof"),
+		})
+		s.Require().ErrorIs(err, gerrc.ErrPermissionDenied)
+		_, err = s.msgServer.SubmitDisputeProof(s.Ctx, &types.MsgSubmitDisputeProof{
+			Proposer:  proposer,
+			DisputeId: res.DisputeId,
+			Proof:     []byte("proof"),
+		})
+		s.Require().NoError(err)
+		d, err = s.k().GetDispute(s.Ctx, res.DisputeId)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DisputeStatus_DISPUTE_AWAITING_VERDICT, d.Status)
+		s.Require().Equal(s.Ctx.BlockHeight()+int64(s.k().GetParams(s.Ctx).DisputeVerdictBlocks), d.Deadline)
+
+		_, err = s.k().ResolveDispute(s.Ctx, &types.MsgResolveDispute{
+			Authority:     authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			DisputeId:     res.DisputeId,
+			ChallengerWon: true,
+		})
+		s.Require().NoError(err)
+
+		d, err = s.k().GetDispute(s.Ctx, res.DisputeId)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DisputeStatus_DISPUTE_CHALLENGER_WON, d.Status)
+		s.Require().Equal(uint64(1), s.k().MustGetRollapp(s.Ctx, rollappID).LatestRevision().Number)
+		s.Require().Equal(d.StartHeight, s.GetRollappLastHeight(rollappID))
+		s.Require().False(s.App.BankKeeper.GetAllBalances(s.Ctx, challenger).IsZero())
+		s.Require().True(s.App.SequencerKeeper.GetSequencer(s.Ctx, proposer).Tokens.IsZero())
+	})
+
+	s.Run("verifier judges the step valid", func() {
+		s.SetupTest()
+		s.k().SetDisputeVerifier(mockDisputeVerifier{verdict: types.StepValid})
+		rollappID, proposer, challenger := s.setupDispute()
+
+		res, err := s.msgServer.OpenDispute(s.Ctx, &types.MsgOpenDispute{
+			Challenger:     challenger.String(),
+			RollappId:      rollappID,
+			StateInfoIndex: 1,
+			Height:         1,
+			StateRoot:      []byte{99},
+		})
+		s.Require().NoError(err)
+
+		// one step is left right away
+		_, err = s.msgServer.SubmitDisputeProof(s.Ctx, &types.MsgSubmitDisputeProof{
+			Proposer:  proposer,
+			DisputeId: res.DisputeId,
+			Proof:     []byte("proof"),
+		})
+		s.Require().NoError(err)
+
+		d, err := s.k().GetDispute(s.Ctx, res.DisputeId)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DisputeStatus_DISPUTE_PROPOSER_WON, d.Status)
+		s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, challenger).IsZero())
+		s.Require().Zero(s.k().MustGetRollapp(s.Ctx, rollappID).LatestRevision().Number)
+	})
+
+	s.Run("challenger misses the deadline", func() {
+		s.SetupTest()
+		rollappID, _, challenger := s.setupDispute()
+
+		res, err := s.msgServer.OpenDispute(s.Ctx, &types.MsgOpenDispute{
+			Challenger:     challenger.String(),
+			RollappId:      rollappID,
+			StateInfoIndex: 2,
+			Height:         16,
+			StateRoot:      []byte{99},
+		})
+		s.Require().NoError(err)
+
+		deadline := s.Ctx.BlockHeight() + int64(s.k().GetParams(s.Ctx).DisputeMoveBlocks)
+		s.k().ProcessDisputeDeadlines(s.Ctx.WithBlockHeight(deadline))
+		d, err := s.k().GetDispute(s.Ctx, res.DisputeId)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DisputeStatus_DISPUTE_BISECTING, d.Status)
+
+		ctx := s.Ctx.WithBlockHeight(deadline + 1)
+		_, err = s.msgServer.BisectDispute(ctx, &types.MsgBisectDispute{
+			Challenger: challenger.String(),
+			DisputeId:  res.DisputeId,
+			StateRoot:  []byte{12},
+		})
+		s.Require().ErrorIs(err, gerrc.ErrDeadlineExceeded)
+
+		s.k().ProcessDisputeDeadlines(ctx)
+		d, err = s.k().GetDispute(s.Ctx, res.DisputeId)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DisputeStatus_DISPUTE_PROPOSER_WON, d.Status)
+		open, err := s.k().HasOpenDispute(s.Ctx, rollappID)
+		s.Require().NoError(err)
+		s.Require().False(open)
+	})
+
+	s.Run("a dispute against an earlier state holds it back", func() {
+		s.SetupTest()
+		rollappID, _, challenger := s.setupDispute()
+		puppet := apptesting.CreateRandomAccounts(1)[0]
+		apptesting.FundAccount(s.App, s.Ctx, puppet, sdk.NewCoins(s.k().GetParams(s.Ctx).DisputeChallengerBond))
+
+		// the proposer disputes its own honest later state
+		puppetRes, err := s.msgServer.OpenDispute(s.Ctx, &types.MsgOpenDispute{
+			Challenger:     puppet.String(),
+			RollappId:      rollappID,
+			StateInfoIndex: 2,
+			Height:         16,
+			StateRoot:      []byte{99},
+		})
+		s.Require().NoError(err)
+		res, err := s.msgServer.OpenDispute(s.Ctx, &types.MsgOpenDispute{
+			Challenger:     challenger.String(),
+			RollappId:      rollappID,
+			StateInfoIndex: 1,
+			Height:         2,
+			StateRoot:      []byte{99},
+		})
+		s.Require().NoError(err)
+
+		s.k().FinalizeRollappStates(s.Ctx.WithBlockHeight(1000))
+		s.Require().Equal(common.Status_PENDING, s.k().MustGetStateInfo(s.Ctx, rollappID, 1).Status)
+
+		// agree at 1, the proposer does not prove the step to 2
+		_, err = s.msgServer.BisectDispute(s.Ctx, &types.MsgBisectDispute{
+			Challenger: challenger.String(),
+			DisputeId:  res.DisputeId,
+			StateRoot:  []byte{1},
+		})
+		s.Require().NoError(err)
+		d, err := s.k().GetDispute(s.Ctx, res.DisputeId)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DisputeStatus_DISPUTE_AWAITING_PROOF, d.Status)
+		s.k().ProcessDisputeDeadlines(s.Ctx.WithBlockHeight(d.Deadline + 1))
+
+		d, err = s.k().GetDispute(s.Ctx, res.DisputeId)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DisputeStatus_DISPUTE_CHALLENGER_WON, d.Status)
+		s.Require().False(s.App.BankKeeper.GetAllBalances(s.Ctx, challenger).IsZero())
+
+		// the fork reverts the state of the other dispute, which is cancelled
+		d, err = s.k().GetDispute(s.Ctx, puppetRes.DisputeId)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DisputeStatus_DISPUTE_CANCELLED, d.Status)
+		open, err := s.k().HasOpenDispute(s.Ctx, rollappID)
+		s.Require().NoError(err)
+		s.Require().False(open)
+	})
+
+	s.Run("governance misses the verdict deadline", func() {
+		s.SetupTest()
+		rollappID, proposer, challenger := s.setupDispute()
+
+		res, err := s.msgServer.OpenDispute(s.Ctx, &types.MsgOpenDispute{
+			Challenger:     challenger.String(),
+			RollappId:      rollappID,
+			StateInfoIndex: 1,
+			Height:         1,
+			StateRoot:      []byte{99},
+		})
+		s.Require().NoError(err)
+		_, err = s.msgServer.SubmitDisputeProof(s.Ctx, &types.MsgSubmitDisputeProof{
+			Proposer:  proposer,
+			DisputeId: res.DisputeId,
+			Proof:     []byte("proof"),
+		})
+		s.Require().NoError(err)
+
+		d, err := s.k().GetDispute(s.Ctx, res.DisputeId)
+		s.Require().NoError(err)
+		s.k().ProcessDisputeDeadlines(s.Ctx.WithBlockHeight(d.Deadline + 1))
+		d, err = s.k().GetDispute(s.Ctx, res.DisputeId)
+		s.Require().NoError(err)
+		// nobody is at fault, the challenger gets the bond back
+		s.Require().Equal(types.DisputeStatus_DISPUTE_CANCELLED, d.Status)
+		s.Require().Equal(sdk.NewCoins(d.Bond), s.App.BankKeeper.GetAllBalances(s.Ctx, challenger))
+		s.Require().Zero(s.k().MustGetRollapp(s.Ctx, rollappID).LatestRevision().Number)
+		open, err := s.k().HasOpenDispute(s.Ctx, rollappID)
+		s.Require().NoError(err)
+		s.Require().False(open)
+	})
+
+	s.Run("proposer proves the midpoints of a compressed state update", func() {
+		s.SetupTest()
+		rollappID, proposer, challenger := s.setupDispute()
+
+		// compress the second batch, only its first and last block descriptors are kept
+		root := func(h uint64) []byte {
+			r := make([]byte, 32)
+			r[0] = byte(h)
+			return r
+		}
+		stateInfo := s.k().MustGetStateInfo(s.Ctx, rollappID, 2)
+		bds := stateInfo.BDs.BD
+		for i := range bds {
+			bds[i].StateRoot = root(bds[i].Height)
+		}
+		proofs := types.BlockDescriptorsProofs(bds)
+		stateInfo.BdsRoot = types.BlockDescriptorsRoot(bds)
+		stateInfo.BDs.BD = []types.BlockDescriptor{bds[0], bds[len(bds)-1]}
+		s.k().SetStateInfo(s.Ctx, stateInfo)
+		prove := func(h uint64) {
+			i := h - stateInfo.StartHeight
+			_, err := s.msgServer.ProveBlockDescriptor(s.Ctx, &types.MsgProveBlockDescriptor{
+				Creator:        proposer,
+				RollappId:      rollappID,
+				StateInfoIndex: 2,
+				Bd:             bds[i],
+				Proof:          proofs[i],
+			})
+			s.Require().NoError(err)
+		}
+
+		res, err := s.msgServer.OpenDispute(s.Ctx, &types.MsgOpenDispute{
+			Challenger:     challenger.String(),
+			RollappId:      rollappID,
+			StateInfoIndex: 2,
+			Height:         16,
+			StateRoot:      []byte{99},
+		})
+		s.Require().NoError(err)
+		d, err := s.k().GetDispute(s.Ctx, res.DisputeId)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DisputeStatus_DISPUTE_AWAITING_MIDPOINT, d.Status)
+
+		bisect := func() error {
+			_, err := s.msgServer.BisectDispute(s.Ctx, &types.MsgBisectDispute{
+				Challenger: challenger.String(),
+				DisputeId:  res.DisputeId,
+				StateRoot:  root(12),
+			})
+			return err
+		}
+		s.Require().ErrorIs(bisect(), gerrc.ErrFailedPrecondition)
+
+		// the proof of the block descriptor at 12 gives the turn back to the challenger
+		prove(12)
+		d, err = s.k().GetDispute(s.Ctx, res.DisputeId)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DisputeStatus_DISPUTE_BISECTING, d.Status)
+		s.Require().NoError(bisect())
+
+		// the proposer does not prove the block descriptor at 14 in time
+		d, err = s.k().GetDispute(s.Ctx, res.DisputeId)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DisputeStatus_DISPUTE_AWAITING_MIDPOINT, d.Status)
+		s.Require().Equal(uint64(14), d.Midpoint())
+		s.k().ProcessDisputeDeadlines(s.Ctx.WithBlockHeight(d.Deadline + 1))
+		d, err = s.k().GetDispute(s.Ctx, res.DisputeId)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DisputeStatus_DISPUTE_CHALLENGER_WON, d.Status)
+		s.Require().Equal(uint64(1), s.k().MustGetRollapp(s.Ctx, rollappID).LatestRevision().Number)
+	})
+}
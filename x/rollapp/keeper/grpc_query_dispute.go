@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k Keeper) Dispute(goCtx context.Context, req *types.QueryDisputeRequest) (*types.QueryDisputeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	d, err := k.GetDispute(ctx, req.DisputeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDisputeResponse{Dispute: d}, nil
+}
+
+func (k Keeper) Disputes(goCtx context.Context, req *types.QueryDisputesRequest) (*types.QueryDisputesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	disputes, pageRes, err := query.CollectionPaginate(ctx, k.disputes, req.Pagination,
+		func(_ collections.Pair[string, uint64], d types.Dispute) (types.Dispute, error) {
+			return d, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.RollappId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDisputesResponse{Disputes: disputes, Pagination: pageRes}, nil
+}
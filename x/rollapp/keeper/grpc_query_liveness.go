@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k Keeper) LivenessEvent(goCtx context.Context, req *types.QueryLivenessEventRequest) (*types.QueryLivenessEventResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ra, ok := k.GetRollapp(ctx, req.RollappId)
+	if !ok {
+		return nil, errorsmod.Wrap(gerrc.ErrNotFound, "rollapp")
+	}
+
+	res := &types.QueryLivenessEventResponse{
+		HubHeight:            ra.LivenessEventHeight,
+		MaintenanceEndHeight: ra.MaintenanceEndHeight,
+	}
+	if ra.LivenessEventHeight == 0 {
+		return res, nil
+	}
+	res.BlocksRemaining = uint64(max(0, ra.LivenessEventHeight-ctx.BlockHeight()))   //nolint:gosec
+	res.Downtime = uint64(max(0, ctx.BlockHeight()-ra.LivenessCountdownStartHeight)) //nolint:gosec
+	if proposer := k.SequencerK.GetProposer(ctx, req.RollappId); !proposer.Sentinel() {
+		res.Proposer = proposer.Address
+		res.ProjectedSlash = k.SequencerK.LivenessSlashAmount(ctx, proposer)
+	}
+	return res, nil
+}
+
+func (k Keeper) LivenessHistory(goCtx context.Context, req *types.QueryLivenessHistoryRequest) (*types.QueryLivenessHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	records, pageRes, err := query.CollectionPaginate(ctx, k.livenessHistory, req.Pagination,
+		func(_ collections.Pair[string, int64], r types.LivenessRecord) (types.LivenessRecord, error) {
+			return r, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, int64](req.RollappId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLivenessHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -61,8 +61,22 @@ func getSummaryResponse(ctx sdk.Context, k Keeper, rollapp types.Rollapp, ok, wi
 		return nil, errorsmod.Wrap(gerrc.ErrNotFound, "rollapp")
 	}
 
+	resp := &types.QueryGetRollappResponse{
+		Rollapp: rollapp,
+		Summary: k.rollappSummary(ctx, rollapp),
+	}
+
+	if withApps {
+		resp.Apps = k.GetRollappApps(ctx, rollapp.RollappId)
+	}
+
+	return resp, nil
+}
+
+func (k Keeper) rollappSummary(ctx sdk.Context, rollapp types.Rollapp) types.RollappSummary {
 	s := types.RollappSummary{
-		RollappId: rollapp.RollappId,
+		RollappId:             rollapp.RollappId,
+		DisputePeriodInBlocks: k.DisputePeriod(ctx, rollapp),
 	}
 	latestStateInfoIndex, found := k.GetLatestStateInfoIndex(ctx, rollapp.RollappId)
 	if found {
@@ -84,14 +98,5 @@ func getSummaryResponse(ctx sdk.Context, k Keeper, rollapp types.Rollapp, ok, wi
 		}
 	}
 
-	resp := &types.QueryGetRollappResponse{
-		Rollapp: rollapp,
-		Summary: s,
-	}
-
-	if withApps {
-		resp.Apps = k.GetRollappApps(ctx, rollapp.RollappId)
-	}
-
-	return resp, nil
+	return s
 }
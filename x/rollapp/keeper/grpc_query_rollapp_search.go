@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// RollappSearch searches the rollapps registry. The most selective filter drives the search through its index,
+// the other filters are checked against the registry entry of each rollapp.
+func (k Keeper) RollappSearch(goCtx context.Context, req *types.QueryRollappSearchRequest) (*types.QueryRollappSearchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		rollapps []types.RollappSummary
+		pageRes  *query.PageResponse
+		err      error
+		idx      = k.registryIndexes
+	)
+	switch {
+	case req.Owner != "":
+		rollapps, pageRes, err = searchRegistryIndex(ctx, k, idx.byOwner, pairRollappID, req,
+			query.WithCollectionPaginationPairPrefix[string, string](req.Owner))
+	case req.DisplayNamePrefix != "":
+		prefix := strings.ToLower(req.DisplayNamePrefix)
+		rollapps, pageRes, err = searchRegistryIndex(ctx, k, idx.byDisplayName, registryDisplayNameKeyRollappID, req,
+			func(opt *query.CollectionsPaginateOptions[string]) { opt.Prefix = &prefix })
+	case req.DrsVersion != 0:
+		rollapps, pageRes, err = searchRegistryIndex(ctx, k, idx.byDRSVersion, pairRollappID, req,
+			query.WithCollectionPaginationPairPrefix[uint32, string](req.DrsVersion))
+	case req.VmType != types.Rollapp_Unspecified:
+		rollapps, pageRes, err = searchRegistryIndex(ctx, k, idx.byVMType, pairRollappID, req,
+			query.WithCollectionPaginationPairPrefix[int32, string](int32(req.VmType)))
+	case req.Launched != types.BoolFilter_BOOL_FILTER_ANY:
+		rollapps, pageRes, err = searchRegistryIndex(ctx, k, idx.byLaunched, pairRollappID, req,
+			query.WithCollectionPaginationPairPrefix[bool, string](req.Launched.Matches(true)))
+	case req.HasIro != types.BoolFilter_BOOL_FILTER_ANY:
+		rollapps, pageRes, err = searchRegistryIndex(ctx, k, idx.byIRO, pairRollappID, req,
+			query.WithCollectionPaginationPairPrefix[bool, string](req.HasIro.Matches(true)))
+	case req.HasCanonicalChannel != types.BoolFilter_BOOL_FILTER_ANY:
+		rollapps, pageRes, err = searchRegistryIndex(ctx, k, idx.byChannel, pairRollappID, req,
+			query.WithCollectionPaginationPairPrefix[bool, string](req.HasCanonicalChannel.Matches(true)))
+	case req.CreatedAfter != nil || req.CreatedBefore != nil:
+		// the rollapps are returned by creation time
+		rollapps, pageRes, err = searchRegistryIndex(ctx, k, idx.byCreatedAt, pairRollappID, req)
+	default:
+		rollapps, pageRes, err = query.CollectionFilteredPaginate(ctx, k.registry, req.Pagination,
+			func(_ string, e types.RollappRegistryEntry) (bool, error) {
+				return e.Matches(req), nil
+			},
+			func(rollappID string, _ types.RollappRegistryEntry) (types.RollappSummary, error) {
+				return k.rollappSummary(ctx, k.MustGetRollapp(ctx, rollappID)), nil
+			},
+		)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRollappSearchResponse{Rollapps: rollapps, Pagination: pageRes}, nil
+}
+
+// searchRegistryIndex paginates over the rollapps of the index which match the search
+func searchRegistryIndex[K any](
+	ctx sdk.Context,
+	k Keeper,
+	index collections.KeySet[K],
+	rollappID func(K) string,
+	req *types.QueryRollappSearchRequest,
+	opts ...func(opt *query.CollectionsPaginateOptions[K]),
+) ([]types.RollappSummary, *query.PageResponse, error) {
+	return query.CollectionFilteredPaginate(ctx, index, req.Pagination,
+		func(key K, _ collections.NoValue) (bool, error) {
+			e, err := k.registry.Get(ctx, rollappID(key))
+			if err != nil {
+				return false, err
+			}
+			return e.Matches(req), nil
+		},
+		func(key K, _ collections.NoValue) (types.RollappSummary, error) {
+			return k.rollappSummary(ctx, k.MustGetRollapp(ctx, rollappID(key))), nil
+		},
+		opts...,
+	)
+}
+
+func pairRollappID[K1 any](key collections.Pair[K1, string]) string {
+	return key.K2()
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestRollappSearch() {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := created.Add(time.Hour)
+	owner := apptesting.CreateRandomAccounts(1)[0].String()
+
+	rollapps := []types.Rollapp{
+		{
+			RollappId: "alpha_1000-1",
+			Owner:     owner,
+			VmType:    types.Rollapp_EVM,
+			Launched:  true,
+			ChannelId: "channel-0",
+			Metadata:  &types.RollappMetadata{DisplayName: "Dymension Alpha"},
+			CreatedAt: &created,
+		},
+		{
+			RollappId: "beta_1001-1",
+			Owner:     apptesting.Alice,
+			VmType:    types.Rollapp_WASM,
+			Metadata:  &types.RollappMetadata{DisplayName: "Beta"},
+			CreatedAt: &later,
+		},
+		{
+			// created before the creation time was recorded
+			RollappId: "gamma_1002-1",
+			Owner:     owner,
+			VmType:    types.Rollapp_EVM,
+			Metadata:  &types.RollappMetadata{DisplayName: "dymlabs"},
+		},
+	}
+	for _, ra := range rollapps {
+		s.k().SetRollapp(s.Ctx, ra)
+	}
+	s.Require().NoError(s.k().SetRegistryDRSVersion(s.Ctx, "beta_1001-1", 3))
+
+	search := func(req types.QueryRollappSearchRequest) []string {
+		res, err := s.k().RollappSearch(s.Ctx, &req)
+		s.Require().NoError(err)
+		var ids []string
+		for _, ra := range res.Rollapps {
+			ids = append(ids, ra.RollappId)
+		}
+		return ids
+	}
+
+	tcs := []struct {
+		name string
+		req  types.QueryRollappSearchRequest
+		exp  []string
+	}{
+		{"no filter", types.QueryRollappSearchRequest{}, []string{"alpha_1000-1", "beta_1001-1", "gamma_1002-1"}},
+		{"vm type", types.QueryRollappSearchRequest{VmType: types.Rollapp_EVM}, []string{"alpha_1000-1", "gamma_1002-1"}},
+		{"not launched", types.QueryRollappSearchRequest{Launched: types.BoolFilter_BOOL_FILTER_FALSE}, []string{"beta_1001-1", "gamma_1002-1"}},
+		{"drs version", types.QueryRollappSearchRequest{DrsVersion: 3}, []string{"beta_1001-1"}},
+		{"iro", types.QueryRollappSearchRequest{HasIro: types.BoolFilter_BOOL_FILTER_TRUE}, nil},
+		{"canonical channel", types.QueryRollappSearchRequest{HasCanonicalChannel: types.BoolFilter_BOOL_FILTER_TRUE}, []string{"alpha_1000-1"}},
+		{"owner", types.QueryRollappSearchRequest{Owner: owner}, []string{"alpha_1000-1", "gamma_1002-1"}},
+		{"owner and vm type", types.QueryRollappSearchRequest{Owner: owner, VmType: types.Rollapp_WASM}, nil},
+		{"display name prefix", types.QueryRollappSearchRequest{DisplayNamePrefix: "DYM"}, []string{"alpha_1000-1", "gamma_1002-1"}},
+		{"display name prefix and launched", types.QueryRollappSearchRequest{DisplayNamePrefix: "dym", Launched: types.BoolFilter_BOOL_FILTER_TRUE}, []string{"alpha_1000-1"}},
+		{"created after", types.QueryRollappSearchRequest{CreatedAfter: &later}, []string{"beta_1001-1"}},
+		{"created before", types.QueryRollappSearchRequest{CreatedBefore: &later}, []string{"alpha_1000-1"}},
+	}
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.exp, search(tc.req))
+		})
+	}
+
+	// the indexes follow the updates of the rollapp
+	gamma := s.k().MustGetRollapp(s.Ctx, "gamma_1002-1")
+	gamma.Owner = apptesting.Alice
+	gamma.Metadata.DisplayName = "Gamma"
+	s.k().SetRollapp(s.Ctx, gamma)
+	s.Require().Equal([]string{"alpha_1000-1"}, search(types.QueryRollappSearchRequest{Owner: owner}))
+	s.Require().Equal([]string{"alpha_1000-1"}, search(types.QueryRollappSearchRequest{DisplayNamePrefix: "dym"}))
+
+	// pagination
+	res, err := s.k().RollappSearch(s.Ctx, &types.QueryRollappSearchRequest{
+		VmType:     types.Rollapp_EVM,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Rollapps, 1)
+	s.Require().NotEmpty(res.Pagination.NextKey)
+
+	next, err := s.k().RollappSearch(s.Ctx, &types.QueryRollappSearchRequest{
+		VmType:     types.Rollapp_EVM,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(next.Rollapps, 1)
+	s.Require().Equal("gamma_1002-1", next.Rollapps[0].RollappId)
+
+	// rollapps created through the msg server record their creation time
+	rollappID := s.CreateDefaultRollapp()
+	entry, err := s.k().GetRegistryEntry(s.Ctx, rollappID)
+	s.Require().NoError(err)
+	s.Require().Equal(s.Ctx.BlockTime().Unix(), entry.CreatedAt)
+}
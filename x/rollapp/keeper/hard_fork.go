@@ -24,6 +24,11 @@ func (k Keeper) HardFork(ctx sdk.Context, rollappID string, lastValidHeight uint
 		return gerrc.ErrFailedPrecondition.Wrap("fork not allowed")
 	}
 
+	// the disputed states are reverted, if the dispute did not cause the fork itself
+	if err := k.CancelRevertedDisputes(ctx, rollappID, lastValidHeight); err != nil {
+		return errorsmod.Wrap(err, "cancel reverted disputes")
+	}
+
 	_, ok := k.GetLatestStateInfo(ctx, rollappID)
 	if ok {
 		lastValidHeight, err := k.RevertPendingStates(ctx, rollappID, lastValidHeight+1)
@@ -38,6 +43,9 @@ func (k Keeper) HardFork(ctx sdk.Context, rollappID string, lastValidHeight uint
 	// stop liveness events
 	k.ResetLivenessClock(ctx, &rollapp)
 	k.SetRollapp(ctx, rollapp)
+	if err := k.RefreshRegistryDRSVersion(ctx, rollappID); err != nil {
+		return errorsmod.Wrap(err, "refresh registry drs version")
+	}
 
 	// handle the sequencers, clean delayed packets, handle light client
 	err := k.hooks.OnHardFork(ctx, rollappID, lastValidHeight)
@@ -94,7 +102,12 @@ func (k Keeper) RevertPendingStates(ctx sdk.Context, rollappID string, newRevisi
 	lastIdx, _ := k.GetLatestStateInfoIndex(ctx, rollappID)
 	for i := lastStateIdxToKeep + 1; i <= lastIdx.Index; i++ {
 		// Add the proposer to the unique map
-		uniqueProposers[k.MustGetStateInfo(ctx, rollappID, i).Sequencer] = struct{}{}
+		removed := k.MustGetStateInfo(ctx, rollappID, i)
+		uniqueProposers[removed.Sequencer] = struct{}{}
+
+		if err := k.pruneProvenBlockDescriptors(ctx, removed); err != nil {
+			return 0, errorsmod.Wrap(err, "prune proven block descriptors")
+		}
 
 		// clear the state info
 		k.RemoveStateInfo(ctx, rollappID, i)
@@ -130,7 +143,8 @@ func (k Keeper) UpdateLastStateInfo(ctx sdk.Context, stateInfo *types.StateInfo,
 		return nil, errorsmod.Wrapf(gerrc.ErrInternal, "state info start height is greater than fraud height")
 	}
 
-	if stateInfo.StartHeight == fraudHeight {
+	// the block descriptor of the new last height of a compressed state info is not stored, so it is reverted whole
+	if stateInfo.StartHeight == fraudHeight || stateInfo.IsCompressed() && stateInfo.GetLatestHeight() >= fraudHeight {
 		// If fraud height is at the beginning of the state info, return the previous index to keep
 		var ok bool
 		*stateInfo, ok = k.GetStateInfo(ctx, stateInfo.StateInfoIndex.RollappId, stateInfo.StateInfoIndex.Index-1)
@@ -26,6 +26,16 @@ func (b finalizationQueueIndex) IndexesList() []collections.Index[collections.Pa
 	return []collections.Index[collections.Pair[uint64, string], types.BlockHeightToFinalizationQueue]{b.RollappIDReverseLookup}
 }
 
+// finalizationDueIndex is a set of indexes for the due heights of the finalization queues.
+type finalizationDueIndex struct {
+	// ByDueHeight lists the finalization queues by the height at which they are due.
+	ByDueHeight *indexes.Multi[uint64, collections.Pair[string, uint64], uint64]
+}
+
+func (b finalizationDueIndex) IndexesList() []collections.Index[collections.Pair[string, uint64], uint64] {
+	return []collections.Index[collections.Pair[string, uint64], uint64]{b.ByDueHeight}
+}
+
 type Keeper struct {
 	cdc       codec.BinaryCodec
 	storeKey  storetypes.StoreKey
@@ -45,9 +55,35 @@ type Keeper struct {
 	// Contains a special index that helps reverse lookup: finalization queue (all available heights) by rollapp.
 	// Index key: (rollappID, creation height), Value: state indexes to finalize.
 	finalizationQueue *collections.IndexedMap[collections.Pair[uint64, string], types.BlockHeightToFinalizationQueue, finalizationQueueIndex]
+	// finalizationDueHeights is a map from the rollappID and creation height of a finalization queue to the
+	// height at which its dispute period is over.
+	// Contains an index by due height, so that only the due queues are read every block.
+	finalizationDueHeights *collections.IndexedMap[collections.Pair[string, uint64], uint64, finalizationDueIndex]
 
 	finalizePending        func(ctx sdk.Context, stateInfoIndex types.StateInfoIndex) error
 	seqToUnfinalizedHeight collections.KeySet[collections.Pair[string, uint64]]
+
+	// disputes is a map from (rollappID, dispute id) to the dispute game
+	disputes collections.Map[collections.Pair[string, uint64], types.Dispute]
+	// disputeRollapps is a map from dispute id to its rollappID
+	disputeRollapps collections.Map[uint64, string]
+	// openDisputes is a map from (rollappID, state info index) to the id of the dispute against the state info
+	// which is not over yet
+	openDisputes    collections.Map[collections.Pair[string, uint64], uint64]
+	disputeID       collections.Sequence
+	disputeVerifier types.DisputeVerifier
+
+	// provenBDs is a map from (rollappID, height) to the proven block descriptor of a compressed state update
+	provenBDs collections.Map[collections.Pair[string, uint64], types.BlockDescriptor]
+	// sunsettingRollapps is the set of rollapps which are sunsetting, until they are shut down
+	sunsettingRollapps collections.KeySet[string]
+	// livenessHistory is a map from (rollappID, hub height) to the past liveness event
+	livenessHistory collections.Map[collections.Pair[string, int64], types.LivenessRecord]
+	// maintenanceEnds is the set of (hub height, rollappID) at which the maintenance of the rollapp ends
+	maintenanceEnds collections.KeySet[collections.Pair[int64, string]]
+	// registry is a map from rollappID to its searchable attributes, which are indexed by registryIndexes
+	registry        collections.Map[string, types.RollappRegistryEntry]
+	registryIndexes registryIndexes
 }
 
 func NewKeeper(
@@ -103,6 +139,25 @@ func NewKeeper(
 				),
 			},
 		),
+		finalizationDueHeights: collections.NewIndexedMap(
+			sb,
+			types.FinalizationDueHeightsKeyPrefix,
+			"finalization_due_heights",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collections.Uint64Value,
+			finalizationDueIndex{
+				ByDueHeight: indexes.NewMulti(
+					sb,
+					types.FinalizationByDueHeightKeyPrefix,
+					"finalization_by_due_height",
+					collections.Uint64Key,
+					collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+					func(_ collections.Pair[string, uint64], due uint64) (uint64, error) {
+						return due, nil
+					},
+				),
+			},
+		),
 		finalizePending:       nil,
 		canonicalClientKeeper: canonicalClientKeeper,
 		seqToUnfinalizedHeight: collections.NewKeySet(
@@ -111,6 +166,66 @@ func NewKeeper(
 			"seq_to_unfinalized_height",
 			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
 		),
+		disputes: collections.NewMap(
+			sb,
+			types.DisputesKeyPrefix,
+			"disputes",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collcompat.ProtoValue[types.Dispute](cdc),
+		),
+		disputeRollapps: collections.NewMap(
+			sb,
+			types.DisputeRollappsKeyPrefix,
+			"dispute_rollapps",
+			collections.Uint64Key,
+			collections.StringValue,
+		),
+		openDisputes: collections.NewMap(
+			sb,
+			types.OpenDisputesKeyPrefix,
+			"open_disputes",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collections.Uint64Value,
+		),
+		disputeID: collections.NewSequence(
+			sb,
+			types.DisputeIDKey,
+			"dispute_id",
+		),
+		provenBDs: collections.NewMap(
+			sb,
+			types.ProvenBlockDescriptorsKeyPrefix,
+			"proven_bds",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collcompat.ProtoValue[types.BlockDescriptor](cdc),
+		),
+		sunsettingRollapps: collections.NewKeySet(
+			sb,
+			types.SunsettingRollappsKeyPrefix,
+			"sunsetting_rollapps",
+			collections.StringKey,
+		),
+		livenessHistory: collections.NewMap(
+			sb,
+			types.LivenessHistoryKeyPrefix,
+			"liveness_history",
+			collections.PairKeyCodec(collections.StringKey, collections.Int64Key),
+			collcompat.ProtoValue[types.LivenessRecord](cdc),
+		),
+		maintenanceEnds: collections.NewKeySet(
+			sb,
+			types.MaintenanceEndsKeyPrefix,
+			"maintenance_ends",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+		),
+		registry: collections.NewMap(
+			sb,
+			types.RollappRegistryKeyPrefix,
+			"rollapp_registry",
+			collections.StringKey,
+			collcompat.ProtoValue[types.RollappRegistryEntry](cdc),
+		),
+		registryIndexes: newRegistryIndexes(sb),
 	}
 	k.SetFinalizePendingFn(k.finalizePendingState)
 	return k
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// UpdateLifecycleState lets the owner pause, resume or sunset the rollapp
+func (k msgServer) UpdateLifecycleState(goCtx context.Context, msg *types.MsgUpdateLifecycleState) (*types.MsgUpdateLifecycleStateResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ra, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+	if ra.Owner != msg.Owner {
+		return nil, types.ErrUnauthorizedSigner
+	}
+	// the owner can't undo a decision of governance, but it may still retire the rollapp
+	if ra.LifecycleForced && msg.State != types.Rollapp_SUNSETTING {
+		return nil, errorsmod.Wrapf(gerrc.ErrPermissionDenied, "lifecycle state was set by governance: %s", ra.LifecycleState)
+	}
+
+	if err := k.setLifecycleState(ctx, &ra, msg.State, false); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateLifecycleStateResponse{}, nil
+}
+
+// ForceLifecycleState allows the gov module to set the lifecycle state of a rollapp. The owner can't undo it.
+func (k Keeper) ForceLifecycleState(goCtx context.Context, msg *types.MsgForceLifecycleState) (*types.MsgForceLifecycleStateResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrap(gerrc.ErrUnauthenticated, "only the gov module can force a lifecycle state")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ra, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	if err := k.setLifecycleState(ctx, &ra, msg.State, true); err != nil {
+		return nil, err
+	}
+	return &types.MsgForceLifecycleStateResponse{}, nil
+}
+
+// setLifecycleState moves the rollapp to a new lifecycle state and saves it.
+// A sunset can't be cancelled, and a rollapp is only shut down at the end of its sunset.
+func (k Keeper) setLifecycleState(ctx sdk.Context, ra *types.Rollapp, to types.Rollapp_LifecycleState, forced bool) error {
+	from := ra.LifecycleState
+	if from == to {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "rollapp is already in lifecycle state: %s", to)
+	}
+	if ra.IsSunset() {
+		return errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "rollapp is retired: %s", from)
+	}
+
+	ra.LifecycleState = to
+	// a resume by governance gives the control back to the owner
+	ra.LifecycleForced = forced && to != types.Rollapp_ACTIVE
+	if to == types.Rollapp_SUNSETTING {
+		ra.SunsetHeight = ctx.BlockHeight() + int64(k.GetParams(ctx).SunsetPeriodInBlocks) //nolint:gosec
+		if err := k.SetSunsettingRollapp(ctx, ra.RollappId); err != nil {
+			return errorsmod.Wrap(err, "set sunsetting rollapp")
+		}
+	}
+	// the liveness clock only runs while the rollapp may post states
+	k.IndicateLiveness(ctx, ra)
+	k.SetRollapp(ctx, *ra)
+
+	return k.afterLifecycleStateChanged(ctx, *ra, from)
+}
+
+func (k Keeper) afterLifecycleStateChanged(ctx sdk.Context, ra types.Rollapp, from types.Rollapp_LifecycleState) error {
+	err := k.hooks.AfterLifecycleStateChanged(ctx, ra.RollappId, from, ra.LifecycleState)
+	if err != nil {
+		return errorsmod.Wrap(err, "after lifecycle state changed")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventLifecycleStateChanged{
+		RollappId:    ra.RollappId,
+		From:         from,
+		To:           ra.LifecycleState,
+		Forced:       ra.LifecycleForced,
+		SunsetHeight: ra.SunsetHeight,
+	})
+}
+
+// ProcessSunsets shuts down the sunsetting rollapps whose sunset period is over, once all their states
+// are finalized. Until then, the last states go through the dispute period as usual.
+func (k Keeper) ProcessSunsets(ctx sdk.Context) {
+	rollapps, err := k.GetAllSunsettingRollapps(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Get sunsetting rollapps.", "error", err)
+		return
+	}
+
+	for _, rollappID := range rollapps {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.tryShutDown(ctx, rollappID)
+		})
+		if err != nil {
+			k.Logger(ctx).Error("Shut down rollapp.", "rollapp_id", rollappID, "error", err)
+		}
+	}
+}
+
+func (k Keeper) tryShutDown(ctx sdk.Context, rollappID string) error {
+	ra := k.MustGetRollapp(ctx, rollappID)
+	if ctx.BlockHeight() < ra.SunsetHeight {
+		return nil
+	}
+	// no more states are expected, the sequencer can't be blamed for not posting them
+	if ra.LivenessEventHeight != 0 {
+		k.ResetLivenessClock(ctx, &ra)
+		k.SetRollapp(ctx, ra)
+	}
+	if k.hasPendingStates(ctx, rollappID) {
+		return nil
+	}
+
+	ra.LifecycleState = types.Rollapp_SHUT_DOWN
+	k.SetRollapp(ctx, ra)
+	if err := k.sunsettingRollapps.Remove(ctx, rollappID); err != nil {
+		return errorsmod.Wrap(err, "remove sunsetting rollapp")
+	}
+
+	return k.afterLifecycleStateChanged(ctx, ra, types.Rollapp_SUNSETTING)
+}
+
+// hasPendingStates returns true if the rollapp has states which are not finalized yet
+func (k Keeper) hasPendingStates(ctx sdk.Context, rollappID string) bool {
+	latest, found := k.GetLatestStateInfoIndex(ctx, rollappID)
+	if !found {
+		return false
+	}
+	finalized, _ := k.GetLatestFinalizedStateIndex(ctx, rollappID)
+	return finalized.Index < latest.Index
+}
+
+func (k Keeper) SetSunsettingRollapp(ctx sdk.Context, rollappID string) error {
+	return k.sunsettingRollapps.Set(ctx, rollappID)
+}
+
+func (k Keeper) GetAllSunsettingRollapps(ctx sdk.Context) ([]string, error) {
+	iter, err := k.sunsettingRollapps.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Keys()
+}
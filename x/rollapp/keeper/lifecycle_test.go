@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *RollappTestSuite) updateLifecycleState(owner, rollappID string, state types.Rollapp_LifecycleState) error {
+	_, err := s.msgServer.UpdateLifecycleState(s.Ctx, types.NewMsgUpdateLifecycleState(owner, rollappID, state))
+	return err
+}
+
+func (s *RollappTestSuite) forceLifecycleState(rollappID string, state types.Rollapp_LifecycleState) error {
+	_, err := s.k().ForceLifecycleState(s.Ctx, &types.MsgForceLifecycleState{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		RollappId: rollappID,
+		State:     state,
+	})
+	return err
+}
+
+func (s *RollappTestSuite) TestLifecycleState() {
+	s.Run("pause and resume", func() {
+		s.SetupTest()
+		s.Ctx = s.Ctx.WithBlockHeight(1)
+		rollappID, proposer := s.CreateDefaultRollappAndProposer()
+
+		s.Require().ErrorIs(s.updateLifecycleState(apptesting.CreateRandomAccounts(1)[0].String(), rollappID, types.Rollapp_PAUSED), types.ErrUnauthorizedSigner)
+		s.Require().NoError(s.updateLifecycleState(apptesting.Alice, rollappID, types.Rollapp_PAUSED))
+		s.Require().Zero(s.k().MustGetRollapp(s.Ctx, rollappID).LivenessEventHeight)
+
+		_, err := s.PostStateUpdate(s.Ctx, rollappID, proposer, 1, 5)
+		s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+
+		s.Require().NoError(s.updateLifecycleState(apptesting.Alice, rollappID, types.Rollapp_ACTIVE))
+		s.Require().NotZero(s.k().MustGetRollapp(s.Ctx, rollappID).LivenessEventHeight)
+
+		_, err = s.PostStateUpdate(s.Ctx, rollappID, proposer, 1, 5)
+		s.Require().NoError(err)
+	})
+
+	s.Run("the owner can't resume a rollapp paused by governance", func() {
+		s.SetupTest()
+		rollappID := s.CreateDefaultRollapp()
+
+		s.Require().NoError(s.forceLifecycleState(rollappID, types.Rollapp_PAUSED))
+		s.Require().ErrorIs(s.updateLifecycleState(apptesting.Alice, rollappID, types.Rollapp_ACTIVE), gerrc.ErrPermissionDenied)
+
+		s.Require().NoError(s.forceLifecycleState(rollappID, types.Rollapp_ACTIVE))
+		s.Require().NoError(s.updateLifecycleState(apptesting.Alice, rollappID, types.Rollapp_PAUSED))
+	})
+
+	s.Run("sunset and shut down", func() {
+		s.SetupTest()
+		params := s.k().GetParams(s.Ctx)
+		params.SunsetPeriodInBlocks = 10
+		s.k().SetParams(s.Ctx, params)
+		s.Ctx = s.Ctx.WithBlockHeight(1)
+		rollappID, proposer := s.CreateDefaultRollappAndProposer()
+
+		lastHeight, err := s.PostStateUpdate(s.Ctx, rollappID, proposer, 1, 5)
+		s.Require().NoError(err)
+		s.Require().NoError(s.updateLifecycleState(apptesting.Alice, rollappID, types.Rollapp_SUNSETTING))
+		s.Require().Equal(int64(11), s.k().MustGetRollapp(s.Ctx, rollappID).SunsetHeight)
+
+		// a sunset can't be cancelled and no sequencer can join
+		s.Require().ErrorIs(s.updateLifecycleState(apptesting.Alice, rollappID, types.Rollapp_ACTIVE), gerrc.ErrFailedPrecondition)
+		err = s.CreateSequencerByPubkey(s.Ctx, rollappID, ed25519.GenPrivKey().PubKey())
+		s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+
+		// the last states are accepted during the sunset period
+		ctx := s.Ctx.WithBlockHeight(5)
+		_, err = s.PostStateUpdate(ctx, rollappID, proposer, lastHeight, 5)
+		s.Require().NoError(err)
+
+		ctx = s.Ctx.WithBlockHeight(11)
+		_, err = s.PostStateUpdate(ctx, rollappID, proposer, lastHeight+5, 5)
+		s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+
+		// the rollapp is shut down once its states are finalized
+		s.k().ProcessSunsets(ctx)
+		ra := s.k().MustGetRollapp(ctx, rollappID)
+		s.Require().Equal(types.Rollapp_SUNSETTING, ra.LifecycleState)
+		s.Require().Zero(ra.LivenessEventHeight)
+
+		ctx = s.Ctx.WithBlockHeight(100)
+		s.k().FinalizeRollappStates(ctx)
+		s.k().ProcessSunsets(ctx)
+		s.Require().Equal(types.Rollapp_SHUT_DOWN, s.k().MustGetRollapp(ctx, rollappID).LifecycleState)
+
+		// the sequencers are unbonded and refunded
+		s.Require().True(s.App.SequencerKeeper.GetProposer(ctx, rollappID).Sentinel())
+		seq := s.App.SequencerKeeper.GetSequencer(ctx, proposer)
+		s.Require().Equal(sequencertypes.Unbonded, seq.Status)
+		s.Require().True(seq.Tokens.IsZero())
+		s.Require().Equal(
+			sdk.NewCoins(types.DefaultMinSequencerBondGlobalCoin),
+			s.App.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(proposer)),
+		)
+	})
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -54,12 +55,19 @@ func (k Keeper) CheckLiveness(ctx sdk.Context) {
 				"event", e,
 				"err", err,
 			)
+			record := k.newLivenessRecord(ctx, e)
+			record.Error = err.Error()
+			if err := k.SaveLivenessRecord(ctx, record); err != nil {
+				k.Logger(ctx).Error("Save liveness record.", "event", e, "err", err)
+			}
 		}
 	}
 }
 
 // HandleLivenessEvent will slash or jail and then schedule a new event in the future.
 func (k Keeper) HandleLivenessEvent(ctx sdk.Context, e types.LivenessEvent) error {
+	record := k.newLivenessRecord(ctx, e)
+
 	err := k.SequencerK.SlashLiveness(ctx, e.RollappId)
 	if err != nil {
 		return errorsmod.Wrap(err, "slash liveness")
@@ -69,12 +77,71 @@ func (k Keeper) HandleLivenessEvent(ctx sdk.Context, e types.LivenessEvent) erro
 	k.DelLivenessEvents(ctx, e.HubHeight, e.RollappId)
 	k.ScheduleLivenessEvent(ctx, &ra)
 	k.SetRollapp(ctx, ra)
+
+	// nothing is slashed if the rollapp has no proposer
+	if record.Sequencer == "" {
+		return nil
+	}
+	record.Kickable = k.SequencerK.Kickable(ctx, k.SequencerK.GetProposer(ctx, e.RollappId))
+	return errorsmod.Wrap(k.SaveLivenessRecord(ctx, record), "save liveness record")
+}
+
+// newLivenessRecord describes the liveness event before it is handled
+func (k Keeper) newLivenessRecord(ctx sdk.Context, e types.LivenessEvent) types.LivenessRecord {
+	record := types.LivenessRecord{
+		RollappId: e.RollappId,
+		HubHeight: e.HubHeight,
+	}
+	if ra, ok := k.GetRollapp(ctx, e.RollappId); ok {
+		record.Downtime = uint64(e.HubHeight - ra.LivenessCountdownStartHeight) //nolint:gosec
+	}
+	if proposer := k.SequencerK.GetProposer(ctx, e.RollappId); !proposer.Sentinel() {
+		record.Sequencer = proposer.Address
+		record.Slashed = k.SequencerK.LivenessSlashAmount(ctx, proposer)
+	}
+	return record
+}
+
+// SaveLivenessRecord saves a past liveness event, and prunes the oldest ones of the rollapp beyond
+// types.MaxLivenessRecords
+func (k Keeper) SaveLivenessRecord(ctx sdk.Context, record types.LivenessRecord) error {
+	err := k.livenessHistory.Set(ctx, collections.Join(record.RollappId, record.HubHeight), record)
+	if err != nil {
+		return err
+	}
+
+	rng := collections.NewPrefixedPairRange[string, int64](record.RollappId).Descending()
+	iter, err := k.livenessHistory.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for i := types.MaxLivenessRecords; i < len(keys); i++ {
+		if err := k.livenessHistory.Remove(ctx, keys[i]); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func (k Keeper) GetAllLivenessRecords(ctx sdk.Context) ([]types.LivenessRecord, error) {
+	iter, err := k.livenessHistory.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// IndicateLiveness restarts the liveness clock. The clock is stopped if the rollapp is not expected to post
+// states, e.g. it is paused or under maintenance.
 func (k Keeper) IndicateLiveness(ctx sdk.Context, ra *types.Rollapp) {
 	k.ResetLivenessClock(ctx, ra)
-	k.ScheduleLivenessEvent(ctx, ra)
+	if ra.AcceptsStateUpdates(ctx.BlockHeight()) && !ra.InMaintenance() {
+		k.ScheduleLivenessEvent(ctx, ra)
+	}
 }
 
 // ResetLivenessClock will reschedule pending liveness events to a later block height.
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"slices"
@@ -167,6 +168,47 @@ func (s *RollappTestSuite) TestLivenessEndBlock() {
 	s.checkLiveness(rollapp, false, true)
 }
 
+func (s *RollappTestSuite) TestLivenessHistory() {
+	s.Ctx = s.Ctx.WithBlockHeight(1)
+
+	p := s.k().GetParams(s.Ctx)
+	p.LivenessSlashBlocks = 2
+	p.LivenessSlashInterval = 3
+	s.k().SetParams(s.Ctx, p)
+	rollapp, proposer := s.CreateDefaultRollappAndProposer()
+	_, err := s.PostStateUpdate(s.Ctx, rollapp, proposer, 1, uint64(10))
+	s.Require().NoError(err)
+
+	next, err := s.k().LivenessEvent(s.Ctx, &types.QueryLivenessEventRequest{RollappId: rollapp})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(3), next.HubHeight)
+	s.Require().Equal(uint64(2), next.BlocksRemaining)
+	s.Require().Equal(proposer, next.Proposer)
+	s.Require().True(next.ProjectedSlash.IsPositive())
+
+	s.Ctx = s.Ctx.WithBlockHeight(next.HubHeight)
+	s.k().CheckLiveness(s.Ctx)
+
+	// a failure is recorded too
+	s.k().SetSequencerKeeper(failingLivenessSequencerKeeper{s.k().SequencerK})
+	s.Ctx = s.Ctx.WithBlockHeight(next.HubHeight + int64(p.LivenessSlashInterval))
+	s.k().CheckLiveness(s.Ctx)
+
+	history, err := s.k().LivenessHistory(s.Ctx, &types.QueryLivenessHistoryRequest{RollappId: rollapp})
+	s.Require().NoError(err)
+	s.Require().Len(history.Records, 2)
+
+	slash := history.Records[0]
+	s.Require().Equal(proposer, slash.Sequencer)
+	s.Require().Equal(next.ProjectedSlash, slash.Slashed)
+	s.Require().Equal(uint64(2), slash.Downtime)
+	s.Require().Empty(slash.Error)
+
+	failure := history.Records[1]
+	s.Require().Equal(uint64(5), failure.Downtime)
+	s.Require().NotEmpty(failure.Error)
+}
+
 func (s *RollappTestSuite) checkLiveness(rollappId string, expectClockReset, expectEvent bool) {
 	msg, broken := keeper.LivenessEventInvariant(*s.k())(s.Ctx)
 	s.Require().False(broken, msg)
@@ -279,3 +321,11 @@ func (l livenessMockSequencerKeeper) SlashLiveness(ctx sdk.Context, rollappID st
 func (l livenessMockSequencerKeeper) clear(rollappID string) {
 	delete(l.slashes, rollappID)
 }
+
+type failingLivenessSequencerKeeper struct {
+	keeper.SequencerKeeper
+}
+
+func (failingLivenessSequencerKeeper) SlashLiveness(sdk.Context, string) error {
+	return errors.New("slash liveness failed")
+}
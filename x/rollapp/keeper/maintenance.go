@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// DeclareMaintenance lets the owner or the proposer of the rollapp pause its liveness clock during a planned
+// maintenance. The length of the window, the frequency of the declarations and the total maintenance within
+// a maintenance window are bounded by the params.
+func (k msgServer) DeclareMaintenance(goCtx context.Context, msg *types.MsgDeclareMaintenance) (*types.MsgDeclareMaintenanceResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ra, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+	proposer := k.SequencerK.GetProposer(ctx, msg.RollappId)
+	if msg.Creator != ra.Owner && (proposer.Sentinel() || msg.Creator != proposer.Address) {
+		return nil, types.ErrUnauthorizedSigner
+	}
+	if !ra.IsActive() {
+		return nil, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "rollapp is not active: %s", ra.LifecycleState)
+	}
+	if ra.InMaintenance() {
+		return nil, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "maintenance is ongoing until: %d", ra.MaintenanceEndHeight)
+	}
+
+	params := k.GetParams(ctx)
+	if params.MaxMaintenanceBlocks < msg.DurationBlocks {
+		return nil, errorsmod.Wrapf(gerrc.ErrOutOfRange, "duration exceeds max maintenance blocks: %d", params.MaxMaintenanceBlocks)
+	}
+	if ra.MaintenanceStartHeight != 0 {
+		next := ra.MaintenanceStartHeight + int64(params.MaintenanceCooldownBlocks) //nolint:gosec
+		if ctx.BlockHeight() < next {
+			return nil, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "next maintenance can be declared from height: %d", next)
+		}
+	}
+
+	// a new maintenance window starts at the first declaration after the previous one is over
+	if ra.MaintenanceWindowStartHeight == 0 || ra.MaintenanceWindowStartHeight+int64(params.MaintenanceWindowBlocks) <= ctx.BlockHeight() { //nolint:gosec
+		ra.MaintenanceWindowStartHeight = ctx.BlockHeight()
+		ra.MaintenanceWindowBlocksUsed = 0
+	}
+	if params.MaxMaintenanceBlocksPerWindow < ra.MaintenanceWindowBlocksUsed+msg.DurationBlocks {
+		return nil, errorsmod.Wrapf(gerrc.ErrOutOfRange, "total maintenance exceeds max maintenance blocks per window: %d: used: %d",
+			params.MaxMaintenanceBlocksPerWindow, ra.MaintenanceWindowBlocksUsed)
+	}
+	ra.MaintenanceWindowBlocksUsed += msg.DurationBlocks
+
+	ra.MaintenanceStartHeight = ctx.BlockHeight()
+	ra.MaintenanceEndHeight = ctx.BlockHeight() + int64(msg.DurationBlocks) //nolint:gosec
+	// the clock stays stopped until the end of the maintenance
+	k.ResetLivenessClock(ctx, &ra)
+	k.SetRollapp(ctx, ra)
+	if err := k.SetMaintenanceEnd(ctx, ra); err != nil {
+		return nil, errorsmod.Wrap(err, "set maintenance end")
+	}
+
+	err := uevent.EmitTypedEvent(ctx, &types.EventMaintenanceDeclared{
+		RollappId:   ra.RollappId,
+		Creator:     msg.Creator,
+		StartHeight: ra.MaintenanceStartHeight,
+		EndHeight:   ra.MaintenanceEndHeight,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgDeclareMaintenanceResponse{}, nil
+}
+
+// ProcessMaintenanceEnds restarts the liveness clock of the rollapps whose maintenance is over
+func (k Keeper) ProcessMaintenanceEnds(ctx sdk.Context) {
+	rng := new(collections.Range[collections.Pair[int64, string]]).
+		EndExclusive(collections.Join(ctx.BlockHeight()+1, ""))
+	iter, err := k.maintenanceEnds.Iterate(ctx, rng)
+	if err != nil {
+		k.Logger(ctx).Error("Get maintenance ends.", "error", err)
+		return
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		k.Logger(ctx).Error("Get maintenance ends.", "error", err)
+		return
+	}
+
+	for _, key := range keys {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.endMaintenance(ctx, key)
+		})
+		if err != nil {
+			k.Logger(ctx).Error("End maintenance.", "rollapp_id", key.K2(), "error", err)
+		}
+	}
+}
+
+func (k Keeper) endMaintenance(ctx sdk.Context, key collections.Pair[int64, string]) error {
+	if err := k.maintenanceEnds.Remove(ctx, key); err != nil {
+		return errorsmod.Wrap(err, "remove maintenance end")
+	}
+
+	ra := k.MustGetRollapp(ctx, key.K2())
+	ra.MaintenanceEndHeight = 0
+	k.IndicateLiveness(ctx, &ra)
+	k.SetRollapp(ctx, ra)
+
+	return uevent.EmitTypedEvent(ctx, &types.EventMaintenanceEnded{
+		RollappId: ra.RollappId,
+		EndHeight: key.K1(),
+	})
+}
+
+func (k Keeper) SetMaintenanceEnd(ctx sdk.Context, ra types.Rollapp) error {
+	return k.maintenanceEnds.Set(ctx, collections.Join(ra.MaintenanceEndHeight, ra.RollappId))
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestDeclareMaintenance() {
+	s.Ctx = s.Ctx.WithBlockHeight(1)
+
+	p := s.k().GetParams(s.Ctx)
+	p.LivenessSlashBlocks = 5
+	p.MaxMaintenanceBlocks = 10
+	p.MaintenanceCooldownBlocks = 20
+	p.MaintenanceWindowBlocks = 100
+	p.MaxMaintenanceBlocksPerWindow = 20
+	s.k().SetParams(s.Ctx, p)
+
+	rollappID, proposer := s.CreateDefaultRollappAndProposer()
+	_, err := s.PostStateUpdate(s.Ctx, rollappID, proposer, 1, uint64(3))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(6), s.k().MustGetRollapp(s.Ctx, rollappID).LivenessEventHeight)
+
+	declare := func(creator string, duration uint64) error {
+		_, err := s.msgServer.DeclareMaintenance(s.Ctx, types.NewMsgDeclareMaintenance(creator, rollappID, duration))
+		return err
+	}
+
+	// only the owner or the proposer may declare, within the max window
+	err = declare(apptesting.CreateRandomAccounts(1)[0].String(), 5)
+	s.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+	err = declare(proposer, 11)
+	s.Require().ErrorIs(err, gerrc.ErrOutOfRange)
+
+	s.Require().NoError(declare(proposer, 10))
+	ra := s.k().MustGetRollapp(s.Ctx, rollappID)
+	s.Require().Equal(int64(11), ra.MaintenanceEndHeight)
+	s.Require().Zero(ra.LivenessEventHeight)
+	s.Require().Empty(s.k().GetLivenessEvents(s.Ctx, nil))
+	s.Require().ErrorIs(declare(proposer, 5), gerrc.ErrFailedPrecondition)
+
+	// a state update during the window does not restart the clock
+	s.Ctx = s.Ctx.WithBlockHeight(5)
+	_, err = s.PostStateUpdate(s.Ctx, rollappID, proposer, 4, uint64(3))
+	s.Require().NoError(err)
+	s.Require().Zero(s.k().MustGetRollapp(s.Ctx, rollappID).LivenessEventHeight)
+
+	res, err := s.k().LivenessEvent(s.Ctx, &types.QueryLivenessEventRequest{RollappId: rollappID})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(11), res.MaintenanceEndHeight)
+
+	// the clock restarts at the end of the window
+	s.Ctx = s.Ctx.WithBlockHeight(11)
+	s.k().ProcessMaintenanceEnds(s.Ctx)
+	ra = s.k().MustGetRollapp(s.Ctx, rollappID)
+	s.Require().False(ra.InMaintenance())
+	s.Require().Equal(int64(16), ra.LivenessEventHeight)
+
+	// the next declaration must wait for the cooldown
+	s.Require().ErrorIs(declare(apptesting.Alice, 5), gerrc.ErrFailedPrecondition)
+	s.Ctx = s.Ctx.WithBlockHeight(21)
+	s.Require().NoError(declare(apptesting.Alice, 5))
+	s.k().ProcessMaintenanceEnds(s.Ctx.WithBlockHeight(26))
+
+	// the total maintenance within the window is bounded
+	s.Ctx = s.Ctx.WithBlockHeight(41)
+	s.Require().ErrorIs(declare(apptesting.Alice, 6), gerrc.ErrOutOfRange)
+	s.Require().NoError(declare(apptesting.Alice, 5))
+	ra = s.k().MustGetRollapp(s.Ctx, rollappID)
+	s.Require().Equal(int64(1), ra.MaintenanceWindowStartHeight)
+	s.Require().Equal(uint64(20), ra.MaintenanceWindowBlocksUsed)
+	s.k().ProcessMaintenanceEnds(s.Ctx.WithBlockHeight(46))
+	s.Ctx = s.Ctx.WithBlockHeight(61)
+	s.Require().ErrorIs(declare(apptesting.Alice, 1), gerrc.ErrOutOfRange)
+
+	// the next declaration after the window is over starts a new one
+	s.Ctx = s.Ctx.WithBlockHeight(101)
+	s.Require().NoError(declare(apptesting.Alice, 10))
+	ra = s.k().MustGetRollapp(s.Ctx, rollappID)
+	s.Require().Equal(int64(101), ra.MaintenanceWindowStartHeight)
+	s.Require().Equal(uint64(10), ra.MaintenanceWindowBlocksUsed)
+}
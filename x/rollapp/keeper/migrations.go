@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	k Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{k: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It sets the new params, the due heights of the pending finalization queues and the registry of the
+// existing rollapps.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.k.GetParams(ctx)
+	// as by default, the proposer bond can at most halve the dispute period of the live chain
+	params.MinDisputePeriodInBlocks = max(params.DisputePeriodInBlocks/2, types.MinDisputePeriodInBlocks)
+	params.MaxDisputePeriodInBlocks = types.DefaultMaxDisputePeriodInBlocks
+	params.DisputePeriodFraudPenaltyBlocks = params.DisputePeriodInBlocks
+	defaults := types.DefaultParams()
+	params.DisputeChallengerBond = defaults.DisputeChallengerBond
+	params.DisputeMoveBlocks = defaults.DisputeMoveBlocks
+	params.DisputeVerdictBlocks = defaults.DisputeVerdictBlocks
+	params.SunsetPeriodInBlocks = defaults.SunsetPeriodInBlocks
+	params.MaxMaintenanceBlocks = defaults.MaxMaintenanceBlocks
+	params.MaintenanceCooldownBlocks = defaults.MaintenanceCooldownBlocks
+	params.MaintenanceWindowBlocks = defaults.MaintenanceWindowBlocks
+	params.MaxMaintenanceBlocksPerWindow = defaults.MaxMaintenanceBlocksPerWindow
+	params.OwnershipTransferExpiryBlocks = defaults.OwnershipTransferExpiryBlocks
+	if err := params.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "validate params")
+	}
+	m.k.SetParams(ctx, params)
+
+	queues, err := m.k.GetEntireFinalizationQueue(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "get finalization queue")
+	}
+	for _, q := range queues {
+		if err := m.k.setFinalizationDueHeight(ctx, q.RollappId, q.CreationHeight); err != nil {
+			return errorsmod.Wrap(err, "set finalization due height")
+		}
+	}
+
+	// The creation time of the existing rollapps was not recorded, their registry entries keep it unknown (0),
+	// so that they match no creation time filter of the search.
+	for _, ra := range m.k.GetAllRollapps(ctx) {
+		if err := m.k.updateRegistry(ctx, ra); err != nil {
+			return errorsmod.Wrapf(err, "update registry: %s", ra.RollappId)
+		}
+		if err := m.k.RefreshRegistryDRSVersion(ctx, ra.RollappId); err != nil {
+			return errorsmod.Wrapf(err, "refresh registry drs version: %s", ra.RollappId)
+		}
+	}
+	return nil
+}
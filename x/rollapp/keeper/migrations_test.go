@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestMigrate2to3() {
+	// the params of version 2 only have the global dispute period, the liveness slashing and the fees
+	defaults := types.DefaultParams()
+	v2 := types.NewParams(10, defaults.LivenessSlashBlocks, defaults.LivenessSlashInterval,
+		defaults.AppRegistrationFee, defaults.MinSequencerBondGlobal)
+	v2.DisputeChallengerBond = sdk.Coin{}
+	s.k().SetParams(s.Ctx, v2)
+
+	s.Require().NoError(keeper.NewMigrator(*s.k()).Migrate2to3(s.Ctx))
+
+	params := s.k().GetParams(s.Ctx)
+	s.Require().NoError(params.ValidateBasic())
+	s.Require().Equal(uint64(10), params.DisputePeriodInBlocks)
+	s.Require().Equal(uint64(5), params.MinDisputePeriodInBlocks)
+	s.Require().Equal(uint64(10), params.DisputePeriodFraudPenaltyBlocks)
+	s.Require().Equal(defaults.DisputeChallengerBond, params.DisputeChallengerBond)
+	s.Require().Equal(defaults.DisputeMoveBlocks, params.DisputeMoveBlocks)
+	s.Require().Equal(defaults.DisputeVerdictBlocks, params.DisputeVerdictBlocks)
+	s.Require().Equal(defaults.SunsetPeriodInBlocks, params.SunsetPeriodInBlocks)
+	s.Require().Equal(defaults.MaxMaintenanceBlocks, params.MaxMaintenanceBlocks)
+	s.Require().Equal(defaults.MaintenanceCooldownBlocks, params.MaintenanceCooldownBlocks)
+	s.Require().Equal(defaults.MaintenanceWindowBlocks, params.MaintenanceWindowBlocks)
+	s.Require().Equal(defaults.MaxMaintenanceBlocksPerWindow, params.MaxMaintenanceBlocksPerWindow)
+	s.Require().Equal(defaults.OwnershipTransferExpiryBlocks, params.OwnershipTransferExpiryBlocks)
+}
+
+func (s *RollappTestSuite) TestMigrate2to3Registry() {
+	rollappID, proposer := s.CreateDefaultRollappAndProposer()
+	_, err := s.PostStateUpdate(s.Ctx, rollappID, proposer, 1, 5)
+	s.Require().NoError(err)
+
+	// the rollapps of version 2 have no registry entry and no creation time
+	ra := s.k().MustGetRollapp(s.Ctx, rollappID)
+	ra.CreatedAt = nil
+	s.k().RemoveRollapp(s.Ctx, rollappID)
+	store := prefix.NewStore(s.Ctx.KVStore(s.App.GetKey(types.StoreKey)), types.KeyPrefix(types.RollappKeyPrefix))
+	store.Set(types.RollappKey(rollappID), s.App.AppCodec().MustMarshal(&ra))
+	_, err = s.k().GetRegistryEntry(s.Ctx, rollappID)
+	s.Require().Error(err)
+
+	s.Require().NoError(keeper.NewMigrator(*s.k()).Migrate2to3(s.Ctx))
+
+	entry, err := s.k().GetRegistryEntry(s.Ctx, rollappID)
+	s.Require().NoError(err)
+	s.Require().Equal(ra.Owner, entry.Owner)
+	info := s.k().MustGetStateInfo(s.Ctx, rollappID, 1)
+	s.Require().Equal(info.GetLatestBlockDescriptor().DrsVersion, entry.DrsVersion)
+	s.Require().Zero(entry.CreatedAt)
+
+	res, err := s.k().RollappSearch(s.Ctx, &types.QueryRollappSearchRequest{Owner: ra.Owner})
+	s.Require().NoError(err)
+	s.Require().Len(res.Rollapps, 1)
+}
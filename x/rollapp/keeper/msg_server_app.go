@@ -87,9 +87,9 @@ func (k msgServer) checkInputs(ctx sdk.Context, msg appMsg) error {
 		return errorsmod.Wrapf(gerrc.ErrNotFound, "rollappId: %s", app.GetRollappId())
 	}
 
-	// check if the sender is the owner of the app
-	if msg.GetCreator() != rollapp.Owner {
-		return errorsmod.Wrap(gerrc.ErrPermissionDenied, "not the owner of the RollApp")
+	// check if the sender is the owner of the rollapp or its app manager
+	if !rollapp.HasRole(msg.GetCreator(), types.RollappRole_ROLLAPP_ROLE_APP_MANAGER) {
+		return errorsmod.Wrap(gerrc.ErrPermissionDenied, "not the owner or the app manager of the RollApp")
 	}
 
 	switch msg.(type) {
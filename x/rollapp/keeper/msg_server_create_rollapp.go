@@ -31,7 +31,10 @@ func (k msgServer) CreateRollapp(goCtx context.Context, msg *types.MsgCreateRoll
 		return nil, err
 	}
 
-	k.SetRollapp(ctx, msg.GetRollapp())
+	rollapp := msg.GetRollapp()
+	createdAt := ctx.BlockTime()
+	rollapp.CreatedAt = &createdAt
+	k.SetRollapp(ctx, rollapp)
 
 	creator := sdk.MustAccAddressFromBech32(msg.Creator)
 
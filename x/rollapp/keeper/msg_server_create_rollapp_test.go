@@ -203,6 +203,7 @@ func (s *RollappTestSuite) createRollappWithCreatorAndVerify(
 		s.FundForAliasRegistration(rollapp)
 	}
 	// rollappExpect is the expected result of creating rollapp
+	createdAt := s.Ctx.BlockTime()
 	rollappExpect := types.Rollapp{
 		RollappId:        rollapp.GetRollappId(),
 		Owner:            rollapp.GetCreator(),
@@ -215,6 +216,7 @@ func (s *RollappTestSuite) createRollappWithCreatorAndVerify(
 			Number:      0,
 			StartHeight: 0,
 		}},
+		CreatedAt: &createdAt,
 	}
 
 	// create rollapp
@@ -234,7 +236,8 @@ func (s *RollappTestSuite) createRollappWithCreatorAndVerify(
 	s.Require().EqualValues(&rollappExpect, &queryResponse.Rollapp)
 
 	rollappSummaryExpect := types.RollappSummary{
-		RollappId: rollappExpect.RollappId,
+		RollappId:             rollappExpect.RollappId,
+		DisputePeriodInBlocks: s.k().DisputePeriodInBlocks(s.Ctx),
 	}
 	return rollappSummaryExpect
 }
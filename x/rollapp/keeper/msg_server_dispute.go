@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k msgServer) OpenDispute(goCtx context.Context, msg *types.MsgOpenDispute) (*types.MsgOpenDisputeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	d, err := k.openDispute(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgOpenDisputeResponse{DisputeId: d.Id}, nil
+}
+
+func (k msgServer) BisectDispute(goCtx context.Context, msg *types.MsgBisectDispute) (*types.MsgBisectDisputeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.bisectDispute(ctx, msg); err != nil {
+		return nil, err
+	}
+	return &types.MsgBisectDisputeResponse{}, nil
+}
+
+func (k msgServer) SubmitDisputeProof(goCtx context.Context, msg *types.MsgSubmitDisputeProof) (*types.MsgSubmitDisputeProofResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.submitDisputeProof(ctx, msg); err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitDisputeProofResponse{}, nil
+}
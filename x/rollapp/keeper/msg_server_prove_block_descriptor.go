@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k msgServer) ProveBlockDescriptor(goCtx context.Context, msg *types.MsgProveBlockDescriptor) (*types.MsgProveBlockDescriptorResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.proveBlockDescriptor(ctx, msg); err != nil {
+		return nil, err
+	}
+	return &types.MsgProveBlockDescriptorResponse{}, nil
+}
@@ -6,11 +6,14 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
+// TransferOwnership proposes a new owner, which must accept the ownership before the transfer expires.
+// It replaces any pending transfer.
 func (k msgServer) TransferOwnership(goCtx context.Context, msg *types.MsgTransferOwnership) (*types.MsgTransferOwnershipResponse, error) {
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
@@ -31,7 +34,8 @@ func (k msgServer) TransferOwnership(goCtx context.Context, msg *types.MsgTransf
 		return nil, types.ErrSameOwner
 	}
 
-	rollapp.Owner = msg.NewOwner
+	rollapp.PendingOwner = msg.NewOwner
+	rollapp.OwnershipTransferExpiryHeight = ctx.BlockHeight() + int64(k.GetParams(ctx).OwnershipTransferExpiryBlocks) //nolint:gosec
 	k.SetRollapp(ctx, rollapp)
 
 	if err := uevent.EmitTypedEvent(ctx, msg); err != nil {
@@ -40,3 +44,70 @@ func (k msgServer) TransferOwnership(goCtx context.Context, msg *types.MsgTransf
 
 	return &types.MsgTransferOwnershipResponse{}, nil
 }
+
+// AcceptOwnership completes a pending transfer of ownership. The roles delegated by the previous owner are revoked.
+func (k msgServer) AcceptOwnership(goCtx context.Context, msg *types.MsgAcceptOwnership) (*types.MsgAcceptOwnershipResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	if rollapp.PendingOwner == "" {
+		return nil, errorsmod.Wrap(gerrc.ErrNotFound, "no pending ownership transfer")
+	}
+
+	if rollapp.PendingOwner != msg.NewOwner {
+		return nil, types.ErrUnauthorizedSigner
+	}
+
+	if rollapp.OwnershipTransferExpiryHeight <= ctx.BlockHeight() {
+		return nil, errorsmod.Wrapf(gerrc.ErrDeadlineExceeded, "ownership transfer expired at height: %d", rollapp.OwnershipTransferExpiryHeight)
+	}
+
+	rollapp.Owner = msg.NewOwner
+	rollapp.PendingOwner = ""
+	rollapp.OwnershipTransferExpiryHeight = 0
+	rollapp.Roles = types.RollappRoles{}
+	k.SetRollapp(ctx, rollapp)
+
+	if err := uevent.EmitTypedEvent(ctx, msg); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgAcceptOwnershipResponse{}, nil
+}
+
+// AssignRole lets the owner delegate a role to an address, or revoke it
+func (k msgServer) AssignRole(goCtx context.Context, msg *types.MsgAssignRole) (*types.MsgAssignRoleResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	if rollapp.Owner != msg.Owner {
+		return nil, types.ErrUnauthorizedSigner
+	}
+
+	if err := rollapp.Roles.Set(msg.Role, msg.Address); err != nil {
+		return nil, err
+	}
+	k.SetRollapp(ctx, rollapp)
+
+	if err := uevent.EmitTypedEvent(ctx, msg); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgAssignRoleResponse{}, nil
+}
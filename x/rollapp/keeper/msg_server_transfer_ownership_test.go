@@ -2,7 +2,10 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
@@ -23,9 +26,11 @@ func (s *RollappTestSuite) TestTransferOwnership() {
 			),
 			expError: nil,
 			expRollapp: types.Rollapp{
-				Owner:       bob,
-				RollappId:   rollappId,
-				GenesisInfo: *mockGenesisInfo,
+				Owner:                         alice,
+				RollappId:                     rollappId,
+				GenesisInfo:                   *mockGenesisInfo,
+				PendingOwner:                  bob,
+				OwnershipTransferExpiryHeight: 10 + int64(types.DefaultOwnershipTransferExpiryBlocks),
 			},
 		}, {
 			name: "Transfer rollapp ownership: failed, rollapp not found",
@@ -74,6 +79,7 @@ func (s *RollappTestSuite) TestTransferOwnership() {
 
 			s.k().SetRollapp(s.Ctx, rollapp)
 
+			s.Ctx = s.Ctx.WithBlockHeight(10)
 			goCtx := sdk.WrapSDKContext(s.Ctx)
 			_, err := s.msgServer.TransferOwnership(goCtx, tc.request)
 			if tc.expError == nil {
@@ -87,3 +93,84 @@ func (s *RollappTestSuite) TestTransferOwnership() {
 		})
 	}
 }
+
+func (s *RollappTestSuite) TestAcceptOwnership() {
+	const rollappId = "rollapp_1234-1"
+
+	s.k().SetRollapp(s.Ctx, types.Rollapp{
+		RollappId:   rollappId,
+		Owner:       alice,
+		GenesisInfo: *mockGenesisInfo,
+		Roles:       types.RollappRoles{AppManager: alice},
+	})
+
+	// nothing to accept yet
+	_, err := s.msgServer.AcceptOwnership(s.Ctx, types.NewMsgAcceptOwnership(bob, rollappId))
+	s.Require().ErrorIs(err, gerrc.ErrNotFound)
+
+	_, err = s.msgServer.TransferOwnership(s.Ctx, types.NewMsgTransferOwnership(alice, bob, rollappId))
+	s.Require().NoError(err)
+
+	// only the proposed owner can accept
+	_, err = s.msgServer.AcceptOwnership(s.Ctx, types.NewMsgAcceptOwnership(alice, rollappId))
+	s.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+
+	// the transfer can't be accepted after it expires
+	expiry := s.Ctx.BlockHeight() + int64(types.DefaultOwnershipTransferExpiryBlocks)
+	_, err = s.msgServer.AcceptOwnership(s.Ctx.WithBlockHeight(expiry), types.NewMsgAcceptOwnership(bob, rollappId))
+	s.Require().ErrorIs(err, gerrc.ErrDeadlineExceeded)
+
+	_, err = s.msgServer.AcceptOwnership(s.Ctx.WithBlockHeight(expiry-1), types.NewMsgAcceptOwnership(bob, rollappId))
+	s.Require().NoError(err)
+
+	rollapp := s.k().MustGetRollapp(s.Ctx, rollappId)
+	s.Require().Equal(bob, rollapp.Owner)
+	s.Require().Empty(rollapp.PendingOwner)
+	s.Require().Zero(rollapp.OwnershipTransferExpiryHeight)
+	s.Require().Equal(types.RollappRoles{}, rollapp.Roles)
+}
+
+func (s *RollappTestSuite) TestAssignRole() {
+	rollappId := s.CreateDefaultRollapp()
+	owner := apptesting.Alice
+	editor := apptesting.CreateRandomAccounts(1)[0].String()
+
+	// only the owner can assign roles
+	_, err := s.msgServer.AssignRole(s.Ctx, types.NewMsgAssignRole(bob, rollappId, types.RollappRole_ROLLAPP_ROLE_METADATA_EDITOR, editor))
+	s.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+
+	// the owner role can't be delegated
+	_, err = s.msgServer.AssignRole(s.Ctx, types.NewMsgAssignRole(owner, rollappId, types.RollappRole_ROLLAPP_ROLE_OWNER, editor))
+	s.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+
+	_, err = s.msgServer.AssignRole(s.Ctx, types.NewMsgAssignRole(owner, rollappId, types.RollappRole_ROLLAPP_ROLE_METADATA_EDITOR, editor))
+	s.Require().NoError(err)
+
+	// the editor can update the metadata
+	_, err = s.msgServer.UpdateRollappInformation(s.Ctx, &types.MsgUpdateRollappInformation{
+		Owner:     editor,
+		RollappId: rollappId,
+		Metadata:  &types.RollappMetadata{Website: "https://dymension.xyz"},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("https://dymension.xyz", s.k().MustGetRollapp(s.Ctx, rollappId).Metadata.Website)
+
+	// but not the other fields
+	_, err = s.msgServer.UpdateRollappInformation(s.Ctx, &types.MsgUpdateRollappInformation{
+		Owner:                 editor,
+		RollappId:             rollappId,
+		DisputePeriodInBlocks: 100,
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// revoke the role
+	_, err = s.msgServer.AssignRole(s.Ctx, types.NewMsgAssignRole(owner, rollappId, types.RollappRole_ROLLAPP_ROLE_METADATA_EDITOR, ""))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.UpdateRollappInformation(s.Ctx, &types.MsgUpdateRollappInformation{
+		Owner:     editor,
+		RollappId: rollappId,
+		Metadata:  &types.RollappMetadata{Website: "https://example.com"},
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
@@ -19,6 +19,11 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 		return nil, types.ErrUnknownRollappID
 	}
 
+	if !rollapp.AcceptsStateUpdates(ctx.BlockHeight()) {
+		return nil, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "rollapp does not accept state updates: lifecycle state: %s",
+			rollapp.LifecycleState)
+	}
+
 	// call the before-update-state hook
 	// currently used by `x/sequencer` to validate the proposer
 	err := k.hooks.BeforeUpdateState(ctx, msg.Creator, msg.RollappId, msg.Last)
@@ -95,6 +100,20 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 		blockTime,
 		successor.Address,
 	)
+	stateInfo.BdsRoot = msg.BdsRoot
+
+	// the first and the last block descriptors of a compressed update must be the ones committed to by the root
+	if stateInfo.IsCompressed() {
+		if len(msg.BdProofs) != len(msg.BDs.BD) {
+			return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument, "number of block descriptor proofs (%d) != %d",
+				len(msg.BdProofs), len(msg.BDs.BD))
+		}
+		for i, bd := range msg.BDs.BD {
+			if err := stateInfo.VerifyBlockDescriptor(bd, msg.BdProofs[i]); err != nil {
+				return nil, errorsmod.Wrapf(err, "block descriptor: height: %d", bd.Height)
+			}
+		}
+	}
 
 	// verify the DRS version is not obsolete
 	// check only last block descriptor DRS, since if that last is not obsolete it means the rollapp already upgraded and is not obsolete anymore
@@ -156,6 +175,9 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 	rollapp = k.MustGetRollapp(ctx, msg.RollappId)
 	k.IndicateLiveness(ctx, &rollapp)
 	k.SetRollapp(ctx, rollapp)
+	if err := k.SetRegistryDRSVersion(ctx, msg.RollappId, stateInfo.GetLatestBlockDescriptor().DrsVersion); err != nil {
+		return nil, errorsmod.Wrap(err, "set registry drs version")
+	}
 
 	events := stateInfo.GetEvents()
 
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// registryDisplayNameSeparator separates the display name from the rollapp id in the display name index.
+// Rollapp ids never contain it.
+const registryDisplayNameSeparator = "\x00"
+
+// registryIndexes are the secondary indexes of the rollapp registry, from a searchable attribute to the rollapp id
+type registryIndexes struct {
+	byVMType     collections.KeySet[collections.Pair[int32, string]]
+	byLaunched   collections.KeySet[collections.Pair[bool, string]]
+	byDRSVersion collections.KeySet[collections.Pair[uint32, string]]
+	byIRO        collections.KeySet[collections.Pair[bool, string]]
+	byChannel    collections.KeySet[collections.Pair[bool, string]]
+	byOwner      collections.KeySet[collections.Pair[string, string]]
+	byCreatedAt  collections.KeySet[collections.Pair[int64, string]]
+	// byDisplayName is keyed by the display name followed by the rollapp id, to allow prefix search
+	byDisplayName collections.KeySet[string]
+}
+
+func newRegistryIndexes(sb *collections.SchemaBuilder) registryIndexes {
+	return registryIndexes{
+		byVMType: collections.NewKeySet(sb, types.RegistryByVMTypeKeyPrefix, "registry_by_vm_type",
+			collections.PairKeyCodec(collections.Int32Key, collections.StringKey)),
+		byLaunched: collections.NewKeySet(sb, types.RegistryByLaunchedKeyPrefix, "registry_by_launched",
+			collections.PairKeyCodec(collections.BoolKey, collections.StringKey)),
+		byDRSVersion: collections.NewKeySet(sb, types.RegistryByDRSVersionKeyPrefix, "registry_by_drs_version",
+			collections.PairKeyCodec(collections.Uint32Key, collections.StringKey)),
+		byIRO: collections.NewKeySet(sb, types.RegistryByIROKeyPrefix, "registry_by_iro",
+			collections.PairKeyCodec(collections.BoolKey, collections.StringKey)),
+		byChannel: collections.NewKeySet(sb, types.RegistryByChannelKeyPrefix, "registry_by_channel",
+			collections.PairKeyCodec(collections.BoolKey, collections.StringKey)),
+		byOwner: collections.NewKeySet(sb, types.RegistryByOwnerKeyPrefix, "registry_by_owner",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		byCreatedAt: collections.NewKeySet(sb, types.RegistryByCreatedAtKeyPrefix, "registry_by_created_at",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey)),
+		byDisplayName: collections.NewKeySet(sb, types.RegistryByDisplayNameKeyPrefix, "registry_by_display_name",
+			collections.StringKey),
+	}
+}
+
+func (i registryIndexes) reference(ctx context.Context, e types.RollappRegistryEntry) error {
+	return errors.Join(
+		i.byVMType.Set(ctx, collections.Join(int32(e.VmType), e.RollappId)),
+		i.byLaunched.Set(ctx, collections.Join(e.Launched, e.RollappId)),
+		i.byDRSVersion.Set(ctx, collections.Join(e.DrsVersion, e.RollappId)),
+		i.byIRO.Set(ctx, collections.Join(e.HasIro, e.RollappId)),
+		i.byChannel.Set(ctx, collections.Join(e.HasCanonicalChannel, e.RollappId)),
+		i.byOwner.Set(ctx, collections.Join(e.Owner, e.RollappId)),
+		i.byCreatedAt.Set(ctx, collections.Join(e.CreatedAt, e.RollappId)),
+		i.byDisplayName.Set(ctx, registryDisplayNameKey(e)),
+	)
+}
+
+func (i registryIndexes) unreference(ctx context.Context, e types.RollappRegistryEntry) error {
+	return errors.Join(
+		i.byVMType.Remove(ctx, collections.Join(int32(e.VmType), e.RollappId)),
+		i.byLaunched.Remove(ctx, collections.Join(e.Launched, e.RollappId)),
+		i.byDRSVersion.Remove(ctx, collections.Join(e.DrsVersion, e.RollappId)),
+		i.byIRO.Remove(ctx, collections.Join(e.HasIro, e.RollappId)),
+		i.byChannel.Remove(ctx, collections.Join(e.HasCanonicalChannel, e.RollappId)),
+		i.byOwner.Remove(ctx, collections.Join(e.Owner, e.RollappId)),
+		i.byCreatedAt.Remove(ctx, collections.Join(e.CreatedAt, e.RollappId)),
+		i.byDisplayName.Remove(ctx, registryDisplayNameKey(e)),
+	)
+}
+
+func registryDisplayNameKey(e types.RollappRegistryEntry) string {
+	return e.DisplayName + registryDisplayNameSeparator + e.RollappId
+}
+
+func registryDisplayNameKeyRollappID(key string) string {
+	return key[strings.LastIndex(key, registryDisplayNameSeparator)+1:]
+}
+
+// updateRegistry saves the searchable attributes of the rollapp. The DRS version is kept.
+func (k Keeper) updateRegistry(ctx sdk.Context, ra types.Rollapp) error {
+	old, err := k.registry.Get(ctx, ra.RollappId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return k.setRegistryEntry(ctx, nil, types.NewRollappRegistryEntry(ra, 0))
+	}
+	if err != nil {
+		return err
+	}
+	return k.setRegistryEntry(ctx, &old, types.NewRollappRegistryEntry(ra, old.DrsVersion))
+}
+
+// SetRegistryDRSVersion sets the DRS version of the rollapp in the registry
+func (k Keeper) SetRegistryDRSVersion(ctx sdk.Context, rollappID string, version uint32) error {
+	old, err := k.registry.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return k.setRegistryEntry(ctx, nil, types.NewRollappRegistryEntry(k.MustGetRollapp(ctx, rollappID), version))
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get registry entry")
+	}
+	e := old
+	e.DrsVersion = version
+	return k.setRegistryEntry(ctx, &old, e)
+}
+
+// RefreshRegistryDRSVersion sets the DRS version of the rollapp in the registry from its latest state update
+func (k Keeper) RefreshRegistryDRSVersion(ctx sdk.Context, rollappID string) error {
+	var version uint32
+	if info, ok := k.GetLatestStateInfo(ctx, rollappID); ok && len(info.BDs.BD) != 0 {
+		version = info.GetLatestBlockDescriptor().DrsVersion
+	}
+	return k.SetRegistryDRSVersion(ctx, rollappID, version)
+}
+
+func (k Keeper) setRegistryEntry(ctx sdk.Context, old *types.RollappRegistryEntry, e types.RollappRegistryEntry) error {
+	if old != nil {
+		if err := k.registryIndexes.unreference(ctx, *old); err != nil {
+			return errorsmod.Wrap(err, "unreference")
+		}
+	}
+	if err := k.registryIndexes.reference(ctx, e); err != nil {
+		return errorsmod.Wrap(err, "reference")
+	}
+	return k.registry.Set(ctx, e.RollappId, e)
+}
+
+func (k Keeper) removeRegistryEntry(ctx sdk.Context, rollappID string) error {
+	old, err := k.registry.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.registryIndexes.unreference(ctx, old); err != nil {
+		return errorsmod.Wrap(err, "unreference")
+	}
+	return k.registry.Remove(ctx, rollappID)
+}
+
+func (k Keeper) GetRegistryEntry(ctx sdk.Context, rollappID string) (types.RollappRegistryEntry, error) {
+	return k.registry.Get(ctx, rollappID)
+}
@@ -24,8 +24,10 @@ func (k Keeper) CheckAndUpdateRollappFields(ctx sdk.Context, update *types.MsgUp
 		return current, types.ErrRollappNotFound
 	}
 
-	if update.Owner != current.Owner {
-		return current, sdkerrors.ErrUnauthorized
+	for _, role := range update.RequiredRoles() {
+		if !current.HasRole(update.Owner, role) {
+			return current, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "missing role: %s", role)
+		}
 	}
 
 	// immutable values cannot be updated when the rollapp is launched
@@ -49,6 +51,13 @@ func (k Keeper) CheckAndUpdateRollappFields(ctx sdk.Context, update *types.MsgUp
 		current.MinSequencerBond = sdk.NewCoins(minSeqBond)
 	}
 
+	if update.DisputePeriodInBlocks != 0 {
+		if err := k.validDisputePeriod(ctx, update.DisputePeriodInBlocks); err != nil {
+			return current, errorsmod.Wrap(err, "valid dispute period")
+		}
+		current.DisputePeriodInBlocks = update.DisputePeriodInBlocks
+	}
+
 	if update.GenesisInfo != nil {
 		current.GenesisInfo = *update.GenesisInfo
 		// hotfix: if supply is zero, override the denom metadata with empty
@@ -102,6 +111,11 @@ func (k Keeper) SetRollapp(ctx sdk.Context, rollapp types.Rollapp) {
 	store.Set(types.RollappByEIP155Key(
 		rollappID.GetEIP155ID(),
 	), []byte(rollapp.RollappId))
+
+	// panics only on encoding errors
+	if err := k.updateRegistry(ctx, rollapp); err != nil {
+		panic(err)
+	}
 }
 
 func (k Keeper) SetRollappAsLaunched(ctx sdk.Context, rollapp *types.Rollapp) error {
@@ -281,6 +295,10 @@ func (k Keeper) RemoveRollapp(
 	store.Delete(types.RollappKey(
 		rollappId,
 	))
+
+	if err := k.removeRegistryEntry(ctx, rollappId); err != nil {
+		panic(err)
+	}
 }
 
 // GetAllRollapps returns all rollapp
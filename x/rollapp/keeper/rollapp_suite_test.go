@@ -46,7 +46,7 @@ func (s *RollappTestSuite) SetupTest() {
 	err = app.BankKeeper.SetParams(ctx, banktypes.DefaultParams())
 	s.Require().NoError(err)
 	regFee, _ := sdk.ParseCoinNormalized(registrationFee)
-	s.k().SetParams(ctx, types.DefaultParams().WithDisputePeriodInBlocks(2))
+	s.k().SetParams(ctx, types.DefaultParams().WithDisputePeriodInBlocks(2).WithDisputePeriodBounds(2, 0))
 
 	aliceBal := sdk.NewCoins(regFee.AddAmount(regFee.Amount.Mul(math.NewInt(50))))
 	apptesting.FundAccount(app, ctx, sdk.MustAccAddressFromBech32(alice), aliceBal)
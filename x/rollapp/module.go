@@ -134,6 +134,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterProposalMsgServer(cfg.MsgServer(), am.keeper)
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(*am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/rollapp from version 2 to 3: %v", err))
+	}
 }
 
 // RegisterInvariants registers the module's invariants.
@@ -156,17 +161,21 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 func (am AppModule) GetHooks() []types.RollappHooks {
 	return am.keeper.GetHooks()
 }
 
-// EndBlock finalizes states from rollapps (after dispute period) and corresponding packets. It slashes and jails
-// sequencers of inactive rollapps.
+// EndBlock ends the dispute games whose deadline passed and finalizes states from rollapps (after dispute period)
+// and corresponding packets. It shuts down the rollapps at the end of their sunset, restarts the liveness clock
+// of the rollapps at the end of their maintenance, and slashes and jails sequencers of inactive rollapps.
 func (am AppModule) EndBlock(goCtx context.Context) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	am.keeper.ProcessDisputeDeadlines(ctx)
 	am.keeper.FinalizeRollappStates(ctx)
+	am.keeper.ProcessSunsets(ctx)
+	am.keeper.ProcessMaintenanceEnds(ctx)
 	am.keeper.CheckLiveness(ctx)
 	return nil
 }
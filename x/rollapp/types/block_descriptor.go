@@ -1,6 +1,10 @@
 package types
 
-import errorsmod "cosmossdk.io/errors"
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+)
 
 func (bds BlockDescriptors) Validate() error {
 	for _, bd := range bds.BD {
@@ -17,3 +21,35 @@ func (bd BlockDescriptor) Validate() error {
 	}
 	return nil
 }
+
+// Leaf is the Merkle leaf of the block descriptor in a compressed state update.
+func (bd BlockDescriptor) Leaf() []byte {
+	bz, err := bd.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// BlockDescriptorsRoot returns the Merkle root committing to the block descriptors, in order.
+func BlockDescriptorsRoot(bds []BlockDescriptor) []byte {
+	leaves := make([][]byte, 0, len(bds))
+	for _, bd := range bds {
+		leaves = append(leaves, bd.Leaf())
+	}
+	return merkle.HashFromByteSlices(leaves)
+}
+
+// BlockDescriptorsProofs returns the Merkle proofs of the block descriptors, in order.
+func BlockDescriptorsProofs(bds []BlockDescriptor) []*cmtcrypto.Proof {
+	leaves := make([][]byte, 0, len(bds))
+	for _, bd := range bds {
+		leaves = append(leaves, bd.Leaf())
+	}
+	_, proofs := merkle.ProofsFromByteSlices(leaves)
+	ret := make([]*cmtcrypto.Proof, 0, len(proofs))
+	for _, p := range proofs {
+		ret = append(ret, p.ToProto())
+	}
+	return ret
+}
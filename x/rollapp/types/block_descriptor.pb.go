@@ -101,6 +101,60 @@ func (m *BlockDescriptor) GetDrsVersion() uint32 {
 	return 0
 }
 
+// ProvenBlockDescriptor is a block descriptor of a compressed state update,
+// which was proven against its Merkle root.
+type ProvenBlockDescriptor struct {
+	RollappId string          `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Bd        BlockDescriptor `protobuf:"bytes,2,opt,name=bd,proto3" json:"bd"`
+}
+
+func (m *ProvenBlockDescriptor) Reset()         { *m = ProvenBlockDescriptor{} }
+func (m *ProvenBlockDescriptor) String() string { return proto.CompactTextString(m) }
+func (*ProvenBlockDescriptor) ProtoMessage()    {}
+func (*ProvenBlockDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eb4c1d0c21c2e68, []int{1}
+}
+func (m *ProvenBlockDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvenBlockDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvenBlockDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProvenBlockDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvenBlockDescriptor.Merge(m, src)
+}
+func (m *ProvenBlockDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvenBlockDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvenBlockDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvenBlockDescriptor proto.InternalMessageInfo
+
+func (m *ProvenBlockDescriptor) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *ProvenBlockDescriptor) GetBd() BlockDescriptor {
+	if m != nil {
+		return m.Bd
+	}
+	return BlockDescriptor{}
+}
+
 // BlockDescriptors defines list of BlockDescriptor.
 type BlockDescriptors struct {
 	BD []BlockDescriptor `protobuf:"bytes,1,rep,name=BD,proto3" json:"BD"`
@@ -110,7 +164,7 @@ func (m *BlockDescriptors) Reset()         { *m = BlockDescriptors{} }
 func (m *BlockDescriptors) String() string { return proto.CompactTextString(m) }
 func (*BlockDescriptors) ProtoMessage()    {}
 func (*BlockDescriptors) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6eb4c1d0c21c2e68, []int{1}
+	return fileDescriptor_6eb4c1d0c21c2e68, []int{2}
 }
 func (m *BlockDescriptors) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -148,6 +202,7 @@ func (m *BlockDescriptors) GetBD() []BlockDescriptor {
 
 func init() {
 	proto.RegisterType((*BlockDescriptor)(nil), "dymensionxyz.dymension.rollapp.BlockDescriptor")
+	proto.RegisterType((*ProvenBlockDescriptor)(nil), "dymensionxyz.dymension.rollapp.ProvenBlockDescriptor")
 	proto.RegisterType((*BlockDescriptors)(nil), "dymensionxyz.dymension.rollapp.BlockDescriptors")
 }
 
@@ -156,28 +211,30 @@ func init() {
 }
 
 var fileDescriptor_6eb4c1d0c21c2e68 = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x4f, 0x4f, 0xc2, 0x30,
-	0x18, 0xc6, 0x57, 0x20, 0x44, 0x8a, 0x46, 0xb3, 0x18, 0xb3, 0x10, 0xd3, 0x2d, 0x9c, 0x76, 0x6a,
-	0x13, 0xd0, 0x2f, 0xd0, 0xe0, 0xd5, 0xc3, 0x62, 0x4c, 0xf4, 0x42, 0x18, 0xab, 0x63, 0x71, 0xe3,
-	0x6d, 0xda, 0x42, 0xc0, 0x4f, 0xc1, 0x07, 0xf1, 0x83, 0x70, 0xe4, 0xe8, 0x49, 0x0d, 0x7c, 0x11,
-	0x33, 0x06, 0xc3, 0x90, 0xe8, 0xad, 0xcf, 0x9b, 0xe7, 0xd7, 0xe7, 0xfd, 0x83, 0x6f, 0xa3, 0x79,
-	0x26, 0xc6, 0x3a, 0x81, 0xf1, 0x6c, 0xfe, 0xc6, 0x4a, 0xc1, 0x14, 0xa4, 0xe9, 0x40, 0x4a, 0x16,
-	0xa6, 0x30, 0x7c, 0xed, 0x47, 0x42, 0x0f, 0x55, 0x22, 0x0d, 0x28, 0x2a, 0x15, 0x18, 0xb0, 0xc9,
-	0x6f, 0x8c, 0x96, 0x82, 0xee, 0xb0, 0xd6, 0x65, 0x0c, 0x31, 0x6c, 0xad, 0x2c, 0x7f, 0x15, 0x54,
-	0xcb, 0x8d, 0x01, 0xe2, 0x54, 0xb0, 0xad, 0x0a, 0x27, 0x2f, 0xcc, 0x24, 0x99, 0xd0, 0x66, 0x90,
-	0xc9, 0xc2, 0xd0, 0x7e, 0x47, 0xf8, 0x9c, 0xe7, 0x89, 0xbd, 0x32, 0xd0, 0xbe, 0xc2, 0xf5, 0x91,
-	0x48, 0xe2, 0x91, 0x71, 0x90, 0x87, 0xfc, 0x5a, 0xb0, 0x53, 0xf6, 0x35, 0x6e, 0x68, 0x33, 0x30,
-	0x22, 0x00, 0x30, 0x4e, 0xc5, 0x43, 0xfe, 0x69, 0x70, 0x28, 0xd8, 0x1c, 0x37, 0xca, 0xcf, 0x9d,
-	0xaa, 0x87, 0xfc, 0x66, 0xa7, 0x45, 0x8b, 0x78, 0xba, 0x8f, 0xa7, 0x0f, 0x7b, 0x07, 0x3f, 0x59,
-	0x7e, 0xba, 0xd6, 0xe2, 0xcb, 0x45, 0xc1, 0x01, 0xb3, 0x5d, 0xdc, 0x8c, 0x94, 0xee, 0x4f, 0x85,
-	0xca, 0x67, 0x73, 0x6a, 0x1e, 0xf2, 0xcf, 0x02, 0x1c, 0x29, 0xfd, 0x58, 0x54, 0xda, 0x4f, 0xf8,
-	0xe2, 0xa8, 0x5b, 0x6d, 0xdf, 0xe1, 0x0a, 0xef, 0x39, 0xc8, 0xab, 0xfa, 0xcd, 0x0e, 0xa3, 0xff,
-	0xaf, 0x89, 0x1e, 0xd1, 0xbc, 0x96, 0xb7, 0x11, 0x54, 0x78, 0x8f, 0xdf, 0x2f, 0xd7, 0x04, 0xad,
-	0xd6, 0x04, 0x7d, 0xaf, 0x09, 0x5a, 0x6c, 0x88, 0xb5, 0xda, 0x10, 0xeb, 0x63, 0x43, 0xac, 0xe7,
-	0x9b, 0x38, 0x31, 0xa3, 0x49, 0x48, 0x87, 0x90, 0xb1, 0x3f, 0x8e, 0x37, 0xed, 0xb2, 0x59, 0x79,
-	0x41, 0x33, 0x97, 0x42, 0x87, 0xf5, 0xed, 0xd0, 0xdd, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3b,
-	0xda, 0x31, 0x6f, 0xf0, 0x01, 0x00, 0x00,
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x4d, 0x4a, 0xc3, 0x40,
+	0x14, 0xce, 0xb4, 0xa5, 0x98, 0xa9, 0xa2, 0x04, 0x95, 0x50, 0x34, 0x09, 0x5d, 0x65, 0x35, 0x03,
+	0xad, 0x5e, 0x20, 0xd4, 0x85, 0x1b, 0x91, 0x41, 0x04, 0xdd, 0x94, 0xa6, 0x33, 0xa6, 0xc1, 0xa4,
+	0x13, 0x66, 0xa6, 0xa5, 0x15, 0xbc, 0x43, 0x0f, 0xe2, 0x41, 0xba, 0xec, 0xd2, 0x95, 0x4a, 0x7b,
+	0x11, 0xc9, 0x4f, 0x53, 0x29, 0xe8, 0xc6, 0x5d, 0xbe, 0x97, 0xf7, 0xfd, 0xcc, 0xc7, 0x83, 0x97,
+	0x74, 0x16, 0xb3, 0x91, 0x0c, 0xf9, 0x68, 0x3a, 0x7b, 0xc1, 0x25, 0xc0, 0x82, 0x47, 0x51, 0x3f,
+	0x49, 0xb0, 0x1f, 0xf1, 0xc1, 0x73, 0x8f, 0x32, 0x39, 0x10, 0x61, 0xa2, 0xb8, 0x40, 0x89, 0xe0,
+	0x8a, 0x1b, 0xd6, 0x4f, 0x1a, 0x2a, 0x01, 0x2a, 0x68, 0xcd, 0xe3, 0x80, 0x07, 0x3c, 0x5b, 0xc5,
+	0xe9, 0x57, 0xce, 0x6a, 0xda, 0x01, 0xe7, 0x41, 0xc4, 0x70, 0x86, 0xfc, 0xf1, 0x13, 0x56, 0x61,
+	0xcc, 0xa4, 0xea, 0xc7, 0x49, 0xbe, 0xd0, 0x7a, 0x03, 0xf0, 0xd0, 0x4b, 0x1d, 0xbb, 0xa5, 0xa1,
+	0x71, 0x0a, 0xeb, 0x43, 0x16, 0x06, 0x43, 0x65, 0x02, 0x07, 0xb8, 0x35, 0x52, 0x20, 0xe3, 0x0c,
+	0xea, 0x52, 0xf5, 0x15, 0x23, 0x9c, 0x2b, 0xb3, 0xe2, 0x00, 0x77, 0x9f, 0x6c, 0x07, 0x86, 0x07,
+	0xf5, 0x52, 0xdc, 0xac, 0x3a, 0xc0, 0x6d, 0xb4, 0x9b, 0x28, 0xb7, 0x47, 0x1b, 0x7b, 0x74, 0xb7,
+	0xd9, 0xf0, 0xf6, 0x16, 0x1f, 0xb6, 0x36, 0xff, 0xb4, 0x01, 0xd9, 0xd2, 0x0c, 0x1b, 0x36, 0xa8,
+	0x90, 0xbd, 0x09, 0x13, 0xe9, 0xdb, 0xcc, 0x9a, 0x03, 0xdc, 0x03, 0x02, 0xa9, 0x90, 0xf7, 0xf9,
+	0xa4, 0xf5, 0x0a, 0x4f, 0x6e, 0x05, 0x9f, 0xb0, 0xd1, 0x6e, 0xe6, 0x73, 0x08, 0x8b, 0x26, 0x7a,
+	0x21, 0xcd, 0x72, 0xeb, 0x44, 0x2f, 0x26, 0xd7, 0xd4, 0xb8, 0x82, 0x15, 0x9f, 0x66, 0x99, 0x1b,
+	0x6d, 0x8c, 0xfe, 0xae, 0x12, 0xed, 0x68, 0x7b, 0xb5, 0x34, 0x2a, 0xa9, 0xf8, 0xb4, 0xf5, 0x00,
+	0x8f, 0x76, 0x7e, 0xca, 0x54, 0xda, 0xeb, 0x9a, 0xc0, 0xa9, 0xfe, 0x43, 0xda, 0xeb, 0x7a, 0x37,
+	0x8b, 0x95, 0x05, 0x96, 0x2b, 0x0b, 0x7c, 0xad, 0x2c, 0x30, 0x5f, 0x5b, 0xda, 0x72, 0x6d, 0x69,
+	0xef, 0x6b, 0x4b, 0x7b, 0xbc, 0x08, 0x42, 0x35, 0x1c, 0xfb, 0x68, 0xc0, 0x63, 0xfc, 0xcb, 0xed,
+	0x4c, 0x3a, 0x78, 0x5a, 0x1e, 0x90, 0x9a, 0x25, 0x4c, 0xfa, 0xf5, 0xac, 0xf3, 0xce, 0xf7, 0x00,
+	0x91, 0x92, 0x1d, 0x16, 0x6f, 0x02, 0x00, 0x00,
 }
 
 func (m *BlockDescriptor) Marshal() (dAtA []byte, err error) {
@@ -228,6 +285,46 @@ func (m *BlockDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProvenBlockDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvenBlockDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvenBlockDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bd.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBlockDescriptor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintBlockDescriptor(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BlockDescriptors) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -297,6 +394,21 @@ func (m *BlockDescriptor) Size() (n int) {
 	return n
 }
 
+func (m *ProvenBlockDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovBlockDescriptor(uint64(l))
+	}
+	l = m.Bd.Size()
+	n += 1 + l + sovBlockDescriptor(uint64(l))
+	return n
+}
+
 func (m *BlockDescriptors) Size() (n int) {
 	if m == nil {
 		return 0
@@ -473,6 +585,121 @@ func (m *BlockDescriptor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProvenBlockDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlockDescriptor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProvenBlockDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProvenBlockDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockDescriptor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlockDescriptor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockDescriptor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockDescriptor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlockDescriptor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockDescriptor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlockDescriptor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlockDescriptor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BlockDescriptors) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -96,3 +96,38 @@ func TestBlockDescriptorValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestStateInfoVerifyBlockDescriptor(t *testing.T) {
+	bds := make([]types.BlockDescriptor, 0, 5)
+	for h := uint64(11); h <= 15; h++ {
+		bds = append(bds, types.BlockDescriptor{Height: h, StateRoot: []byte{byte(h)}, Timestamp: time.Unix(int64(h), 0).UTC()})
+	}
+	proofs := types.BlockDescriptorsProofs(bds)
+	stateInfo := types.StateInfo{
+		StartHeight: 11,
+		NumBlocks:   5,
+		BDs:         types.BlockDescriptors{BD: []types.BlockDescriptor{bds[0], bds[4]}},
+		BdsRoot:     types.BlockDescriptorsRoot(bds),
+	}
+
+	for i, bd := range bds {
+		require.NoError(t, stateInfo.VerifyBlockDescriptor(bd, proofs[i]))
+	}
+
+	// only the endpoints are stored
+	_, ok := stateInfo.GetBlockDescriptor(13)
+	require.False(t, ok)
+	bd, ok := stateInfo.GetBlockDescriptor(15)
+	require.True(t, ok)
+	require.Equal(t, bds[4], bd)
+
+	// proof of another height
+	require.Error(t, stateInfo.VerifyBlockDescriptor(bds[2], proofs[3]))
+	// tampered block descriptor
+	tampered := bds[2]
+	tampered.StateRoot = []byte{99}
+	require.Error(t, stateInfo.VerifyBlockDescriptor(tampered, proofs[2]))
+	// state info is not compressed
+	stateInfo.BdsRoot = nil
+	require.Error(t, stateInfo.VerifyBlockDescriptor(bds[2], proofs[2]))
+}
@@ -21,6 +21,16 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Params{}, "rollapp/Params", nil)
 	cdc.RegisterConcrete(&MsgForceGenesisInfoChange{}, "rollapp/ForceGenesisInfoChange", nil)
 	cdc.RegisterConcrete(&GenesisInfo{}, "rollapp/GenesisInfo", nil)
+	cdc.RegisterConcrete(&MsgProveBlockDescriptor{}, "rollapp/ProveBlockDescriptor", nil)
+	cdc.RegisterConcrete(&MsgOpenDispute{}, "rollapp/OpenDispute", nil)
+	cdc.RegisterConcrete(&MsgBisectDispute{}, "rollapp/BisectDispute", nil)
+	cdc.RegisterConcrete(&MsgSubmitDisputeProof{}, "rollapp/SubmitDisputeProof", nil)
+	cdc.RegisterConcrete(&MsgResolveDispute{}, "rollapp/ResolveDispute", nil)
+	cdc.RegisterConcrete(&MsgUpdateLifecycleState{}, "rollapp/UpdateLifecycleState", nil)
+	cdc.RegisterConcrete(&MsgForceLifecycleState{}, "rollapp/ForceLifecycleState", nil)
+	cdc.RegisterConcrete(&MsgDeclareMaintenance{}, "rollapp/DeclareMaintenance", nil)
+	cdc.RegisterConcrete(&MsgAcceptOwnership{}, "rollapp/AcceptOwnership", nil)
+	cdc.RegisterConcrete(&MsgAssignRole{}, "rollapp/AssignRole", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -36,6 +46,16 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgMarkObsoleteRollapps{},
 		&MsgForceGenesisInfoChange{},
 		&MsgUpdateParams{},
+		&MsgProveBlockDescriptor{},
+		&MsgOpenDispute{},
+		&MsgBisectDispute{},
+		&MsgSubmitDisputeProof{},
+		&MsgResolveDispute{},
+		&MsgUpdateLifecycleState{},
+		&MsgForceLifecycleState{},
+		&MsgDeclareMaintenance{},
+		&MsgAcceptOwnership{},
+		&MsgAssignRole{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
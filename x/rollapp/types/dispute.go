@@ -0,0 +1,66 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+// StepVerdict is the judgement of the last step of a dispute
+type StepVerdict int
+
+const (
+	// StepUndecided leaves the step to governance
+	StepUndecided StepVerdict = iota
+	// StepValid means the posted state root follows from the agreed one
+	StepValid
+	// StepFraud means the posted state root does not follow from the agreed one
+	StepFraud
+)
+
+// DisputeVerifier judges the last step of a dispute: whether the state root posted at the end height follows
+// from the agreed state root at the start height, given the proof submitted by the proposer. The agreed root
+// is nil if the start height is the rollapp genesis.
+type DisputeVerifier interface {
+	VerifyStep(ctx sdk.Context, d Dispute, agreedRoot, postedRoot []byte) (StepVerdict, error)
+}
+
+// IsOpen returns true if the dispute is not over yet
+func (d Dispute) IsOpen() bool {
+	switch d.Status {
+	case DisputeStatus_DISPUTE_BISECTING, DisputeStatus_DISPUTE_AWAITING_MIDPOINT,
+		DisputeStatus_DISPUTE_AWAITING_PROOF, DisputeStatus_DISPUTE_AWAITING_VERDICT:
+		return true
+	default:
+		return false
+	}
+}
+
+// Midpoint returns the height at which the disputed range is bisected next
+func (d Dispute) Midpoint() uint64 {
+	return d.StartHeight + (d.EndHeight-d.StartHeight)/2
+}
+
+func (d Dispute) ValidateBasic() error {
+	if d.RollappId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("rollapp id")
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Challenger); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "challenger"))
+	}
+	if d.Proposer == "" {
+		return gerrc.ErrInvalidArgument.Wrap("proposer")
+	}
+	if err := d.Bond.Validate(); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "bond"))
+	}
+	if d.EndHeight <= d.StartHeight {
+		return gerrc.ErrInvalidArgument.Wrap("end height must be greater than start height")
+	}
+	if _, ok := DisputeStatus_name[int32(d.Status)]; !ok {
+		return gerrc.ErrInvalidArgument.Wrap("status")
+	}
+	return nil
+}
@@ -0,0 +1,882 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/rollapp/dispute.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DisputeStatus is the stage of a dispute game
+type DisputeStatus int32
+
+const (
+	// the challenger has to bisect the disputed range
+	DisputeStatus_DISPUTE_BISECTING DisputeStatus = 0
+	// one step is left, the proposer has to submit a proof of it
+	DisputeStatus_DISPUTE_AWAITING_PROOF DisputeStatus = 1
+	// the verifier could not judge the step, governance has to
+	DisputeStatus_DISPUTE_AWAITING_VERDICT DisputeStatus = 2
+	// the state was fraudulent, the rollapp was hard forked
+	DisputeStatus_DISPUTE_CHALLENGER_WON DisputeStatus = 3
+	// the state was valid, the challenger bond was burned
+	DisputeStatus_DISPUTE_PROPOSER_WON DisputeStatus = 4
+	// the dispute was dropped, for example because the rollapp was forked by
+	// other means or governance did not judge the last step in time. The
+	// challenger bond was returned.
+	DisputeStatus_DISPUTE_CANCELLED DisputeStatus = 5
+	// the bisection is at a height whose block descriptor is not known, the
+	// proposer has to prove it
+	DisputeStatus_DISPUTE_AWAITING_MIDPOINT DisputeStatus = 6
+)
+
+var DisputeStatus_name = map[int32]string{
+	0: "DISPUTE_BISECTING",
+	1: "DISPUTE_AWAITING_PROOF",
+	2: "DISPUTE_AWAITING_VERDICT",
+	3: "DISPUTE_CHALLENGER_WON",
+	4: "DISPUTE_PROPOSER_WON",
+	5: "DISPUTE_CANCELLED",
+	6: "DISPUTE_AWAITING_MIDPOINT",
+}
+
+var DisputeStatus_value = map[string]int32{
+	"DISPUTE_BISECTING":         0,
+	"DISPUTE_AWAITING_PROOF":    1,
+	"DISPUTE_AWAITING_VERDICT":  2,
+	"DISPUTE_CHALLENGER_WON":    3,
+	"DISPUTE_PROPOSER_WON":      4,
+	"DISPUTE_CANCELLED":         5,
+	"DISPUTE_AWAITING_MIDPOINT": 6,
+}
+
+func (x DisputeStatus) String() string {
+	return proto.EnumName(DisputeStatus_name, int32(x))
+}
+
+func (DisputeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_970f673aa3681515, []int{0}
+}
+
+// Dispute is a challenge against a pending state info. The challenger and the
+// proposer agree on the state root at start_height and disagree on the state
+// root at end_height. The range is bisected over the block descriptors of the
+// state info until one step is left.
+type Dispute struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// state_info_index is the index of the disputed state info
+	StateInfoIndex uint64 `protobuf:"varint,3,opt,name=state_info_index,json=stateInfoIndex,proto3" json:"state_info_index,omitempty"`
+	// challenger is the bech32 address which opened the dispute
+	Challenger string `protobuf:"bytes,4,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// proposer is the address of the sequencer which posted the state info
+	Proposer string `protobuf:"bytes,5,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// bond is escrowed from the challenger while the dispute is open
+	Bond types.Coin `protobuf:"bytes,6,opt,name=bond,proto3" json:"bond"`
+	// start_height is the last rollapp height both sides agree on
+	StartHeight uint64 `protobuf:"varint,7,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the first rollapp height the sides disagree on, as far as
+	// the bisection went
+	EndHeight uint64 `protobuf:"varint,8,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// challenger_state_root is the state root the challenger claims at
+	// end_height
+	ChallengerStateRoot []byte        `protobuf:"bytes,9,opt,name=challenger_state_root,json=challengerStateRoot,proto3" json:"challenger_state_root,omitempty"`
+	Status              DisputeStatus `protobuf:"varint,10,opt,name=status,proto3,enum=dymensionxyz.dymension.rollapp.DisputeStatus" json:"status,omitempty"`
+	// deadline is the hub height by which the side whose turn it is has to move.
+	// 0 when no move is expected.
+	Deadline int64 `protobuf:"varint,11,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// proof is the one step proof submitted by the proposer
+	Proof []byte `protobuf:"bytes,12,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *Dispute) Reset()         { *m = Dispute{} }
+func (m *Dispute) String() string { return proto.CompactTextString(m) }
+func (*Dispute) ProtoMessage()    {}
+func (*Dispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_970f673aa3681515, []int{0}
+}
+func (m *Dispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Dispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Dispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Dispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dispute.Merge(m, src)
+}
+func (m *Dispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *Dispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dispute proto.InternalMessageInfo
+
+func (m *Dispute) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Dispute) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *Dispute) GetStateInfoIndex() uint64 {
+	if m != nil {
+		return m.StateInfoIndex
+	}
+	return 0
+}
+
+func (m *Dispute) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
+}
+
+func (m *Dispute) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *Dispute) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+func (m *Dispute) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Dispute) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *Dispute) GetChallengerStateRoot() []byte {
+	if m != nil {
+		return m.ChallengerStateRoot
+	}
+	return nil
+}
+
+func (m *Dispute) GetStatus() DisputeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DisputeStatus_DISPUTE_BISECTING
+}
+
+func (m *Dispute) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *Dispute) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.rollapp.DisputeStatus", DisputeStatus_name, DisputeStatus_value)
+	proto.RegisterType((*Dispute)(nil), "dymensionxyz.dymension.rollapp.Dispute")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/rollapp/dispute.proto", fileDescriptor_970f673aa3681515)
+}
+
+var fileDescriptor_970f673aa3681515 = []byte{
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0xaf, 0xdb, 0xae, 0x5b, 0xbd, 0x31, 0x05, 0xb3, 0x21, 0xaf, 0x62, 0x21, 0x70, 0x8a, 0x10,
+	0x24, 0xda, 0xc6, 0x0b, 0x74, 0x69, 0xd8, 0x22, 0x95, 0xa4, 0x4a, 0x0b, 0x93, 0xb8, 0x44, 0x69,
+	0xed, 0xb5, 0x96, 0x3a, 0x3b, 0x4a, 0xbc, 0x69, 0xe5, 0x29, 0x78, 0x26, 0x4e, 0x3b, 0x70, 0xd8,
+	0x91, 0x13, 0x42, 0xed, 0x8b, 0xa0, 0x38, 0x69, 0x57, 0x34, 0xc1, 0xad, 0xbf, 0x7f, 0x9f, 0xbf,
+	0x9f, 0x1b, 0xc3, 0xb7, 0x64, 0x76, 0x45, 0x79, 0xc6, 0x04, 0xbf, 0x9d, 0x7d, 0xb5, 0x57, 0xc0,
+	0x4e, 0xc5, 0x74, 0x1a, 0x27, 0x89, 0x4d, 0x58, 0x96, 0x5c, 0x4b, 0x6a, 0x25, 0xa9, 0x90, 0x02,
+	0xe9, 0xeb, 0x6e, 0x6b, 0x05, 0xac, 0xd2, 0xdd, 0xda, 0x1b, 0x8b, 0xb1, 0x50, 0x56, 0x3b, 0xff,
+	0x55, 0xa4, 0x5a, 0xfa, 0x48, 0x64, 0x57, 0x22, 0xb3, 0x87, 0x71, 0x46, 0xed, 0x9b, 0xa3, 0x21,
+	0x95, 0xf1, 0x91, 0x3d, 0x12, 0x8c, 0x17, 0xfa, 0xeb, 0xef, 0x35, 0xb8, 0xd9, 0x29, 0xce, 0x41,
+	0xbb, 0xb0, 0xca, 0x08, 0x06, 0x06, 0x30, 0xeb, 0x61, 0x95, 0x11, 0x74, 0x08, 0x61, 0x39, 0x3c,
+	0x62, 0x04, 0x57, 0x0d, 0x60, 0x36, 0xc3, 0x66, 0xc9, 0x78, 0x04, 0x99, 0x50, 0xcb, 0x64, 0x2c,
+	0x69, 0xc4, 0xf8, 0xa5, 0x88, 0x18, 0x27, 0xf4, 0x16, 0xd7, 0x54, 0x78, 0x57, 0xf1, 0x1e, 0xbf,
+	0x14, 0x5e, 0xce, 0x22, 0x1d, 0xc2, 0xd1, 0x24, 0x9e, 0x4e, 0x29, 0x1f, 0xd3, 0x14, 0xd7, 0xd5,
+	0xa0, 0x35, 0x06, 0xb5, 0xe0, 0x56, 0x92, 0x8a, 0x44, 0x64, 0x34, 0xc5, 0x1b, 0x4a, 0x5d, 0x61,
+	0x74, 0x02, 0xeb, 0x43, 0xc1, 0x09, 0x6e, 0x18, 0xc0, 0xdc, 0x3e, 0x3e, 0xb0, 0x8a, 0x3e, 0x56,
+	0xde, 0xc7, 0x2a, 0xfb, 0x58, 0x8e, 0x60, 0xfc, 0xb4, 0x7e, 0xf7, 0xeb, 0x65, 0x25, 0x54, 0x66,
+	0xf4, 0x0a, 0xee, 0x64, 0x32, 0x4e, 0x65, 0x34, 0xa1, 0x6c, 0x3c, 0x91, 0x78, 0x53, 0xad, 0xb5,
+	0xad, 0xb8, 0x73, 0x45, 0xe5, 0xe5, 0x28, 0x27, 0x4b, 0xc3, 0x96, 0x32, 0x34, 0x29, 0x27, 0xa5,
+	0x7c, 0x0c, 0xf7, 0x1f, 0x16, 0x8c, 0x8a, 0x9e, 0xa9, 0x10, 0x12, 0x37, 0x0d, 0x60, 0xee, 0x84,
+	0xcf, 0x1e, 0xc4, 0x7e, 0xae, 0x85, 0x42, 0x48, 0xe4, 0xc2, 0x46, 0x6e, 0xbc, 0xce, 0x30, 0x34,
+	0x80, 0xb9, 0x7b, 0xfc, 0xce, 0xfa, 0xff, 0x5f, 0x66, 0x95, 0x17, 0xdf, 0x57, 0xa1, 0xb0, 0x0c,
+	0xe7, 0xb7, 0x41, 0x68, 0x4c, 0xa6, 0x8c, 0x53, 0xbc, 0x6d, 0x00, 0xb3, 0x16, 0xae, 0x30, 0xda,
+	0x83, 0x1b, 0x49, 0x2a, 0xc4, 0x25, 0xde, 0x51, 0x6b, 0x14, 0xe0, 0xcd, 0x0f, 0x00, 0x9f, 0xfc,
+	0x35, 0x0b, 0xed, 0xc3, 0xa7, 0x1d, 0xaf, 0xdf, 0xfb, 0x34, 0x70, 0xa3, 0x53, 0xaf, 0xef, 0x3a,
+	0x03, 0xcf, 0x3f, 0xd3, 0x2a, 0xa8, 0x05, 0x9f, 0x2f, 0xe9, 0xf6, 0x45, 0xdb, 0xcb, 0xd9, 0xa8,
+	0x17, 0x06, 0xc1, 0x07, 0x0d, 0xa0, 0x17, 0x10, 0x3f, 0xd2, 0x3e, 0xbb, 0x61, 0xc7, 0x73, 0x06,
+	0x5a, 0x75, 0x3d, 0xe9, 0x9c, 0xb7, 0xbb, 0x5d, 0xd7, 0x3f, 0x73, 0xc3, 0xe8, 0x22, 0xf0, 0xb5,
+	0x1a, 0xc2, 0x70, 0x6f, 0xa9, 0xf5, 0xc2, 0xa0, 0x17, 0xf4, 0x4b, 0xa5, 0xbe, 0xbe, 0x86, 0xd3,
+	0xf6, 0x1d, 0xb7, 0xdb, 0x75, 0x3b, 0xda, 0x06, 0x3a, 0x84, 0x07, 0x8f, 0x8e, 0xfa, 0xe8, 0x75,
+	0x7a, 0x81, 0xe7, 0x0f, 0xb4, 0xc6, 0xa9, 0x7f, 0x37, 0xd7, 0xc1, 0xfd, 0x5c, 0x07, 0xbf, 0xe7,
+	0x3a, 0xf8, 0xb6, 0xd0, 0x2b, 0xf7, 0x0b, 0xbd, 0xf2, 0x73, 0xa1, 0x57, 0xbe, 0xbc, 0x1f, 0x33,
+	0x39, 0xb9, 0x1e, 0x5a, 0x23, 0x71, 0x65, 0xff, 0xe3, 0xf1, 0xdc, 0x9c, 0xd8, 0xb7, 0xab, 0x17,
+	0x24, 0x67, 0x09, 0xcd, 0x86, 0x0d, 0xf5, 0xa9, 0x9f, 0xfc, 0x19, 0x00, 0xdf, 0xce, 0xca, 0x00,
+	0x70, 0x03, 0x00, 0x00,
+}
+
+func (m *Dispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Dispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Dispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Status != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ChallengerStateRoot) > 0 {
+		i -= len(m.ChallengerStateRoot)
+		copy(dAtA[i:], m.ChallengerStateRoot)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.ChallengerStateRoot)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StateInfoIndex != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.StateInfoIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDispute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDispute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Dispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDispute(uint64(m.Id))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	if m.StateInfoIndex != 0 {
+		n += 1 + sovDispute(uint64(m.StateInfoIndex))
+	}
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovDispute(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovDispute(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovDispute(uint64(m.EndHeight))
+	}
+	l = len(m.ChallengerStateRoot)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovDispute(uint64(m.Status))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovDispute(uint64(m.Deadline))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	return n
+}
+
+func sovDispute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDispute(x uint64) (n int) {
+	return sovDispute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Dispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDispute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateInfoIndex", wireType)
+			}
+			m.StateInfoIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateInfoIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerStateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerStateRoot = append(m.ChallengerStateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChallengerStateRoot == nil {
+				m.ChallengerStateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DisputeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDispute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDispute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDispute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDispute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDispute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDispute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDispute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDispute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDispute = fmt.Errorf("proto: unexpected end of group")
+)
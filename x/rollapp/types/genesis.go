@@ -153,5 +153,45 @@ func (gs GenesisState) Validate() error {
 		obsoleteDRSVersionIndexMap[elem] = struct{}{}
 	}
 
+	disputeIndexMap := make(map[uint64]struct{})
+	openDisputeIndexMap := make(map[string]struct{})
+	for _, elem := range gs.Disputes {
+		if err := elem.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid dispute %d: %w", elem.Id, err)
+		}
+		if _, ok := disputeIndexMap[elem.Id]; ok {
+			return errors.New("duplicated index for dispute")
+		}
+		disputeIndexMap[elem.Id] = struct{}{}
+		if elem.IsOpen() {
+			index := fmt.Sprintf("%s/%d", elem.RollappId, elem.StateInfoIndex)
+			if _, ok := openDisputeIndexMap[index]; ok {
+				return errors.New("more than one open dispute for state info")
+			}
+			openDisputeIndexMap[index] = struct{}{}
+		}
+	}
+
+	provenBDIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ProvenBlockDescriptors {
+		if len(elem.Bd.StateRoot) != 32 {
+			return fmt.Errorf("invalid proven block descriptor state root: rollapp: %s: height: %d", elem.RollappId, elem.Bd.Height)
+		}
+		index := fmt.Sprintf("%s/%d", elem.RollappId, elem.Bd.Height)
+		if _, ok := provenBDIndexMap[index]; ok {
+			return errors.New("duplicated index for proven block descriptor")
+		}
+		provenBDIndexMap[index] = struct{}{}
+	}
+
+	livenessRecordIndexMap := make(map[string]struct{})
+	for _, elem := range gs.LivenessHistory {
+		index := fmt.Sprintf("%s/%d", elem.RollappId, elem.HubHeight)
+		if _, ok := livenessRecordIndexMap[index]; ok {
+			return errors.New("duplicated index for liveness record")
+		}
+		livenessRecordIndexMap[index] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
@@ -38,6 +38,13 @@ type GenesisState struct {
 	SequencerHeightPairs []SequencerHeightPair     `protobuf:"bytes,10,rep,name=sequencerHeightPairs,proto3" json:"sequencerHeightPairs"`
 	// ObsoleteDrsVersions is a list of DRS versions that are marked obsolete
 	ObsoleteDrsVersions []uint32 `protobuf:"varint,11,rep,packed,name=obsolete_drs_versions,json=obsoleteDrsVersions,proto3" json:"obsolete_drs_versions,omitempty"`
+	// Disputes is a list of all the dispute games, open and closed
+	Disputes []Dispute `protobuf:"bytes,12,rep,name=disputes,proto3" json:"disputes"`
+	// ProvenBlockDescriptors are the proven block descriptors of compressed state
+	// updates
+	ProvenBlockDescriptors []ProvenBlockDescriptor `protobuf:"bytes,13,rep,name=proven_block_descriptors,json=provenBlockDescriptors,proto3" json:"proven_block_descriptors"`
+	// LivenessHistory are the latest past liveness events of every rollapp
+	LivenessHistory []LivenessRecord `protobuf:"bytes,14,rep,name=liveness_history,json=livenessHistory,proto3" json:"liveness_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +157,27 @@ func (m *GenesisState) GetObsoleteDrsVersions() []uint32 {
 	return nil
 }
 
+func (m *GenesisState) GetDisputes() []Dispute {
+	if m != nil {
+		return m.Disputes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProvenBlockDescriptors() []ProvenBlockDescriptor {
+	if m != nil {
+		return m.ProvenBlockDescriptors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLivenessHistory() []LivenessRecord {
+	if m != nil {
+		return m.LivenessHistory
+	}
+	return nil
+}
+
 type SequencerHeightPair struct {
 	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
 	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
@@ -265,44 +293,50 @@ func init() {
 }
 
 var fileDescriptor_b76890aebc09aa04 = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0xe3, 0xb6, 0x5f, 0xda, 0x4c, 0xbe, 0x22, 0x34, 0x2d, 0x60, 0x45, 0xd4, 0x44, 0x41,
-	0x82, 0x20, 0xa8, 0x2d, 0x25, 0x48, 0xec, 0x90, 0x08, 0xe1, 0x4f, 0x44, 0x05, 0xc1, 0x05, 0x16,
-	0xb0, 0x88, 0x9c, 0xf8, 0xd6, 0x19, 0xe1, 0x78, 0x8c, 0x67, 0x12, 0x25, 0x79, 0x0a, 0x16, 0xbc,
-	0x08, 0x6f, 0xd1, 0x65, 0x97, 0xac, 0x10, 0x4a, 0x5e, 0x04, 0x79, 0x3c, 0x36, 0xa1, 0x6d, 0x32,
-	0x91, 0x58, 0x39, 0xe3, 0x39, 0xf7, 0x77, 0x8e, 0x6f, 0xae, 0x2e, 0x7a, 0xe0, 0x4e, 0x06, 0x10,
-	0x30, 0x42, 0x83, 0xf1, 0x64, 0x6a, 0x65, 0x07, 0x2b, 0xa2, 0xbe, 0xef, 0x84, 0xa1, 0xe5, 0x41,
-	0x00, 0x8c, 0x30, 0x33, 0x8c, 0x28, 0xa7, 0xd8, 0x58, 0x54, 0x9b, 0xd9, 0xc1, 0x94, 0xea, 0xd2,
-	0xbe, 0x47, 0x3d, 0x2a, 0xa4, 0x56, 0xfc, 0x2b, 0xa9, 0x2a, 0xdd, 0x57, 0x78, 0x84, 0x4e, 0xe4,
-	0x0c, 0xa4, 0x45, 0x49, 0x15, 0x48, 0x3e, 0xa5, 0xda, 0x52, 0xa8, 0x19, 0x77, 0x38, 0x74, 0x48,
-	0x70, 0x92, 0x66, 0x39, 0x54, 0x14, 0xf8, 0x64, 0x14, 0x7f, 0x71, 0x9a, 0xa6, 0xaa, 0x90, 0x67,
-	0x49, 0x2a, 0xdf, 0x77, 0xd0, 0xff, 0x2f, 0x92, 0x66, 0x1d, 0xc7, 0xa6, 0xb8, 0x89, 0xf2, 0xc9,
-	0x87, 0xe9, 0x5a, 0x59, 0xab, 0x16, 0x6b, 0x77, 0xcc, 0xd5, 0xcd, 0x33, 0xdb, 0x42, 0xdd, 0xd8,
-	0x3a, 0xfd, 0x79, 0x2b, 0x67, 0xcb, 0x5a, 0xfc, 0x06, 0x15, 0xe5, 0xfd, 0x11, 0x61, 0x5c, 0xdf,
-	0x28, 0x6f, 0x56, 0x8b, 0xb5, 0xbb, 0x2a, 0x94, 0x9d, 0x3c, 0x25, 0x6b, 0x91, 0x80, 0xdf, 0xa3,
-	0x5d, 0xd1, 0x94, 0x56, 0x70, 0x42, 0x05, 0x72, 0x53, 0x20, 0xef, 0xa9, 0x90, 0xc7, 0x69, 0x91,
-	0x84, 0xfe, 0x4d, 0xc1, 0x21, 0xd2, 0x7d, 0x87, 0x03, 0xe3, 0x99, 0xae, 0x15, 0xb8, 0x30, 0x16,
-	0x0e, 0x5b, 0xc2, 0xc1, 0x5c, 0xdb, 0x41, 0x54, 0x4a, 0x9b, 0xa5, 0x54, 0x3c, 0x45, 0x07, 0xc9,
-	0xdd, 0x73, 0x12, 0x38, 0x3e, 0x99, 0x82, 0x2b, 0x45, 0xa9, 0xed, 0x7f, 0xff, 0x60, 0xbb, 0x1a,
-	0x8d, 0xbf, 0x69, 0xa8, 0xd2, 0xf5, 0x69, 0xef, 0xf3, 0x4b, 0x20, 0x5e, 0x9f, 0xbf, 0xa3, 0x52,
-	0xe8, 0x70, 0x42, 0x83, 0xb7, 0x43, 0x18, 0x82, 0x48, 0x90, 0x17, 0x09, 0x1e, 0xab, 0x12, 0x34,
-	0x56, 0x92, 0x64, 0xa2, 0x35, 0xfc, 0xf0, 0x27, 0x74, 0x25, 0x9d, 0xdf, 0x67, 0x23, 0x08, 0x38,
-	0xd3, 0xb7, 0x45, 0x82, 0x43, 0x55, 0x82, 0xa3, 0xc5, 0x2a, 0x69, 0x78, 0x0e, 0x85, 0x9f, 0xa2,
-	0xed, 0x74, 0x0a, 0x77, 0x04, 0xf5, 0xb6, 0x8a, 0xfa, 0x24, 0x9b, 0xc0, 0xb4, 0x12, 0x13, 0x74,
-	0x35, 0x02, 0x8f, 0x30, 0x0e, 0x11, 0xb8, 0x4d, 0x08, 0xe8, 0x80, 0xe9, 0x05, 0x41, 0x7b, 0xb4,
-	0xe6, 0x4c, 0xdb, 0xe7, 0xca, 0xa5, 0xc3, 0x05, 0x2c, 0x1e, 0xa0, 0x7d, 0x06, 0x5f, 0x86, 0x10,
-	0xf4, 0x20, 0x4a, 0xda, 0xd6, 0x76, 0x48, 0xc4, 0x74, 0x24, 0xec, 0xea, 0xca, 0xb1, 0xb8, 0x58,
-	0x2b, 0xad, 0x2e, 0xc5, 0xe2, 0x1a, 0xba, 0x46, 0xbb, 0x8c, 0xfa, 0xc0, 0xa1, 0xe3, 0x46, 0xac,
-	0x33, 0x82, 0x28, 0xe6, 0x31, 0xbd, 0x58, 0xde, 0xac, 0xee, 0xda, 0x7b, 0xe9, 0x65, 0x33, 0x62,
-	0x1f, 0xe4, 0x55, 0xe5, 0x15, 0xda, 0xbb, 0xc4, 0x06, 0xdf, 0x44, 0x85, 0xcc, 0x42, 0x2c, 0x8f,
-	0x82, 0xfd, 0xe7, 0x05, 0xbe, 0x8e, 0xf2, 0x7d, 0xa1, 0xd5, 0x37, 0xca, 0x5a, 0x75, 0xcb, 0x96,
-	0xa7, 0x4a, 0x1b, 0xdd, 0x58, 0xd2, 0x22, 0x7c, 0x80, 0x90, 0xfc, 0xac, 0x0e, 0x71, 0x53, 0xa2,
-	0x7c, 0xd3, 0x72, 0x63, 0xa2, 0x9b, 0xfc, 0x15, 0xf1, 0x7a, 0x29, 0xd8, 0xf2, 0xd4, 0x78, 0x7d,
-	0x3a, 0x33, 0xb4, 0xb3, 0x99, 0xa1, 0xfd, 0x9a, 0x19, 0xda, 0xd7, 0xb9, 0x91, 0x3b, 0x9b, 0x1b,
-	0xb9, 0x1f, 0x73, 0x23, 0xf7, 0xf1, 0xa1, 0x47, 0x78, 0x7f, 0xd8, 0x35, 0x7b, 0x74, 0xb0, 0x6c,
-	0x03, 0x8f, 0xea, 0xd6, 0x38, 0x5b, 0x93, 0x7c, 0x12, 0x02, 0xeb, 0xe6, 0xc5, 0xa6, 0xac, 0xff,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x21, 0x55, 0x13, 0x74, 0x06, 0x00, 0x00,
+	// 687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x4f, 0x13, 0x4d,
+	0x18, 0xef, 0x02, 0x6f, 0xa1, 0x53, 0xe0, 0x25, 0x03, 0x2f, 0xef, 0x86, 0xc8, 0xda, 0xd4, 0x44,
+	0x6b, 0x94, 0x6d, 0x02, 0x12, 0x6f, 0x26, 0x62, 0x55, 0x1a, 0x89, 0xe2, 0xa2, 0x1e, 0xf4, 0xb0,
+	0xd9, 0x76, 0x1f, 0xda, 0x89, 0xdb, 0x9d, 0x75, 0x66, 0xda, 0x50, 0x3e, 0x85, 0x07, 0x3f, 0x14,
+	0x47, 0x0e, 0x1e, 0x3c, 0x19, 0x03, 0x5f, 0xc4, 0x74, 0xf6, 0xd9, 0x05, 0xf9, 0x37, 0x4d, 0x3c,
+	0xed, 0xce, 0xce, 0xef, 0xdf, 0xce, 0x3c, 0xf3, 0x0c, 0x79, 0x18, 0x0e, 0x7b, 0x10, 0x4b, 0xc6,
+	0xe3, 0x83, 0xe1, 0x61, 0x3d, 0x1f, 0xd4, 0x05, 0x8f, 0xa2, 0x20, 0x49, 0xea, 0x1d, 0x88, 0x41,
+	0x32, 0xe9, 0x26, 0x82, 0x2b, 0x4e, 0x9d, 0xf3, 0x68, 0x37, 0x1f, 0xb8, 0x88, 0x5e, 0x59, 0xea,
+	0xf0, 0x0e, 0xd7, 0xd0, 0xfa, 0xe8, 0x2d, 0x65, 0xad, 0x3c, 0x30, 0x78, 0x24, 0x81, 0x08, 0x7a,
+	0x68, 0xb1, 0x62, 0x0a, 0x84, 0x4f, 0x44, 0xd7, 0x0d, 0x68, 0xa9, 0x02, 0x05, 0x3e, 0x8b, 0xf7,
+	0xb3, 0x2c, 0x6b, 0x06, 0x42, 0xc4, 0x06, 0xa3, 0x3f, 0xce, 0xd2, 0xd4, 0x0c, 0xf0, 0xb3, 0x24,
+	0xa6, 0xdc, 0x21, 0x93, 0x49, 0x5f, 0x01, 0xa2, 0x37, 0x0d, 0xe8, 0x56, 0xc4, 0xdb, 0x9f, 0xfd,
+	0x10, 0x64, 0x5b, 0xb0, 0x44, 0x71, 0x91, 0xd2, 0xaa, 0xdf, 0x09, 0x99, 0x7d, 0x99, 0xee, 0xc8,
+	0xde, 0xe8, 0xcf, 0x68, 0x83, 0x14, 0xd3, 0xd5, 0xb3, 0xad, 0x8a, 0x55, 0x2b, 0xaf, 0xdf, 0x75,
+	0x6f, 0xde, 0x21, 0x77, 0x57, 0xa3, 0xb7, 0xa6, 0x8e, 0x7e, 0xde, 0x2e, 0x78, 0xc8, 0xa5, 0x6f,
+	0x48, 0x19, 0xe7, 0x77, 0x98, 0x54, 0xf6, 0x44, 0x65, 0xb2, 0x56, 0x5e, 0xbf, 0x67, 0x92, 0xf2,
+	0xd2, 0x27, 0x6a, 0x9d, 0x57, 0xa0, 0xef, 0xc9, 0x9c, 0x5e, 0xf9, 0x66, 0xbc, 0xcf, 0xb5, 0xe4,
+	0xa4, 0x96, 0xbc, 0x6f, 0x92, 0xdc, 0xcb, 0x48, 0x28, 0xfa, 0xa7, 0x0a, 0x4d, 0x88, 0x1d, 0x05,
+	0x0a, 0xa4, 0xca, 0x71, 0xcd, 0x38, 0x84, 0x03, 0xed, 0x30, 0xa5, 0x1d, 0xdc, 0xb1, 0x1d, 0x34,
+	0x13, 0x6d, 0xae, 0x55, 0xa5, 0x87, 0x64, 0x35, 0x9d, 0x7b, 0xc1, 0xe2, 0x20, 0x62, 0x87, 0x10,
+	0x22, 0x28, 0xb3, 0xfd, 0xe7, 0x2f, 0x6c, 0x6f, 0x96, 0xa6, 0xdf, 0x2c, 0x52, 0xd5, 0x75, 0xb0,
+	0x0d, 0xac, 0xd3, 0x55, 0xef, 0x38, 0x02, 0x03, 0xc5, 0x78, 0xfc, 0xb6, 0x0f, 0x7d, 0xd0, 0x09,
+	0x8a, 0x3a, 0xc1, 0x13, 0x53, 0x82, 0xad, 0x1b, 0x95, 0x30, 0xd1, 0x18, 0x7e, 0xf4, 0x13, 0x99,
+	0xcf, 0x0e, 0xc9, 0xf3, 0x01, 0xc4, 0x4a, 0xda, 0xd3, 0x3a, 0xc1, 0x9a, 0x29, 0xc1, 0xce, 0x79,
+	0x16, 0x1a, 0x5e, 0x90, 0xa2, 0xcf, 0xc8, 0x74, 0x56, 0x85, 0x33, 0x5a, 0xf5, 0x8e, 0x49, 0xf5,
+	0x69, 0x5e, 0x81, 0x19, 0x93, 0x32, 0xb2, 0x20, 0xa0, 0xc3, 0xa4, 0x02, 0x01, 0x61, 0x03, 0x62,
+	0xde, 0x93, 0x76, 0x49, 0xab, 0x3d, 0x1e, 0xb3, 0xa6, 0xbd, 0x0b, 0x74, 0x74, 0xb8, 0x24, 0x4b,
+	0x7b, 0x64, 0x49, 0xc2, 0x97, 0x3e, 0xc4, 0x6d, 0x10, 0xe9, 0xb2, 0xed, 0x06, 0x4c, 0x48, 0x9b,
+	0x68, 0xbb, 0x0d, 0x63, 0x59, 0x5c, 0xe6, 0xa2, 0xd5, 0x95, 0xb2, 0x74, 0x9d, 0xfc, 0xc7, 0x5b,
+	0x92, 0x47, 0xa0, 0xc0, 0x0f, 0x85, 0xf4, 0x07, 0x20, 0x46, 0x7a, 0xd2, 0x2e, 0x57, 0x26, 0x6b,
+	0x73, 0xde, 0x62, 0x36, 0xd9, 0x10, 0xf2, 0x03, 0x4e, 0xd1, 0x26, 0x99, 0xc1, 0xde, 0x23, 0xed,
+	0xd9, 0xf1, 0x4e, 0x76, 0x23, 0xc5, 0x63, 0x94, 0x9c, 0x4e, 0xfb, 0xc4, 0x4e, 0x04, 0x1f, 0x40,
+	0xec, 0x5f, 0xec, 0x4f, 0xd2, 0x9e, 0xd3, 0xd2, 0x9b, 0xc6, 0xfe, 0xa3, 0xf9, 0xba, 0x18, 0x1b,
+	0x39, 0x1b, 0x8d, 0x96, 0x93, 0xab, 0x26, 0x25, 0xf5, 0xc9, 0x42, 0x56, 0x26, 0x7e, 0x97, 0x49,
+	0xc5, 0xc5, 0xd0, 0x9e, 0x1f, 0xef, 0xdc, 0x65, 0x35, 0xe7, 0x41, 0x9b, 0x8b, 0x10, 0x7d, 0xfe,
+	0xcd, 0xd4, 0xb6, 0x53, 0xb1, 0xea, 0x2b, 0xb2, 0x78, 0xc5, 0x4e, 0xd0, 0x5b, 0xa4, 0x94, 0xef,
+	0x82, 0xee, 0xaf, 0x25, 0xef, 0xec, 0x03, 0x5d, 0x26, 0xc5, 0xae, 0xc6, 0xda, 0x13, 0x15, 0xab,
+	0x36, 0xe5, 0xe1, 0xa8, 0xba, 0x4b, 0xfe, 0xbf, 0xa6, 0x8a, 0xe8, 0x2a, 0x21, 0x18, 0xcc, 0x67,
+	0x61, 0xa6, 0x88, 0x5f, 0x9a, 0xe1, 0x48, 0x31, 0x4c, 0xab, 0x75, 0xd4, 0x81, 0x4b, 0x1e, 0x8e,
+	0xb6, 0x5e, 0x1f, 0x9d, 0x38, 0xd6, 0xf1, 0x89, 0x63, 0xfd, 0x3a, 0x71, 0xac, 0xaf, 0xa7, 0x4e,
+	0xe1, 0xf8, 0xd4, 0x29, 0xfc, 0x38, 0x75, 0x0a, 0x1f, 0x1f, 0x75, 0x98, 0xea, 0xf6, 0x5b, 0x6e,
+	0x9b, 0xf7, 0xae, 0xbb, 0x09, 0x07, 0x1b, 0xf5, 0x83, 0xfc, 0x5a, 0x51, 0xc3, 0x04, 0x64, 0xab,
+	0xa8, 0x2f, 0x93, 0x8d, 0xdf, 0x03, 0x00, 0x45, 0x3e, 0x32, 0xbc, 0xfc, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -325,6 +359,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LivenessHistory) > 0 {
+		for iNdEx := len(m.LivenessHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LivenessHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ProvenBlockDescriptors) > 0 {
+		for iNdEx := len(m.ProvenBlockDescriptors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProvenBlockDescriptors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Disputes) > 0 {
+		for iNdEx := len(m.Disputes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Disputes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.ObsoleteDrsVersions) > 0 {
 		dAtA2 := make([]byte, len(m.ObsoleteDrsVersions)*10)
 		var j1 int
@@ -636,6 +712,24 @@ func (m *GenesisState) Size() (n int) {
 		}
 		n += 1 + sovGenesis(uint64(l)) + l
 	}
+	if len(m.Disputes) > 0 {
+		for _, e := range m.Disputes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProvenBlockDescriptors) > 0 {
+		for _, e := range m.ProvenBlockDescriptors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LivenessHistory) > 0 {
+		for _, e := range m.LivenessHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1124,6 +1218,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObsoleteDrsVersions", wireType)
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disputes = append(m.Disputes, Dispute{})
+			if err := m.Disputes[len(m.Disputes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvenBlockDescriptors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvenBlockDescriptors = append(m.ProvenBlockDescriptors, ProvenBlockDescriptor{})
+			if err := m.ProvenBlockDescriptors[len(m.ProvenBlockDescriptors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LivenessHistory = append(m.LivenessHistory, LivenessRecord{})
+			if err := m.LivenessHistory[len(m.LivenessHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -14,10 +14,12 @@ type RollappHooks interface {
 	BeforeUpdateState(ctx sdk.Context, seqAddr, rollappId string, lastStateUpdateBySequencer bool) error // Must be called when a rollapp's state changes
 	AfterUpdateState(ctx sdk.Context, stateInfo *StateInfoMeta) error                                    // Must be called when a rollapp's state changes
 	AfterStateFinalized(ctx sdk.Context, rollappID string, stateInfo *StateInfo) error                   // Must be called when a rollapp's state changes
+	AfterBlockDescriptorProven(ctx sdk.Context, stateInfo *StateInfo, bd BlockDescriptor) error          // Must be called when a block descriptor of a compressed state is proven
 	RollappCreated(ctx sdk.Context, rollappID, alias string, creator sdk.AccAddress) error
 	AfterTransfersEnabled(ctx sdk.Context, rollappID, rollappIBCDenom string) error
 
 	OnHardFork(ctx sdk.Context, rollappID string, height uint64) error
+	AfterLifecycleStateChanged(ctx sdk.Context, rollappID string, from, to Rollapp_LifecycleState) error // Must be called when a rollapp is paused, resumed, sunset or shut down
 }
 
 var _ RollappHooks = MultiRollappHooks{}
@@ -60,6 +62,16 @@ func (h MultiRollappHooks) AfterStateFinalized(ctx sdk.Context, rollappID string
 	return nil
 }
 
+func (h MultiRollappHooks) AfterBlockDescriptorProven(ctx sdk.Context, stateInfo *StateInfo, bd BlockDescriptor) error {
+	for i := range h {
+		err := h[i].AfterBlockDescriptorProven(ctx, stateInfo, bd)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h MultiRollappHooks) OnHardFork(ctx sdk.Context, rollappID string, lastValidHeight uint64) error {
 	for i := range h {
 		err := h[i].OnHardFork(ctx, rollappID, lastValidHeight)
@@ -70,6 +82,16 @@ func (h MultiRollappHooks) OnHardFork(ctx sdk.Context, rollappID string, lastVal
 	return nil
 }
 
+func (h MultiRollappHooks) AfterLifecycleStateChanged(ctx sdk.Context, rollappID string, from, to Rollapp_LifecycleState) error {
+	for i := range h {
+		err := h[i].AfterLifecycleStateChanged(ctx, rollappID, from, to)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // RollappCreated implements RollappHooks.
 func (h MultiRollappHooks) RollappCreated(ctx sdk.Context, rollappID, alias string, creatorAddr sdk.AccAddress) error {
 	for i := range h {
@@ -104,6 +126,12 @@ func (StubRollappCreatedHooks) AfterUpdateState(sdk.Context, *StateInfoMeta) err
 	return nil
 }
 func (StubRollappCreatedHooks) OnHardFork(sdk.Context, string, uint64) error { return nil }
+func (StubRollappCreatedHooks) AfterLifecycleStateChanged(sdk.Context, string, Rollapp_LifecycleState, Rollapp_LifecycleState) error {
+	return nil
+}
+func (StubRollappCreatedHooks) AfterBlockDescriptorProven(sdk.Context, *StateInfo, BlockDescriptor) error {
+	return nil
+}
 func (StubRollappCreatedHooks) AfterStateFinalized(sdk.Context, string, *StateInfo) error {
 	return nil
 }
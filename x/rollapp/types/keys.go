@@ -32,4 +32,34 @@ const (
 	KeyRegisteredDenomPrefix = "RegisteredDenom/value/"
 )
 
-var SeqToUnfinalizedHeightKeyPrefix = collections.NewPrefix("seqToFinalizeHeight/")
+var (
+	SeqToUnfinalizedHeightKeyPrefix = collections.NewPrefix("seqToFinalizeHeight/")
+
+	FinalizationDueHeightsKeyPrefix  = collections.NewPrefix("finalizationDueHeights/")
+	FinalizationByDueHeightKeyPrefix = collections.NewPrefix("finalizationByDueHeight/")
+
+	DisputesKeyPrefix        = collections.NewPrefix("disputes/")
+	DisputeRollappsKeyPrefix = collections.NewPrefix("disputeRollapps/")
+	OpenDisputesKeyPrefix    = collections.NewPrefix("openDisputes/")
+	DisputeIDKey             = collections.NewPrefix("disputeID/")
+
+	ProvenBlockDescriptorsKeyPrefix = collections.NewPrefix("provenBDs/")
+
+	SunsettingRollappsKeyPrefix = collections.NewPrefix("sunsettingRollapps/")
+
+	LivenessHistoryKeyPrefix = collections.NewPrefix("livenessHistory/")
+
+	MaintenanceEndsKeyPrefix = collections.NewPrefix("maintenanceEnds/")
+
+	// The registry is paginated by prefix. Its prefixes are built from bytes so they have no spare capacity,
+	// which collections would overwrite when it builds the bounds of the iteration.
+	RollappRegistryKeyPrefix       = collections.NewPrefix([]byte("rollappRegistry/"))
+	RegistryByVMTypeKeyPrefix      = collections.NewPrefix([]byte("registryByVMType/"))
+	RegistryByLaunchedKeyPrefix    = collections.NewPrefix([]byte("registryByLaunched/"))
+	RegistryByDRSVersionKeyPrefix  = collections.NewPrefix([]byte("registryByDRSVersion/"))
+	RegistryByIROKeyPrefix         = collections.NewPrefix([]byte("registryByIRO/"))
+	RegistryByChannelKeyPrefix     = collections.NewPrefix([]byte("registryByChannel/"))
+	RegistryByOwnerKeyPrefix       = collections.NewPrefix([]byte("registryByOwner/"))
+	RegistryByCreatedAtKeyPrefix   = collections.NewPrefix([]byte("registryByCreatedAt/"))
+	RegistryByDisplayNameKeyPrefix = collections.NewPrefix([]byte("registryByDisplayName/"))
+)
@@ -9,6 +9,9 @@ var (
 	LivenessEventQueueSlash     = []byte("s")
 )
 
+// MaxLivenessRecords is the number of past liveness events kept per rollapp
+const MaxLivenessRecords = 100
+
 func LivenessEventQueueKey(e LivenessEvent) []byte {
 	kind := LivenessEventQueueSlash // there is only one kind now https://github.com/dymensionxyz/dymension/issues/1857
 
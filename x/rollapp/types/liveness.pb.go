@@ -5,7 +5,7 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -79,8 +79,110 @@ func (m *LivenessEvent) GetHubHeight() int64 {
 	return 0
 }
 
+// LivenessRecord is a past liveness event of a rollapp: its proposer was
+// slashed, or handling the event failed
+type LivenessRecord struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// hub_height is the height on the HUB at which the event happened
+	HubHeight int64 `protobuf:"varint,2,opt,name=hub_height,json=hubHeight,proto3" json:"hub_height,omitempty"`
+	// sequencer is the proposer at the time of the event
+	Sequencer string `protobuf:"bytes,3,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// slashed is the amount taken from the bond of the proposer
+	Slashed types.Coin `protobuf:"bytes,4,opt,name=slashed,proto3" json:"slashed"`
+	// downtime is the number of hub blocks the rollapp went without a state
+	// update
+	Downtime uint64 `protobuf:"varint,5,opt,name=downtime,proto3" json:"downtime,omitempty"`
+	// kickable is true if the proposer could be kicked after the event
+	Kickable bool `protobuf:"varint,6,opt,name=kickable,proto3" json:"kickable,omitempty"`
+	// error is set if handling the event failed. Nothing was slashed then.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *LivenessRecord) Reset()         { *m = LivenessRecord{} }
+func (m *LivenessRecord) String() string { return proto.CompactTextString(m) }
+func (*LivenessRecord) ProtoMessage()    {}
+func (*LivenessRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e2dfe628b004fdb, []int{1}
+}
+func (m *LivenessRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LivenessRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LivenessRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LivenessRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LivenessRecord.Merge(m, src)
+}
+func (m *LivenessRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *LivenessRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LivenessRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LivenessRecord proto.InternalMessageInfo
+
+func (m *LivenessRecord) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *LivenessRecord) GetHubHeight() int64 {
+	if m != nil {
+		return m.HubHeight
+	}
+	return 0
+}
+
+func (m *LivenessRecord) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *LivenessRecord) GetSlashed() types.Coin {
+	if m != nil {
+		return m.Slashed
+	}
+	return types.Coin{}
+}
+
+func (m *LivenessRecord) GetDowntime() uint64 {
+	if m != nil {
+		return m.Downtime
+	}
+	return 0
+}
+
+func (m *LivenessRecord) GetKickable() bool {
+	if m != nil {
+		return m.Kickable
+	}
+	return false
+}
+
+func (m *LivenessRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*LivenessEvent)(nil), "dymensionxyz.dymension.rollapp.LivenessEvent")
+	proto.RegisterType((*LivenessRecord)(nil), "dymensionxyz.dymension.rollapp.LivenessRecord")
 }
 
 func init() {
@@ -88,23 +190,30 @@ func init() {
 }
 
 var fileDescriptor_0e2dfe628b004fdb = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4d, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x8b, 0xf2, 0x73, 0x72,
-	0x12, 0x0b, 0x0a, 0xf4, 0x73, 0x32, 0xcb, 0x52, 0xf3, 0x52, 0x8b, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2,
-	0x4b, 0xf2, 0x85, 0xe4, 0x90, 0x95, 0xeb, 0xc1, 0x39, 0x7a, 0x50, 0xe5, 0x52, 0x22, 0xe9, 0xf9,
-	0xe9, 0xf9, 0x60, 0xa5, 0xfa, 0x20, 0x16, 0x44, 0x97, 0x94, 0x3e, 0x01, 0x4b, 0x8a, 0x4b, 0x12,
-	0x4b, 0x52, 0xe3, 0x33, 0xf3, 0xd2, 0x60, 0x1a, 0xe4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5,
-	0x93, 0x12, 0x8b, 0x53, 0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x93, 0xf3, 0x33,
-	0xf3, 0x20, 0xf2, 0x4a, 0xc1, 0x5c, 0xbc, 0x3e, 0x50, 0x87, 0xb9, 0x96, 0xa5, 0xe6, 0x95, 0x08,
-	0xc9, 0x72, 0x71, 0x41, 0x0d, 0x8b, 0xcf, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0xe2,
-	0x84, 0x8a, 0x78, 0xa6, 0x80, 0xa4, 0x33, 0x4a, 0x93, 0xe2, 0x33, 0x52, 0x33, 0xd3, 0x33, 0x4a,
-	0x24, 0x98, 0x14, 0x18, 0x35, 0x98, 0x83, 0x38, 0x33, 0x4a, 0x93, 0x3c, 0xc0, 0x02, 0x5e, 0x2c,
-	0x1c, 0xcc, 0x02, 0x2c, 0x4e, 0x7e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x65, 0x92, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0x8b, 0xcb, 0x2b, 0x65, 0xc6,
-	0xfa, 0x15, 0x70, 0xff, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xdd, 0x6a, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0x0d, 0x3f, 0x53, 0x4d, 0x63, 0x01, 0x00, 0x00,
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x51, 0x3d, 0xaf, 0xd3, 0x30,
+	0x14, 0x8d, 0x69, 0xfa, 0x11, 0x23, 0x10, 0x8a, 0x3a, 0x84, 0x0a, 0x4c, 0xd4, 0x29, 0x0b, 0xb6,
+	0x4a, 0x59, 0x58, 0x8b, 0x90, 0x00, 0x21, 0x86, 0xb0, 0xb1, 0x54, 0xf9, 0xb8, 0x24, 0x56, 0x13,
+	0x3b, 0xc4, 0x4e, 0x68, 0xf9, 0x15, 0xfc, 0xac, 0x8e, 0x1d, 0x99, 0x10, 0x6a, 0x7f, 0x03, 0x3b,
+	0xca, 0x47, 0xa3, 0xb7, 0x3c, 0xbd, 0xe1, 0x6d, 0x3e, 0xf7, 0x9e, 0x73, 0x7c, 0x8f, 0x0e, 0x7e,
+	0x19, 0x1f, 0x72, 0x10, 0x8a, 0x4b, 0xb1, 0x3f, 0xfc, 0x64, 0x03, 0x60, 0xa5, 0xcc, 0xb2, 0xa0,
+	0x28, 0x58, 0xc6, 0x6b, 0x10, 0xa0, 0x14, 0x2d, 0x4a, 0xa9, 0xa5, 0x4d, 0x6e, 0xd2, 0xe9, 0x00,
+	0x68, 0x4f, 0x5f, 0xcc, 0x13, 0x99, 0xc8, 0x96, 0xca, 0x9a, 0x57, 0xa7, 0x5a, 0xb0, 0x3b, 0x3e,
+	0x51, 0x3a, 0xd0, 0xb0, 0xe5, 0xe2, 0xdb, 0x55, 0x40, 0x22, 0xa9, 0x72, 0xa9, 0x58, 0x18, 0x28,
+	0x60, 0xf5, 0x2a, 0x04, 0x1d, 0xac, 0x58, 0x24, 0xb9, 0xe8, 0xf6, 0xcb, 0x2f, 0xf8, 0xd1, 0xa7,
+	0xfe, 0xb0, 0x77, 0x35, 0x08, 0x6d, 0x3f, 0xc7, 0xb8, 0x37, 0xdb, 0xf2, 0xd8, 0x41, 0x2e, 0xf2,
+	0x2c, 0xdf, 0xea, 0x27, 0x1f, 0xe2, 0x66, 0x9d, 0x56, 0xe1, 0x36, 0x05, 0x9e, 0xa4, 0xda, 0x79,
+	0xe0, 0x22, 0x6f, 0xe4, 0x5b, 0x69, 0x15, 0xbe, 0x6f, 0x07, 0x1f, 0xcd, 0xd9, 0xe8, 0x89, 0xb9,
+	0xfc, 0x87, 0xf0, 0xe3, 0xab, 0xab, 0x0f, 0x91, 0x2c, 0xe3, 0xfb, 0xd9, 0xda, 0xcf, 0xb0, 0xa5,
+	0xe0, 0x7b, 0x05, 0x22, 0x82, 0xd2, 0x19, 0x75, 0xe2, 0x61, 0x60, 0xbf, 0xc1, 0x53, 0x95, 0x05,
+	0x2a, 0x85, 0xd8, 0x31, 0x5d, 0xe4, 0x3d, 0x7c, 0xf5, 0x94, 0x76, 0xa9, 0x69, 0x93, 0x9a, 0xf6,
+	0xa9, 0xe9, 0x5b, 0xc9, 0xc5, 0xc6, 0x3c, 0xfe, 0x79, 0x61, 0xf8, 0x57, 0xbe, 0xbd, 0xc0, 0xb3,
+	0x58, 0xfe, 0x10, 0x9a, 0xe7, 0xe0, 0x8c, 0x5d, 0xe4, 0x99, 0xfe, 0x80, 0x9b, 0xdd, 0x8e, 0x47,
+	0xbb, 0x20, 0xcc, 0xc0, 0x99, 0xb8, 0xc8, 0x9b, 0xf9, 0x03, 0xb6, 0xe7, 0x78, 0x0c, 0x65, 0x29,
+	0x4b, 0x67, 0xda, 0x1e, 0xd3, 0x81, 0xcd, 0xe7, 0xe3, 0x99, 0xa0, 0xd3, 0x99, 0xa0, 0xbf, 0x67,
+	0x82, 0x7e, 0x5d, 0x88, 0x71, 0xba, 0x10, 0xe3, 0xf7, 0x85, 0x18, 0x5f, 0x5f, 0x27, 0x5c, 0xa7,
+	0x55, 0x48, 0x23, 0x99, 0xdf, 0x56, 0x61, 0xbd, 0x66, 0xfb, 0xa1, 0x47, 0x7d, 0x28, 0x40, 0x85,
+	0x93, 0xb6, 0xa3, 0xf5, 0xff, 0x01, 0x00, 0x06, 0xf5, 0x6c, 0x23, 0x5b, 0x02, 0x00, 0x00,
 }
 
 func (m *LivenessEvent) Marshal() (dAtA []byte, err error) {
@@ -142,6 +251,80 @@ func (m *LivenessEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LivenessRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LivenessRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LivenessRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Kickable {
+		i--
+		if m.Kickable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Downtime != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.Downtime))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Slashed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiveness(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HubHeight != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.HubHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLiveness(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLiveness(v)
 	base := offset
@@ -169,6 +352,38 @@ func (m *LivenessEvent) Size() (n int) {
 	return n
 }
 
+func (m *LivenessRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	if m.HubHeight != 0 {
+		n += 1 + sovLiveness(uint64(m.HubHeight))
+	}
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	l = m.Slashed.Size()
+	n += 1 + l + sovLiveness(uint64(l))
+	if m.Downtime != 0 {
+		n += 1 + sovLiveness(uint64(m.Downtime))
+	}
+	if m.Kickable {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	return n
+}
+
 func sovLiveness(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -276,6 +491,243 @@ func (m *LivenessEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LivenessRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LivenessRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LivenessRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubHeight", wireType)
+			}
+			m.HubHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HubHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downtime", wireType)
+			}
+			m.Downtime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Downtime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kickable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Kickable = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLiveness(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
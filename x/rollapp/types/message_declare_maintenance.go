@@ -0,0 +1,32 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var _ sdk.Msg = &MsgDeclareMaintenance{}
+
+func NewMsgDeclareMaintenance(creator, rollappId string, durationBlocks uint64) *MsgDeclareMaintenance {
+	return &MsgDeclareMaintenance{
+		Creator:        creator,
+		RollappId:      rollappId,
+		DurationBlocks: durationBlocks,
+	}
+}
+
+func (msg *MsgDeclareMaintenance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Join(ErrInvalidCreatorAddress, err)
+	}
+	if msg.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id is required")
+	}
+	if msg.DurationBlocks == 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "duration must be positive")
+	}
+	return nil
+}
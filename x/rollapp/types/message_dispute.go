@@ -0,0 +1,63 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var (
+	_ sdk.Msg = &MsgOpenDispute{}
+	_ sdk.Msg = &MsgBisectDispute{}
+	_ sdk.Msg = &MsgSubmitDisputeProof{}
+	_ sdk.Msg = &MsgResolveDispute{}
+)
+
+func (m *MsgOpenDispute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Challenger); err != nil {
+		return errors.Join(sdkerrors.ErrInvalidAddress, errorsmod.Wrapf(err, "challenger must be a valid bech32 address: %s", m.Challenger))
+	}
+	if m.RollappId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("rollapp id cannot be empty")
+	}
+	if m.Height == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("height must be positive")
+	}
+	if len(m.StateRoot) == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("state root cannot be empty")
+	}
+	return nil
+}
+
+func (m *MsgBisectDispute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Challenger); err != nil {
+		return errors.Join(sdkerrors.ErrInvalidAddress, errorsmod.Wrapf(err, "challenger must be a valid bech32 address: %s", m.Challenger))
+	}
+	if len(m.StateRoot) == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("state root cannot be empty")
+	}
+	return nil
+}
+
+func (m *MsgSubmitDisputeProof) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Proposer); err != nil {
+		return errors.Join(sdkerrors.ErrInvalidAddress, errorsmod.Wrapf(err, "proposer must be a valid bech32 address: %s", m.Proposer))
+	}
+	if len(m.Proof) == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("proof cannot be empty")
+	}
+	return nil
+}
+
+func (m *MsgResolveDispute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(
+			errors.Join(gerrc.ErrInvalidArgument, err),
+			"authority is not a valid bech32 address: %s", m.Authority,
+		)
+	}
+	return nil
+}
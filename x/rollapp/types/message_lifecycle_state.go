@@ -0,0 +1,54 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateLifecycleState{}
+	_ sdk.Msg = &MsgForceLifecycleState{}
+)
+
+func NewMsgUpdateLifecycleState(owner, rollappId string, state Rollapp_LifecycleState) *MsgUpdateLifecycleState {
+	return &MsgUpdateLifecycleState{
+		Owner:     owner,
+		RollappId: rollappId,
+		State:     state,
+	}
+}
+
+func (msg *MsgUpdateLifecycleState) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Join(ErrInvalidCreatorAddress, err)
+	}
+	if msg.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id is required")
+	}
+	return validateLifecycleStateTarget(msg.State)
+}
+
+func (msg *MsgForceLifecycleState) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "authority must be a valid bech32 address"))
+	}
+	if msg.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id is required")
+	}
+	return validateLifecycleStateTarget(msg.State)
+}
+
+// validateLifecycleStateTarget checks that the state can be requested. A rollapp is shut down at the end of its sunset.
+func validateLifecycleStateTarget(state Rollapp_LifecycleState) error {
+	switch state {
+	case Rollapp_ACTIVE, Rollapp_PAUSED, Rollapp_SUNSETTING:
+		return nil
+	case Rollapp_SHUT_DOWN:
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "a rollapp is shut down at the end of its sunset")
+	default:
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "unknown lifecycle state: %d", state)
+	}
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var _ sdk.Msg = &MsgProveBlockDescriptor{}
+
+func (m *MsgProveBlockDescriptor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return errors.Join(sdkerrors.ErrInvalidAddress, errorsmod.Wrapf(err, "creator must be a valid bech32 address: %s", m.Creator))
+	}
+	if m.RollappId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("rollapp id cannot be empty")
+	}
+	if m.StateInfoIndex == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("state info index must be positive")
+	}
+	if len(m.Bd.StateRoot) != 32 {
+		return errorsmod.Wrapf(ErrInvalidStateRoot, "StateRoot of block high (%d) must be 32 byte array. But received (%d) bytes",
+			m.Bd.Height, len(m.Bd.StateRoot))
+	}
+	if _, err := merkle.ProofFromProto(m.Proof); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "proof"))
+	}
+	return nil
+}
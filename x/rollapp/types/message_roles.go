@@ -0,0 +1,55 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var (
+	_ sdk.Msg = &MsgAcceptOwnership{}
+	_ sdk.Msg = &MsgAssignRole{}
+)
+
+func NewMsgAcceptOwnership(newOwner, rollappId string) *MsgAcceptOwnership {
+	return &MsgAcceptOwnership{
+		NewOwner:  newOwner,
+		RollappId: rollappId,
+	}
+}
+
+func (msg *MsgAcceptOwnership) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return err
+	}
+	if msg.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id is required")
+	}
+	return nil
+}
+
+func NewMsgAssignRole(owner, rollappId string, role RollappRole, addr string) *MsgAssignRole {
+	return &MsgAssignRole{
+		Owner:     owner,
+		RollappId: rollappId,
+		Role:      role,
+		Address:   addr,
+	}
+}
+
+func (msg *MsgAssignRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Join(ErrInvalidCreatorAddress, err)
+	}
+	if msg.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id is required")
+	}
+	if msg.Address != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+			return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "address"))
+		}
+	}
+	return new(RollappRoles).Set(msg.Role, msg.Address)
+}
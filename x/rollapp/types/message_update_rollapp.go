@@ -71,6 +71,24 @@ func (msg *MsgUpdateRollappInformation) UpdatingGenesisInfo() bool {
 	return msg.GenesisInfo != nil
 }
 
+// RequiredRoles returns the roles the signer must hold to make the update
+func (msg *MsgUpdateRollappInformation) RequiredRoles() []RollappRole {
+	var roles []RollappRole
+	if msg.UpdatingGenesisInfo() || msg.DisputePeriodInBlocks != 0 {
+		roles = append(roles, RollappRole_ROLLAPP_ROLE_OWNER)
+	}
+	if msg.UpdatingImmutableValues() {
+		roles = append(roles, RollappRole_ROLLAPP_ROLE_SEQUENCER_WHITELISTER)
+	}
+	if msg.Metadata != nil && !msg.Metadata.IsEmpty() {
+		roles = append(roles, RollappRole_ROLLAPP_ROLE_METADATA_EDITOR)
+	}
+	if len(roles) == 0 {
+		roles = append(roles, RollappRole_ROLLAPP_ROLE_OWNER)
+	}
+	return roles
+}
+
 /* ------------------------ MsgForceGenesisInfoChange ----------------------- */
 // ValidateBasic performs basic validation for the MsgForceGenesisInfoChange.
 func (m *MsgForceGenesisInfoChange) ValidateBasic() error {
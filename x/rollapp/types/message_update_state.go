@@ -5,6 +5,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 )
 
 var _ sdk.Msg = &MsgUpdateState{}
@@ -36,9 +37,24 @@ func (msg *MsgUpdateState) ValidateBasic() error {
 		return errorsmod.Wrapf(ErrInvalidNumBlocks, "numBlocks(%d) + startHeight(%d) exceeds max uint64", msg.NumBlocks, msg.StartHeight)
 	}
 
-	// check to see that update contains all BDs
-	if len(msg.BDs.BD) != int(msg.NumBlocks) { //nolint:gosec
-		return errorsmod.Wrapf(ErrInvalidNumBlocks, "number of blocks (%d) != number of block descriptors(%d)", msg.NumBlocks, len(msg.BDs.BD))
+	// check to see that update contains all BDs, or only the first and the last ones if compressed
+	numBDs := msg.NumBlocks
+	if msg.IsCompressed() {
+		if len(msg.BdsRoot) != 32 {
+			return errorsmod.Wrapf(ErrInvalidStateRoot, "BDs root must be 32 byte array. But received (%d) bytes", len(msg.BdsRoot))
+		}
+		numBDs = min(msg.NumBlocks, 2)
+	}
+	if len(msg.BDs.BD) != int(numBDs) { //nolint:gosec
+		return errorsmod.Wrapf(ErrInvalidNumBlocks, "number of blocks (%d) != number of block descriptors(%d)", numBDs, len(msg.BDs.BD))
+	}
+	// the block descriptors of a compressed update are proven against the root
+	numProofs := 0
+	if msg.IsCompressed() {
+		numProofs = len(msg.BDs.BD)
+	}
+	if len(msg.BdProofs) != numProofs {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "number of block descriptor proofs (%d) != %d", len(msg.BdProofs), numProofs)
 	}
 
 	// check to see that startHeight is not zaro
@@ -47,13 +63,17 @@ func (msg *MsgUpdateState) ValidateBasic() error {
 	}
 
 	// check that the blocks are sequential by height
-	for bdIndex := uint64(0); bdIndex < msg.NumBlocks; bdIndex += 1 {
+	for bdIndex := uint64(0); bdIndex < numBDs; bdIndex += 1 {
 
 		// Pre 3D rollapps will use zero DRS until they upgrade. Post 3D rollapps
 		// should use a non-zero version. We rely on other fraud mechanisms
 		// to catch that if it's wrong. So we don't check DRS.
 
-		if msg.BDs.BD[bdIndex].Height != msg.StartHeight+bdIndex {
+		height := msg.StartHeight + bdIndex
+		if msg.IsCompressed() && 0 < bdIndex {
+			height = msg.StartHeight + msg.NumBlocks - 1
+		}
+		if msg.BDs.BD[bdIndex].Height != height {
 			return ErrInvalidBlockSequence
 		}
 		// check to see stateRoot is a 32 byte array
@@ -65,3 +85,8 @@ func (msg *MsgUpdateState) ValidateBasic() error {
 
 	return nil
 }
+
+// IsCompressed returns true if the update commits to the block descriptors with their Merkle root.
+func (msg *MsgUpdateState) IsCompressed() bool {
+	return len(msg.BdsRoot) != 0
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dymensionxyz/dymension/v3/testutil/sample"
@@ -10,6 +12,9 @@ import (
 
 var hash32 = []byte("12345678901234567890123456789012")
 
+// the proofs are only counted, they are verified against the root in the msg server
+var bdProofs = []*cmtcrypto.Proof{{}, {}}
+
 func TestMsgUpdateState_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name string
@@ -60,6 +65,99 @@ func TestMsgUpdateState_ValidateBasic(t *testing.T) {
 					{Height: 6, StateRoot: hash32, DrsVersion: 1},
 				}},
 			},
+		}, {
+			name: "valid compressed state",
+			msg: MsgUpdateState{
+				Creator:     sample.AccAddress(),
+				StartHeight: 1,
+				NumBlocks:   10,
+				BdsRoot:     hash32,
+				BDs: BlockDescriptors{BD: []BlockDescriptor{
+					{Height: 1, StateRoot: hash32, DrsVersion: 1},
+					{Height: 10, StateRoot: hash32, DrsVersion: 1},
+				}},
+				BdProofs: bdProofs,
+			},
+		}, {
+			name: "valid compressed state with 1 block",
+			msg: MsgUpdateState{
+				Creator:     sample.AccAddress(),
+				StartHeight: 5,
+				NumBlocks:   1,
+				BdsRoot:     hash32,
+				BDs: BlockDescriptors{BD: []BlockDescriptor{
+					{Height: 5, StateRoot: hash32, DrsVersion: 1},
+				}},
+				BdProofs: bdProofs[:1],
+			},
+		}, {
+			name: "compressed state with a missing block descriptor proof",
+			msg: MsgUpdateState{
+				Creator:     sample.AccAddress(),
+				StartHeight: 1,
+				NumBlocks:   10,
+				BdsRoot:     hash32,
+				BDs: BlockDescriptors{BD: []BlockDescriptor{
+					{Height: 1, StateRoot: hash32, DrsVersion: 1},
+					{Height: 10, StateRoot: hash32, DrsVersion: 1},
+				}},
+				BdProofs: bdProofs[:1],
+			},
+			err: gerrc.ErrInvalidArgument,
+		}, {
+			name: "uncompressed state with block descriptor proofs",
+			msg: MsgUpdateState{
+				Creator:     sample.AccAddress(),
+				StartHeight: 1,
+				NumBlocks:   1,
+				BDs: BlockDescriptors{BD: []BlockDescriptor{
+					{Height: 1, StateRoot: hash32, DrsVersion: 1},
+				}},
+				BdProofs: bdProofs[:1],
+			},
+			err: gerrc.ErrInvalidArgument,
+		}, {
+			name: "compressed state with all block descriptors",
+			msg: MsgUpdateState{
+				Creator:     sample.AccAddress(),
+				StartHeight: 1,
+				NumBlocks:   3,
+				BdsRoot:     hash32,
+				BDs: BlockDescriptors{BD: []BlockDescriptor{
+					{Height: 1, StateRoot: hash32, DrsVersion: 1},
+					{Height: 2, StateRoot: hash32, DrsVersion: 1},
+					{Height: 3, StateRoot: hash32, DrsVersion: 1},
+				}},
+			},
+			err: ErrInvalidNumBlocks,
+		}, {
+			name: "compressed state with wrong last height",
+			msg: MsgUpdateState{
+				Creator:     sample.AccAddress(),
+				StartHeight: 1,
+				NumBlocks:   10,
+				BdsRoot:     hash32,
+				BDs: BlockDescriptors{BD: []BlockDescriptor{
+					{Height: 1, StateRoot: hash32, DrsVersion: 1},
+					{Height: 2, StateRoot: hash32, DrsVersion: 1},
+				}},
+				BdProofs: bdProofs[:2],
+			},
+			err: ErrInvalidBlockSequence,
+		}, {
+			name: "compressed state with invalid root",
+			msg: MsgUpdateState{
+				Creator:     sample.AccAddress(),
+				StartHeight: 1,
+				NumBlocks:   10,
+				BdsRoot:     []byte("root"),
+				BDs: BlockDescriptors{BD: []BlockDescriptor{
+					{Height: 1, StateRoot: hash32, DrsVersion: 1},
+					{Height: 10, StateRoot: hash32, DrsVersion: 1},
+				}},
+				BdProofs: bdProofs[:2],
+			},
+			err: ErrInvalidStateRoot,
 		}, {
 			name: "invalid address",
 			msg: MsgUpdateState{
@@ -15,6 +15,7 @@ import (
 var (
 	DefaultAppRegistrationFee         = commontypes.Dym(math.NewInt(1))
 	DefaultMinSequencerBondGlobalCoin = commontypes.Dym(math.NewInt(100))
+	DefaultDisputeChallengerBond      = commontypes.Dym(math.NewInt(100))
 )
 
 const (
@@ -22,8 +23,28 @@ const (
 	// MinDisputePeriodInBlocks is the minimum number of blocks for dispute period
 	MinDisputePeriodInBlocks uint64 = 1
 
+	// by default, a proposer bond above the min bond can at most halve the global dispute period
+	DefaultMinDisputePeriodInBlocks = DefaultDisputePeriodInBlocks / 2
+	DefaultMaxDisputePeriodInBlocks = uint64(0) // no upper bound
+	// by default, each hard fork adds the global dispute period
+	DefaultDisputePeriodFraudPenaltyBlocks = DefaultDisputePeriodInBlocks
+
 	DefaultLivenessSlashBlocks   = uint64(7200) // 12 hours worth of blocks at 1 block per 6 seconds
 	DefaultLivenessSlashInterval = uint64(600)  // 1 hour worth of blocks at 1 block per 6 seconds
+
+	DefaultDisputeMoveBlocks    = uint64(600)    // 1 hour worth of blocks at 1 block per 6 seconds
+	DefaultDisputeVerdictBlocks = uint64(100800) // 1 week worth of blocks at 1 block per 6 seconds
+
+	DefaultSunsetPeriodInBlocks = uint64(100800) // 1 week worth of blocks at 1 block per 6 seconds
+
+	DefaultMaxMaintenanceBlocks      = uint64(14400)  // 1 day worth of blocks at 1 block per 6 seconds
+	DefaultMaintenanceCooldownBlocks = uint64(100800) // 1 week worth of blocks at 1 block per 6 seconds
+	// DefaultMaintenanceWindowBlocks is 30 days worth of blocks at 1 block per 6 seconds
+	DefaultMaintenanceWindowBlocks = uint64(432000)
+	// DefaultMaxMaintenanceBlocksPerWindow is 3 days worth of blocks at 1 block per 6 seconds
+	DefaultMaxMaintenanceBlocksPerWindow = uint64(43200)
+
+	DefaultOwnershipTransferExpiryBlocks = uint64(100800) // 1 week worth of blocks at 1 block per 6 seconds
 )
 
 // NewParams creates a new Params instance
@@ -45,13 +66,26 @@ func NewParams(
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(
+	p := NewParams(
 		DefaultDisputePeriodInBlocks,
 		DefaultLivenessSlashBlocks,
 		DefaultLivenessSlashInterval,
 		DefaultAppRegistrationFee,
 		DefaultMinSequencerBondGlobalCoin,
 	)
+	p.MinDisputePeriodInBlocks = DefaultMinDisputePeriodInBlocks
+	p.MaxDisputePeriodInBlocks = DefaultMaxDisputePeriodInBlocks
+	p.DisputePeriodFraudPenaltyBlocks = DefaultDisputePeriodFraudPenaltyBlocks
+	p.DisputeChallengerBond = DefaultDisputeChallengerBond
+	p.DisputeMoveBlocks = DefaultDisputeMoveBlocks
+	p.DisputeVerdictBlocks = DefaultDisputeVerdictBlocks
+	p.SunsetPeriodInBlocks = DefaultSunsetPeriodInBlocks
+	p.MaxMaintenanceBlocks = DefaultMaxMaintenanceBlocks
+	p.MaintenanceCooldownBlocks = DefaultMaintenanceCooldownBlocks
+	p.MaintenanceWindowBlocks = DefaultMaintenanceWindowBlocks
+	p.MaxMaintenanceBlocksPerWindow = DefaultMaxMaintenanceBlocksPerWindow
+	p.OwnershipTransferExpiryBlocks = DefaultOwnershipTransferExpiryBlocks
+	return p
 }
 
 func (p Params) WithDisputePeriodInBlocks(x uint64) Params {
@@ -59,6 +93,13 @@ func (p Params) WithDisputePeriodInBlocks(x uint64) Params {
 	return p
 }
 
+// WithDisputePeriodBounds sets the bounds of the dispute period of the rollapps. A zero max means no upper bound.
+func (p Params) WithDisputePeriodBounds(min_, max_ uint64) Params {
+	p.MinDisputePeriodInBlocks = min_
+	p.MaxDisputePeriodInBlocks = max_
+	return p
+}
+
 func (p Params) WithLivenessSlashBlocks(x uint64) Params {
 	p.LivenessSlashBlocks = x
 	return p
@@ -74,6 +115,9 @@ func (p Params) ValidateBasic() error {
 	if err := validateDisputePeriodInBlocks(p.DisputePeriodInBlocks); err != nil {
 		return errorsmod.Wrap(err, "dispute period")
 	}
+	if err := validateDisputePeriodBounds(p.MinDisputePeriodInBlocks, p.MaxDisputePeriodInBlocks, p.DisputePeriodInBlocks); err != nil {
+		return errorsmod.Wrap(err, "dispute period bounds")
+	}
 
 	if err := validateLivenessSlashBlocks(p.LivenessSlashBlocks); err != nil {
 		return errorsmod.Wrap(err, "liveness slash blocks")
@@ -81,6 +125,19 @@ func (p Params) ValidateBasic() error {
 	if err := validateLivenessSlashInterval(p.LivenessSlashInterval); err != nil {
 		return errorsmod.Wrap(err, "liveness slash interval")
 	}
+	if err := uparam.ValidatePositiveUint64(p.OwnershipTransferExpiryBlocks); err != nil {
+		return errorsmod.Wrap(err, "ownership transfer expiry blocks")
+	}
+	if err := uparam.ValidatePositiveUint64(p.DisputeVerdictBlocks); err != nil {
+		return errorsmod.Wrap(err, "dispute verdict blocks")
+	}
+	if err := uparam.ValidatePositiveUint64(p.MaintenanceWindowBlocks); err != nil {
+		return errorsmod.Wrap(err, "maintenance window blocks")
+	}
+	if p.MaxMaintenanceBlocksPerWindow < p.MaxMaintenanceBlocks {
+		return fmt.Errorf("max maintenance blocks per window must not be below max maintenance blocks: %d < %d",
+			p.MaxMaintenanceBlocksPerWindow, p.MaxMaintenanceBlocks)
+	}
 
 	if err := validateAppRegistrationFee(p.AppRegistrationFee); err != nil {
 		return errorsmod.Wrap(err, "app registration fee")
@@ -88,6 +145,9 @@ func (p Params) ValidateBasic() error {
 	if err := uparam.ValidateCoin(p.MinSequencerBondGlobal); err != nil {
 		return errorsmod.Wrap(err, "min sequencer bond")
 	}
+	if err := uparam.ValidateCoin(p.DisputeChallengerBond); err != nil {
+		return errorsmod.Wrap(err, "dispute challenger bond")
+	}
 	return nil
 }
 
@@ -119,6 +179,29 @@ func validateDisputePeriodInBlocks(disputePeriodInBlocks uint64) error {
 	return nil
 }
 
+// validateDisputePeriodBounds validates the min and max dispute period params, which must contain the global dispute period
+func validateDisputePeriodBounds(min_, max_, disputePeriodInBlocks uint64) error {
+	if min_ < MinDisputePeriodInBlocks {
+		return errors.New("min dispute period cannot be lower than 1 block")
+	}
+	if disputePeriodInBlocks < min_ {
+		return errors.New("dispute period cannot be lower than the min dispute period")
+	}
+	if max_ != 0 && max_ < disputePeriodInBlocks {
+		return errors.New("dispute period cannot be greater than the max dispute period")
+	}
+	return nil
+}
+
+// ClampDisputePeriod keeps the period within the min and max dispute period. A zero max means no upper bound.
+func (p Params) ClampDisputePeriod(period uint64) uint64 {
+	period = max(period, p.MinDisputePeriodInBlocks, MinDisputePeriodInBlocks)
+	if p.MaxDisputePeriodInBlocks != 0 {
+		period = min(period, p.MaxDisputePeriodInBlocks)
+	}
+	return period
+}
+
 func validateAppRegistrationFee(v sdk.Coin) error {
 	if !v.IsValid() {
 		return fmt.Errorf("invalid app creation cost: %s", v)
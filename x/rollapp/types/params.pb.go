@@ -40,6 +40,44 @@ type Params struct {
 	AppRegistrationFee types.Coin `protobuf:"bytes,7,opt,name=app_registration_fee,json=appRegistrationFee,proto3" json:"app_registration_fee" yaml:"app_registration_fee"`
 	// no rollapp can have a minimum less than this (in dym)
 	MinSequencerBondGlobal types.Coin `protobuf:"bytes,8,opt,name=min_sequencer_bond_global,json=minSequencerBondGlobal,proto3" json:"min_sequencer_bond_global" yaml:"min_sequencer_bond_global"`
+	// min_dispute_period_in_blocks is the lowest dispute period a rollapp can
+	// have, whether chosen by the owner or derived from the proposer bond
+	MinDisputePeriodInBlocks uint64 `protobuf:"varint,9,opt,name=min_dispute_period_in_blocks,json=minDisputePeriodInBlocks,proto3" json:"min_dispute_period_in_blocks,omitempty" yaml:"min_dispute_period_in_blocks"`
+	// max_dispute_period_in_blocks is the highest dispute period a rollapp can
+	// have. 0 means no upper bound
+	MaxDisputePeriodInBlocks uint64 `protobuf:"varint,10,opt,name=max_dispute_period_in_blocks,json=maxDisputePeriodInBlocks,proto3" json:"max_dispute_period_in_blocks,omitempty" yaml:"max_dispute_period_in_blocks"`
+	// dispute_period_fraud_penalty_blocks is added to the dispute period of a
+	// rollapp for each hard fork it went through
+	DisputePeriodFraudPenaltyBlocks uint64 `protobuf:"varint,11,opt,name=dispute_period_fraud_penalty_blocks,json=disputePeriodFraudPenaltyBlocks,proto3" json:"dispute_period_fraud_penalty_blocks,omitempty" yaml:"dispute_period_fraud_penalty_blocks"`
+	// dispute_challenger_bond is the bond a challenger escrows to open a dispute
+	// against a pending state
+	DisputeChallengerBond types.Coin `protobuf:"bytes,12,opt,name=dispute_challenger_bond,json=disputeChallengerBond,proto3" json:"dispute_challenger_bond" yaml:"dispute_challenger_bond"`
+	// dispute_move_blocks is the number of hub blocks each side of a dispute
+	// has to make its move before it loses. 0 disables disputes
+	DisputeMoveBlocks uint64 `protobuf:"varint,13,opt,name=dispute_move_blocks,json=disputeMoveBlocks,proto3" json:"dispute_move_blocks,omitempty" yaml:"dispute_move_blocks"`
+	// sunset_period_in_blocks is the number of hub blocks a sunsetting rollapp
+	// may still post its last states for
+	SunsetPeriodInBlocks uint64 `protobuf:"varint,14,opt,name=sunset_period_in_blocks,json=sunsetPeriodInBlocks,proto3" json:"sunset_period_in_blocks,omitempty" yaml:"sunset_period_in_blocks"`
+	// max_maintenance_blocks is the longest maintenance window a rollapp may
+	// declare, in hub blocks. 0 disables maintenance declarations
+	MaxMaintenanceBlocks uint64 `protobuf:"varint,15,opt,name=max_maintenance_blocks,json=maxMaintenanceBlocks,proto3" json:"max_maintenance_blocks,omitempty" yaml:"max_maintenance_blocks"`
+	// maintenance_cooldown_blocks is the minimum number of hub blocks between
+	// two maintenance declarations of a rollapp
+	MaintenanceCooldownBlocks uint64 `protobuf:"varint,16,opt,name=maintenance_cooldown_blocks,json=maintenanceCooldownBlocks,proto3" json:"maintenance_cooldown_blocks,omitempty" yaml:"maintenance_cooldown_blocks"`
+	// ownership_transfer_expiry_blocks is the number of hub blocks the proposed
+	// owner has to accept the ownership of a rollapp
+	OwnershipTransferExpiryBlocks uint64 `protobuf:"varint,17,opt,name=ownership_transfer_expiry_blocks,json=ownershipTransferExpiryBlocks,proto3" json:"ownership_transfer_expiry_blocks,omitempty" yaml:"ownership_transfer_expiry_blocks"`
+	// dispute_verdict_blocks is the number of hub blocks governance has to judge
+	// the last step of a dispute the verifier could not judge. When it does not,
+	// the dispute is cancelled and the challenger bond returned
+	DisputeVerdictBlocks uint64 `protobuf:"varint,18,opt,name=dispute_verdict_blocks,json=disputeVerdictBlocks,proto3" json:"dispute_verdict_blocks,omitempty" yaml:"dispute_verdict_blocks"`
+	// maintenance_window_blocks is the length of the window, in hub blocks,
+	// over which the maintenance of a rollapp is bounded in total. The window
+	// starts at the first declaration after the previous window is over
+	MaintenanceWindowBlocks uint64 `protobuf:"varint,19,opt,name=maintenance_window_blocks,json=maintenanceWindowBlocks,proto3" json:"maintenance_window_blocks,omitempty" yaml:"maintenance_window_blocks"`
+	// max_maintenance_blocks_per_window is the longest total maintenance, in hub
+	// blocks, a rollapp may declare within a maintenance window
+	MaxMaintenanceBlocksPerWindow uint64 `protobuf:"varint,20,opt,name=max_maintenance_blocks_per_window,json=maxMaintenanceBlocksPerWindow,proto3" json:"max_maintenance_blocks_per_window,omitempty" yaml:"max_maintenance_blocks_per_window"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -109,6 +147,90 @@ func (m *Params) GetMinSequencerBondGlobal() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetMinDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.MinDisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.MaxDisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDisputePeriodFraudPenaltyBlocks() uint64 {
+	if m != nil {
+		return m.DisputePeriodFraudPenaltyBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeChallengerBond() types.Coin {
+	if m != nil {
+		return m.DisputeChallengerBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetDisputeMoveBlocks() uint64 {
+	if m != nil {
+		return m.DisputeMoveBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSunsetPeriodInBlocks() uint64 {
+	if m != nil {
+		return m.SunsetPeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMaintenanceBlocks() uint64 {
+	if m != nil {
+		return m.MaxMaintenanceBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaintenanceCooldownBlocks() uint64 {
+	if m != nil {
+		return m.MaintenanceCooldownBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetOwnershipTransferExpiryBlocks() uint64 {
+	if m != nil {
+		return m.OwnershipTransferExpiryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeVerdictBlocks() uint64 {
+	if m != nil {
+		return m.DisputeVerdictBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaintenanceWindowBlocks() uint64 {
+	if m != nil {
+		return m.MaintenanceWindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMaintenanceBlocksPerWindow() uint64 {
+	if m != nil {
+		return m.MaxMaintenanceBlocksPerWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.rollapp.Params")
 }
@@ -118,36 +240,59 @@ func init() {
 }
 
 var fileDescriptor_75a44aa904ae1ba5 = []byte{
-	// 454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
-	0x14, 0x85, 0x6d, 0x6a, 0xd2, 0xc8, 0x6c, 0x22, 0xd3, 0x80, 0x5b, 0xd0, 0x38, 0x72, 0x37, 0x91,
-	0x90, 0x3c, 0x2a, 0x65, 0xd5, 0xa5, 0x91, 0x40, 0xcd, 0x02, 0x55, 0x2e, 0xab, 0x0a, 0x69, 0x34,
-	0xb6, 0x07, 0x77, 0xc4, 0x78, 0xee, 0xe0, 0x71, 0xa2, 0x86, 0x05, 0xcf, 0xc0, 0x92, 0x25, 0xe2,
-	0x69, 0xba, 0xec, 0x92, 0x95, 0x85, 0x92, 0x37, 0xc8, 0x13, 0xa0, 0xf8, 0x27, 0x2a, 0x28, 0x11,
-	0xbb, 0xb9, 0xe7, 0x9c, 0xf9, 0x8e, 0x74, 0x75, 0xed, 0x17, 0xe9, 0x3c, 0x67, 0x52, 0x73, 0x90,
-	0x37, 0xf3, 0x2f, 0x78, 0x33, 0xe0, 0x02, 0x84, 0xa0, 0x4a, 0x61, 0x45, 0x0b, 0x9a, 0xeb, 0x40,
-	0x15, 0x50, 0x82, 0x83, 0xee, 0x87, 0x83, 0xcd, 0x10, 0xb4, 0xe1, 0xa3, 0x83, 0x0c, 0x32, 0xa8,
-	0xa3, 0x78, 0xfd, 0x6a, 0x7e, 0x1d, 0xa1, 0x04, 0x74, 0x0e, 0x1a, 0xc7, 0x54, 0x33, 0x3c, 0x3b,
-	0x89, 0x59, 0x49, 0x4f, 0x70, 0x02, 0x5c, 0x36, 0xbe, 0xff, 0xd3, 0xb2, 0x7b, 0x17, 0x75, 0x8d,
-	0xf3, 0xc1, 0x76, 0x53, 0xae, 0xd5, 0xb4, 0x64, 0x44, 0xb1, 0x82, 0x43, 0x4a, 0xb8, 0x24, 0xb1,
-	0x80, 0xe4, 0x93, 0x76, 0xcd, 0x91, 0x39, 0xb6, 0xc2, 0xe3, 0x55, 0xe5, 0x79, 0x73, 0x9a, 0x8b,
-	0x33, 0x7f, 0x57, 0xd2, 0x8f, 0x86, 0xad, 0x75, 0x51, 0x3b, 0xe7, 0x32, 0xac, 0x75, 0xe7, 0xbd,
-	0x3d, 0x14, 0x7c, 0xc6, 0x24, 0xd3, 0x9a, 0x68, 0x41, 0xf5, 0x75, 0x87, 0xb6, 0x6a, 0xf4, 0x68,
-	0x55, 0x79, 0xcf, 0x1b, 0xf4, 0xd6, 0x98, 0x1f, 0x3d, 0xee, 0xf4, 0xcb, 0xb5, 0xdc, 0x52, 0xaf,
-	0xec, 0xa7, 0xff, 0xc4, 0xb9, 0x2c, 0x59, 0x31, 0xa3, 0xc2, 0x7d, 0x58, 0x73, 0xfd, 0x55, 0xe5,
-	0xa1, 0xad, 0xdc, 0x2e, 0xe8, 0x47, 0xc3, 0xbf, 0xc8, 0xe7, 0xad, 0xee, 0x28, 0xfb, 0x80, 0x2a,
-	0x45, 0x0a, 0x96, 0x71, 0x5d, 0x16, 0xb4, 0xe4, 0x20, 0xc9, 0x47, 0xc6, 0xdc, 0xfd, 0x91, 0x39,
-	0x7e, 0xf4, 0xf2, 0x30, 0x68, 0x36, 0x1b, 0xac, 0x37, 0x1b, 0xb4, 0x9b, 0x0d, 0x5e, 0x03, 0x97,
-	0xe1, 0xf1, 0x6d, 0xe5, 0x19, 0xab, 0xca, 0x7b, 0xd6, 0xf4, 0x6e, 0x83, 0xf8, 0x91, 0x43, 0x95,
-	0x8a, 0xee, 0xa9, 0x6f, 0x18, 0x73, 0xbe, 0xda, 0x87, 0x39, 0x97, 0x44, 0xb3, 0xcf, 0x53, 0x26,
-	0x13, 0x56, 0x90, 0x18, 0x64, 0x4a, 0x32, 0x01, 0x31, 0x15, 0x6e, 0xff, 0x7f, 0xb5, 0xe3, 0xb6,
-	0x76, 0xd4, 0xd4, 0xee, 0x24, 0xf9, 0xd1, 0x93, 0x9c, 0xcb, 0xcb, 0xce, 0x0a, 0x41, 0xa6, 0x6f,
-	0x6b, 0xe3, 0xcc, 0xfa, 0xfe, 0xc3, 0x33, 0x26, 0x56, 0xff, 0xc1, 0x60, 0x6f, 0x62, 0xf5, 0xf7,
-	0x06, 0xd6, 0xc4, 0xea, 0xf7, 0x06, 0xfb, 0xe1, 0xbb, 0xdb, 0x05, 0x32, 0xef, 0x16, 0xc8, 0xfc,
-	0xbd, 0x40, 0xe6, 0xb7, 0x25, 0x32, 0xee, 0x96, 0xc8, 0xf8, 0xb5, 0x44, 0xc6, 0xd5, 0xab, 0x8c,
-	0x97, 0xd7, 0xd3, 0x38, 0x48, 0x20, 0xc7, 0x3b, 0x8e, 0x79, 0x76, 0x8a, 0x6f, 0x36, 0x17, 0x5d,
-	0xce, 0x15, 0xd3, 0x71, 0xaf, 0xbe, 0xbd, 0xd3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x96, 0xbb,
-	0xec, 0xbf, 0x00, 0x03, 0x00, 0x00,
+	// 823 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x41, 0x8f, 0xdb, 0x44,
+	0x18, 0x4d, 0x68, 0xd8, 0x2e, 0x53, 0x28, 0x5b, 0x6f, 0xb6, 0xeb, 0xdd, 0x76, 0xed, 0x74, 0x82,
+	0xda, 0x88, 0x22, 0x5b, 0xa5, 0x9c, 0x7a, 0xcc, 0x42, 0x51, 0x57, 0x6a, 0x15, 0xb9, 0x15, 0x15,
+	0x15, 0x92, 0x99, 0xd8, 0x93, 0x64, 0x84, 0x3d, 0x63, 0x3c, 0x8e, 0x93, 0x20, 0xc4, 0x81, 0x5f,
+	0xc0, 0x91, 0x23, 0x3f, 0xa7, 0xc7, 0x1e, 0x39, 0x59, 0x68, 0xf7, 0x1f, 0xf8, 0x17, 0x20, 0x8f,
+	0xc7, 0x8e, 0x93, 0xb5, 0x77, 0x6f, 0xf1, 0xf7, 0xde, 0xf7, 0x9e, 0xe7, 0x7b, 0x9f, 0x33, 0xe0,
+	0xb1, 0xbb, 0xf2, 0x31, 0xe5, 0x84, 0xd1, 0xe5, 0xea, 0x37, 0xb3, 0x7c, 0x30, 0x43, 0xe6, 0x79,
+	0x28, 0x08, 0xcc, 0x00, 0x85, 0xc8, 0xe7, 0x46, 0x10, 0xb2, 0x88, 0x29, 0x5a, 0x95, 0x6c, 0x94,
+	0x0f, 0x86, 0x24, 0x1f, 0x77, 0xa7, 0x6c, 0xca, 0x04, 0xd5, 0xcc, 0x7e, 0xe5, 0x5d, 0xc7, 0x9a,
+	0xc3, 0xb8, 0xcf, 0xb8, 0x39, 0x46, 0x1c, 0x9b, 0xf1, 0x93, 0x31, 0x8e, 0xd0, 0x13, 0xd3, 0x61,
+	0x84, 0xe6, 0x38, 0xfc, 0xf3, 0x36, 0xd8, 0x19, 0x09, 0x1b, 0xe5, 0x27, 0xa0, 0xba, 0x84, 0x07,
+	0xf3, 0x08, 0xdb, 0x01, 0x0e, 0x09, 0x73, 0x6d, 0x42, 0xed, 0xb1, 0xc7, 0x9c, 0x5f, 0xb8, 0xda,
+	0xee, 0xb5, 0x07, 0x9d, 0x61, 0x3f, 0x4d, 0x74, 0x7d, 0x85, 0x7c, 0xef, 0x19, 0x6c, 0x62, 0x42,
+	0xeb, 0x40, 0x42, 0x23, 0x81, 0xbc, 0xa0, 0x43, 0x51, 0x57, 0xde, 0x80, 0x03, 0x8f, 0xc4, 0x98,
+	0x62, 0xce, 0x6d, 0xee, 0x21, 0x3e, 0x2b, 0xa4, 0x3b, 0x42, 0xba, 0x97, 0x26, 0xfa, 0xfd, 0x5c,
+	0xba, 0x96, 0x06, 0xad, 0xfd, 0xa2, 0xfe, 0x3a, 0x2b, 0x4b, 0xd5, 0x77, 0xe0, 0x70, 0x8b, 0x4e,
+	0x68, 0x84, 0xc3, 0x18, 0x79, 0xea, 0xc7, 0x42, 0x17, 0xa6, 0x89, 0xae, 0xd5, 0xea, 0x16, 0x44,
+	0x68, 0x1d, 0x6c, 0x28, 0xbf, 0x90, 0x75, 0x25, 0x00, 0x5d, 0x14, 0x04, 0x76, 0x88, 0xa7, 0x84,
+	0x47, 0x21, 0x8a, 0x08, 0xa3, 0xf6, 0x04, 0x63, 0xf5, 0x66, 0xaf, 0x3d, 0xb8, 0xf5, 0xf5, 0x91,
+	0x91, 0x4f, 0xd6, 0xc8, 0x26, 0x6b, 0xc8, 0xc9, 0x1a, 0xa7, 0x8c, 0xd0, 0x61, 0xff, 0x7d, 0xa2,
+	0xb7, 0xd2, 0x44, 0xbf, 0x97, 0xfb, 0xd6, 0x89, 0x40, 0x4b, 0x41, 0x41, 0x60, 0x55, 0xaa, 0xcf,
+	0x31, 0x56, 0xfe, 0x00, 0x47, 0x3e, 0xa1, 0x36, 0xc7, 0xbf, 0xce, 0x31, 0x75, 0x70, 0x68, 0x8f,
+	0x19, 0x75, 0xed, 0xa9, 0xc7, 0xc6, 0xc8, 0x53, 0x77, 0xaf, 0xb3, 0x1d, 0x48, 0xdb, 0x5e, 0x6e,
+	0xdb, 0xa8, 0x04, 0xad, 0xbb, 0x3e, 0xa1, 0xaf, 0x0b, 0x68, 0xc8, 0xa8, 0xfb, 0xbd, 0x00, 0x94,
+	0x29, 0xb8, 0x9f, 0x75, 0x35, 0x6e, 0xc1, 0x27, 0x62, 0xa4, 0x8f, 0xd2, 0x44, 0xef, 0xaf, 0x3d,
+	0x9a, 0x37, 0x41, 0xf5, 0x09, 0xfd, 0xb6, 0x76, 0x19, 0x32, 0x23, 0xb4, 0x6c, 0x36, 0x02, 0x97,
+	0x8c, 0xd0, 0xf2, 0x4a, 0x23, 0xb4, 0xac, 0x37, 0xfa, 0x1d, 0xf4, 0xb7, 0xda, 0x26, 0x21, 0x9a,
+	0xbb, 0x76, 0x80, 0x29, 0xf2, 0xa2, 0x55, 0xe1, 0x77, 0x4b, 0xf8, 0x19, 0x69, 0xa2, 0x7f, 0x59,
+	0xbb, 0xde, 0x75, 0x4d, 0xd0, 0xd2, 0x37, 0x36, 0xfd, 0x79, 0xc6, 0x19, 0xe5, 0x14, 0xe9, 0xbe,
+	0x02, 0x87, 0x85, 0x90, 0x33, 0x43, 0x9e, 0x87, 0xe9, 0x54, 0x46, 0xa1, 0x7e, 0x7a, 0x5d, 0x9a,
+	0x0f, 0x65, 0x9a, 0xda, 0xe6, 0x0b, 0x6d, 0xe9, 0xac, 0x3f, 0xb7, 0xd3, 0x12, 0xc8, 0x02, 0x55,
+	0x5e, 0x81, 0xfd, 0xa2, 0xc5, 0x67, 0x31, 0x2e, 0x0e, 0xfa, 0x99, 0x38, 0xa8, 0x96, 0x26, 0xfa,
+	0xf1, 0xa6, 0x6e, 0x85, 0x04, 0xad, 0x3b, 0xb2, 0xfa, 0x92, 0xc5, 0x58, 0x1e, 0xe5, 0x47, 0x70,
+	0xc8, 0xe7, 0x94, 0xe3, 0xe8, 0x72, 0x58, 0xb7, 0xb7, 0x3f, 0xb4, 0x06, 0x22, 0xb4, 0xba, 0x39,
+	0xb2, 0x95, 0xd1, 0x5b, 0x70, 0x37, 0x8b, 0xd7, 0x47, 0xd9, 0x17, 0x49, 0x11, 0x75, 0xca, 0xb7,
+	0xfd, 0x5c, 0x28, 0x3f, 0x48, 0x13, 0xfd, 0x64, 0xbd, 0x06, 0x97, 0x79, 0xd0, 0xea, 0xfa, 0x68,
+	0xf9, 0x72, 0x5d, 0x97, 0xc2, 0x13, 0x70, 0xaf, 0x4a, 0x76, 0x18, 0xf3, 0x5c, 0xb6, 0x28, 0xdf,
+	0x7b, 0x4f, 0xa8, 0x3f, 0x4c, 0x13, 0x1d, 0x16, 0xea, 0x8d, 0x64, 0x68, 0x1d, 0x55, 0xd0, 0x53,
+	0x09, 0x4a, 0x9f, 0x08, 0xf4, 0xd8, 0x82, 0xe2, 0x90, 0xcf, 0x48, 0x60, 0x47, 0x21, 0xa2, 0x7c,
+	0x82, 0x43, 0x1b, 0x2f, 0x03, 0x12, 0x96, 0x1b, 0x76, 0x47, 0x98, 0x3d, 0x4e, 0x13, 0xfd, 0x51,
+	0x6e, 0x76, 0x5d, 0x07, 0xb4, 0x4e, 0x4a, 0xca, 0x1b, 0xc9, 0xf8, 0x4e, 0x10, 0xd6, 0x63, 0x2b,
+	0xc2, 0x8b, 0x71, 0xe8, 0x12, 0x27, 0x2a, 0xbc, 0x94, 0xed, 0xb1, 0xd5, 0xf3, 0xa0, 0xd5, 0x95,
+	0xc0, 0x0f, 0x79, 0x5d, 0x0a, 0xff, 0x0c, 0xaa, 0x67, 0xb5, 0x17, 0x84, 0xba, 0x6c, 0x51, 0x68,
+	0xef, 0x0b, 0xed, 0x2f, 0x2a, 0x7f, 0x33, 0x4d, 0x54, 0x68, 0x1d, 0x56, 0xb0, 0xb7, 0x02, 0x92,
+	0x0e, 0x31, 0x78, 0x50, 0x9f, 0x64, 0xb6, 0x33, 0x52, 0x45, 0xed, 0x0a, 0xa7, 0xaf, 0xd2, 0x44,
+	0x1f, 0x5c, 0x15, 0x7e, 0xa5, 0x05, 0x5a, 0x27, 0x75, 0x7b, 0x30, 0xc2, 0x61, 0xee, 0xfe, 0xac,
+	0xf3, 0xf7, 0x3f, 0x7a, 0xeb, 0xac, 0xb3, 0xfb, 0xd1, 0xde, 0x8d, 0xb3, 0xce, 0xee, 0x8d, 0xbd,
+	0xce, 0x59, 0x67, 0x77, 0x67, 0xef, 0xe6, 0xf0, 0xd5, 0xfb, 0x73, 0xad, 0xfd, 0xe1, 0x5c, 0x6b,
+	0xff, 0x77, 0xae, 0xb5, 0xff, 0xba, 0xd0, 0x5a, 0x1f, 0x2e, 0xb4, 0xd6, 0xbf, 0x17, 0x5a, 0xeb,
+	0xdd, 0x37, 0x53, 0x12, 0xcd, 0xe6, 0x63, 0xc3, 0x61, 0xbe, 0xd9, 0x70, 0x59, 0xc7, 0x4f, 0xcd,
+	0x65, 0x79, 0x63, 0x47, 0xab, 0x00, 0xf3, 0xf1, 0x8e, 0xb8, 0x5b, 0x9f, 0xfe, 0x3f, 0x00, 0x86,
+	0xf9, 0x8b, 0x2d, 0xe0, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -170,6 +315,81 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxMaintenanceBlocksPerWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMaintenanceBlocksPerWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MaintenanceWindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaintenanceWindowBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.DisputeVerdictBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeVerdictBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.OwnershipTransferExpiryBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OwnershipTransferExpiryBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaintenanceCooldownBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaintenanceCooldownBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxMaintenanceBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMaintenanceBlocks))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.SunsetPeriodInBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SunsetPeriodInBlocks))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.DisputeMoveBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeMoveBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.DisputeChallengerBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.DisputePeriodFraudPenaltyBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputePeriodFraudPenaltyBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxDisputePeriodInBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MinDisputePeriodInBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinDisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size, err := m.MinSequencerBondGlobal.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -238,6 +458,41 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.MinSequencerBondGlobal.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.MinDisputePeriodInBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MinDisputePeriodInBlocks))
+	}
+	if m.MaxDisputePeriodInBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxDisputePeriodInBlocks))
+	}
+	if m.DisputePeriodFraudPenaltyBlocks != 0 {
+		n += 1 + sovParams(uint64(m.DisputePeriodFraudPenaltyBlocks))
+	}
+	l = m.DisputeChallengerBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DisputeMoveBlocks != 0 {
+		n += 1 + sovParams(uint64(m.DisputeMoveBlocks))
+	}
+	if m.SunsetPeriodInBlocks != 0 {
+		n += 1 + sovParams(uint64(m.SunsetPeriodInBlocks))
+	}
+	if m.MaxMaintenanceBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxMaintenanceBlocks))
+	}
+	if m.MaintenanceCooldownBlocks != 0 {
+		n += 2 + sovParams(uint64(m.MaintenanceCooldownBlocks))
+	}
+	if m.OwnershipTransferExpiryBlocks != 0 {
+		n += 2 + sovParams(uint64(m.OwnershipTransferExpiryBlocks))
+	}
+	if m.DisputeVerdictBlocks != 0 {
+		n += 2 + sovParams(uint64(m.DisputeVerdictBlocks))
+	}
+	if m.MaintenanceWindowBlocks != 0 {
+		n += 2 + sovParams(uint64(m.MaintenanceWindowBlocks))
+	}
+	if m.MaxMaintenanceBlocksPerWindow != 0 {
+		n += 2 + sovParams(uint64(m.MaxMaintenanceBlocksPerWindow))
+	}
 	return n
 }
 
@@ -399,6 +654,248 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDisputePeriodInBlocks", wireType)
+			}
+			m.MinDisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDisputePeriodInBlocks", wireType)
+			}
+			m.MaxDisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodFraudPenaltyBlocks", wireType)
+			}
+			m.DisputePeriodFraudPenaltyBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputePeriodFraudPenaltyBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeChallengerBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisputeChallengerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeMoveBlocks", wireType)
+			}
+			m.DisputeMoveBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeMoveBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetPeriodInBlocks", wireType)
+			}
+			m.SunsetPeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SunsetPeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMaintenanceBlocks", wireType)
+			}
+			m.MaxMaintenanceBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMaintenanceBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceCooldownBlocks", wireType)
+			}
+			m.MaintenanceCooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceCooldownBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipTransferExpiryBlocks", wireType)
+			}
+			m.OwnershipTransferExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnershipTransferExpiryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeVerdictBlocks", wireType)
+			}
+			m.DisputeVerdictBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeVerdictBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindowBlocks", wireType)
+			}
+			m.MaintenanceWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMaintenanceBlocksPerWindow", wireType)
+			}
+			m.MaxMaintenanceBlocksPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMaintenanceBlocksPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
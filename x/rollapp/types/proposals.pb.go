@@ -260,11 +260,216 @@ func (m *MsgForceGenesisInfoChangeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgForceGenesisInfoChangeResponse proto.InternalMessageInfo
 
+// MsgResolveDispute judges the last step of a dispute which the verifier could
+// not judge
+type MsgResolveDispute struct {
+	// Authority is the authority address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	DisputeId uint64 `protobuf:"varint,2,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	// challenger_won is true if the disputed step is fraudulent
+	ChallengerWon bool `protobuf:"varint,3,opt,name=challenger_won,json=challengerWon,proto3" json:"challenger_won,omitempty"`
+}
+
+func (m *MsgResolveDispute) Reset()         { *m = MsgResolveDispute{} }
+func (m *MsgResolveDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveDispute) ProtoMessage()    {}
+func (*MsgResolveDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33ca2627c5c98011, []int{4}
+}
+func (m *MsgResolveDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveDispute.Merge(m, src)
+}
+func (m *MsgResolveDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveDispute proto.InternalMessageInfo
+
+func (m *MsgResolveDispute) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResolveDispute) GetDisputeId() uint64 {
+	if m != nil {
+		return m.DisputeId
+	}
+	return 0
+}
+
+func (m *MsgResolveDispute) GetChallengerWon() bool {
+	if m != nil {
+		return m.ChallengerWon
+	}
+	return false
+}
+
+type MsgResolveDisputeResponse struct {
+}
+
+func (m *MsgResolveDisputeResponse) Reset()         { *m = MsgResolveDisputeResponse{} }
+func (m *MsgResolveDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveDisputeResponse) ProtoMessage()    {}
+func (*MsgResolveDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33ca2627c5c98011, []int{5}
+}
+func (m *MsgResolveDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveDisputeResponse.Merge(m, src)
+}
+func (m *MsgResolveDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveDisputeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveDisputeResponse proto.InternalMessageInfo
+
+// MsgForceLifecycleState sets the lifecycle state of a rollapp. The owner
+// can't undo it.
+type MsgForceLifecycleState struct {
+	// Authority is the authority address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// state is the new lifecycle state. Shut down is reached at the end of the
+	// sunset.
+	State Rollapp_LifecycleState `protobuf:"varint,3,opt,name=state,proto3,enum=dymensionxyz.dymension.rollapp.Rollapp_LifecycleState" json:"state,omitempty"`
+}
+
+func (m *MsgForceLifecycleState) Reset()         { *m = MsgForceLifecycleState{} }
+func (m *MsgForceLifecycleState) String() string { return proto.CompactTextString(m) }
+func (*MsgForceLifecycleState) ProtoMessage()    {}
+func (*MsgForceLifecycleState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33ca2627c5c98011, []int{6}
+}
+func (m *MsgForceLifecycleState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceLifecycleState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceLifecycleState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceLifecycleState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceLifecycleState.Merge(m, src)
+}
+func (m *MsgForceLifecycleState) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceLifecycleState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceLifecycleState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceLifecycleState proto.InternalMessageInfo
+
+func (m *MsgForceLifecycleState) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgForceLifecycleState) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MsgForceLifecycleState) GetState() Rollapp_LifecycleState {
+	if m != nil {
+		return m.State
+	}
+	return Rollapp_ACTIVE
+}
+
+type MsgForceLifecycleStateResponse struct {
+}
+
+func (m *MsgForceLifecycleStateResponse) Reset()         { *m = MsgForceLifecycleStateResponse{} }
+func (m *MsgForceLifecycleStateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceLifecycleStateResponse) ProtoMessage()    {}
+func (*MsgForceLifecycleStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33ca2627c5c98011, []int{7}
+}
+func (m *MsgForceLifecycleStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceLifecycleStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceLifecycleStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceLifecycleStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceLifecycleStateResponse.Merge(m, src)
+}
+func (m *MsgForceLifecycleStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceLifecycleStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceLifecycleStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceLifecycleStateResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRollappFraudProposal)(nil), "dymensionxyz.dymension.rollapp.MsgRollappFraudProposal")
 	proto.RegisterType((*MsgRollappFraudProposalResponse)(nil), "dymensionxyz.dymension.rollapp.MsgRollappFraudProposalResponse")
 	proto.RegisterType((*MsgForceGenesisInfoChange)(nil), "dymensionxyz.dymension.rollapp.MsgForceGenesisInfoChange")
 	proto.RegisterType((*MsgForceGenesisInfoChangeResponse)(nil), "dymensionxyz.dymension.rollapp.MsgForceGenesisInfoChangeResponse")
+	proto.RegisterType((*MsgResolveDispute)(nil), "dymensionxyz.dymension.rollapp.MsgResolveDispute")
+	proto.RegisterType((*MsgResolveDisputeResponse)(nil), "dymensionxyz.dymension.rollapp.MsgResolveDisputeResponse")
+	proto.RegisterType((*MsgForceLifecycleState)(nil), "dymensionxyz.dymension.rollapp.MsgForceLifecycleState")
+	proto.RegisterType((*MsgForceLifecycleStateResponse)(nil), "dymensionxyz.dymension.rollapp.MsgForceLifecycleStateResponse")
 }
 
 func init() {
@@ -272,38 +477,48 @@ func init() {
 }
 
 var fileDescriptor_33ca2627c5c98011 = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0x43, 0x54, 0xe8, 0x06, 0x22, 0xb4, 0x42, 0xad, 0xb1, 0xc0, 0x6d, 0x82, 0x90, 0x2a,
-	0x90, 0x6c, 0xb5, 0x45, 0xe2, 0xe3, 0x82, 0x5a, 0xa4, 0x42, 0x0f, 0x41, 0xc8, 0xbd, 0xc1, 0xc1,
-	0x72, 0xe2, 0xc9, 0x7a, 0xa5, 0x78, 0xd7, 0xec, 0xd8, 0x49, 0xc3, 0x91, 0x03, 0x47, 0xc4, 0x89,
-	0xdf, 0xc1, 0x8d, 0x5f, 0x80, 0xd4, 0x63, 0x8f, 0x9c, 0x10, 0x4a, 0x0e, 0xfc, 0x0d, 0x14, 0x7f,
-	0x24, 0x96, 0xc0, 0x14, 0xb5, 0xa7, 0x64, 0xdf, 0xbc, 0x37, 0xfb, 0xe6, 0xad, 0x87, 0x58, 0xfe,
-	0x24, 0x04, 0x81, 0x5c, 0x8a, 0xe3, 0xc9, 0x3b, 0x7b, 0x71, 0xb0, 0x95, 0x1c, 0x0e, 0xbd, 0x28,
-	0xb2, 0x23, 0x25, 0x23, 0x89, 0xde, 0x10, 0xad, 0x48, 0xc9, 0x58, 0x52, 0xb3, 0xcc, 0x5f, 0x8a,
-	0xad, 0x9c, 0x6f, 0xdc, 0x60, 0x92, 0xc9, 0x94, 0x6a, 0xcf, 0xff, 0x65, 0x2a, 0x63, 0xbd, 0x2f,
-	0x31, 0x94, 0x68, 0x87, 0xc8, 0xec, 0xd1, 0xf6, 0xfc, 0x27, 0x2f, 0x6c, 0x9f, 0x71, 0x3d, 0x03,
-	0x01, 0xc8, 0xd1, 0xe5, 0x62, 0x90, 0xf7, 0xea, 0x7c, 0xa8, 0x93, 0xf5, 0x2e, 0x32, 0x27, 0x63,
-	0x1c, 0x28, 0x2f, 0xf1, 0x5f, 0xe5, 0x26, 0xe9, 0x2d, 0xb2, 0xea, 0x25, 0x71, 0x20, 0x15, 0x8f,
-	0x27, 0xba, 0xb6, 0xa9, 0x6d, 0xad, 0x3a, 0x4b, 0x80, 0xde, 0x26, 0x24, 0xef, 0xeb, 0x72, 0x5f,
-	0xaf, 0x67, 0xe5, 0x1c, 0x39, 0xf4, 0x69, 0x9b, 0x5c, 0x1d, 0xcc, 0xbb, 0xb9, 0x01, 0x70, 0x16,
-	0xc4, 0x7a, 0x63, 0x53, 0xdb, 0x6a, 0x38, 0xcd, 0x14, 0x7b, 0x91, 0x42, 0xf4, 0x2e, 0x69, 0x65,
-	0x14, 0x05, 0x23, 0x3e, 0x37, 0xaa, 0x5f, 0x4a, 0x49, 0xd7, 0x52, 0xd4, 0xc9, 0x41, 0xfa, 0x88,
-	0xe8, 0x51, 0x22, 0x38, 0x06, 0x2e, 0xc2, 0xdb, 0x04, 0x44, 0x1f, 0x94, 0xeb, 0xf9, 0xbe, 0x02,
-	0x44, 0x7d, 0x25, 0xbd, 0x76, 0x2d, 0xab, 0x1f, 0x15, 0xe5, 0xbd, 0xac, 0x4a, 0x0d, 0x72, 0x45,
-	0xc1, 0xd8, 0x53, 0x3e, 0x80, 0x7e, 0x39, 0x65, 0x2e, 0xce, 0x4f, 0x5a, 0xef, 0x7f, 0x7d, 0xb9,
-	0xb7, 0x1c, 0xa7, 0xd3, 0x26, 0x1b, 0x15, 0x39, 0x38, 0x80, 0x91, 0x14, 0x08, 0x9d, 0x6f, 0x1a,
-	0xb9, 0xd9, 0x45, 0x76, 0x20, 0x55, 0x1f, 0x9e, 0x67, 0x51, 0x1e, 0x8a, 0x81, 0x7c, 0x16, 0x78,
-	0x82, 0xc1, 0xc5, 0xd2, 0x7a, 0x43, 0xae, 0x0b, 0x18, 0xbb, 0xe5, 0x07, 0x4a, 0xc3, 0x68, 0xee,
-	0xdc, 0xb7, 0xfe, 0xfd, 0x8d, 0x58, 0x25, 0x27, 0xfb, 0x8d, 0x93, 0x1f, 0x1b, 0x35, 0xa7, 0x25,
-	0x60, 0x5c, 0x42, 0xff, 0x18, 0xf5, 0x0e, 0x69, 0x57, 0x8e, 0x51, 0x0c, 0xbb, 0xf3, 0xb5, 0x4e,
-	0x9a, 0x45, 0x02, 0x5d, 0x64, 0xf4, 0xa3, 0x46, 0xe8, 0x51, 0xd2, 0x0b, 0x79, 0x5c, 0xce, 0x88,
-	0x3e, 0x3c, 0xcb, 0x5e, 0x45, 0xa8, 0xc6, 0xd3, 0x73, 0x0a, 0x0b, 0x83, 0xf4, 0xb3, 0x46, 0xd6,
-	0x2a, 0x9e, 0xe2, 0xf1, 0x7f, 0xf4, 0xfe, 0xbb, 0xd4, 0xd8, 0x3b, 0xb7, 0xb4, 0x30, 0xb6, 0xff,
-	0xf2, 0x64, 0x6a, 0x6a, 0xa7, 0x53, 0x53, 0xfb, 0x39, 0x35, 0xb5, 0x4f, 0x33, 0xb3, 0x76, 0x3a,
-	0x33, 0x6b, 0xdf, 0x67, 0x66, 0xed, 0xf5, 0x03, 0xc6, 0xe3, 0x20, 0xe9, 0x59, 0x7d, 0x19, 0xda,
-	0x15, 0xab, 0x3a, 0xda, 0xb5, 0x8f, 0x17, 0xfb, 0x1a, 0x4f, 0x22, 0xc0, 0xde, 0x4a, 0xba, 0xa9,
-	0xbb, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x07, 0x1b, 0x72, 0x5d, 0x04, 0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0xcd, 0xf6, 0x97, 0x5f, 0x69, 0xb7, 0x34, 0x02, 0x83, 0x5a, 0xd7, 0x80, 0xfb, 0x07, 0x21,
+	0x55, 0x80, 0x6c, 0xb5, 0x45, 0x85, 0x72, 0x00, 0xb5, 0xa0, 0x42, 0xa5, 0x16, 0x21, 0xf7, 0x80,
+	0x04, 0x07, 0xcb, 0xb5, 0x27, 0xf6, 0x4a, 0xce, 0xae, 0xd9, 0xb5, 0x93, 0x86, 0x03, 0x07, 0x0e,
+	0x70, 0x02, 0x71, 0xe2, 0x73, 0x20, 0xf1, 0x19, 0x10, 0x3d, 0xf6, 0xc8, 0x09, 0xa1, 0xf6, 0xc0,
+	0xd7, 0x40, 0xfe, 0x9b, 0x94, 0x24, 0x24, 0xa4, 0x27, 0x67, 0xdf, 0xcc, 0x9b, 0x7d, 0x6f, 0x66,
+	0xa2, 0xc5, 0x9a, 0xd3, 0xac, 0x01, 0x15, 0x84, 0xd1, 0xfd, 0xe6, 0x2b, 0xbd, 0x38, 0xe8, 0x9c,
+	0xf9, 0xbe, 0x15, 0x04, 0x7a, 0xc0, 0x59, 0xc0, 0x84, 0xe5, 0x0b, 0x2d, 0xe0, 0x2c, 0x64, 0x92,
+	0xda, 0x9e, 0xdf, 0x22, 0x6b, 0x59, 0xbe, 0x72, 0xd1, 0x65, 0x2e, 0x4b, 0x52, 0xf5, 0xf8, 0x57,
+	0xca, 0x52, 0xa6, 0x6d, 0x26, 0x6a, 0x4c, 0xe8, 0x35, 0xe1, 0xea, 0xf5, 0xa5, 0xf8, 0x93, 0x05,
+	0x96, 0xfa, 0x5c, 0xef, 0x02, 0x05, 0x41, 0x84, 0x49, 0x68, 0x35, 0xaf, 0x75, 0xb3, 0x0f, 0x25,
+	0xfb, 0xa6, 0xd9, 0x0b, 0x6f, 0x47, 0xf0, 0xf4, 0x8e, 0x70, 0x8d, 0x14, 0xdc, 0xe4, 0x56, 0xe4,
+	0x3c, 0xcd, 0x2c, 0x49, 0x97, 0xf1, 0xb8, 0x15, 0x85, 0x1e, 0xe3, 0x24, 0x6c, 0xca, 0x68, 0x0e,
+	0x2d, 0x8e, 0x1b, 0x2d, 0x40, 0xba, 0x82, 0x71, 0x56, 0xca, 0x24, 0x8e, 0x3c, 0x92, 0x86, 0x33,
+	0x64, 0xcb, 0x91, 0xe6, 0xf1, 0xd9, 0x6a, 0x5c, 0xcd, 0xf4, 0x80, 0xb8, 0x5e, 0x28, 0x97, 0xe7,
+	0xd0, 0x62, 0xd9, 0x98, 0x48, 0xb0, 0xc7, 0x09, 0x24, 0x5d, 0xc3, 0x95, 0x34, 0x85, 0x43, 0x9d,
+	0xc4, 0x1a, 0xe5, 0xff, 0x92, 0xa4, 0xc9, 0x04, 0x35, 0x32, 0x50, 0xba, 0x83, 0xe5, 0x20, 0xa2,
+	0x44, 0x78, 0xa6, 0x80, 0x97, 0x11, 0x50, 0x1b, 0xb8, 0x69, 0x39, 0x0e, 0x07, 0x21, 0xe4, 0xd1,
+	0xe4, 0xda, 0xa9, 0x34, 0xbe, 0x9b, 0x87, 0xd7, 0xd3, 0xa8, 0xa4, 0xe0, 0x31, 0x0e, 0x0d, 0x8b,
+	0x3b, 0x00, 0xf2, 0x99, 0x24, 0xb3, 0x38, 0xdf, 0xad, 0xbc, 0xf9, 0xf5, 0xf9, 0x7a, 0xcb, 0xce,
+	0xc2, 0x3c, 0x9e, 0xed, 0xd1, 0x07, 0x03, 0x44, 0xc0, 0xa8, 0x80, 0x85, 0xaf, 0x08, 0xcf, 0xec,
+	0x08, 0x77, 0x93, 0x71, 0x1b, 0x1e, 0xa5, 0x8d, 0xdf, 0xa2, 0x55, 0xf6, 0xc0, 0xb3, 0xa8, 0x0b,
+	0xa7, 0xeb, 0xd6, 0x0b, 0x7c, 0x8e, 0x42, 0xc3, 0x6c, 0x1f, 0x67, 0xd2, 0x8c, 0x89, 0xe5, 0x1b,
+	0xda, 0xdf, 0x37, 0x4a, 0x6b, 0x53, 0xb2, 0x51, 0x3e, 0xf8, 0x31, 0x5b, 0x32, 0x2a, 0x14, 0x1a,
+	0x6d, 0x68, 0x87, 0xd5, 0xab, 0x78, 0xbe, 0xa7, 0x8d, 0xc2, 0xec, 0x3b, 0x84, 0xcf, 0xc7, 0x0d,
+	0x01, 0xc1, 0xfc, 0x3a, 0x3c, 0x24, 0x22, 0x88, 0xc2, 0x01, 0x4c, 0x3a, 0x69, 0x62, 0x6e, 0xb2,
+	0x6c, 0x8c, 0x67, 0xc8, 0x96, 0x13, 0xcf, 0xdb, 0xf6, 0x2c, 0xdf, 0x07, 0xea, 0x02, 0x37, 0x1b,
+	0xd9, 0xbc, 0xc7, 0x8c, 0xc9, 0x16, 0xfa, 0x8c, 0xd1, 0x0e, 0xb9, 0x97, 0xf0, 0x4c, 0x87, 0x90,
+	0x42, 0xe6, 0x17, 0x84, 0xa7, 0x72, 0x33, 0xdb, 0xa4, 0x0a, 0x76, 0xd3, 0xf6, 0x61, 0x37, 0xb4,
+	0xc2, 0x53, 0x0e, 0x64, 0x1b, 0xff, 0x2f, 0xe2, 0x2a, 0x89, 0xc4, 0xca, 0xf2, 0x6a, 0xbf, 0x29,
+	0x64, 0x8b, 0xa3, 0x9d, 0xd4, 0x60, 0xa4, 0x45, 0x3a, 0x2c, 0xcd, 0x61, 0xb5, 0xbb, 0xe8, 0xdc,
+	0xd7, 0xf2, 0xb7, 0x32, 0x9e, 0xc8, 0x17, 0x70, 0x47, 0xb8, 0xd2, 0x07, 0x84, 0xa5, 0xdd, 0x68,
+	0xaf, 0x46, 0xc2, 0xf6, 0x15, 0x95, 0x6e, 0xf7, 0xd3, 0xd5, 0x63, 0xa7, 0x95, 0xfb, 0x43, 0x12,
+	0x73, 0x81, 0xd2, 0x27, 0x84, 0xa7, 0x7a, 0xfc, 0x13, 0xd6, 0x06, 0xa8, 0xdd, 0x9d, 0xaa, 0xac,
+	0x0f, 0x4d, 0x2d, 0x84, 0xbd, 0xc6, 0x95, 0x3f, 0x96, 0x76, 0x69, 0x10, 0xaf, 0x27, 0x28, 0xca,
+	0xda, 0x3f, 0x53, 0x8a, 0xfb, 0xdf, 0x23, 0x7c, 0xa1, 0xdb, 0x3a, 0xae, 0x0e, 0x6a, 0xed, 0x24,
+	0x4f, 0xb9, 0x37, 0x1c, 0x2f, 0xd7, 0xb3, 0xf1, 0xe4, 0xe0, 0x48, 0x45, 0x87, 0x47, 0x2a, 0xfa,
+	0x79, 0xa4, 0xa2, 0x8f, 0xc7, 0x6a, 0xe9, 0xf0, 0x58, 0x2d, 0x7d, 0x3f, 0x56, 0x4b, 0xcf, 0x6f,
+	0xb9, 0x24, 0xf4, 0xa2, 0x3d, 0xcd, 0x66, 0x35, 0xbd, 0xc7, 0xa3, 0x51, 0x5f, 0xd1, 0xf7, 0x8b,
+	0x97, 0x23, 0x6c, 0x06, 0x20, 0xf6, 0x46, 0x93, 0x87, 0x63, 0xe5, 0xf7, 0x00, 0xba, 0x50, 0x62,
+	0xee, 0x1a, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -320,6 +535,8 @@ const _ = grpc.SupportPackageIsVersion4
 type ProposalMsgClient interface {
 	SubmitRollappFraud(ctx context.Context, in *MsgRollappFraudProposal, opts ...grpc.CallOption) (*MsgRollappFraudProposalResponse, error)
 	ForceGenesisInfoChange(ctx context.Context, in *MsgForceGenesisInfoChange, opts ...grpc.CallOption) (*MsgForceGenesisInfoChangeResponse, error)
+	ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error)
+	ForceLifecycleState(ctx context.Context, in *MsgForceLifecycleState, opts ...grpc.CallOption) (*MsgForceLifecycleStateResponse, error)
 }
 
 type proposalMsgClient struct {
@@ -348,10 +565,30 @@ func (c *proposalMsgClient) ForceGenesisInfoChange(ctx context.Context, in *MsgF
 	return out, nil
 }
 
+func (c *proposalMsgClient) ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error) {
+	out := new(MsgResolveDisputeResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.ProposalMsg/ResolveDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposalMsgClient) ForceLifecycleState(ctx context.Context, in *MsgForceLifecycleState, opts ...grpc.CallOption) (*MsgForceLifecycleStateResponse, error) {
+	out := new(MsgForceLifecycleStateResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.ProposalMsg/ForceLifecycleState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProposalMsgServer is the server API for ProposalMsg service.
 type ProposalMsgServer interface {
 	SubmitRollappFraud(context.Context, *MsgRollappFraudProposal) (*MsgRollappFraudProposalResponse, error)
 	ForceGenesisInfoChange(context.Context, *MsgForceGenesisInfoChange) (*MsgForceGenesisInfoChangeResponse, error)
+	ResolveDispute(context.Context, *MsgResolveDispute) (*MsgResolveDisputeResponse, error)
+	ForceLifecycleState(context.Context, *MsgForceLifecycleState) (*MsgForceLifecycleStateResponse, error)
 }
 
 // UnimplementedProposalMsgServer can be embedded to have forward compatible implementations.
@@ -364,6 +601,12 @@ func (*UnimplementedProposalMsgServer) SubmitRollappFraud(ctx context.Context, r
 func (*UnimplementedProposalMsgServer) ForceGenesisInfoChange(ctx context.Context, req *MsgForceGenesisInfoChange) (*MsgForceGenesisInfoChangeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceGenesisInfoChange not implemented")
 }
+func (*UnimplementedProposalMsgServer) ResolveDispute(ctx context.Context, req *MsgResolveDispute) (*MsgResolveDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDispute not implemented")
+}
+func (*UnimplementedProposalMsgServer) ForceLifecycleState(ctx context.Context, req *MsgForceLifecycleState) (*MsgForceLifecycleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceLifecycleState not implemented")
+}
 
 func RegisterProposalMsgServer(s grpc1.Server, srv ProposalMsgServer) {
 	s.RegisterService(&_ProposalMsg_serviceDesc, srv)
@@ -405,6 +648,42 @@ func _ProposalMsg_ForceGenesisInfoChange_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProposalMsg_ResolveDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalMsgServer).ResolveDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.ProposalMsg/ResolveDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalMsgServer).ResolveDispute(ctx, req.(*MsgResolveDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposalMsg_ForceLifecycleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceLifecycleState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalMsgServer).ForceLifecycleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.ProposalMsg/ForceLifecycleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalMsgServer).ForceLifecycleState(ctx, req.(*MsgForceLifecycleState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ProposalMsg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.rollapp.ProposalMsg",
 	HandlerType: (*ProposalMsgServer)(nil),
@@ -417,6 +696,14 @@ var _ProposalMsg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForceGenesisInfoChange",
 			Handler:    _ProposalMsg_ForceGenesisInfoChange_Handler,
 		},
+		{
+			MethodName: "ResolveDispute",
+			Handler:    _ProposalMsg_ResolveDispute_Handler,
+		},
+		{
+			MethodName: "ForceLifecycleState",
+			Handler:    _ProposalMsg_ForceLifecycleState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/rollapp/proposals.proto",
@@ -576,92 +863,548 @@ func (m *MsgForceGenesisInfoChangeResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProposals(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgResolveDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRollappFraudProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgResolveDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	if m.FraudRevision != 0 {
-		n += 1 + sovProposals(uint64(m.FraudRevision))
-	}
-	if m.FraudHeight != 0 {
-		n += 1 + sovProposals(uint64(m.FraudHeight))
+	if m.ChallengerWon {
+		i--
+		if m.ChallengerWon {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.PunishSequencerAddress)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if m.DisputeId != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Rewardee)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRollappFraudProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgResolveDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgForceGenesisInfoChange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgResolveDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceLifecycleState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.RollappId)
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceLifecycleState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceLifecycleState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceLifecycleStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceLifecycleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceLifecycleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposals(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRollappFraudProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovProposals(uint64(l))
 	}
-	l = m.NewGenesisInfo.Size()
-	n += 1 + l + sovProposals(uint64(l))
-	return n
-}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.FraudRevision != 0 {
+		n += 1 + sovProposals(uint64(m.FraudRevision))
+	}
+	if m.FraudHeight != 0 {
+		n += 1 + sovProposals(uint64(m.FraudHeight))
+	}
+	l = len(m.PunishSequencerAddress)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Rewardee)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRollappFraudProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForceGenesisInfoChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.NewGenesisInfo.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	return n
+}
+
+func (m *MsgForceGenesisInfoChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResolveDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.DisputeId != 0 {
+		n += 1 + sovProposals(uint64(m.DisputeId))
+	}
+	if m.ChallengerWon {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgResolveDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForceLifecycleState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovProposals(uint64(m.State))
+	}
+	return n
+}
+
+func (m *MsgForceLifecycleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovProposals(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposals(x uint64) (n int) {
+	return sovProposals(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRollappFraudProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRollappFraudProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRollappFraudProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudRevision", wireType)
+			}
+			m.FraudRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FraudRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudHeight", wireType)
+			}
+			m.FraudHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FraudHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PunishSequencerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PunishSequencerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewardee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewardee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRollappFraudProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRollappFraudProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRollappFraudProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgForceGenesisInfoChangeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovProposals(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProposals(x uint64) (n int) {
-	return sovProposals(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRollappFraudProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgForceGenesisInfoChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -684,10 +1427,10 @@ func (m *MsgRollappFraudProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRollappFraudProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceGenesisInfoChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRollappFraudProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceGenesisInfoChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -755,10 +1498,10 @@ func (m *MsgRollappFraudProposal) Unmarshal(dAtA []byte) error {
 			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FraudRevision", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewGenesisInfo", wireType)
 			}
-			m.FraudRevision = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -768,33 +1511,128 @@ func (m *MsgRollappFraudProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FraudRevision |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FraudHeight", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
 			}
-			m.FraudHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FraudHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
 			}
-		case 6:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewGenesisInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForceGenesisInfoChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForceGenesisInfoChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForceGenesisInfoChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PunishSequencerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -822,13 +1660,13 @@ func (m *MsgRollappFraudProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PunishSequencerAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewardee", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
 			}
-			var stringLen uint64
+			m.DisputeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -838,24 +1676,31 @@ func (m *MsgRollappFraudProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DisputeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerWon", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Rewardee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ChallengerWon = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -877,7 +1722,7 @@ func (m *MsgRollappFraudProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRollappFraudProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveDisputeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -900,10 +1745,10 @@ func (m *MsgRollappFraudProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRollappFraudProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveDisputeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRollappFraudProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -927,7 +1772,7 @@ func (m *MsgRollappFraudProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceGenesisInfoChange) Unmarshal(dAtA []byte) error {
+func (m *MsgForceLifecycleState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -950,10 +1795,10 @@ func (m *MsgForceGenesisInfoChange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceGenesisInfoChange: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceLifecycleState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceGenesisInfoChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceLifecycleState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1021,10 +1866,10 @@ func (m *MsgForceGenesisInfoChange) Unmarshal(dAtA []byte) error {
 			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewGenesisInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1034,25 +1879,11 @@ func (m *MsgForceGenesisInfoChange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= Rollapp_LifecycleState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NewGenesisInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -1074,7 +1905,7 @@ func (m *MsgForceGenesisInfoChange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceGenesisInfoChangeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceLifecycleStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1097,10 +1928,10 @@ func (m *MsgForceGenesisInfoChangeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceGenesisInfoChangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceLifecycleStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceGenesisInfoChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceLifecycleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"strings"
+)
+
+// NewRollappRegistryEntry returns the searchable attributes of the rollapp. The DRS version is not kept in the
+// rollapp, it comes from its latest state update.
+func NewRollappRegistryEntry(ra Rollapp, drsVersion uint32) RollappRegistryEntry {
+	e := RollappRegistryEntry{
+		RollappId:           ra.RollappId,
+		VmType:              ra.VmType,
+		Launched:            ra.Launched,
+		DrsVersion:          drsVersion,
+		HasIro:              ra.PreLaunchTime != nil,
+		HasCanonicalChannel: ra.ChannelId != "",
+		Owner:               ra.Owner,
+	}
+	if ra.CreatedAt != nil {
+		e.CreatedAt = ra.CreatedAt.Unix()
+	}
+	if ra.Metadata != nil {
+		e.DisplayName = strings.ToLower(ra.Metadata.DisplayName)
+	}
+	return e
+}
+
+// Matches returns true if the entry satisfies all the filters of the search
+func (e RollappRegistryEntry) Matches(req *QueryRollappSearchRequest) bool {
+	return (req.VmType == Rollapp_Unspecified || e.VmType == req.VmType) &&
+		req.Launched.Matches(e.Launched) &&
+		(req.DrsVersion == 0 || e.DrsVersion == req.DrsVersion) &&
+		req.HasIro.Matches(e.HasIro) &&
+		req.HasCanonicalChannel.Matches(e.HasCanonicalChannel) &&
+		(req.Owner == "" || e.Owner == req.Owner) &&
+		// the creation time of the rollapps created before it was recorded is unknown
+		(req.CreatedAfter == nil || req.CreatedAfter.Unix() <= e.CreatedAt) &&
+		(req.CreatedBefore == nil || (e.CreatedAt != 0 && e.CreatedAt < req.CreatedBefore.Unix())) &&
+		strings.HasPrefix(e.DisplayName, strings.ToLower(req.DisplayNamePrefix))
+}
+
+// Matches returns true if the value passes the filter
+func (f BoolFilter) Matches(v bool) bool {
+	switch f {
+	case BoolFilter_BOOL_FILTER_TRUE:
+		return v
+	case BoolFilter_BOOL_FILTER_FALSE:
+		return !v
+	default:
+		return true
+	}
+}
@@ -0,0 +1,668 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/rollapp/registry.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RollappRegistryEntry holds the searchable attributes of a rollapp. It is kept
+// in sync with the rollapp to maintain the registry indexes.
+type RollappRegistryEntry struct {
+	RollappId string         `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	VmType    Rollapp_VMType `protobuf:"varint,2,opt,name=vm_type,json=vmType,proto3,enum=dymensionxyz.dymension.rollapp.Rollapp_VMType" json:"vm_type,omitempty"`
+	Launched  bool           `protobuf:"varint,3,opt,name=launched,proto3" json:"launched,omitempty"`
+	// drs_version is the DRS version of the latest state update. 0 means none
+	DrsVersion uint32 `protobuf:"varint,4,opt,name=drs_version,json=drsVersion,proto3" json:"drs_version,omitempty"`
+	// has_iro is true if an IRO plan was created for the rollapp
+	HasIro              bool   `protobuf:"varint,5,opt,name=has_iro,json=hasIro,proto3" json:"has_iro,omitempty"`
+	HasCanonicalChannel bool   `protobuf:"varint,6,opt,name=has_canonical_channel,json=hasCanonicalChannel,proto3" json:"has_canonical_channel,omitempty"`
+	Owner               string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	// created_at is the unix time of the creation of the rollapp, in seconds.
+	// 0 means unknown: the creation time of the rollapps created before the v6
+	// upgrade was not recorded
+	CreatedAt int64 `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// display_name is the lower-cased display name of the rollapp metadata
+	DisplayName string `protobuf:"bytes,9,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+}
+
+func (m *RollappRegistryEntry) Reset()         { *m = RollappRegistryEntry{} }
+func (m *RollappRegistryEntry) String() string { return proto.CompactTextString(m) }
+func (*RollappRegistryEntry) ProtoMessage()    {}
+func (*RollappRegistryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c4435864cb3b8e1, []int{0}
+}
+func (m *RollappRegistryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappRegistryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappRegistryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappRegistryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappRegistryEntry.Merge(m, src)
+}
+func (m *RollappRegistryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappRegistryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappRegistryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappRegistryEntry proto.InternalMessageInfo
+
+func (m *RollappRegistryEntry) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *RollappRegistryEntry) GetVmType() Rollapp_VMType {
+	if m != nil {
+		return m.VmType
+	}
+	return Rollapp_Unspecified
+}
+
+func (m *RollappRegistryEntry) GetLaunched() bool {
+	if m != nil {
+		return m.Launched
+	}
+	return false
+}
+
+func (m *RollappRegistryEntry) GetDrsVersion() uint32 {
+	if m != nil {
+		return m.DrsVersion
+	}
+	return 0
+}
+
+func (m *RollappRegistryEntry) GetHasIro() bool {
+	if m != nil {
+		return m.HasIro
+	}
+	return false
+}
+
+func (m *RollappRegistryEntry) GetHasCanonicalChannel() bool {
+	if m != nil {
+		return m.HasCanonicalChannel
+	}
+	return false
+}
+
+func (m *RollappRegistryEntry) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *RollappRegistryEntry) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *RollappRegistryEntry) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RollappRegistryEntry)(nil), "dymensionxyz.dymension.rollapp.RollappRegistryEntry")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/rollapp/registry.proto", fileDescriptor_4c4435864cb3b8e1)
+}
+
+var fileDescriptor_4c4435864cb3b8e1 = []byte{
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0x3d, 0x6f, 0xdb, 0x30,
+	0x10, 0x35, 0xed, 0x5a, 0xb6, 0xe8, 0xb6, 0x03, 0xeb, 0xa2, 0x84, 0x81, 0xaa, 0x6a, 0x27, 0x0d,
+	0x2d, 0x05, 0xd8, 0xfd, 0x03, 0xad, 0x51, 0x14, 0x1e, 0xea, 0x41, 0x28, 0x3c, 0x64, 0x11, 0x68,
+	0x91, 0xb0, 0x04, 0x48, 0xa4, 0x40, 0xd2, 0x8a, 0x95, 0x5f, 0x91, 0x9f, 0x95, 0xd1, 0x63, 0x46,
+	0xc3, 0xfe, 0x23, 0x81, 0x3e, 0x22, 0x64, 0x49, 0x32, 0x1d, 0xde, 0xbb, 0x77, 0xef, 0xc8, 0x7b,
+	0xf0, 0x07, 0x2b, 0x33, 0x2e, 0x74, 0x22, 0xc5, 0xa1, 0xbc, 0xf1, 0x3b, 0xe0, 0x2b, 0x99, 0xa6,
+	0x34, 0xcf, 0x7d, 0xc5, 0x77, 0x89, 0x36, 0xaa, 0x24, 0xb9, 0x92, 0x46, 0x22, 0xe7, 0xa9, 0x9c,
+	0x74, 0x80, 0xb4, 0xf2, 0xd9, 0xf7, 0xd7, 0xec, 0x9a, 0xda, 0xb8, 0x7d, 0x3b, 0xf5, 0xe1, 0x34,
+	0x68, 0x98, 0xa0, 0xdd, 0xf3, 0x47, 0x18, 0x55, 0xa2, 0xcf, 0x10, 0xb6, 0xca, 0x30, 0x61, 0x18,
+	0xb8, 0xc0, 0xb3, 0x03, 0xbb, 0x65, 0x56, 0x0c, 0xfd, 0x85, 0xa3, 0x22, 0x0b, 0x4d, 0x99, 0x73,
+	0xdc, 0x77, 0x81, 0xf7, 0x7e, 0x4e, 0xc8, 0xcb, 0xef, 0x22, 0xed, 0x16, 0xb2, 0xf9, 0xf7, 0xbf,
+	0xcc, 0x79, 0x60, 0x15, 0x59, 0x55, 0xd1, 0x0c, 0x8e, 0x53, 0xba, 0x17, 0x51, 0xcc, 0x19, 0x1e,
+	0xb8, 0xc0, 0x1b, 0x07, 0x1d, 0x46, 0x5f, 0xe0, 0x84, 0x29, 0x1d, 0x16, 0x5c, 0x55, 0x4e, 0xf8,
+	0x8d, 0x0b, 0xbc, 0x77, 0x01, 0x64, 0x4a, 0x6f, 0x1a, 0x06, 0x7d, 0x82, 0xa3, 0x98, 0xea, 0x30,
+	0x51, 0x12, 0x0f, 0xeb, 0x59, 0x2b, 0xa6, 0x7a, 0xa5, 0x24, 0x9a, 0xc3, 0x8f, 0x55, 0x23, 0xa2,
+	0x42, 0x8a, 0x24, 0xa2, 0x69, 0x18, 0xc5, 0x54, 0x08, 0x9e, 0x62, 0xab, 0x96, 0x7d, 0x88, 0xa9,
+	0x5e, 0x3e, 0xf6, 0x96, 0x4d, 0x0b, 0x4d, 0xe1, 0x50, 0x5e, 0x0b, 0xae, 0xf0, 0xa8, 0xfe, 0x6c,
+	0x03, 0xaa, 0x3b, 0x44, 0x8a, 0x53, 0xc3, 0x59, 0x48, 0x0d, 0x1e, 0xbb, 0xc0, 0x1b, 0x04, 0x76,
+	0xcb, 0xfc, 0x32, 0xe8, 0x2b, 0x7c, 0xcb, 0x12, 0x9d, 0xa7, 0xb4, 0x0c, 0x05, 0xcd, 0x38, 0xb6,
+	0xeb, 0xd9, 0x49, 0xcb, 0xad, 0x69, 0xc6, 0x7f, 0xaf, 0xef, 0xce, 0x0e, 0x38, 0x9e, 0x1d, 0x70,
+	0x3a, 0x3b, 0xe0, 0xf6, 0xe2, 0xf4, 0x8e, 0x17, 0xa7, 0x77, 0x7f, 0x71, 0x7a, 0x57, 0x3f, 0x77,
+	0x89, 0x89, 0xf7, 0x5b, 0x12, 0xc9, 0xcc, 0x7f, 0x26, 0xb5, 0x62, 0xe1, 0x1f, 0xba, 0xe8, 0xaa,
+	0x73, 0xeb, 0xad, 0x55, 0x27, 0xb7, 0x78, 0x18, 0x00, 0x12, 0xb6, 0x6d, 0x5a, 0x38, 0x02, 0x00,
+	0x00,
+}
+
+func (m *RollappRegistryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappRegistryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappRegistryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HasCanonicalChannel {
+		i--
+		if m.HasCanonicalChannel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HasIro {
+		i--
+		if m.HasIro {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DrsVersion != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.DrsVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Launched {
+		i--
+		if m.Launched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VmType != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.VmType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRegistry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRegistry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RollappRegistryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.VmType != 0 {
+		n += 1 + sovRegistry(uint64(m.VmType))
+	}
+	if m.Launched {
+		n += 2
+	}
+	if m.DrsVersion != 0 {
+		n += 1 + sovRegistry(uint64(m.DrsVersion))
+	}
+	if m.HasIro {
+		n += 2
+	}
+	if m.HasCanonicalChannel {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovRegistry(uint64(m.CreatedAt))
+	}
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	return n
+}
+
+func sovRegistry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRegistry(x uint64) (n int) {
+	return sovRegistry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RollappRegistryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollappRegistryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollappRegistryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmType", wireType)
+			}
+			m.VmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VmType |= Rollapp_VMType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Launched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Launched = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersion", wireType)
+			}
+			m.DrsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasIro", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasIro = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCanonicalChannel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasCanonicalChannel = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRegistry(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRegistry
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRegistry
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRegistry
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRegistry        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRegistry          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRegistry = fmt.Errorf("proto: unexpected end of group")
+)
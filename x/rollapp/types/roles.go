@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+// HasRole returns true if the address holds the role. The owner holds every role.
+func (r Rollapp) HasRole(addr string, role RollappRole) bool {
+	if addr == r.Owner {
+		return true
+	}
+	holder := r.Roles.Get(role)
+	return holder != "" && addr == holder
+}
+
+// Get returns the holder of the role, besides the owner
+func (r RollappRoles) Get(role RollappRole) string {
+	switch role {
+	case RollappRole_ROLLAPP_ROLE_METADATA_EDITOR:
+		return r.MetadataEditor
+	case RollappRole_ROLLAPP_ROLE_APP_MANAGER:
+		return r.AppManager
+	case RollappRole_ROLLAPP_ROLE_SEQUENCER_WHITELISTER:
+		return r.SequencerWhitelister
+	default:
+		return ""
+	}
+}
+
+// Set assigns the role to the address. An empty address revokes the role.
+func (r *RollappRoles) Set(role RollappRole, addr string) error {
+	switch role {
+	case RollappRole_ROLLAPP_ROLE_METADATA_EDITOR:
+		r.MetadataEditor = addr
+	case RollappRole_ROLLAPP_ROLE_APP_MANAGER:
+		r.AppManager = addr
+	case RollappRole_ROLLAPP_ROLE_SEQUENCER_WHITELISTER:
+		r.SequencerWhitelister = addr
+	default:
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "role can't be delegated: %s", role)
+	}
+	return nil
+}
@@ -127,6 +127,34 @@ func (s RollappGenesisState) IsTransferEnabled() bool {
 	return s.TransferProofHeight != 0
 }
 
+// IsActive returns true if the rollapp is neither paused nor retired. Only active rollapps accept deposits.
+func (r Rollapp) IsActive() bool {
+	return r.LifecycleState == Rollapp_ACTIVE
+}
+
+// IsSunset returns true if the rollapp started its sunset or is shut down. It can't be active again.
+func (r Rollapp) IsSunset() bool {
+	return r.LifecycleState == Rollapp_SUNSETTING || r.LifecycleState == Rollapp_SHUT_DOWN
+}
+
+// InMaintenance returns true if the rollapp declared a maintenance which is not over yet
+func (r Rollapp) InMaintenance() bool {
+	return r.MaintenanceEndHeight != 0
+}
+
+// AcceptsStateUpdates returns true if the rollapp may post states at the given hub height: it is active,
+// or it is sunsetting and the sunset period is not over.
+func (r Rollapp) AcceptsStateUpdates(hubHeight int64) bool {
+	switch r.LifecycleState {
+	case Rollapp_ACTIVE:
+		return true
+	case Rollapp_SUNSETTING:
+		return hubHeight < r.SunsetHeight
+	default:
+		return false
+	}
+}
+
 func (r Rollapp) AllImmutableFieldsAreSet() bool {
 	return r.InitialSequencer != "" && r.GenesisInfo.Launchable() && ValidateBasicMinSeqBondCoins(r.MinSequencerBond) == nil
 }
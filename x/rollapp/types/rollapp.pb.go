@@ -29,6 +29,42 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RollappRole is a permission of the owner which may be delegated
+type RollappRole int32
+
+const (
+	// only the owner holds the role, it can't be delegated
+	RollappRole_ROLLAPP_ROLE_OWNER RollappRole = 0
+	// updates the rollapp metadata
+	RollappRole_ROLLAPP_ROLE_METADATA_EDITOR RollappRole = 1
+	// adds, updates and removes the apps of the rollapp
+	RollappRole_ROLLAPP_ROLE_APP_MANAGER RollappRole = 2
+	// updates the initial sequencers and the min sequencer bond
+	RollappRole_ROLLAPP_ROLE_SEQUENCER_WHITELISTER RollappRole = 3
+)
+
+var RollappRole_name = map[int32]string{
+	0: "ROLLAPP_ROLE_OWNER",
+	1: "ROLLAPP_ROLE_METADATA_EDITOR",
+	2: "ROLLAPP_ROLE_APP_MANAGER",
+	3: "ROLLAPP_ROLE_SEQUENCER_WHITELISTER",
+}
+
+var RollappRole_value = map[string]int32{
+	"ROLLAPP_ROLE_OWNER":                 0,
+	"ROLLAPP_ROLE_METADATA_EDITOR":       1,
+	"ROLLAPP_ROLE_APP_MANAGER":           2,
+	"ROLLAPP_ROLE_SEQUENCER_WHITELISTER": 3,
+}
+
+func (x RollappRole) String() string {
+	return proto.EnumName(RollappRole_name, int32(x))
+}
+
+func (RollappRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d4ef2bec3aea5528, []int{0}
+}
+
 type Rollapp_VMType int32
 
 const (
@@ -57,6 +93,44 @@ func (Rollapp_VMType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_d4ef2bec3aea5528, []int{1, 0}
 }
 
+type Rollapp_LifecycleState int32
+
+const (
+	// the rollapp operates normally
+	Rollapp_ACTIVE Rollapp_LifecycleState = 0
+	// the rollapp does not post states and does not accept deposits until it
+	// is resumed
+	Rollapp_PAUSED Rollapp_LifecycleState = 1
+	// the rollapp is being retired: deposits are disabled, withdrawals are
+	// still allowed and states are accepted until sunset_height
+	Rollapp_SUNSETTING Rollapp_LifecycleState = 2
+	// the rollapp is permanently retired, all its states are finalized and
+	// its sequencers are unbonded
+	Rollapp_SHUT_DOWN Rollapp_LifecycleState = 3
+)
+
+var Rollapp_LifecycleState_name = map[int32]string{
+	0: "ACTIVE",
+	1: "PAUSED",
+	2: "SUNSETTING",
+	3: "SHUT_DOWN",
+}
+
+var Rollapp_LifecycleState_value = map[string]int32{
+	"ACTIVE":     0,
+	"PAUSED":     1,
+	"SUNSETTING": 2,
+	"SHUT_DOWN":  3,
+}
+
+func (x Rollapp_LifecycleState) String() string {
+	return proto.EnumName(Rollapp_LifecycleState_name, int32(x))
+}
+
+func (Rollapp_LifecycleState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d4ef2bec3aea5528, []int{1, 1}
+}
+
 // RollappGenesisState is a partial repr of the state the hub can expect the
 // rollapp to be in upon genesis
 type RollappGenesisState struct {
@@ -152,6 +226,43 @@ type Rollapp struct {
 	LivenessCountdownStartHeight int64 `protobuf:"varint,18,opt,name=liveness_countdown_start_height,json=livenessCountdownStartHeight,proto3" json:"liveness_countdown_start_height,omitempty"`
 	// Revisions is a list of all the rollapp revisions.
 	Revisions []Revision `protobuf:"bytes,19,rep,name=revisions,proto3" json:"revisions"`
+	// dispute_period_in_blocks is the dispute period chosen by the owner, within
+	// the bounds of the params. 0 means it is derived from the global dispute
+	// period and the proposer bond.
+	DisputePeriodInBlocks uint64 `protobuf:"varint,21,opt,name=dispute_period_in_blocks,json=disputePeriodInBlocks,proto3" json:"dispute_period_in_blocks,omitempty"`
+	// lifecycle_state is the current lifecycle state of the rollapp
+	LifecycleState Rollapp_LifecycleState `protobuf:"varint,22,opt,name=lifecycle_state,json=lifecycleState,proto3,enum=dymensionxyz.dymension.rollapp.Rollapp_LifecycleState" json:"lifecycle_state,omitempty"`
+	// lifecycle_forced is true if governance set the current lifecycle state.
+	// The owner can't undo it.
+	LifecycleForced bool `protobuf:"varint,23,opt,name=lifecycle_forced,json=lifecycleForced,proto3" json:"lifecycle_forced,omitempty"`
+	// sunset_height is the height on the HUB from which a sunsetting rollapp
+	// no longer accepts state updates. 0 means not set
+	SunsetHeight int64 `protobuf:"varint,24,opt,name=sunset_height,json=sunsetHeight,proto3" json:"sunset_height,omitempty"`
+	// maintenance_start_height is the height on the HUB at which the last
+	// maintenance was declared. 0 means never
+	MaintenanceStartHeight int64 `protobuf:"varint,25,opt,name=maintenance_start_height,json=maintenanceStartHeight,proto3" json:"maintenance_start_height,omitempty"`
+	// maintenance_end_height is the height on the HUB at which the ongoing
+	// maintenance ends. The liveness clock is paused until then. 0 means no
+	// maintenance is ongoing
+	MaintenanceEndHeight int64 `protobuf:"varint,26,opt,name=maintenance_end_height,json=maintenanceEndHeight,proto3" json:"maintenance_end_height,omitempty"`
+	// created_at is the time on the HUB at which the rollapp was created. It is
+	// not set for the rollapps created before the v6 upgrade
+	CreatedAt *time.Time `protobuf:"bytes,27,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	// pending_owner is the owner proposed by the current owner. It must accept
+	// the ownership before ownership_transfer_expiry_height. Empty means no
+	// transfer is pending
+	PendingOwner string `protobuf:"bytes,28,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+	// ownership_transfer_expiry_height is the height on the HUB from which the
+	// pending transfer can no longer be accepted
+	OwnershipTransferExpiryHeight int64 `protobuf:"varint,29,opt,name=ownership_transfer_expiry_height,json=ownershipTransferExpiryHeight,proto3" json:"ownership_transfer_expiry_height,omitempty"`
+	// roles are the addresses the owner delegated a part of its permissions to
+	Roles RollappRoles `protobuf:"bytes,30,opt,name=roles,proto3" json:"roles"`
+	// maintenance_window_start_height is the height on the HUB at which the
+	// current maintenance window started. 0 means never
+	MaintenanceWindowStartHeight int64 `protobuf:"varint,31,opt,name=maintenance_window_start_height,json=maintenanceWindowStartHeight,proto3" json:"maintenance_window_start_height,omitempty"`
+	// maintenance_window_blocks_used is the number of hub blocks of maintenance
+	// declared within the current maintenance window
+	MaintenanceWindowBlocksUsed uint64 `protobuf:"varint,32,opt,name=maintenance_window_blocks_used,json=maintenanceWindowBlocksUsed,proto3" json:"maintenance_window_blocks_used,omitempty"`
 }
 
 func (m *Rollapp) Reset()         { *m = Rollapp{} }
@@ -285,6 +396,152 @@ func (m *Rollapp) GetRevisions() []Revision {
 	return nil
 }
 
+func (m *Rollapp) GetDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.DisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Rollapp) GetLifecycleState() Rollapp_LifecycleState {
+	if m != nil {
+		return m.LifecycleState
+	}
+	return Rollapp_ACTIVE
+}
+
+func (m *Rollapp) GetLifecycleForced() bool {
+	if m != nil {
+		return m.LifecycleForced
+	}
+	return false
+}
+
+func (m *Rollapp) GetSunsetHeight() int64 {
+	if m != nil {
+		return m.SunsetHeight
+	}
+	return 0
+}
+
+func (m *Rollapp) GetMaintenanceStartHeight() int64 {
+	if m != nil {
+		return m.MaintenanceStartHeight
+	}
+	return 0
+}
+
+func (m *Rollapp) GetMaintenanceEndHeight() int64 {
+	if m != nil {
+		return m.MaintenanceEndHeight
+	}
+	return 0
+}
+
+func (m *Rollapp) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Rollapp) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return ""
+}
+
+func (m *Rollapp) GetOwnershipTransferExpiryHeight() int64 {
+	if m != nil {
+		return m.OwnershipTransferExpiryHeight
+	}
+	return 0
+}
+
+func (m *Rollapp) GetRoles() RollappRoles {
+	if m != nil {
+		return m.Roles
+	}
+	return RollappRoles{}
+}
+
+func (m *Rollapp) GetMaintenanceWindowStartHeight() int64 {
+	if m != nil {
+		return m.MaintenanceWindowStartHeight
+	}
+	return 0
+}
+
+func (m *Rollapp) GetMaintenanceWindowBlocksUsed() uint64 {
+	if m != nil {
+		return m.MaintenanceWindowBlocksUsed
+	}
+	return 0
+}
+
+// RollappRoles are the addresses holding the delegated roles of the rollapp.
+// The owner holds every role. An empty address means only the owner does.
+type RollappRoles struct {
+	MetadataEditor       string `protobuf:"bytes,1,opt,name=metadata_editor,json=metadataEditor,proto3" json:"metadata_editor,omitempty"`
+	AppManager           string `protobuf:"bytes,2,opt,name=app_manager,json=appManager,proto3" json:"app_manager,omitempty"`
+	SequencerWhitelister string `protobuf:"bytes,3,opt,name=sequencer_whitelister,json=sequencerWhitelister,proto3" json:"sequencer_whitelister,omitempty"`
+}
+
+func (m *RollappRoles) Reset()         { *m = RollappRoles{} }
+func (m *RollappRoles) String() string { return proto.CompactTextString(m) }
+func (*RollappRoles) ProtoMessage()    {}
+func (*RollappRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ef2bec3aea5528, []int{2}
+}
+func (m *RollappRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappRoles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappRoles.Merge(m, src)
+}
+func (m *RollappRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappRoles proto.InternalMessageInfo
+
+func (m *RollappRoles) GetMetadataEditor() string {
+	if m != nil {
+		return m.MetadataEditor
+	}
+	return ""
+}
+
+func (m *RollappRoles) GetAppManager() string {
+	if m != nil {
+		return m.AppManager
+	}
+	return ""
+}
+
+func (m *RollappRoles) GetSequencerWhitelister() string {
+	if m != nil {
+		return m.SequencerWhitelister
+	}
+	return ""
+}
+
 // Revision is a representation of the rollapp revision.
 type Revision struct {
 	// Number is the revision number of the rollapp. Always start with 0 revision.
@@ -297,7 +554,7 @@ func (m *Revision) Reset()         { *m = Revision{} }
 func (m *Revision) String() string { return proto.CompactTextString(m) }
 func (*Revision) ProtoMessage()    {}
 func (*Revision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ef2bec3aea5528, []int{2}
+	return fileDescriptor_d4ef2bec3aea5528, []int{3}
 }
 func (m *Revision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -351,13 +608,16 @@ type RollappSummary struct {
 	LatestFinalizedStateIndex *StateInfoIndex `protobuf:"bytes,3,opt,name=latestFinalizedStateIndex,proto3" json:"latestFinalizedStateIndex,omitempty"`
 	LatestHeight              uint64          `protobuf:"varint,4,opt,name=latestHeight,proto3" json:"latestHeight,omitempty"`
 	LatestFinalizedHeight     uint64          `protobuf:"varint,5,opt,name=latestFinalizedHeight,proto3" json:"latestFinalizedHeight,omitempty"`
+	// The number of hub blocks the states of the rollapp currently wait before
+	// they are finalized, including the fraud history penalty.
+	DisputePeriodInBlocks uint64 `protobuf:"varint,6,opt,name=disputePeriodInBlocks,proto3" json:"disputePeriodInBlocks,omitempty"`
 }
 
 func (m *RollappSummary) Reset()         { *m = RollappSummary{} }
 func (m *RollappSummary) String() string { return proto.CompactTextString(m) }
 func (*RollappSummary) ProtoMessage()    {}
 func (*RollappSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ef2bec3aea5528, []int{3}
+	return fileDescriptor_d4ef2bec3aea5528, []int{4}
 }
 func (m *RollappSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,10 +681,20 @@ func (m *RollappSummary) GetLatestFinalizedHeight() uint64 {
 	return 0
 }
 
+func (m *RollappSummary) GetDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.DisputePeriodInBlocks
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.rollapp.RollappRole", RollappRole_name, RollappRole_value)
 	proto.RegisterEnum("dymensionxyz.dymension.rollapp.Rollapp_VMType", Rollapp_VMType_name, Rollapp_VMType_value)
+	proto.RegisterEnum("dymensionxyz.dymension.rollapp.Rollapp_LifecycleState", Rollapp_LifecycleState_name, Rollapp_LifecycleState_value)
 	proto.RegisterType((*RollappGenesisState)(nil), "dymensionxyz.dymension.rollapp.RollappGenesisState")
 	proto.RegisterType((*Rollapp)(nil), "dymensionxyz.dymension.rollapp.Rollapp")
+	proto.RegisterType((*RollappRoles)(nil), "dymensionxyz.dymension.rollapp.RollappRoles")
 	proto.RegisterType((*Revision)(nil), "dymensionxyz.dymension.rollapp.Revision")
 	proto.RegisterType((*RollappSummary)(nil), "dymensionxyz.dymension.rollapp.RollappSummary")
 }
@@ -434,62 +704,93 @@ func init() {
 }
 
 var fileDescriptor_d4ef2bec3aea5528 = []byte{
-	// 873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
-	0x10, 0x15, 0x2d, 0xc6, 0xa2, 0x47, 0x8a, 0xcd, 0xac, 0x9c, 0x82, 0x16, 0x12, 0x49, 0xd5, 0x89,
-	0x40, 0x12, 0x12, 0xb6, 0x73, 0xea, 0xad, 0x0a, 0xdc, 0xc4, 0x6e, 0x54, 0x14, 0x94, 0x93, 0x02,
-	0x39, 0x94, 0xa0, 0xc4, 0x15, 0xb5, 0x28, 0xb9, 0xcb, 0x72, 0x57, 0x8a, 0x95, 0xaf, 0xc8, 0xa9,
-	0x1f, 0xd1, 0x2f, 0xc9, 0x31, 0xe8, 0xa9, 0x27, 0xa7, 0xb0, 0xff, 0xa0, 0x5f, 0x50, 0x70, 0xb9,
-	0x94, 0x94, 0x3a, 0xa9, 0x82, 0x9e, 0xa8, 0x99, 0x37, 0xf3, 0x76, 0x66, 0x76, 0xde, 0x0a, 0x1e,
-	0x86, 0x8b, 0x04, 0x53, 0x4e, 0x18, 0xbd, 0x58, 0xbc, 0x71, 0x97, 0x86, 0x9b, 0xb1, 0x38, 0x0e,
-	0xd2, 0xb4, 0xfc, 0x3a, 0x69, 0xc6, 0x04, 0x43, 0xed, 0xf5, 0x68, 0x67, 0x69, 0x38, 0x2a, 0xaa,
-	0xb5, 0x1f, 0xb1, 0x88, 0xc9, 0x50, 0x37, 0xff, 0x55, 0x64, 0xb5, 0x3a, 0x11, 0x63, 0x51, 0x8c,
-	0x5d, 0x69, 0x8d, 0x66, 0x13, 0x57, 0x90, 0x04, 0x73, 0x11, 0x24, 0x8a, 0xb6, 0xe5, 0x6e, 0x28,
-	0x82, 0x8b, 0x40, 0x60, 0x9f, 0xd0, 0x49, 0xc9, 0xf8, 0x68, 0x43, 0x42, 0x82, 0x45, 0x10, 0x06,
-	0x22, 0x50, 0xe1, 0xed, 0x31, 0xe3, 0x09, 0xe3, 0xee, 0x28, 0xe0, 0xd8, 0x9d, 0x1f, 0x8e, 0xb0,
-	0x08, 0x0e, 0xdd, 0x31, 0x23, 0x54, 0xe1, 0x87, 0x1b, 0xe8, 0x22, 0x4c, 0x31, 0x27, 0x7c, 0xad,
-	0x82, 0xde, 0x0b, 0x68, 0x7a, 0x05, 0xfa, 0xb4, 0x00, 0x87, 0x79, 0x8d, 0xe8, 0x08, 0xee, 0x8a,
-	0x2c, 0xa0, 0x7c, 0x82, 0x33, 0x3f, 0xcd, 0x18, 0x9b, 0xf8, 0x53, 0x4c, 0xa2, 0xa9, 0xb0, 0xaa,
-	0x5d, 0xcd, 0xd6, 0xbd, 0x66, 0x09, 0xfe, 0x98, 0x63, 0xcf, 0x24, 0x74, 0xa6, 0x1b, 0x9a, 0xb9,
-	0x75, 0xa6, 0x1b, 0x5b, 0x66, 0xb5, 0x77, 0x59, 0x83, 0x9a, 0xe2, 0x45, 0xf7, 0x01, 0x54, 0x01,
-	0x3e, 0x09, 0x2d, 0xad, 0xab, 0xd9, 0x3b, 0xde, 0x8e, 0xf2, 0x9c, 0x86, 0x68, 0x1f, 0x6e, 0xb1,
-	0xd7, 0x14, 0x67, 0xd6, 0x96, 0x44, 0x0a, 0x03, 0xfd, 0x0c, 0xb7, 0xcb, 0x6a, 0xe5, 0xd4, 0xac,
-	0x5a, 0x57, 0xb3, 0xeb, 0x47, 0xc7, 0xce, 0x7f, 0xdf, 0x9c, 0xf3, 0x89, 0x66, 0xfa, 0xfa, 0xbb,
-	0xcb, 0x4e, 0xc5, 0x6b, 0x44, 0xeb, 0x0d, 0xde, 0x07, 0x18, 0x4f, 0x03, 0x4a, 0x71, 0x9c, 0x17,
-	0x65, 0x14, 0x45, 0x29, 0xcf, 0x69, 0x88, 0xbe, 0x07, 0xa3, 0x9c, 0xbd, 0x55, 0x97, 0x27, 0xbb,
-	0x5f, 0x78, 0xf2, 0x40, 0xa5, 0x79, 0x4b, 0x02, 0x74, 0x0e, 0x8d, 0xf5, 0xc9, 0x5b, 0x0d, 0x49,
-	0xf8, 0x60, 0x13, 0xa1, 0xea, 0xe1, 0x94, 0x4e, 0x98, 0x6a, 0xa1, 0x1e, 0xad, 0x5c, 0xe8, 0x01,
-	0xdc, 0x21, 0x94, 0x08, 0x12, 0xc4, 0x3e, 0xc7, 0xbf, 0xce, 0x30, 0x1d, 0xe3, 0xcc, 0xba, 0x2d,
-	0x1b, 0x31, 0x15, 0x30, 0x2c, 0xfd, 0xe8, 0x37, 0x0d, 0x50, 0x42, 0xe8, 0x2a, 0xd2, 0x1f, 0x31,
-	0x1a, 0x5a, 0xfb, 0xdd, 0xaa, 0x5d, 0x3f, 0x3a, 0x70, 0x8a, 0xbd, 0x72, 0xf2, 0xbd, 0x72, 0xd4,
-	0x5e, 0x39, 0x4f, 0x18, 0xa1, 0xfd, 0x41, 0x7e, 0xee, 0xdf, 0x97, 0x9d, 0x83, 0x45, 0x90, 0xc4,
-	0xdf, 0xf4, 0x6e, 0x52, 0xf4, 0x7e, 0xff, 0xd0, 0xb1, 0x23, 0x22, 0xa6, 0xb3, 0x91, 0x33, 0x66,
-	0x89, 0xab, 0x36, 0xb4, 0xf8, 0x3c, 0xe2, 0xe1, 0x2f, 0xae, 0x58, 0xa4, 0x98, 0x4b, 0x36, 0xee,
-	0x99, 0x09, 0xa1, 0xcb, 0xa2, 0xfa, 0x8c, 0x86, 0xe8, 0x29, 0xd4, 0xe6, 0x89, 0x9f, 0xc7, 0x58,
-	0xbb, 0x5d, 0xcd, 0xde, 0x3d, 0x72, 0xbe, 0x70, 0xce, 0xce, 0xcb, 0xc1, 0xf9, 0x22, 0xc5, 0xde,
-	0xf6, 0x3c, 0xc9, 0xbf, 0xa8, 0x05, 0x46, 0x1c, 0xcc, 0xe8, 0x78, 0x8a, 0x43, 0x6b, 0xaf, 0xab,
-	0xd9, 0x86, 0xb7, 0xb4, 0xd1, 0x33, 0xd8, 0x4b, 0x33, 0xec, 0x17, 0xb6, 0x9f, 0xab, 0xd6, 0x32,
-	0xe5, 0x1d, 0xb4, 0x9c, 0x42, 0xd2, 0x4e, 0x29, 0x69, 0xe7, 0xbc, 0x94, 0x74, 0x5f, 0x7f, 0xfb,
-	0xa1, 0xa3, 0x79, 0xb7, 0xd3, 0x0c, 0x3f, 0x97, 0x79, 0x39, 0x92, 0xeb, 0x22, 0x26, 0xf3, 0xfc,
-	0x16, 0xb8, 0x8f, 0xe7, 0x98, 0x8a, 0x52, 0x17, 0x77, 0xba, 0x9a, 0x5d, 0xf5, 0x9a, 0x25, 0x78,
-	0x92, 0x63, 0x85, 0x2e, 0xd0, 0x09, 0x74, 0x96, 0x39, 0x63, 0x36, 0xa3, 0x22, 0x64, 0xaf, 0x69,
-	0xbe, 0xd5, 0xd9, 0x32, 0x1b, 0xc9, 0xec, 0x7b, 0x65, 0xd8, 0x93, 0x32, 0x6a, 0x98, 0x07, 0x29,
-	0x9a, 0xe7, 0xb0, 0x93, 0xe1, 0x39, 0xc9, 0x67, 0xc1, 0xad, 0xa6, 0xbc, 0x38, 0x7b, 0xe3, 0xac,
-	0x54, 0x82, 0xda, 0x9f, 0x15, 0x41, 0xef, 0x21, 0x6c, 0x17, 0x03, 0x44, 0x7b, 0x50, 0x7f, 0x41,
-	0x79, 0x8a, 0xc7, 0x64, 0x42, 0x70, 0x68, 0x56, 0x50, 0x0d, 0xaa, 0x27, 0x2f, 0x07, 0xa6, 0x86,
-	0x0c, 0xd0, 0x7f, 0xfa, 0x76, 0x38, 0x90, 0xa2, 0xae, 0x9a, 0xb5, 0x33, 0xdd, 0xd8, 0x31, 0xe1,
-	0x4c, 0x37, 0xc0, 0xac, 0xf7, 0x4e, 0xc0, 0x28, 0xc9, 0xd1, 0x57, 0xb0, 0x4d, 0x67, 0xc9, 0x08,
-	0x67, 0x56, 0x53, 0xbe, 0x0e, 0xca, 0x42, 0x5f, 0x43, 0xe3, 0xa3, 0x2e, 0xf7, 0x25, 0x5a, 0xe7,
-	0xab, 0xa6, 0x7a, 0x7f, 0x6c, 0xc1, 0xae, 0xba, 0xd0, 0xe1, 0x2c, 0x49, 0x82, 0x6c, 0x81, 0xee,
-	0xc1, 0xea, 0x71, 0xb8, 0xf9, 0x5a, 0xbc, 0x02, 0x33, 0x0e, 0x04, 0xe6, 0x42, 0xca, 0xf8, 0x94,
-	0x86, 0xf8, 0x42, 0x3e, 0x1c, 0xf5, 0xcd, 0x8b, 0xa3, 0x32, 0x26, 0x4c, 0x66, 0x79, 0x37, 0x78,
-	0x50, 0x0c, 0x07, 0x85, 0xef, 0x3b, 0x42, 0x83, 0x98, 0xbc, 0xc1, 0xe1, 0xda, 0x21, 0xd5, 0xff,
-	0x75, 0xc8, 0xe7, 0x09, 0x51, 0x0f, 0x1a, 0x05, 0x58, 0x8c, 0xc2, 0xd2, 0xe5, 0x74, 0x3e, 0xf2,
-	0xa1, 0xc7, 0x70, 0xf7, 0x5f, 0x04, 0x2a, 0xf8, 0x96, 0x0c, 0xfe, 0x34, 0xd8, 0xff, 0xe1, 0xdd,
-	0x55, 0x5b, 0x7b, 0x7f, 0xd5, 0xd6, 0xfe, 0xba, 0x6a, 0x6b, 0x6f, 0xaf, 0xdb, 0x95, 0xf7, 0xd7,
-	0xed, 0xca, 0x9f, 0xd7, 0xed, 0xca, 0xab, 0xc7, 0x6b, 0x4a, 0xfd, 0xcc, 0x7f, 0xc5, 0xfc, 0xd8,
-	0xbd, 0x58, 0xfe, 0x61, 0x48, 0xed, 0x8e, 0xb6, 0xa5, 0x3a, 0x8e, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x89, 0x4e, 0x0e, 0xd0, 0x64, 0x07, 0x00, 0x00,
+	// 1364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x52, 0xdb, 0x46,
+	0x14, 0x46, 0xd8, 0x01, 0x73, 0x6c, 0x8c, 0xb2, 0xfc, 0x54, 0x10, 0xb0, 0x5d, 0x77, 0xa6, 0x75,
+	0x9b, 0x44, 0x9e, 0x40, 0xa6, 0xed, 0xf4, 0xa6, 0x63, 0x40, 0x01, 0x53, 0xfe, 0x2a, 0x9b, 0x30,
+	0x93, 0x8b, 0x6a, 0x64, 0x69, 0x6d, 0x76, 0x22, 0xed, 0xaa, 0x92, 0x0c, 0x38, 0x0f, 0xd0, 0xe9,
+	0x4d, 0x66, 0x72, 0xd5, 0x87, 0xe8, 0x93, 0xe4, 0x32, 0x97, 0xbd, 0x4a, 0x3a, 0xc9, 0x1b, 0xf4,
+	0x09, 0x3a, 0x5a, 0xad, 0x6c, 0xb9, 0x90, 0xc0, 0xf4, 0x4a, 0xda, 0xf3, 0x9d, 0x73, 0xf6, 0xec,
+	0x77, 0x7e, 0x76, 0xe1, 0x81, 0x3d, 0x70, 0x31, 0x0d, 0x08, 0xa3, 0x97, 0x83, 0x17, 0xf5, 0xe1,
+	0xa2, 0xee, 0x33, 0xc7, 0x31, 0x3d, 0x2f, 0xf9, 0xaa, 0x9e, 0xcf, 0x42, 0x86, 0x4a, 0x69, 0x6d,
+	0x75, 0xb8, 0x50, 0x85, 0xd6, 0xca, 0x42, 0x8f, 0xf5, 0x18, 0x57, 0xad, 0x47, 0x7f, 0xb1, 0xd5,
+	0x4a, 0xb9, 0xc7, 0x58, 0xcf, 0xc1, 0x75, 0xbe, 0xea, 0xf4, 0xbb, 0xf5, 0x90, 0xb8, 0x38, 0x08,
+	0x4d, 0x57, 0xb8, 0x5d, 0xa9, 0xdf, 0x10, 0x44, 0x10, 0x9a, 0x21, 0x36, 0x08, 0xed, 0x26, 0x1e,
+	0x1f, 0xde, 0x60, 0xe0, 0xe2, 0xd0, 0xb4, 0xcd, 0xd0, 0x14, 0xea, 0x25, 0x8b, 0x05, 0x2e, 0x0b,
+	0xea, 0x1d, 0x33, 0xc0, 0xf5, 0xf3, 0x47, 0x1d, 0x1c, 0x9a, 0x8f, 0xea, 0x16, 0x23, 0x54, 0xe0,
+	0x8f, 0x6e, 0x70, 0xd7, 0xc3, 0x14, 0x07, 0x24, 0x48, 0x45, 0x50, 0x3d, 0x81, 0x79, 0x3d, 0x46,
+	0x77, 0x62, 0xb0, 0x15, 0xc5, 0x88, 0xd6, 0x61, 0x31, 0xf4, 0x4d, 0x1a, 0x74, 0xb1, 0x6f, 0x78,
+	0x3e, 0x63, 0x5d, 0xe3, 0x0c, 0x93, 0xde, 0x59, 0xa8, 0x64, 0x2a, 0x52, 0x2d, 0xab, 0xcf, 0x27,
+	0xe0, 0x71, 0x84, 0xed, 0x72, 0x68, 0x2f, 0x9b, 0x93, 0xe4, 0xc9, 0xbd, 0x6c, 0x6e, 0x52, 0xce,
+	0x54, 0x7f, 0x2b, 0xc2, 0xb4, 0xf0, 0x8b, 0xd6, 0x00, 0x44, 0x00, 0x06, 0xb1, 0x15, 0xa9, 0x22,
+	0xd5, 0x66, 0xf4, 0x19, 0x21, 0x69, 0xda, 0x68, 0x01, 0xee, 0xb0, 0x0b, 0x8a, 0x7d, 0x65, 0x92,
+	0x23, 0xf1, 0x02, 0xfd, 0x02, 0xb3, 0x49, 0xb4, 0x9c, 0x35, 0x65, 0xba, 0x22, 0xd5, 0xf2, 0xeb,
+	0x1b, 0xea, 0xa7, 0x33, 0xa7, 0x5e, 0x73, 0x98, 0xcd, 0xec, 0xeb, 0xb7, 0xe5, 0x09, 0xbd, 0xd0,
+	0x4b, 0x1f, 0x70, 0x0d, 0xc0, 0x3a, 0x33, 0x29, 0xc5, 0x4e, 0x14, 0x54, 0x2e, 0x0e, 0x4a, 0x48,
+	0x9a, 0x36, 0xfa, 0x09, 0x72, 0x09, 0xf7, 0x4a, 0x9e, 0xef, 0x5c, 0xbf, 0xe5, 0xce, 0x07, 0xc2,
+	0x4c, 0x1f, 0x3a, 0x40, 0x6d, 0x28, 0xa4, 0x99, 0x57, 0x0a, 0xdc, 0xe1, 0xfd, 0x9b, 0x1c, 0x8a,
+	0x33, 0x34, 0x69, 0x97, 0x89, 0x23, 0xe4, 0x7b, 0x23, 0x11, 0xba, 0x0f, 0x77, 0x09, 0x25, 0x21,
+	0x31, 0x1d, 0x23, 0xc0, 0xbf, 0xf6, 0x31, 0xb5, 0xb0, 0xaf, 0xcc, 0xf2, 0x83, 0xc8, 0x02, 0x68,
+	0x25, 0x72, 0xf4, 0x87, 0x04, 0xc8, 0x25, 0x74, 0xa4, 0x69, 0x74, 0x18, 0xb5, 0x95, 0x85, 0x4a,
+	0xa6, 0x96, 0x5f, 0x5f, 0x56, 0xe3, 0xba, 0x52, 0xa3, 0xba, 0x52, 0x45, 0x5d, 0xa9, 0x5b, 0x8c,
+	0xd0, 0xcd, 0x83, 0x68, 0xdf, 0x7f, 0xde, 0x96, 0x97, 0x07, 0xa6, 0xeb, 0xfc, 0x50, 0xbd, 0xea,
+	0xa2, 0xfa, 0xe7, 0xbb, 0x72, 0xad, 0x47, 0xc2, 0xb3, 0x7e, 0x47, 0xb5, 0x98, 0x5b, 0x17, 0x15,
+	0x1a, 0x7f, 0x1e, 0x06, 0xf6, 0xf3, 0x7a, 0x38, 0xf0, 0x70, 0xc0, 0xbd, 0x05, 0xba, 0xec, 0x12,
+	0x3a, 0x0c, 0x6a, 0x93, 0x51, 0x1b, 0xed, 0xc0, 0xf4, 0xb9, 0x6b, 0x44, 0x3a, 0x4a, 0xb1, 0x22,
+	0xd5, 0x8a, 0xeb, 0xea, 0x2d, 0x79, 0x56, 0x9f, 0x1e, 0xb4, 0x07, 0x1e, 0xd6, 0xa7, 0xce, 0xdd,
+	0xe8, 0x8b, 0x56, 0x20, 0xe7, 0x98, 0x7d, 0x6a, 0x9d, 0x61, 0x5b, 0x99, 0xab, 0x48, 0xb5, 0x9c,
+	0x3e, 0x5c, 0xa3, 0x5d, 0x98, 0xf3, 0x7c, 0x6c, 0xc4, 0x6b, 0x23, 0xea, 0x5a, 0x45, 0xe6, 0x39,
+	0x58, 0x51, 0xe3, 0x96, 0x56, 0x93, 0x96, 0x56, 0xdb, 0x49, 0x4b, 0x6f, 0x66, 0x5f, 0xbd, 0x2b,
+	0x4b, 0xfa, 0xac, 0xe7, 0xe3, 0x7d, 0x6e, 0x17, 0x21, 0x51, 0x5f, 0x38, 0xe4, 0x3c, 0xca, 0x42,
+	0x60, 0xe0, 0x73, 0x4c, 0xc3, 0xa4, 0x2f, 0xee, 0x56, 0xa4, 0x5a, 0x46, 0x9f, 0x4f, 0x40, 0x2d,
+	0xc2, 0xe2, 0xbe, 0x40, 0x1a, 0x94, 0x87, 0x36, 0x16, 0xeb, 0xd3, 0xd0, 0x66, 0x17, 0x34, 0xaa,
+	0x6a, 0x7f, 0x68, 0x8d, 0xb8, 0xf5, 0x6a, 0xa2, 0xb6, 0x95, 0x68, 0xb5, 0x22, 0x25, 0xe1, 0x66,
+	0x1f, 0x66, 0x7c, 0x7c, 0x4e, 0x22, 0x2e, 0x02, 0x65, 0x9e, 0x27, 0xae, 0x76, 0x23, 0x57, 0xc2,
+	0x40, 0xd4, 0xcf, 0xc8, 0x01, 0xfa, 0x0e, 0x14, 0x9b, 0x04, 0x5e, 0x3f, 0xc4, 0x86, 0x87, 0x7d,
+	0xc2, 0x6c, 0x83, 0x50, 0xa3, 0xe3, 0x30, 0xeb, 0x79, 0xa0, 0x2c, 0xf2, 0x1e, 0x5f, 0x14, 0xf8,
+	0x31, 0x87, 0x9b, 0x74, 0x93, 0x83, 0xc8, 0x80, 0x39, 0x87, 0x74, 0xb1, 0x35, 0xb0, 0x1c, 0x2c,
+	0x5a, 0x73, 0x89, 0x27, 0xee, 0xdb, 0xdb, 0x26, 0x6e, 0x3f, 0x31, 0xe7, 0x9d, 0xa8, 0x17, 0x9d,
+	0xb1, 0x35, 0xfa, 0x1a, 0xe4, 0xd1, 0x06, 0x5d, 0xe6, 0x5b, 0xd8, 0x56, 0x3e, 0xe3, 0x09, 0x1d,
+	0x6d, 0xfc, 0x84, 0x8b, 0xd1, 0x17, 0x30, 0x1b, 0xf4, 0x69, 0x80, 0x87, 0x3c, 0x2a, 0x9c, 0xc7,
+	0x42, 0x2c, 0x14, 0xbc, 0x7d, 0x0f, 0x8a, 0x6b, 0x12, 0x1a, 0x62, 0x6a, 0x52, 0x0b, 0x8f, 0xf3,
+	0xbe, 0xcc, 0xf5, 0x97, 0x52, 0x78, 0x9a, 0xf1, 0xc7, 0x90, 0x46, 0x0c, 0x4c, 0xed, 0xc4, 0x6e,
+	0x85, 0xdb, 0x2d, 0xa4, 0x50, 0x8d, 0xda, 0xc2, 0xea, 0x47, 0x00, 0xcb, 0xc7, 0x66, 0x88, 0x6d,
+	0xc3, 0x0c, 0x95, 0x7b, 0xb7, 0xac, 0xb3, 0x19, 0x61, 0xd3, 0x08, 0xa3, 0x53, 0x79, 0x98, 0xda,
+	0x84, 0xf6, 0x8c, 0x78, 0x30, 0xae, 0xf2, 0xa6, 0x2e, 0x08, 0xe1, 0x51, 0x24, 0x43, 0x3b, 0x50,
+	0xe1, 0x60, 0x70, 0x46, 0x3c, 0x63, 0x38, 0xaa, 0xf1, 0xa5, 0x47, 0xfc, 0x41, 0x12, 0xe5, 0x1a,
+	0x8f, 0x72, 0x6d, 0xa8, 0xd7, 0x16, 0x6a, 0x1a, 0xd7, 0x12, 0xe1, 0xee, 0xc2, 0x1d, 0x9f, 0x39,
+	0x38, 0x50, 0x4a, 0x3c, 0xd2, 0x07, 0xb7, 0xcc, 0xa2, 0x1e, 0xd9, 0x88, 0xb2, 0x8a, 0x1d, 0x44,
+	0x75, 0x9e, 0xa6, 0xeb, 0x82, 0x50, 0x9b, 0x5d, 0x8c, 0xf3, 0x5d, 0x8e, 0xeb, 0x3c, 0xa5, 0x76,
+	0xca, 0xb5, 0xd2, 0xac, 0x6f, 0x41, 0xe9, 0x1a, 0x37, 0x71, 0x69, 0x1a, 0xfd, 0x00, 0xdb, 0x4a,
+	0x85, 0xd7, 0xe7, 0xbd, 0x2b, 0x5e, 0xe2, 0x0a, 0x3d, 0x09, 0xb0, 0x5d, 0x7d, 0x00, 0x53, 0xf1,
+	0x7c, 0x40, 0x73, 0x90, 0x3f, 0xa1, 0x81, 0x87, 0x2d, 0xd2, 0x25, 0xd8, 0x96, 0x27, 0xd0, 0x34,
+	0x64, 0xb4, 0xa7, 0x07, 0xb2, 0x84, 0x72, 0x90, 0x3d, 0x6d, 0xb4, 0x0e, 0xe4, 0xc9, 0xea, 0x0e,
+	0x14, 0xc7, 0x8b, 0x12, 0x01, 0x4c, 0x35, 0xb6, 0xda, 0xcd, 0xa7, 0x9a, 0x3c, 0x11, 0xfd, 0x1f,
+	0x37, 0x4e, 0x5a, 0xda, 0xb6, 0x2c, 0xa1, 0x22, 0x40, 0xeb, 0xe4, 0xb0, 0xa5, 0xb5, 0xdb, 0xcd,
+	0xc3, 0x1d, 0x79, 0x12, 0xcd, 0xc2, 0x4c, 0x6b, 0xf7, 0xa4, 0x6d, 0x6c, 0x1f, 0x9d, 0x1e, 0xca,
+	0x99, 0xbd, 0x6c, 0x2e, 0x23, 0x4f, 0xef, 0x65, 0x73, 0x33, 0x32, 0xec, 0x65, 0x73, 0x20, 0xe7,
+	0xab, 0x2f, 0x25, 0x28, 0xa4, 0x29, 0x43, 0x5f, 0xc1, 0x5c, 0x72, 0x31, 0x18, 0xd8, 0x26, 0x21,
+	0xf3, 0xc5, 0x95, 0x58, 0x4c, 0xc4, 0x1a, 0x97, 0xa2, 0x32, 0xe4, 0xa3, 0x2b, 0xd3, 0x35, 0xa9,
+	0xd9, 0x1b, 0xde, 0x8e, 0x10, 0xdd, 0x30, 0xb1, 0x04, 0x6d, 0xc0, 0xe2, 0x68, 0x16, 0x5f, 0x9c,
+	0x91, 0x10, 0x3b, 0x24, 0x08, 0xb1, 0xcf, 0xef, 0xe8, 0x19, 0x7d, 0x61, 0x08, 0x9e, 0x8e, 0xb0,
+	0xaa, 0x06, 0xb9, 0x64, 0x28, 0xa0, 0x25, 0x98, 0xa2, 0x7d, 0xb7, 0x83, 0x7d, 0x65, 0x9e, 0x33,
+	0x2a, 0x56, 0xe8, 0x73, 0x28, 0x8c, 0x65, 0x6d, 0x81, 0xa3, 0xf9, 0x60, 0x94, 0xa4, 0xea, 0xef,
+	0x19, 0x28, 0x8a, 0x63, 0xb5, 0xfa, 0xae, 0x6b, 0xfa, 0x03, 0xb4, 0x0a, 0xa3, 0x4b, 0xfd, 0xea,
+	0x2d, 0xff, 0x0c, 0x64, 0xc7, 0x0c, 0x71, 0x10, 0x72, 0x7e, 0x9b, 0xd4, 0xc6, 0x97, 0xfc, 0x48,
+	0xf9, 0x9b, 0x07, 0xbe, 0xb0, 0xe8, 0x32, 0x6e, 0xa5, 0x5f, 0xf1, 0x83, 0x1c, 0x58, 0x8e, 0x65,
+	0x4f, 0x08, 0x35, 0x1d, 0xf2, 0x02, 0xdb, 0xa9, 0x4d, 0x32, 0xff, 0x6b, 0x93, 0x8f, 0x3b, 0x44,
+	0x55, 0x28, 0xc4, 0x60, 0x4c, 0x85, 0x92, 0xe5, 0xec, 0x8c, 0xc9, 0xd0, 0x63, 0x58, 0xfc, 0x8f,
+	0x03, 0xa1, 0x7c, 0x27, 0x1e, 0xad, 0xd7, 0x82, 0x91, 0xd5, 0xb5, 0x33, 0x57, 0x99, 0xfa, 0xc4,
+	0x40, 0xfe, 0xe6, 0xa5, 0x04, 0xf9, 0x54, 0x85, 0xa1, 0x25, 0x40, 0xfa, 0xd1, 0xfe, 0x7e, 0xe3,
+	0xf8, 0xd8, 0xd0, 0x8f, 0xf6, 0x35, 0xe3, 0xe8, 0xf4, 0x50, 0xd3, 0xe5, 0x09, 0x54, 0x81, 0xd5,
+	0x31, 0xf9, 0x81, 0xd6, 0x6e, 0x6c, 0x37, 0xda, 0x0d, 0x43, 0xdb, 0x6e, 0xb6, 0x8f, 0x74, 0x59,
+	0x42, 0xab, 0xa0, 0x8c, 0x69, 0x44, 0x3f, 0x07, 0x8d, 0xc3, 0xc6, 0x8e, 0xa6, 0xcb, 0x93, 0xe8,
+	0x4b, 0xa8, 0x8e, 0xa1, 0x2d, 0xed, 0xe7, 0x13, 0xed, 0x70, 0x4b, 0xd3, 0x8d, 0xd3, 0xdd, 0x66,
+	0x5b, 0xdb, 0x6f, 0xb6, 0xda, 0x9a, 0x2e, 0x67, 0x36, 0x0f, 0x5f, 0xbf, 0x2f, 0x49, 0x6f, 0xde,
+	0x97, 0xa4, 0xbf, 0xdf, 0x97, 0xa4, 0x57, 0x1f, 0x4a, 0x13, 0x6f, 0x3e, 0x94, 0x26, 0xfe, 0xfa,
+	0x50, 0x9a, 0x78, 0xf6, 0x38, 0xf5, 0x4e, 0xf8, 0xc8, 0x4b, 0xf5, 0x7c, 0xa3, 0x7e, 0x39, 0x7c,
+	0xae, 0xf2, 0x97, 0x43, 0x67, 0x8a, 0xcf, 0xcc, 0x8d, 0x7f, 0x07, 0x00, 0x48, 0xdc, 0x9e, 0xf4,
+	0xe2, 0x0b, 0x00, 0x00,
 }
 
 func (m *RollappGenesisState) Marshal() (dAtA []byte, err error) {
@@ -540,6 +841,107 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaintenanceWindowBlocksUsed != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.MaintenanceWindowBlocksUsed))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaintenanceWindowStartHeight != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.MaintenanceWindowStartHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	{
+		size, err := m.Roles.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRollapp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	if m.OwnershipTransferExpiryHeight != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.OwnershipTransferExpiryHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintRollapp(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.CreatedAt != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedAt):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintRollapp(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.MaintenanceEndHeight != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.MaintenanceEndHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.MaintenanceStartHeight != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.MaintenanceStartHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.SunsetHeight != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.SunsetHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.LifecycleForced {
+		i--
+		if m.LifecycleForced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.LifecycleState != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.LifecycleState))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.DisputePeriodInBlocks != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.DisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if len(m.MinSequencerBond) > 0 {
 		for iNdEx := len(m.MinSequencerBond) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -587,12 +989,12 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x88
 	}
 	if m.PreLaunchTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreLaunchTime):])
-		if err1 != nil {
-			return 0, err1
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreLaunchTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n1
-		i = encodeVarintRollapp(dAtA, i, uint64(n1))
+		i -= n3
+		i = encodeVarintRollapp(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -676,6 +1078,50 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RollappRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SequencerWhitelister) > 0 {
+		i -= len(m.SequencerWhitelister)
+		copy(dAtA[i:], m.SequencerWhitelister)
+		i = encodeVarintRollapp(dAtA, i, uint64(len(m.SequencerWhitelister)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AppManager) > 0 {
+		i -= len(m.AppManager)
+		copy(dAtA[i:], m.AppManager)
+		i = encodeVarintRollapp(dAtA, i, uint64(len(m.AppManager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MetadataEditor) > 0 {
+		i -= len(m.MetadataEditor)
+		copy(dAtA[i:], m.MetadataEditor)
+		i = encodeVarintRollapp(dAtA, i, uint64(len(m.MetadataEditor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Revision) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -733,6 +1179,11 @@ func (m *RollappSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DisputePeriodInBlocks != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.DisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.LatestFinalizedHeight != 0 {
 		i = encodeVarintRollapp(dAtA, i, uint64(m.LatestFinalizedHeight))
 		i--
@@ -858,6 +1309,64 @@ func (m *Rollapp) Size() (n int) {
 			n += 2 + l + sovRollapp(uint64(l))
 		}
 	}
+	if m.DisputePeriodInBlocks != 0 {
+		n += 2 + sovRollapp(uint64(m.DisputePeriodInBlocks))
+	}
+	if m.LifecycleState != 0 {
+		n += 2 + sovRollapp(uint64(m.LifecycleState))
+	}
+	if m.LifecycleForced {
+		n += 3
+	}
+	if m.SunsetHeight != 0 {
+		n += 2 + sovRollapp(uint64(m.SunsetHeight))
+	}
+	if m.MaintenanceStartHeight != 0 {
+		n += 2 + sovRollapp(uint64(m.MaintenanceStartHeight))
+	}
+	if m.MaintenanceEndHeight != 0 {
+		n += 2 + sovRollapp(uint64(m.MaintenanceEndHeight))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedAt)
+		n += 2 + l + sovRollapp(uint64(l))
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 2 + l + sovRollapp(uint64(l))
+	}
+	if m.OwnershipTransferExpiryHeight != 0 {
+		n += 2 + sovRollapp(uint64(m.OwnershipTransferExpiryHeight))
+	}
+	l = m.Roles.Size()
+	n += 2 + l + sovRollapp(uint64(l))
+	if m.MaintenanceWindowStartHeight != 0 {
+		n += 2 + sovRollapp(uint64(m.MaintenanceWindowStartHeight))
+	}
+	if m.MaintenanceWindowBlocksUsed != 0 {
+		n += 2 + sovRollapp(uint64(m.MaintenanceWindowBlocksUsed))
+	}
+	return n
+}
+
+func (m *RollappRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetadataEditor)
+	if l > 0 {
+		n += 1 + l + sovRollapp(uint64(l))
+	}
+	l = len(m.AppManager)
+	if l > 0 {
+		n += 1 + l + sovRollapp(uint64(l))
+	}
+	l = len(m.SequencerWhitelister)
+	if l > 0 {
+		n += 1 + l + sovRollapp(uint64(l))
+	}
 	return n
 }
 
@@ -900,6 +1409,9 @@ func (m *RollappSummary) Size() (n int) {
 	if m.LatestFinalizedHeight != 0 {
 		n += 1 + sovRollapp(uint64(m.LatestFinalizedHeight))
 	}
+	if m.DisputePeriodInBlocks != 0 {
+		n += 1 + sovRollapp(uint64(m.DisputePeriodInBlocks))
+	}
 	return n
 }
 
@@ -1418,13 +1930,432 @@ func (m *Rollapp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRollapp(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodInBlocks", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.DisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleState", wireType)
+			}
+			m.LifecycleState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LifecycleState |= Rollapp_LifecycleState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleForced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LifecycleForced = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetHeight", wireType)
+			}
+			m.SunsetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SunsetHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceStartHeight", wireType)
+			}
+			m.MaintenanceStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceEndHeight", wireType)
+			}
+			m.MaintenanceEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipTransferExpiryHeight", wireType)
+			}
+			m.OwnershipTransferExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnershipTransferExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Roles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindowStartHeight", wireType)
+			}
+			m.MaintenanceWindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceWindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindowBlocksUsed", wireType)
+			}
+			m.MaintenanceWindowBlocksUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceWindowBlocksUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollapp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollappRoles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollapp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollappRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollappRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataEditor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataEditor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequencerWhitelister", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SequencerWhitelister = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollapp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRollapp
 			}
 			if (iNdEx + skippy) > l {
@@ -1698,6 +2629,25 @@ func (m *RollappSummary) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodInBlocks", wireType)
+			}
+			m.DisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollapp(dAtA[iNdEx:])
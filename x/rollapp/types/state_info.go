@@ -1,10 +1,15 @@
 package types
 
 import (
+	"errors"
 	"strconv"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
 	common "github.com/dymensionxyz/dymension/v3/x/common/types"
 )
@@ -64,9 +69,19 @@ func (s *StateInfo) GetBlockDescriptor(height uint64) (BlockDescriptor, bool) {
 	if !s.ContainsHeight(height) {
 		return BlockDescriptor{}, false
 	}
+	if s.IsCompressed() {
+		// only the first and the last block descriptors are stored
+		for _, bd := range s.BDs.BD {
+			if bd.Height == height {
+				return bd, true
+			}
+		}
+		return BlockDescriptor{}, false
+	}
 	return s.BDs.BD[height-s.StartHeight], true
 }
 
+// GetLatestBlockDescriptor returns the block descriptor of the last height, which is also stored for compressed updates.
 func (s *StateInfo) GetLatestBlockDescriptor() BlockDescriptor {
 	// return s.BDs.BD[s.NumBlocks-1] // todo: should it be this? or the one below? using this breaks ibctesting tests
 	return s.BDs.BD[len(s.BDs.BD)-1]
@@ -96,3 +111,30 @@ type StateInfoMeta struct {
 	Revision uint64
 	Rollapp  string
 }
+
+// IsCompressed returns true if the state update only holds the first and the last block descriptors, and commits to
+// the others with a Merkle root.
+func (s *StateInfo) IsCompressed() bool {
+	return len(s.BdsRoot) != 0
+}
+
+// VerifyBlockDescriptor checks the block descriptor against the Merkle root of the compressed state update.
+func (s *StateInfo) VerifyBlockDescriptor(bd BlockDescriptor, proof *cmtcrypto.Proof) error {
+	if !s.IsCompressed() {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "state info is not compressed")
+	}
+	if !s.ContainsHeight(bd.Height) {
+		return errorsmod.Wrapf(gerrc.ErrOutOfRange, "height is not in the state info: %d", bd.Height)
+	}
+	p, err := merkle.ProofFromProto(proof)
+	if err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, err)
+	}
+	if p.Total != int64(s.NumBlocks) || p.Index != int64(bd.Height-s.StartHeight) { //nolint:gosec
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "proof position: index: %d: total: %d", p.Index, p.Total)
+	}
+	if err := p.Verify(s.BdsRoot, bd.Leaf()); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "verify proof")
+	}
+	return nil
+}
@@ -120,6 +120,10 @@ type StateInfo struct {
 	// to see in the next state info. Most of the time NextProposer is the current
 	// proposer. In case of rotation it is changed to the successor.
 	NextProposer string `protobuf:"bytes,11,opt,name=nextProposer,proto3" json:"nextProposer,omitempty"`
+	// bds_root is the Merkle root of the block descriptors of the batch. It is
+	// set if the update was compressed, in which case BDs only holds the first
+	// and the last block descriptors.
+	BdsRoot []byte `protobuf:"bytes,12,opt,name=bds_root,json=bdsRoot,proto3" json:"bds_root,omitempty"`
 }
 
 func (m *StateInfo) Reset()         { *m = StateInfo{} }
@@ -225,6 +229,13 @@ func (m *StateInfo) GetNextProposer() string {
 	return ""
 }
 
+func (m *StateInfo) GetBdsRoot() []byte {
+	if m != nil {
+		return m.BdsRoot
+	}
+	return nil
+}
+
 // StateInfoSummary is a compact representation of StateInfo
 type StateInfoSummary struct {
 	// stateInfoIndex defines what rollapp the state belongs to
@@ -368,44 +379,45 @@ func init() {
 }
 
 var fileDescriptor_750f3a9f16533ec4 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x6a, 0xdb, 0x4a,
-	0x14, 0xf5, 0xd8, 0x8e, 0x13, 0x8d, 0x1f, 0x26, 0x19, 0xc2, 0x43, 0x98, 0x46, 0x36, 0x82, 0x16,
-	0xd3, 0x85, 0x54, 0x92, 0x76, 0x53, 0xe8, 0x22, 0xc6, 0x94, 0xb8, 0x8b, 0x92, 0x2a, 0x59, 0x94,
-	0x52, 0x30, 0xb2, 0x35, 0x96, 0x87, 0x4a, 0x33, 0xaa, 0x66, 0x54, 0xec, 0x7c, 0x45, 0x16, 0xfd,
-	0xa8, 0x40, 0x37, 0xd9, 0xb5, 0xab, 0xb4, 0xd8, 0x7f, 0xd0, 0x2f, 0x28, 0x1a, 0x4d, 0x2c, 0xdb,
-	0xb1, 0x1b, 0x08, 0x74, 0xe7, 0x7b, 0x7c, 0xcf, 0xe1, 0xdc, 0x73, 0xaf, 0x06, 0xda, 0xde, 0x24,
-	0xc4, 0x94, 0x13, 0x46, 0xc7, 0x93, 0x8b, 0xbc, 0xb0, 0x63, 0x16, 0x04, 0x6e, 0x14, 0xd9, 0x5c,
-	0xb8, 0x02, 0xf7, 0x08, 0x1d, 0x32, 0x2b, 0x8a, 0x99, 0x60, 0xc8, 0x58, 0x24, 0x58, 0xf3, 0xc2,
-	0x52, 0x84, 0xfa, 0xbe, 0xcf, 0x7c, 0x26, 0x5b, 0xed, 0xf4, 0x57, 0xc6, 0xaa, 0x37, 0x7c, 0xc6,
-	0xfc, 0x00, 0xdb, 0xb2, 0xea, 0x27, 0x43, 0x5b, 0x90, 0x10, 0x73, 0xe1, 0x86, 0x91, 0x6a, 0x78,
-	0x71, 0x8f, 0x8f, 0x7e, 0xc0, 0x06, 0x9f, 0x7a, 0x1e, 0xe6, 0x83, 0x98, 0x44, 0x82, 0xc5, 0x8a,
-	0xf6, 0x74, 0x03, 0x6d, 0xc0, 0xc2, 0x90, 0x51, 0xe9, 0x3e, 0xe1, 0x59, 0xaf, 0xd9, 0x81, 0xb5,
-	0xb3, 0x74, 0x9a, 0x2e, 0x1d, 0xb2, 0x2e, 0xf5, 0xf0, 0x18, 0x3d, 0x82, 0x9a, 0xd2, 0xef, 0x7a,
-	0x3a, 0x68, 0x82, 0x96, 0xe6, 0xe4, 0x00, 0xda, 0x87, 0x5b, 0x24, 0x6d, 0xd3, 0x8b, 0x4d, 0xd0,
-	0x2a, 0x3b, 0x59, 0x61, 0x7e, 0x2d, 0x43, 0x6d, 0x2e, 0x83, 0x3e, 0xc2, 0x1a, 0x5f, 0xd2, 0x94,
-	0x32, 0xd5, 0x43, 0xcb, 0xfa, 0x7b, 0x4c, 0xd6, 0xb2, 0x93, 0x76, 0xf9, 0xea, 0xa6, 0x51, 0x70,
-	0x56, 0xb4, 0x52, 0x7f, 0x1c, 0x7f, 0x4e, 0x30, 0x1d, 0xe0, 0x58, 0xba, 0xd0, 0x9c, 0x1c, 0x40,
-	0x4d, 0x58, 0xe5, 0xc2, 0x8d, 0xc5, 0x09, 0x26, 0xfe, 0x48, 0xe8, 0x25, 0xe9, 0x72, 0x11, 0x4a,
-	0xf9, 0x34, 0x09, 0xdb, 0x69, 0x74, 0x5c, 0x2f, 0xcb, 0xff, 0x73, 0x00, 0xfd, 0x0f, 0x2b, 0x9d,
-	0xe3, 0x53, 0x57, 0x8c, 0xf4, 0x2d, 0x29, 0xad, 0x2a, 0xf4, 0x04, 0xd6, 0x06, 0x31, 0x76, 0x05,
-	0x61, 0x54, 0x49, 0x6f, 0x4b, 0xea, 0x0a, 0x8a, 0x5e, 0xc1, 0x4a, 0x96, 0xaf, 0xbe, 0xd3, 0x04,
-	0xad, 0xda, 0xe1, 0xe3, 0x4d, 0x33, 0x67, 0xcb, 0x90, 0x23, 0x27, 0xdc, 0x51, 0x24, 0x74, 0x02,
-	0x4b, 0xed, 0x0e, 0xd7, 0x35, 0x99, 0xd7, 0xb3, 0xfb, 0xf2, 0x92, 0x9e, 0x3b, 0xf3, 0xf5, 0x73,
-	0x95, 0x58, 0x2a, 0x81, 0xde, 0x43, 0x28, 0xad, 0x61, 0xaf, 0xe7, 0x0a, 0x1d, 0x4a, 0xc1, 0xba,
-	0x95, 0x5d, 0x9c, 0x75, 0x7b, 0x71, 0xd6, 0xf9, 0xed, 0xc5, 0xb5, 0x0f, 0x52, 0xea, 0xef, 0x9b,
-	0xc6, 0xde, 0xc4, 0x0d, 0x83, 0x97, 0x66, 0xce, 0x35, 0x2f, 0x7f, 0x36, 0x80, 0xa3, 0x29, 0xe0,
-	0x58, 0x20, 0x13, 0xfe, 0x47, 0xf1, 0x58, 0x9c, 0xc6, 0x2c, 0x62, 0x1c, 0xc7, 0x7a, 0x55, 0x06,
-	0xb5, 0x84, 0xbd, 0x29, 0xef, 0x54, 0x76, 0xb7, 0xcd, 0xef, 0x00, 0xee, 0xce, 0x77, 0x7a, 0x96,
-	0x84, 0xa1, 0x1b, 0x4f, 0xfe, 0xf1, 0x75, 0xe4, 0xf9, 0x17, 0x1f, 0x92, 0xff, 0xdd, 0x35, 0x97,
-	0xd6, 0xad, 0xd9, 0xfc, 0x06, 0xa0, 0x21, 0xd3, 0xcf, 0xea, 0x73, 0xf6, 0x9a, 0x50, 0x37, 0x20,
-	0x17, 0xb2, 0xe7, 0x5d, 0x82, 0x13, 0xbc, 0x46, 0x0a, 0xac, 0xbd, 0x98, 0x3e, 0xdc, 0x1b, 0xae,
-	0x92, 0xf5, 0x62, 0xb3, 0xf4, 0xe0, 0x48, 0xee, 0xca, 0xa1, 0x03, 0x08, 0x15, 0xa5, 0x47, 0x3c,
-	0x39, 0xd2, 0xe2, 0x47, 0xdd, 0x7e, 0x7b, 0x35, 0x35, 0xc0, 0xf5, 0xd4, 0x00, 0xbf, 0xa6, 0x06,
-	0xb8, 0x9c, 0x19, 0x85, 0xeb, 0x99, 0x51, 0xf8, 0x31, 0x33, 0x0a, 0x1f, 0x9e, 0xfb, 0x44, 0x8c,
-	0x92, 0x7e, 0x9a, 0xd6, 0xa6, 0x47, 0xf1, 0xcb, 0x91, 0x3d, 0x9e, 0xbf, 0x48, 0x62, 0x12, 0x61,
-	0xde, 0xaf, 0xc8, 0xfb, 0x3a, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x18, 0x9c, 0xca, 0x48,
-	0x05, 0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6a, 0xdb, 0x4c,
+	0x14, 0xf5, 0xd8, 0x8e, 0x6d, 0x8d, 0x8d, 0x49, 0x86, 0xf0, 0x31, 0x9f, 0x69, 0x64, 0x21, 0x68,
+	0x31, 0x5d, 0x48, 0x25, 0x69, 0x37, 0x85, 0x2e, 0x62, 0x4c, 0x89, 0xbb, 0x28, 0xa9, 0x92, 0x45,
+	0x29, 0x05, 0x23, 0x59, 0x63, 0x59, 0x54, 0xd2, 0xa8, 0x9a, 0x51, 0xb1, 0xf3, 0x14, 0x79, 0x93,
+	0xbe, 0x46, 0xa0, 0x9b, 0xec, 0xda, 0x55, 0x5a, 0xec, 0x37, 0xe8, 0x13, 0x14, 0x8d, 0x14, 0x2b,
+	0xfe, 0x6b, 0x20, 0xd0, 0x9d, 0xef, 0xf5, 0x3d, 0x87, 0x73, 0xcf, 0x3d, 0x1a, 0xa8, 0xdb, 0x53,
+	0x9f, 0x04, 0xcc, 0xa5, 0xc1, 0x64, 0x7a, 0x91, 0x17, 0x7a, 0x44, 0x3d, 0xcf, 0x0c, 0x43, 0x9d,
+	0x71, 0x93, 0x93, 0x81, 0x1b, 0x8c, 0xa8, 0x16, 0x46, 0x94, 0x53, 0x24, 0xdf, 0x05, 0x68, 0x8b,
+	0x42, 0xcb, 0x00, 0xad, 0x7d, 0x87, 0x3a, 0x54, 0x8c, 0xea, 0xc9, 0xaf, 0x14, 0xd5, 0x6a, 0x3b,
+	0x94, 0x3a, 0x1e, 0xd1, 0x45, 0x65, 0xc5, 0x23, 0x9d, 0xbb, 0x3e, 0x61, 0xdc, 0xf4, 0xc3, 0x6c,
+	0xe0, 0xc5, 0x3d, 0x3a, 0x2c, 0x8f, 0x0e, 0x3f, 0x0d, 0x6c, 0xc2, 0x86, 0x91, 0x1b, 0x72, 0x1a,
+	0x65, 0xb0, 0xa7, 0x5b, 0x60, 0x43, 0xea, 0xfb, 0x34, 0x10, 0xea, 0x63, 0x96, 0xce, 0xaa, 0x3d,
+	0xd8, 0x3c, 0x4b, 0xb6, 0xe9, 0x07, 0x23, 0xda, 0x0f, 0x6c, 0x32, 0x41, 0x8f, 0xa0, 0x94, 0xf1,
+	0xf7, 0x6d, 0x0c, 0x14, 0xd0, 0x91, 0x8c, 0xbc, 0x81, 0xf6, 0xe1, 0x8e, 0x9b, 0x8c, 0xe1, 0xa2,
+	0x02, 0x3a, 0x65, 0x23, 0x2d, 0xd4, 0xaf, 0x65, 0x28, 0x2d, 0x68, 0xd0, 0x47, 0xd8, 0x64, 0x4b,
+	0x9c, 0x82, 0xa6, 0x7e, 0xa8, 0x69, 0x7f, 0xb7, 0x49, 0x5b, 0x56, 0xd2, 0x2d, 0x5f, 0xdd, 0xb4,
+	0x0b, 0x46, 0x93, 0xad, 0xe9, 0x63, 0xe4, 0x73, 0x4c, 0x82, 0x21, 0x89, 0x84, 0x0a, 0xc9, 0xc8,
+	0x1b, 0x48, 0x81, 0x75, 0xc6, 0xcd, 0x88, 0x9f, 0x10, 0xd7, 0x19, 0x73, 0x5c, 0x12, 0x2a, 0xef,
+	0xb6, 0x12, 0x7c, 0x10, 0xfb, 0xdd, 0xc4, 0x3a, 0x86, 0xcb, 0xe2, 0xff, 0xbc, 0x81, 0xfe, 0x83,
+	0x95, 0xde, 0xf1, 0xa9, 0xc9, 0xc7, 0x78, 0x47, 0x50, 0x67, 0x15, 0x7a, 0x02, 0x9b, 0xc3, 0x88,
+	0x98, 0xdc, 0xa5, 0x41, 0x46, 0x5d, 0x15, 0xd0, 0x95, 0x2e, 0x7a, 0x05, 0x2b, 0xa9, 0xbf, 0xb8,
+	0xa6, 0x80, 0x4e, 0xf3, 0xf0, 0xf1, 0xb6, 0x9d, 0xd3, 0x63, 0x88, 0x95, 0x63, 0x66, 0x64, 0x20,
+	0x74, 0x02, 0x4b, 0xdd, 0x1e, 0xc3, 0x92, 0xf0, 0xeb, 0xd9, 0x7d, 0x7e, 0x09, 0xcd, 0xbd, 0xc5,
+	0xf9, 0x59, 0xe6, 0x58, 0x42, 0x81, 0xde, 0x43, 0x28, 0xa4, 0x11, 0x7b, 0x60, 0x72, 0x0c, 0x05,
+	0x61, 0x4b, 0x4b, 0x13, 0xa7, 0xdd, 0x26, 0x4e, 0x3b, 0xbf, 0x4d, 0x5c, 0xf7, 0x20, 0x81, 0xfe,
+	0xbe, 0x69, 0xef, 0x4d, 0x4d, 0xdf, 0x7b, 0xa9, 0xe6, 0x58, 0xf5, 0xf2, 0x67, 0x1b, 0x18, 0x52,
+	0xd6, 0x38, 0xe6, 0x48, 0x85, 0x8d, 0x80, 0x4c, 0xf8, 0x69, 0x44, 0x43, 0xca, 0x48, 0x84, 0xeb,
+	0xc2, 0xa8, 0xa5, 0x1e, 0xfa, 0x1f, 0xd6, 0x2c, 0x9b, 0x0d, 0x22, 0x4a, 0x39, 0x6e, 0x28, 0xa0,
+	0xd3, 0x30, 0xaa, 0x96, 0xcd, 0x0c, 0x4a, 0xf9, 0x9b, 0x72, 0xad, 0xb2, 0x5b, 0x55, 0xbf, 0x03,
+	0xb8, 0xbb, 0x38, 0xf7, 0x59, 0xec, 0xfb, 0x66, 0x34, 0xfd, 0xc7, 0xc1, 0xc9, 0x4f, 0x53, 0x7c,
+	0xc8, 0x69, 0xd6, 0x13, 0x50, 0xda, 0x94, 0x00, 0xf5, 0x1b, 0x80, 0xb2, 0x38, 0x4c, 0x5a, 0x9f,
+	0xd3, 0xd7, 0x6e, 0x60, 0x7a, 0xee, 0x85, 0x98, 0x79, 0x17, 0x93, 0x98, 0x6c, 0xa0, 0x02, 0x1b,
+	0xc3, 0x64, 0xc1, 0xbd, 0xd1, 0x2a, 0x18, 0x17, 0x95, 0xd2, 0x83, 0x2d, 0x59, 0xa7, 0x43, 0x07,
+	0x10, 0x66, 0x90, 0x81, 0x6b, 0xe3, 0xd2, 0xca, 0xf7, 0xde, 0x7d, 0x7b, 0x35, 0x93, 0xc1, 0xf5,
+	0x4c, 0x06, 0xbf, 0x66, 0x32, 0xb8, 0x9c, 0xcb, 0x85, 0xeb, 0xb9, 0x5c, 0xf8, 0x31, 0x97, 0x0b,
+	0x1f, 0x9e, 0x3b, 0x2e, 0x1f, 0xc7, 0x56, 0xe2, 0xd6, 0xb6, 0xf7, 0xf2, 0xcb, 0x91, 0x3e, 0x59,
+	0x3c, 0x56, 0x7c, 0x1a, 0x12, 0x66, 0x55, 0x44, 0xf4, 0x8e, 0xfe, 0x0c, 0x00, 0x13, 0x15, 0x4e,
+	0x5a, 0x63, 0x05, 0x00, 0x00,
 }
 
 func (m *StateInfoIndex) Marshal() (dAtA []byte, err error) {
@@ -463,6 +475,13 @@ func (m *StateInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BdsRoot) > 0 {
+		i -= len(m.BdsRoot)
+		copy(dAtA[i:], m.BdsRoot)
+		i = encodeVarintStateInfo(dAtA, i, uint64(len(m.BdsRoot)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.NextProposer) > 0 {
 		i -= len(m.NextProposer)
 		copy(dAtA[i:], m.NextProposer)
@@ -690,6 +709,10 @@ func (m *StateInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStateInfo(uint64(l))
 	}
+	l = len(m.BdsRoot)
+	if l > 0 {
+		n += 1 + l + sovStateInfo(uint64(l))
+	}
 	return n
 }
 
@@ -1139,6 +1162,40 @@ func (m *StateInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.NextProposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BdsRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BdsRoot = append(m.BdsRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BdsRoot == nil {
+				m.BdsRoot = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStateInfo(dAtA[iNdEx:])
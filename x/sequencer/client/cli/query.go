@@ -27,6 +27,12 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetProposerByRollapp())
 	cmd.AddCommand(CmdGetNextProposerByRollapp())
 	cmd.AddCommand(CmdGetAllProposers())
+	cmd.AddCommand(CmdShowDelegations())
+	cmd.AddCommand(CmdShowProposerSelection())
+	cmd.AddCommand(CmdShowSequencersPerformance())
+	cmd.AddCommand(CmdShowBondAssets())
+	cmd.AddCommand(CmdShowSequencerBond())
+	cmd.AddCommand(CmdShowHandover())
 
 	return cmd
 }
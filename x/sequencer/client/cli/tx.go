@@ -35,6 +35,13 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdDecreaseBond())
 	cmd.AddCommand(CmdKickProposer())
 	cmd.AddCommand(CmdUpdateOptInStatus())
+	cmd.AddCommand(CmdDelegate())
+	cmd.AddCommand(CmdUndelegate())
+	cmd.AddCommand(CmdUpdateCommission())
+	cmd.AddCommand(CmdDistributeRewards())
+	cmd.AddCommand(CmdWithdrawDelegatorRewards())
+	cmd.AddCommand(CmdUpdateProposerSelection())
+	cmd.AddCommand(CmdAcceptProposership())
 
 	return cmd
 }
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+const FlagFirstStateRoot = "first-state-root"
+
+func CmdAcceptProposership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept-proposership",
+		Short:   "Accept to become the proposer of the rollapp, as its awaited successor",
+		Example: "dymd tx sequencer accept-proposership --first-state-root 0a1b... --from successor",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rootHex, err := cmd.Flags().GetString(FlagFirstStateRoot)
+			if err != nil {
+				return err
+			}
+			root, err := hex.DecodeString(rootHex)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAcceptProposership{
+				Creator:        clientCtx.GetFromAddress().String(),
+				FirstStateRoot: root,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFirstStateRoot, "", "Hex-encoded state root of the first block of the successor (optional)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func CmdDelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delegate [sequencer-addr] [amount]",
+		Short:   "Delegate tokens to the bond of a sequencer",
+		Example: "dymd tx sequencer delegate <sequencer-addr> 100dym --from foouser",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDelegate{
+				Delegator: clientCtx.GetFromAddress().String(),
+				Sequencer: args[0],
+				Amount:    amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUndelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "undelegate [sequencer-addr] [amount]",
+		Short:   "Withdraw delegated tokens from the bond of a sequencer",
+		Example: "dymd tx sequencer undelegate <sequencer-addr> 100dym --from foouser",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUndelegate{
+				Delegator: clientCtx.GetFromAddress().String(),
+				Sequencer: args[0],
+				Amount:    amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateCommission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-commission [rate]",
+		Short:   "Update the fraction of the delegators' rewards kept by the sequencer",
+		Example: "dymd tx sequencer update-commission 0.1 --from foouser",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rate, err := math.LegacyNewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateCommission{
+				Creator:        clientCtx.GetFromAddress().String(),
+				CommissionRate: rate,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDistributeRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "distribute-rewards [sequencer-addr] [rewards]",
+		Short:   "Pay rewards to the delegation rewards account of a sequencer and share its balance with the delegators",
+		Example: "dymd tx sequencer distribute-rewards <sequencer-addr> 100dym --from reward-address",
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var rewards sdk.Coins
+			if len(args) == 2 {
+				rewards, err = sdk.ParseCoinsNormalized(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgDistributeRewards{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Sequencer: args[0],
+				Amount:    rewards,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWithdrawDelegatorRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-delegator-rewards [sequencer-addr]",
+		Short:   "Withdraw the rewards accrued to a delegation",
+		Example: "dymd tx sequencer withdraw-delegator-rewards <sequencer-addr> --from delegator",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdrawDelegatorRewards{
+				Delegator: clientCtx.GetFromAddress().String(),
+				Sequencer: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+const (
+	FlagOwnerList              = "owner-list"
+	FlagRotationIntervalBlocks = "rotation-interval-blocks"
+)
+
+func CmdUpdateProposerSelection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-proposer-selection [rollapp-id] [highest-bond|round-robin|stake-weighted-random|owner-list]",
+		Short: "Set how the proposers of a rollapp are chosen",
+		Long: `Set how the proposers of a rollapp are chosen. Must be signed by the rollapp owner or sequencer whitelister.
+Owner list is a comma-separated list of sequencer addresses, in order of preference, required by the owner-list algo.
+Rotation interval makes the proposer start its notice period every interval blocks. Zero disables scheduled rotations.`,
+		Example: "dymd tx sequencer update-proposer-selection ROLLAPP_CHAIN_ID round-robin --rotation-interval-blocks 100800 --from owner",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			algo, ok := types.ProposerSelectionAlgo_value["PROPOSER_SELECTION_ALGO_"+strings.ToUpper(strings.ReplaceAll(args[1], "-", "_"))]
+			if !ok {
+				return fmt.Errorf("invalid algo: %s", args[1])
+			}
+			ownerList, err := cmd.Flags().GetStringSlice(FlagOwnerList)
+			if err != nil {
+				return err
+			}
+			interval, err := cmd.Flags().GetUint64(FlagRotationIntervalBlocks)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateProposerSelection{
+				Owner:                  clientCtx.GetFromAddress().String(),
+				RollappId:              args[0],
+				Algo:                   types.ProposerSelectionAlgo(algo),
+				OwnerList:              ownerList,
+				RotationIntervalBlocks: interval,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagOwnerList, nil, "Ordered list of preferred sequencer addresses")
+	cmd.Flags().Uint64(FlagRotationIntervalBlocks, 0, "Interval of scheduled rotations in blocks")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
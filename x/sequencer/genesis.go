@@ -27,6 +27,51 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.GenesisSuccessors {
 		k.SetSuccessor(ctx, elem.RollappId, elem.Address)
 	}
+
+	for _, elem := range genState.DelegationPools {
+		if err := k.SetDelegationPool(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.Delegations {
+		if err := k.SetDelegation(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.UnbondingDelegations {
+		if err := k.SetUnbondingDelegation(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.ProposerSelections {
+		if err := k.SetProposerSelection(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	// after the proposers, which open a new term as proposer
+	for _, elem := range genState.SequencerMetrics {
+		k.SetSequencerMetrics(ctx, elem)
+	}
+	for _, elem := range genState.BondAssets {
+		if err := k.SetBondAssets(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.Collaterals {
+		if err := k.SetCollateral(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.BondAssetPrices {
+		if err := k.SetBondAssetPrice(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.Handovers {
+		if err := k.SetHandover(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
@@ -58,5 +103,39 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 		genesis.NoticeQueue = append(genesis.NoticeQueue, seq.Address)
 	}
 
+	genesis.DelegationPools, err = k.AllDelegationPools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Delegations, err = k.AllDelegations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.UnbondingDelegations, err = k.AllUnbondingDelegations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.ProposerSelections, err = k.AllProposerSelections(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.SequencerMetrics = k.AllSequencerMetrics(ctx)
+	genesis.BondAssets, err = k.AllBondAssets(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Collaterals, err = k.AllCollaterals(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.BondAssetPrices, err = k.AllBondAssetPrices(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Handovers, err = k.AllHandovers(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &genesis
 }
@@ -4,7 +4,6 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
-	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
 )
 
 // UnbondBlocker allows vetoing unbond attempts
@@ -15,8 +14,9 @@ type UnbondBlocker interface {
 
 // TryUnbond will try to either partially or totally unbond a sequencer.
 // The sequencer may not be allowed to unbond, based on certain conditions.
-// A partial unbonding refunds tokens, but doesn't allow the remaining bond to fall below a threshold.
-// A total unbond refunds all tokens and changes status to unbonded.
+// A partial unbonding refunds tokens or collateral, but doesn't allow the remaining bond value to fall below a
+// threshold, and can only take tokens the sequencer bonded itself.
+// A total unbond refunds all tokens, including the delegated ones and the collateral, and changes status to unbonded.
 func (k Keeper) TryUnbond(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) error {
 	if k.IsProposer(ctx, *seq) || k.IsSuccessor(ctx, *seq) {
 		return types.ErrUnbondProposerOrSuccessor
@@ -26,17 +26,27 @@ func (k Keeper) TryUnbond(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) e
 			return errorsmod.Wrap(err, "other module")
 		}
 	}
-	bond := seq.TokensCoin()
-	minBond := k.rollappKeeper.MinBond(ctx, seq.RollappId)
-	maxReduction, _ := bond.SafeSub(minBond)
-	isPartial := !amt.IsEqual(bond)
-	if isPartial && maxReduction.IsLT(amt) {
-		return errorsmod.Wrapf(types.ErrUnbondNotAllowed,
-			"attempted reduction: %s, max reduction: %s",
-			amt, ucoin.NonNegative(maxReduction),
-		)
+	// the rewards paid so far are shared according to the bond they were paid for
+	if err := k.accrueRewards(ctx, *seq); err != nil {
+		return errorsmod.Wrap(err, "accrue rewards")
 	}
-	if err := k.refund(ctx, seq, amt); err != nil {
+	if amt.Denom != seq.TokensCoin().Denom {
+		return errorsmod.Wrap(k.withdrawCollateral(ctx, *seq, amt), "withdraw collateral")
+	}
+	isPartial := !amt.IsEqual(seq.TokensCoin())
+	if isPartial {
+		if err := k.checkBondReduction(ctx, *seq, amt, amt.Amount); err != nil {
+			return err
+		}
+		if selfBond := k.SelfBond(ctx, *seq); selfBond.IsLT(amt) {
+			return errorsmod.Wrapf(types.ErrUnbondNotAllowed,
+				"attempted reduction: %s, self bond: %s", amt, selfBond,
+			)
+		}
+		if err := k.refund(ctx, seq, amt); err != nil {
+			return errorsmod.Wrap(err, "refund")
+		}
+	} else if err := k.refundAll(ctx, seq); err != nil {
 		return errorsmod.Wrap(err, "refund")
 	}
 	if seq.Tokens.IsZero() {
@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// GetBondAssets returns the non-DYM denoms accepted in the bonds of the sequencers of the rollapp
+func (k Keeper) GetBondAssets(ctx sdk.Context, rollapp string) types.RollappBondAssets {
+	a, err := k.bondAssets.Get(ctx, rollapp)
+	if err != nil {
+		return types.RollappBondAssets{RollappId: rollapp}
+	}
+	return a
+}
+
+func (k Keeper) SetBondAssets(ctx sdk.Context, a types.RollappBondAssets) error {
+	return k.bondAssets.Set(ctx, a.RollappId, a)
+}
+
+func (k Keeper) AllBondAssets(ctx sdk.Context) ([]types.RollappBondAssets, error) {
+	iter, err := k.bondAssets.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// GetBondAssetPrice returns the reference price of the asset in the pool, false if there is none yet
+func (k Keeper) GetBondAssetPrice(ctx sdk.Context, poolID uint64, denom string) (types.BondAssetPrice, bool) {
+	p, err := k.bondAssetPrices.Get(ctx, collections.Join(poolID, denom))
+	if err != nil {
+		return types.BondAssetPrice{}, false
+	}
+	return p, true
+}
+
+func (k Keeper) SetBondAssetPrice(ctx sdk.Context, p types.BondAssetPrice) error {
+	return k.bondAssetPrices.Set(ctx, collections.Join(p.PoolId, p.Denom), p)
+}
+
+func (k Keeper) AllBondAssetPrices(ctx sdk.Context) ([]types.BondAssetPrice, error) {
+	iter, err := k.bondAssetPrices.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// UpdateBondAssetPrices sets the reference price of every bond asset to its spot price. An asset whose spot price
+// can't be computed keeps its previous reference price.
+func (k Keeper) UpdateBondAssetPrices(ctx sdk.Context) error {
+	if k.priceSource == nil {
+		return nil
+	}
+	all, err := k.AllBondAssets(ctx)
+	if err != nil {
+		return err
+	}
+	for _, assets := range all {
+		for _, a := range assets.Assets {
+			price, err := k.priceSource.CalculateSpotPrice(ctx, a.PoolId, commontypes.DYMCoin.Denom, a.Denom)
+			if err != nil {
+				k.Logger(ctx).Error("Bond asset price.", "denom", a.Denom, "pool", a.PoolId, "err", err)
+				continue
+			}
+			if err := k.SetBondAssetPrice(ctx, types.BondAssetPrice{PoolId: a.PoolId, Denom: a.Denom, Price: price}); err != nil {
+				return errorsmod.Wrap(err, "set bond asset price")
+			}
+		}
+	}
+	return nil
+}
+
+// GetCollateral returns the non-DYM bond of the sequencer
+func (k Keeper) GetCollateral(ctx sdk.Context, seqAddr string) sdk.Coins {
+	c, err := k.collaterals.Get(ctx, seqAddr)
+	if err != nil {
+		return sdk.NewCoins()
+	}
+	return c.Coins
+}
+
+// SetCollateral : an empty collateral is removed
+func (k Keeper) SetCollateral(ctx sdk.Context, c types.Collateral) error {
+	if c.Coins.IsZero() {
+		err := k.collaterals.Remove(ctx, c.Sequencer)
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	return k.collaterals.Set(ctx, c.Sequencer, c)
+}
+
+func (k Keeper) AllCollaterals(ctx sdk.Context) ([]types.Collateral, error) {
+	iter, err := k.collaterals.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// BondValue is the value of the sequencer bond in DYM: its DYM tokens, plus its collateral in the assets accepted by
+// the rollapp, at the lower of the spot and reference prices and net of the haircuts. Bond thresholds and proposer choice are based on it.
+func (k Keeper) BondValue(ctx sdk.Context, seq types.Sequencer) math.Int {
+	ret := seq.TokensCoin().Amount
+	if seq.Sentinel() {
+		return ret
+	}
+	assets := k.GetBondAssets(ctx, seq.RollappId)
+	for _, c := range k.GetCollateral(ctx, seq.Address) {
+		if a, ok := assets.Get(c.Denom); ok {
+			ret = ret.Add(k.assetValue(ctx, a, c.Amount))
+		}
+	}
+	return ret
+}
+
+// assetValue is the value in DYM of amt of the asset, net of the haircut. The asset is valued at the lower of its
+// spot price and its reference price of the last price epoch, so pushing the spot price up in the pool doesn't
+// inflate the bond. Zero if there is no price.
+func (k Keeper) assetValue(ctx sdk.Context, a types.BondAsset, amt math.Int) math.Int {
+	if k.priceSource == nil {
+		return math.ZeroInt()
+	}
+	price, err := k.priceSource.CalculateSpotPrice(ctx, a.PoolId, commontypes.DYMCoin.Denom, a.Denom)
+	if err != nil {
+		k.Logger(ctx).Error("Bond asset price.", "denom", a.Denom, "pool", a.PoolId, "err", err)
+		return math.ZeroInt()
+	}
+	ref, ok := k.GetBondAssetPrice(ctx, a.PoolId, a.Denom)
+	if !ok {
+		k.Logger(ctx).Error("Bond asset reference price not found.", "denom", a.Denom, "pool", a.PoolId)
+		return math.ZeroInt()
+	}
+	price = math.LegacyMinDec(price, ref.Price)
+	return price.Mul(math.LegacyOneDec().Sub(a.Haircut)).MulInt(amt).TruncateInt()
+}
+
+// checkBondReduction returns an error if taking value out of the bond would take it below the minimum
+func (k Keeper) checkBondReduction(ctx sdk.Context, seq types.Sequencer, reduction sdk.Coin, value math.Int) error {
+	minBond := k.rollappKeeper.MinBond(ctx, seq.RollappId)
+	maxReduction := sdk.Coin{Denom: minBond.Denom, Amount: k.BondValue(ctx, seq).Sub(minBond.Amount)}
+	if maxReduction.Amount.LT(value) {
+		return errorsmod.Wrapf(types.ErrUnbondNotAllowed,
+			"attempted reduction: %s, max reduction: %s",
+			reduction, ucoin.NonNegative(maxReduction),
+		)
+	}
+	return nil
+}
+
+// addCollateral charges the sequencer amt of an asset accepted by its rollapp
+func (k Keeper) addCollateral(ctx sdk.Context, seq types.Sequencer, amt sdk.Coin) error {
+	if _, ok := k.GetBondAssets(ctx, seq.RollappId).Get(amt.Denom); !ok {
+		return errorsmod.Wrapf(types.ErrInvalidDenom, "not a bond asset of the rollapp: %s", amt.Denom)
+	}
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, seq.AccAddr(), types.ModuleName, sdk.NewCoins(amt))
+	if err != nil {
+		return errorsmod.Wrap(err, "send coins")
+	}
+	return k.SetCollateral(ctx, types.Collateral{
+		Sequencer: seq.Address,
+		Coins:     k.GetCollateral(ctx, seq.Address).Add(amt),
+	})
+}
+
+// withdrawCollateral refunds amt of the collateral to the sequencer, as long as the bond value stays above the
+// minimum. Collateral in an asset the rollapp no longer accepts has no value, so can always be withdrawn.
+func (k Keeper) withdrawCollateral(ctx sdk.Context, seq types.Sequencer, amt sdk.Coin) error {
+	coll := k.GetCollateral(ctx, seq.Address)
+	if coll.AmountOf(amt.Denom).LT(amt.Amount) {
+		return errorsmod.Wrapf(types.ErrUnbondNotAllowed, "attempted reduction: %s, collateral: %s", amt, coll)
+	}
+	value := math.ZeroInt()
+	if a, ok := k.GetBondAssets(ctx, seq.RollappId).Get(amt.Denom); ok {
+		value = k.assetValue(ctx, a, amt.Amount)
+	}
+	if err := k.checkBondReduction(ctx, seq, amt, value); err != nil {
+		return err
+	}
+	if err := k.SetCollateral(ctx, types.Collateral{Sequencer: seq.Address, Coins: coll.Sub(amt)}); err != nil {
+		return errorsmod.Wrap(err, "set collateral")
+	}
+	return errorsmod.Wrap(
+		k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, seq.AccAddr(), sdk.NewCoins(amt)),
+		"send coins",
+	)
+}
+
+// refundCollateral refunds all the collateral to the sequencer
+func (k Keeper) refundCollateral(ctx sdk.Context, seq types.Sequencer) error {
+	coll := k.GetCollateral(ctx, seq.Address)
+	if coll.IsZero() {
+		return nil
+	}
+	if err := k.SetCollateral(ctx, types.Collateral{Sequencer: seq.Address}); err != nil {
+		return errorsmod.Wrap(err, "set collateral")
+	}
+	return errorsmod.Wrap(
+		k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, seq.AccAddr(), coll),
+		"send coins",
+	)
+}
+
+// slashCollateral takes frac of the collateral, sending the reward part to the rewardee and burning the rest
+func (k Keeper) slashCollateral(ctx sdk.Context, seq types.Sequencer, frac, rewardMul math.LegacyDec, rewardee sdk.AccAddress) error {
+	coll := k.GetCollateral(ctx, seq.Address)
+	slashed := sdk.NewCoins(ucoin.MulDec(frac, coll...)...)
+	if slashed.IsZero() {
+		return nil
+	}
+	if err := k.SetCollateral(ctx, types.Collateral{Sequencer: seq.Address, Coins: coll.Sub(slashed...)}); err != nil {
+		return errorsmod.Wrap(err, "set collateral")
+	}
+	reward := sdk.NewCoins(ucoin.MulDec(rewardMul, slashed...)...)
+	if !reward.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, rewardee, reward)
+		if err != nil {
+			return errorsmod.Wrap(err, "send")
+		}
+	}
+	return errorsmod.Wrap(k.bankKeeper.BurnCoins(ctx, types.ModuleName, slashed.Sub(reward...)), "burn")
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) TestMultiAssetBond() {
+	// foo is worth 1 DYM
+	poolID := s.PrepareDefaultPool()
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, charlie, bond)
+	foo := func(mul int64) sdk.Coin {
+		return sdk.NewCoin("foo", bond.Amount.MulRaw(mul))
+	}
+
+	// only gov can accept foo, at a 50% haircut
+	m := &types.MsgUpdateBondAssets{
+		Authority: pkAddr(alice),
+		RollappId: ra.RollappId,
+		Assets:    []types.BondAsset{{Denom: "foo", Haircut: math.LegacyNewDecWithPrec(5, 1), PoolId: poolID}},
+	}
+	_, err := s.msgServer.UpdateBondAssets(s.Ctx, m)
+	s.Require().Error(err)
+	m.Authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = s.msgServer.UpdateBondAssets(s.Ctx, m)
+	s.Require().NoError(err)
+
+	// bar is not accepted
+	s.FundAcc(pkAcc(bob), sdk.NewCoins(foo(4), sdk.NewCoin("bar", bond.Amount)))
+	_, err = s.msgServer.IncreaseBond(s.Ctx, &types.MsgIncreaseBond{Creator: pkAddr(bob), AddAmount: sdk.NewCoin("bar", bond.Amount)})
+	utest.IsErr(s.Require(), err, types.ErrInvalidDenom)
+
+	// bob adds foo worth 2 bonds, 1 after the haircut
+	_, err = s.msgServer.IncreaseBond(s.Ctx, &types.MsgIncreaseBond{Creator: pkAddr(bob), AddAmount: foo(4)})
+	s.Require().NoError(err)
+	res, err := s.k().SequencerBond(s.Ctx, &types.QuerySequencerBondRequest{Sequencer: pkAddr(bob)})
+	s.Require().NoError(err)
+	s.Require().True(res.Collateral.Equal(sdk.NewCoins(foo(4))))
+	s.Require().True(res.Value.IsEqual(sdk.NewCoin(bond.Denom, bond.Amount.MulRaw(3))))
+
+	// bob can take DYM out, as long as the value stays above the minimum
+	_, err = s.msgServer.DecreaseBond(s.Ctx, &types.MsgDecreaseBond{Creator: pkAddr(bob), DecreaseAmount: sdk.NewCoin(bond.Denom, bond.Amount.QuoRaw(2))})
+	s.Require().NoError(err)
+	_, err = s.msgServer.DecreaseBond(s.Ctx, &types.MsgDecreaseBond{Creator: pkAddr(bob), DecreaseAmount: foo(4)})
+	utest.IsErr(s.Require(), err, types.ErrUnbondNotAllowed)
+	_, err = s.msgServer.DecreaseBond(s.Ctx, &types.MsgDecreaseBond{Creator: pkAddr(bob), DecreaseAmount: foo(2)})
+	s.Require().NoError(err)
+	res, err = s.k().SequencerBond(s.Ctx, &types.QuerySequencerBondRequest{Sequencer: pkAddr(bob)})
+	s.Require().NoError(err)
+	s.Require().True(res.Value.IsEqual(sdk.NewCoin(bond.Denom, bond.Amount.MulRaw(3).QuoRaw(2))))
+
+	// bob has less DYM than charlie, but a more valuable bond, so is chosen as proposer
+	s.Require().True(s.seq(bob).TokensCoin().IsLT(s.seq(charlie).TokensCoin()))
+	s.k().SetProposer(s.Ctx, ra.RollappId, types.SentinelSeqAddr)
+	s.Require().NoError(s.k().ChooseProposerAfterSentinel(s.Ctx, ra.RollappId))
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(bob)))
+
+	// the collateral is slashed like the DYM bond
+	rewardee := pkAcc(randomTMPubKey())
+	s.Require().NoError(s.k().PunishSequencer(s.Ctx, pkAddr(bob), &rewardee))
+	s.Require().True(s.k().GetCollateral(s.Ctx, pkAddr(bob)).IsZero())
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, rewardee, "foo").IsEqual(foo(1)))
+	s.Require().True(s.moduleBalance().IsEqual(s.seq(alice).TokensCoin().Add(s.seq(charlie).TokensCoin())))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, s.App.AccountKeeper.GetModuleAddress(types.ModuleName), "foo").IsZero())
+}
+
+func (s *SequencerTestSuite) TestBondAssetReferencePrice() {
+	// foo is worth 1 DYM
+	poolID := s.PrepareDefaultPool()
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	foo := sdk.NewCoin("foo", bond.Amount.MulRaw(2))
+
+	_, err := s.msgServer.UpdateBondAssets(s.Ctx, &types.MsgUpdateBondAssets{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		RollappId: ra.RollappId,
+		Assets:    []types.BondAsset{{Denom: "foo", Haircut: math.LegacyZeroDec(), PoolId: poolID}},
+	})
+	s.Require().NoError(err)
+
+	// the reference price starts at the spot price
+	ref, ok := s.k().GetBondAssetPrice(s.Ctx, poolID, "foo")
+	s.Require().True(ok)
+	s.Require().True(ref.Price.Equal(math.LegacyOneDec()))
+
+	s.FundAcc(pkAcc(alice), sdk.NewCoins(foo))
+	_, err = s.msgServer.IncreaseBond(s.Ctx, &types.MsgIncreaseBond{Creator: pkAddr(alice), AddAmount: foo})
+	s.Require().NoError(err)
+	s.Require().Equal(bond.Amount.MulRaw(3), s.k().BondValue(s.Ctx, s.seq(alice)))
+
+	// the spot price is above the reference price, e.g. after a pump in the pool: the reference price counts
+	ref.Price = math.LegacyNewDecWithPrec(5, 1)
+	s.Require().NoError(s.k().SetBondAssetPrice(s.Ctx, ref))
+	s.Require().Equal(bond.Amount.MulRaw(2), s.k().BondValue(s.Ctx, s.seq(alice)))
+
+	// the reference price only follows the spot price at the end of the price epoch
+	hooks := s.k().EpochHooks()
+	s.Require().NoError(hooks.AfterEpochEnd(s.Ctx, "other", 1))
+	s.Require().Equal(bond.Amount.MulRaw(2), s.k().BondValue(s.Ctx, s.seq(alice)))
+	s.Require().NoError(hooks.AfterEpochEnd(s.Ctx, s.k().GetParams(s.Ctx).PriceEpochIdentifier, 1))
+	s.Require().Equal(bond.Amount.MulRaw(3), s.k().BondValue(s.Ctx, s.seq(alice)))
+}
@@ -0,0 +1,480 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// unbondingDelegationKey orders the undelegations by completion time: completion time (unix nanos), seq, delegator
+type unbondingDelegationKey = collections.Triple[int64, string, string]
+
+type unbondingDelegationIndexes struct {
+	// BySequencer is used to refund the undelegations of a sequencer without scanning the whole queue
+	BySequencer *indexes.Multi[string, unbondingDelegationKey, types.UnbondingDelegation]
+}
+
+func (i unbondingDelegationIndexes) IndexesList() []collections.Index[unbondingDelegationKey, types.UnbondingDelegation] {
+	return []collections.Index[unbondingDelegationKey, types.UnbondingDelegation]{i.BySequencer}
+}
+
+func makeUnbondingDelegations(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) *collections.IndexedMap[unbondingDelegationKey, types.UnbondingDelegation, unbondingDelegationIndexes] {
+	keyCodec := collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.StringKey)
+	return collections.NewIndexedMap(
+		sb,
+		types.UnbondingDelegationsKeyPrefix,
+		"unbondingDelegations",
+		keyCodec,
+		collcompat.ProtoValue[types.UnbondingDelegation](cdc),
+		unbondingDelegationIndexes{
+			BySequencer: indexes.NewMulti(
+				sb,
+				types.UnbondingDelegationsBySequencerKeyPrefix,
+				"unbondingDelegationsBySequencer",
+				collections.StringKey,
+				keyCodec,
+				func(k unbondingDelegationKey, _ types.UnbondingDelegation) (string, error) {
+					return k.K2(), nil
+				},
+			),
+		},
+	)
+}
+
+// GetDelegationPool returns the delegation pool of the sequencer, or an empty pool if there is none
+func (k Keeper) GetDelegationPool(ctx sdk.Context, seq string) types.DelegationPool {
+	pool, err := k.delegationPools.Get(ctx, seq)
+	if err != nil {
+		return types.NewDelegationPool(seq)
+	}
+	return pool
+}
+
+func (k Keeper) SetDelegationPool(ctx sdk.Context, pool types.DelegationPool) error {
+	return k.delegationPools.Set(ctx, pool.Sequencer, pool)
+}
+
+func (k Keeper) AllDelegationPools(ctx sdk.Context) ([]types.DelegationPool, error) {
+	iter, err := k.delegationPools.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (k Keeper) GetDelegation(ctx sdk.Context, seq, delegator string) (types.Delegation, bool) {
+	d, err := k.delegations.Get(ctx, collections.Join(seq, delegator))
+	if err != nil {
+		return types.NewDelegation(delegator, seq), false
+	}
+	return d, true
+}
+
+// SetDelegation stores the delegation, or deletes it if it has no shares left
+func (k Keeper) SetDelegation(ctx sdk.Context, d types.Delegation) error {
+	key := collections.Join(d.Sequencer, d.Delegator)
+	if d.Shares.IsZero() {
+		return k.delegations.Remove(ctx, key)
+	}
+	return k.delegations.Set(ctx, key, d)
+}
+
+func (k Keeper) SequencerDelegations(ctx sdk.Context, seq string) ([]types.Delegation, error) {
+	iter, err := k.delegations.Iterate(ctx, collections.NewPrefixedPairRange[string, string](seq))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (k Keeper) AllDelegations(ctx sdk.Context) ([]types.Delegation, error) {
+	iter, err := k.delegations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// SetUnbondingDelegation adds the undelegation to the queue. Undelegations of the same delegator from the same
+// sequencer completing at the same time are merged: the rewards of the existing one are withdrawn first, so the
+// merged undelegation keeps the rewards index of the new one.
+func (k Keeper) SetUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDelegation) error {
+	key := collections.Join3(ubd.CompletionTime.UnixNano(), ubd.Sequencer, ubd.Delegator)
+	existing, err := k.unbondingDelegations.Get(ctx, key)
+	if err == nil {
+		pool := k.GetDelegationPool(ctx, ubd.Sequencer)
+		if _, err := k.withdrawRewards(ctx, pool, existing.Delegator, existing.Shares, existing.RewardsIndex); err != nil {
+			return errorsmod.Wrap(err, "withdraw rewards")
+		}
+		ubd.Shares = ubd.Shares.Add(existing.Shares)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	return k.unbondingDelegations.Set(ctx, key, ubd)
+}
+
+func (k Keeper) AllUnbondingDelegations(ctx sdk.Context) ([]types.UnbondingDelegation, error) {
+	iter, err := k.unbondingDelegations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// SelfBond returns the part of the sequencer bond which was not delegated
+func (k Keeper) SelfBond(ctx sdk.Context, seq types.Sequencer) sdk.Coin {
+	bond := seq.TokensCoin()
+	return sdk.NewCoin(bond.Denom, bond.Amount.Sub(k.GetDelegationPool(ctx, seq.Address).Tokens))
+}
+
+// Delegate adds the tokens of the delegator to the bond of the sequencer, in exchange for shares of its
+// delegation pool. Returns the shares issued.
+func (k Keeper) Delegate(ctx sdk.Context, delegator sdk.AccAddress, seq *types.Sequencer, amt sdk.Coin) (math.LegacyDec, error) {
+	if !seq.Bonded() {
+		return math.LegacyDec{}, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "sequencer is not bonded")
+	}
+	if err := validBondDenom(amt); err != nil {
+		return math.LegacyDec{}, err
+	}
+	// the rewards paid so far belong to the current delegators
+	if err := k.accrueRewards(ctx, *seq); err != nil {
+		return math.LegacyDec{}, errorsmod.Wrap(err, "accrue rewards")
+	}
+
+	pool := k.GetDelegationPool(ctx, seq.Address)
+	shares, err := pool.SharesFor(amt.Amount)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if !shares.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidCoins, "amount too small: %s", amt)
+	}
+
+	if err := k.sendToModuleFrom(ctx, seq, amt, delegator); err != nil {
+		return math.LegacyDec{}, errorsmod.Wrap(err, "send to module")
+	}
+	pool.Add(amt.Amount, shares)
+	if err := k.SetDelegationPool(ctx, pool); err != nil {
+		return math.LegacyDec{}, errorsmod.Wrap(err, "set delegation pool")
+	}
+
+	d, _ := k.GetDelegation(ctx, seq.Address, delegator.String())
+	if _, err := k.withdrawRewards(ctx, pool, d.Delegator, d.Shares, d.RewardsIndex); err != nil {
+		return math.LegacyDec{}, errorsmod.Wrap(err, "withdraw rewards")
+	}
+	d.Shares = d.Shares.Add(shares)
+	d.RewardsIndex = pool.RewardsPerShare
+	if err := k.SetDelegation(ctx, d); err != nil {
+		return math.LegacyDec{}, errorsmod.Wrap(err, "set delegation")
+	}
+	return shares, nil
+}
+
+// Undelegate redeems the shares of the delegator worth amt. The tokens are refunded immediately, unless the
+// sequencer is the proposer or successor: then they are refunded when the notice period elapses, and can still
+// be slashed until then. Like a partial unbond, the undelegation can't take the bond below the minimum.
+// Returns the completion time of the undelegation if it is delayed.
+func (k Keeper) Undelegate(ctx sdk.Context, delegator string, seq *types.Sequencer, amt sdk.Coin) (*time.Time, error) {
+	if err := validBondDenom(amt); err != nil {
+		return nil, err
+	}
+	d, ok := k.GetDelegation(ctx, seq.Address, delegator)
+	if !ok {
+		return nil, errorsmod.Wrap(gerrc.ErrNotFound, "delegation")
+	}
+	if err := k.accrueRewards(ctx, *seq); err != nil {
+		return nil, errorsmod.Wrap(err, "accrue rewards")
+	}
+
+	pool := k.GetDelegationPool(ctx, seq.Address)
+	shares, err := pool.SharesFor(amt.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if shares.GT(d.Shares) {
+		// tolerate rounding when undelegating everything
+		if balance := pool.TokensFor(d.Shares); balance.LT(amt.Amount) {
+			return nil, errorsmod.Wrapf(gerrc.ErrOutOfRange, "delegation balance: %s", balance)
+		}
+		shares = d.Shares
+	}
+
+	for _, c := range k.unbondBlockers {
+		if err := c.CanUnbond(ctx, *seq); err != nil {
+			return nil, errorsmod.Wrap(err, "other module")
+		}
+	}
+	if seq.Bonded() {
+		if err := k.checkBondReduction(ctx, *seq, amt, amt.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := k.withdrawRewards(ctx, pool, delegator, d.Shares, d.RewardsIndex); err != nil {
+		return nil, errorsmod.Wrap(err, "withdraw rewards")
+	}
+	d.Shares = d.Shares.Sub(shares)
+	d.RewardsIndex = pool.RewardsPerShare
+	if err := k.SetDelegation(ctx, d); err != nil {
+		return nil, errorsmod.Wrap(err, "set delegation")
+	}
+
+	if k.IsProposer(ctx, *seq) || k.IsSuccessor(ctx, *seq) {
+		completion := ctx.BlockTime().Add(k.GetParams(ctx).NoticePeriod)
+		err := k.SetUnbondingDelegation(ctx, types.UnbondingDelegation{
+			Delegator:      delegator,
+			Sequencer:      seq.Address,
+			Shares:         shares,
+			CompletionTime: completion,
+			RewardsIndex:   pool.RewardsPerShare,
+		})
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "set unbonding delegation")
+		}
+		return &completion, nil
+	}
+
+	return nil, k.redeemShares(ctx, seq, delegator, shares, pool.RewardsPerShare)
+}
+
+// redeemShares sends the delegator the rewards accrued to the shares since the index, and refunds the tokens the
+// shares are worth. The rewards must be accrued beforehand.
+func (k Keeper) redeemShares(ctx sdk.Context, seq *types.Sequencer, delegator string, shares math.LegacyDec, index sdk.DecCoins) error {
+	pool := k.GetDelegationPool(ctx, seq.Address)
+	if _, err := k.withdrawRewards(ctx, pool, delegator, shares, index); err != nil {
+		return errorsmod.Wrap(err, "withdraw rewards")
+	}
+	amt := sdk.NewCoin(seq.TokensCoin().Denom, pool.Remove(shares))
+	if err := k.SetDelegationPool(ctx, pool); err != nil {
+		return errorsmod.Wrap(err, "set delegation pool")
+	}
+	if amt.IsPositive() {
+		delegatorAddr, err := sdk.AccAddressFromBech32(delegator)
+		if err != nil {
+			return err
+		}
+		if err := k.sendFromModule(ctx, seq, amt, delegatorAddr); err != nil {
+			return errorsmod.Wrap(err, "send tokens")
+		}
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventUndelegated{
+		Delegator: delegator,
+		Sequencer: seq.Address,
+		Amount:    amt,
+		Shares:    shares,
+	})
+}
+
+// CompleteUnbondingDelegations refunds the undelegations whose notice period elapsed.
+// An undelegation which fails is logged and dropped from the queue, so it doesn't block the others.
+func (k Keeper) CompleteUnbondingDelegations(ctx sdk.Context, now time.Time) error {
+	rng := new(collections.Range[collections.Triple[int64, string, string]]).
+		EndExclusive(collections.TriplePrefix[int64, string, string](now.UnixNano() + 1))
+	iter, err := k.unbondingDelegations.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		ubd := kv.Value
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			seq, err := k.RealSequencer(ctx, ubd.Sequencer)
+			if err != nil {
+				return err
+			}
+			if err := k.accrueRewards(ctx, seq); err != nil {
+				return errorsmod.Wrap(err, "accrue rewards")
+			}
+			if err := k.redeemShares(ctx, &seq, ubd.Delegator, ubd.Shares, ubd.RewardsIndex); err != nil {
+				return err
+			}
+			k.SetSequencer(ctx, seq)
+			return nil
+		})
+		if err != nil {
+			k.Logger(ctx).Error("Complete unbonding delegation.", "sequencer", ubd.Sequencer, "delegator", ubd.Delegator, "err", err)
+		}
+		if err := k.unbondingDelegations.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// refundDelegators refunds every delegation and pending undelegation of the sequencer
+func (k Keeper) refundDelegators(ctx sdk.Context, seq *types.Sequencer) error {
+	if err := k.accrueRewards(ctx, *seq); err != nil {
+		return errorsmod.Wrap(err, "accrue rewards")
+	}
+	dels, err := k.SequencerDelegations(ctx, seq.Address)
+	if err != nil {
+		return errorsmod.Wrap(err, "delegations")
+	}
+	for _, d := range dels {
+		if err := k.redeemShares(ctx, seq, d.Delegator, d.Shares, d.RewardsIndex); err != nil {
+			return errorsmod.Wrap(err, "redeem shares")
+		}
+		if err := k.delegations.Remove(ctx, collections.Join(d.Sequencer, d.Delegator)); err != nil {
+			return err
+		}
+	}
+
+	iter, err := k.unbondingDelegations.Indexes.BySequencer.MatchExact(ctx, seq.Address)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.PrimaryKeys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		ubd, err := k.unbondingDelegations.Get(ctx, key)
+		if err != nil {
+			return errorsmod.Wrap(err, "unbonding delegation")
+		}
+		if err := k.redeemShares(ctx, seq, ubd.Delegator, ubd.Shares, ubd.RewardsIndex); err != nil {
+			return errorsmod.Wrap(err, "redeem shares")
+		}
+		if err := k.unbondingDelegations.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// refundAll refunds the delegators, then the rest of the bond and the collateral to the sequencer
+func (k Keeper) refundAll(ctx sdk.Context, seq *types.Sequencer) error {
+	if err := k.refundDelegators(ctx, seq); err != nil {
+		return errorsmod.Wrap(err, "refund delegators")
+	}
+	if err := k.refundCollateral(ctx, *seq); err != nil {
+		return errorsmod.Wrap(err, "refund collateral")
+	}
+	if seq.Tokens.IsZero() {
+		return nil
+	}
+	return k.refund(ctx, seq, seq.TokensCoin())
+}
+
+// slashDelegators takes the delegators' part of a slash of amt from the sequencer bond
+func (k Keeper) slashDelegators(ctx sdk.Context, seq types.Sequencer, amt sdk.Coin) error {
+	pool := k.GetDelegationPool(ctx, seq.Address)
+	bond := seq.TokensCoin().Amount
+	if pool.Tokens.IsZero() || bond.IsZero() {
+		return nil
+	}
+	pool.Tokens = pool.Tokens.Sub(pool.Tokens.Mul(amt.Amount).Quo(bond))
+	return k.SetDelegationPool(ctx, pool)
+}
+
+// DistributeRewards pays the rewards from the sender to the delegation rewards account of the sequencer, then
+// accrues the balance of the account. The rewards can be empty, to only accrue what was already paid.
+func (k Keeper) DistributeRewards(ctx sdk.Context, sender sdk.AccAddress, seq types.Sequencer, rewards sdk.Coins) error {
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, sender, types.DelegationRewardsAddress(seq.Address), rewards); err != nil {
+			return errorsmod.Wrap(err, "send coins")
+		}
+	}
+	return k.accrueRewards(ctx, seq)
+}
+
+// accrueRewards shares the balance of the delegation rewards account of the sequencer. The delegators' part, the
+// fraction of the bond they own net of commission, is set aside and added to the rewards per share of the pool,
+// and the rest is sent to the reward address of the sequencer. It must be called before anything which changes
+// this fraction, so that the rewards paid so far are shared according to the bond they were paid for.
+func (k Keeper) accrueRewards(ctx sdk.Context, seq types.Sequencer) error {
+	rewards := k.bankKeeper.GetAllBalances(ctx, types.DelegationRewardsAddress(seq.Address))
+	if rewards.IsZero() {
+		return nil
+	}
+
+	accrued := sdk.NewCoins()
+	pool := k.GetDelegationPool(ctx, seq.Address)
+	if bond := seq.TokensCoin().Amount; pool.Shares.IsPositive() && pool.Tokens.IsPositive() && bond.IsPositive() {
+		delegatorsPart := math.LegacyNewDecFromInt(pool.Tokens).QuoInt(bond).Mul(math.LegacyOneDec().Sub(pool.CommissionRate))
+		accrued = pool.Accrue(sdk.NewDecCoinsFromCoins(rewards...).MulDecTruncate(delegatorsPart))
+		if err := k.SetDelegationPool(ctx, pool); err != nil {
+			return errorsmod.Wrap(err, "set delegation pool")
+		}
+	}
+	if !accrued.IsZero() {
+		err := k.bankKeeper.SendCoins(ctx, types.DelegationRewardsAddress(seq.Address), types.AccruedRewardsAddress, accrued)
+		if err != nil {
+			return errorsmod.Wrap(err, "set aside delegators rewards")
+		}
+	}
+	if rest := rewards.Sub(accrued...); !rest.IsZero() {
+		rewardAddr, err := sdk.AccAddressFromBech32(seq.RewardAddr)
+		if err != nil {
+			return errorsmod.Wrap(err, "reward address")
+		}
+		if err := k.bankKeeper.SendCoins(ctx, types.DelegationRewardsAddress(seq.Address), rewardAddr, rest); err != nil {
+			return errorsmod.Wrap(err, "send sequencer rewards")
+		}
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventRewardsDistributed{
+		Sequencer:   seq.Address,
+		Rewards:     rewards,
+		Distributed: accrued,
+	})
+}
+
+// withdrawRewards sends the delegator the rewards accrued to the shares since the rewards per share of the pool
+// were at index. Returns the amount sent.
+func (k Keeper) withdrawRewards(ctx sdk.Context, pool types.DelegationPool, delegator string, shares math.LegacyDec, index sdk.DecCoins) (sdk.Coins, error) {
+	rewards := pool.PendingRewards(shares, index)
+	if rewards.IsZero() {
+		return rewards, nil
+	}
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, types.AccruedRewardsAddress, delegatorAddr, rewards); err != nil {
+		return nil, errorsmod.Wrap(err, "send coins")
+	}
+	return rewards, uevent.EmitTypedEvent(ctx, &types.EventDelegatorRewardsWithdrawn{
+		Delegator: delegator,
+		Sequencer: pool.Sequencer,
+		Amount:    rewards,
+	})
+}
+
+// WithdrawDelegatorRewards accrues the rewards paid to the sequencer, then sends the delegator the rewards
+// accrued to its delegation. Returns the amount sent.
+func (k Keeper) WithdrawDelegatorRewards(ctx sdk.Context, delegator string, seq types.Sequencer) (sdk.Coins, error) {
+	d, ok := k.GetDelegation(ctx, seq.Address, delegator)
+	if !ok {
+		return nil, errorsmod.Wrap(gerrc.ErrNotFound, "delegation")
+	}
+	if err := k.accrueRewards(ctx, seq); err != nil {
+		return nil, errorsmod.Wrap(err, "accrue rewards")
+	}
+	pool := k.GetDelegationPool(ctx, seq.Address)
+	rewards, err := k.withdrawRewards(ctx, pool, delegator, d.Shares, d.RewardsIndex)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "withdraw rewards")
+	}
+	d.RewardsIndex = pool.RewardsPerShare
+	if err := k.SetDelegation(ctx, d); err != nil {
+		return nil, errorsmod.Wrap(err, "set delegation")
+	}
+	return rewards, nil
+}
@@ -0,0 +1,274 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) delegate(delegator sdk.AccAddress, seq string, amt sdk.Coin) {
+	err := bankutil.FundAccount(s.Ctx, s.App.BankKeeper, delegator, sdk.NewCoins(amt))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Delegate(s.Ctx, &types.MsgDelegate{
+		Delegator: delegator.String(),
+		Sequencer: seq,
+		Amount:    amt,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *SequencerTestSuite) TestDelegateSlashUndelegate() {
+	ra := s.createRollapp()
+	selfBond := ucoin.SimpleMul(bond, 2)
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, selfBond)
+	delegator := pkAcc(bob)
+	s.delegate(delegator, seq.Address, ucoin.SimpleMul(bond, 3))
+
+	seq = s.seq(alice)
+	s.Require().True(ucoin.SimpleMul(bond, 5).IsEqual(seq.TokensCoin()))
+	s.Require().True(selfBond.IsEqual(s.k().SelfBond(s.Ctx, seq)))
+	s.Require().True(seq.TokensCoin().IsEqual(s.moduleBalance()))
+
+	// the sequencer can't take the delegated tokens
+	s.k().SetProposer(s.Ctx, ra.RollappId, pkAddr(randomTMPubKey()))
+	_, err := s.msgServer.DecreaseBond(s.Ctx, &types.MsgDecreaseBond{
+		Creator:        seq.Address,
+		DecreaseAmount: ucoin.SimpleMul(bond, 3),
+	})
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+
+	// the delegators lose their fraction of a slash
+	s.k().SetProposer(s.Ctx, ra.RollappId, seq.Address)
+	slashed := s.k().LivenessSlashAmount(s.Ctx, seq)
+	s.Require().NoError(s.k().SlashLiveness(s.Ctx, ra.RollappId))
+	pool := s.k().GetDelegationPool(s.Ctx, seq.Address)
+	expect := ucoin.SimpleMul(bond, 3).Amount.Sub(slashed.Amount.MulRaw(3).QuoRaw(5))
+	s.Require().Equal(expect, pool.Tokens)
+
+	// undelegate everything, the sequencer is no longer the proposer
+	s.k().SetProposer(s.Ctx, ra.RollappId, pkAddr(randomTMPubKey()))
+	res, err := s.msgServer.Undelegate(s.Ctx, &types.MsgUndelegate{
+		Delegator: delegator.String(),
+		Sequencer: seq.Address,
+		Amount:    sdk.NewCoin(bond.Denom, expect),
+	})
+	s.Require().NoError(err)
+	s.Require().Nil(res.CompletionTime)
+	s.Require().Equal(expect, s.App.BankKeeper.GetBalance(s.Ctx, delegator, bond.Denom).Amount)
+
+	_, found := s.k().GetDelegation(s.Ctx, seq.Address, delegator.String())
+	s.Require().False(found)
+	seq = s.seq(alice)
+	s.Require().True(seq.TokensCoin().IsEqual(s.k().SelfBond(s.Ctx, seq)))
+	s.Require().True(seq.TokensCoin().IsEqual(s.moduleBalance()))
+}
+
+func (s *SequencerTestSuite) TestUndelegateFromProposer() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.Require().True(s.k().IsProposer(s.Ctx, seq))
+	delegator := pkAcc(bob)
+	s.delegate(delegator, seq.Address, bond)
+
+	// can't undelegate more than the delegation
+	_, err := s.msgServer.Undelegate(s.Ctx, &types.MsgUndelegate{
+		Delegator: delegator.String(),
+		Sequencer: seq.Address,
+		Amount:    ucoin.SimpleMul(bond, 2),
+	})
+	utest.IsErr(s.Require(), err, gerrc.ErrOutOfRange)
+
+	half := sdk.NewCoin(bond.Denom, bond.Amount.QuoRaw(2))
+	res, err := s.msgServer.Undelegate(s.Ctx, &types.MsgUndelegate{
+		Delegator: delegator.String(),
+		Sequencer: seq.Address,
+		Amount:    half,
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(res.CompletionTime)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, delegator, bond.Denom).IsZero())
+
+	// nothing happens before the notice period elapses
+	s.Require().NoError(s.k().CompleteUnbondingDelegations(s.Ctx, res.CompletionTime.Add(-1)))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, delegator, bond.Denom).IsZero())
+
+	s.Require().NoError(s.k().CompleteUnbondingDelegations(s.Ctx, *res.CompletionTime))
+	s.Require().True(half.IsEqual(s.App.BankKeeper.GetBalance(s.Ctx, delegator, bond.Denom)))
+	seq = s.seq(alice)
+	s.Require().True(ucoin.SimpleMul(bond, 2).Sub(half).IsEqual(seq.TokensCoin()))
+	s.Require().True(seq.TokensCoin().IsEqual(s.moduleBalance()))
+}
+
+func (s *SequencerTestSuite) TestDistributeRewards() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+
+	// no delegators yet, so the rate can be set freely
+	_, err := s.msgServer.UpdateCommission(s.Ctx, &types.MsgUpdateCommission{
+		Creator:        seq.Address,
+		CommissionRate: math.LegacyMustNewDecFromStr("0.2"),
+	})
+	s.Require().NoError(err)
+
+	s.delegate(pkAcc(bob), seq.Address, ucoin.SimpleMul(bond, 2))
+	s.delegate(pkAcc(charlie), seq.Address, bond)
+
+	// the rollapp pays half of the rewards to the delegation rewards account, anyone can pay the other half
+	rewardsAcc := types.DelegationRewardsAddress(seq.Address)
+	half := sdk.NewCoins(sdk.NewInt64Coin("arax", 500))
+	s.Require().NoError(bankutil.FundAccount(s.Ctx, s.App.BankKeeper, rewardsAcc, half))
+	s.Require().NoError(bankutil.FundAccount(s.Ctx, s.App.BankKeeper, pkAcc(david), half))
+	_, err = s.msgServer.DistributeRewards(s.Ctx, &types.MsgDistributeRewards{
+		Sender:    pkAddr(david),
+		Sequencer: seq.Address,
+		Amount:    half,
+	})
+	s.Require().NoError(err)
+
+	// the delegators own 3/4 of the bond, and the sequencer keeps 20% of their part
+	s.Require().Equal(int64(400), s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(alice), "arax").Amount.Int64())
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, rewardsAcc).IsZero())
+
+	// the delegators pull their part
+	res, err := s.msgServer.WithdrawDelegatorRewards(s.Ctx, &types.MsgWithdrawDelegatorRewards{
+		Delegator: pkAddr(bob),
+		Sequencer: seq.Address,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(400), res.Amount.AmountOf("arax").Int64())
+	s.Require().Equal(int64(400), s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(bob), "arax").Amount.Int64())
+
+	// nothing more to withdraw
+	res, err = s.msgServer.WithdrawDelegatorRewards(s.Ctx, &types.MsgWithdrawDelegatorRewards{
+		Delegator: pkAddr(bob),
+		Sequencer: seq.Address,
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.Amount.IsZero())
+
+	// rewards paid before a delegation changes are accrued first, without anyone distributing them:
+	// charlie undelegates everything and gets his part of both payments
+	s.Require().NoError(bankutil.FundAccount(s.Ctx, s.App.BankKeeper, rewardsAcc, half.MulInt(math.NewInt(2))))
+	_, err = s.msgServer.Undelegate(s.Ctx, &types.MsgUndelegate{
+		Delegator: pkAddr(charlie),
+		Sequencer: seq.Address,
+		Amount:    bond,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(400), s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(charlie), "arax").Amount.Int64())
+	s.Require().Equal(int64(800), s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(alice), "arax").Amount.Int64())
+
+	// a new delegator doesn't get the rewards paid before its delegation. The undelegated shares of charlie are
+	// still in their notice period, so they still earn.
+	s.Require().NoError(bankutil.FundAccount(s.Ctx, s.App.BankKeeper, rewardsAcc, half.MulInt(math.NewInt(2))))
+	s.delegate(pkAcc(david), seq.Address, bond)
+	res, err = s.msgServer.WithdrawDelegatorRewards(s.Ctx, &types.MsgWithdrawDelegatorRewards{
+		Delegator: pkAddr(david),
+		Sequencer: seq.Address,
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.Amount.IsZero())
+	s.Require().Equal(int64(1200), s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(alice), "arax").Amount.Int64())
+
+	res, err = s.msgServer.WithdrawDelegatorRewards(s.Ctx, &types.MsgWithdrawDelegatorRewards{
+		Delegator: pkAddr(bob),
+		Sequencer: seq.Address,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(800), res.Amount.AmountOf("arax").Int64())
+	s.Require().Equal(int64(1200), s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(bob), "arax").Amount.Int64())
+
+	// charlie gets the rest when the undelegation completes
+	s.Require().NoError(s.k().CompleteUnbondingDelegations(s.Ctx, s.Ctx.BlockTime().Add(s.k().GetParams(s.Ctx).NoticePeriod)))
+	s.Require().Equal(int64(600), s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(charlie), "arax").Amount.Int64())
+}
+
+func (s *SequencerTestSuite) TestUpdateCommissionLimits() {
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.delegate(pkAcc(bob), seq.Address, bond)
+	params := s.k().GetParams(s.Ctx)
+
+	update := func(rate string) error {
+		_, err := s.msgServer.UpdateCommission(s.Ctx, &types.MsgUpdateCommission{
+			Creator:        seq.Address,
+			CommissionRate: math.LegacyMustNewDecFromStr(rate),
+		})
+		return err
+	}
+
+	// the rate can't jump once there are delegators
+	utest.IsErr(s.Require(), update("0.5"), gerrc.ErrOutOfRange)
+	s.Require().NoError(update(params.MaxCommissionChange.String()))
+
+	// and it can change once per notice period
+	utest.IsErr(s.Require(), update("0"), gerrc.ErrFailedPrecondition)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(params.NoticePeriod))
+	s.Require().NoError(update("0"))
+	s.Require().True(s.k().GetDelegationPool(s.Ctx, seq.Address).CommissionRate.IsZero())
+}
+
+func (s *SequencerTestSuite) TestUnbondRefundsDelegators() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	delegator := pkAcc(bob)
+	s.delegate(delegator, seq.Address, bond)
+
+	s.k().SetProposer(s.Ctx, ra.RollappId, pkAddr(randomTMPubKey()))
+	_, err := s.msgServer.Unbond(s.Ctx, &types.MsgUnbond{Creator: seq.Address})
+	s.Require().NoError(err)
+
+	s.Require().True(bond.IsEqual(s.App.BankKeeper.GetBalance(s.Ctx, delegator, bond.Denom)))
+	s.Require().True(bond.IsEqual(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(alice), bond.Denom)))
+	s.Require().True(s.moduleBalance().IsZero())
+	s.Require().False(s.seq(alice).Bonded())
+
+	res, err := s.queryClient.Delegations(s.Ctx, &types.QueryDelegationsRequest{Sequencer: seq.Address})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Delegations)
+}
+
+func (s *SequencerTestSuite) TestUnbondRefundsOnlyOwnUndelegations() {
+	half := sdk.NewCoin(bond.Denom, bond.Amount.QuoRaw(2))
+	undelegate := func(delegator sdk.AccAddress, seq string) {
+		res, err := s.msgServer.Undelegate(s.Ctx, &types.MsgUndelegate{
+			Delegator: delegator.String(),
+			Sequencer: seq,
+			Amount:    half,
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(res.CompletionTime)
+	}
+
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.delegate(pkAcc(bob), seq.Address, bond)
+	undelegate(pkAcc(bob), seq.Address)
+
+	raOther := s.createRollapp()
+	other := s.createSequencerWithBond(s.Ctx, raOther.RollappId, charlie, bond)
+	s.delegate(pkAcc(david), other.Address, bond)
+	undelegate(pkAcc(david), other.Address)
+
+	s.k().SetProposer(s.Ctx, ra.RollappId, pkAddr(randomTMPubKey()))
+	_, err := s.msgServer.Unbond(s.Ctx, &types.MsgUnbond{Creator: seq.Address})
+	s.Require().NoError(err)
+
+	// both the delegation and the pending undelegation are refunded
+	s.Require().True(bond.IsEqual(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(bob), bond.Denom)))
+
+	// the undelegation from the other sequencer is untouched
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(david), bond.Denom).IsZero())
+	ubds, err := s.k().AllUnbondingDelegations(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(ubds, 1)
+	s.Require().Equal(other.Address, ubds[0].Sequencer)
+}
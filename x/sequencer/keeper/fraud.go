@@ -27,6 +27,9 @@ func (k Keeper) TryKickProposer(ctx sdk.Context, kicker types.Sequencer) error {
 
 	// clear the proposer
 	k.abruptRemoveProposer(ctx, ra)
+	if err := k.recordKick(ctx, proposer); err != nil {
+		return errorsmod.Wrap(err, "record kick")
+	}
 
 	// This will call hard fork on the rollapp, which will also optOut all sequencers
 	err := k.hooks.AfterKickProposer(ctx, proposer)
@@ -71,16 +74,21 @@ func (k Keeper) SlashLiveness(ctx sdk.Context, rollappID string) error {
 	}
 	k.increasePenaltyDowntime(ctx, &seq)
 	k.SetSequencer(ctx, seq)
-	return nil
+	return errorsmod.Wrap(k.recordLivenessSlash(ctx, seq), "record liveness slash")
 }
 
 func (k Keeper) livenessSlash(ctx sdk.Context, seq *types.Sequencer) error {
+	amt := k.LivenessSlashAmount(ctx, *seq)
+	return errorsmod.Wrap(k.slash(ctx, seq, amt, math.LegacyZeroDec(), nil), "slash")
+}
+
+// LivenessSlashAmount returns the amount a liveness event slashes from the sequencer, given its current bond
+func (k Keeper) LivenessSlashAmount(ctx sdk.Context, seq types.Sequencer) sdk.Coin {
 	mul := k.GetParams(ctx).LivenessSlashMinMultiplier
 	abs := k.GetParams(ctx).LivenessSlashMinAbsolute
 	tokens := seq.TokensCoin()
 	tokensMul := ucoin.MulDec(mul, tokens)
-	amt := ucoin.SimpleMin(tokens, ucoin.SimpleMax(abs, tokensMul[0]))
-	return errorsmod.Wrap(k.slash(ctx, seq, amt, math.LegacyZeroDec(), nil), "slash")
+	return ucoin.SimpleMin(tokens, ucoin.SimpleMax(abs, tokensMul[0]))
 }
 
 func (k Keeper) reducePenaltyUptime(ctx sdk.Context, seq *types.Sequencer) {
@@ -121,6 +129,20 @@ func (k Keeper) PunishSequencer(ctx sdk.Context, seqAddr string, rewardee *sdk.A
 }
 
 func (k Keeper) slash(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin, rewardMul math.LegacyDec, rewardee sdk.AccAddress) error {
+	// the rewards paid so far are shared according to the bond before the slash
+	if err := k.accrueRewards(ctx, *seq); err != nil {
+		return errorsmod.Wrap(err, "accrue rewards")
+	}
+	// the delegators and the collateral lose the same fraction as the sequencer tokens
+	if err := k.slashDelegators(ctx, *seq, amt); err != nil {
+		return errorsmod.Wrap(err, "slash delegators")
+	}
+	if bond := seq.TokensCoin().Amount; bond.IsPositive() {
+		frac := math.LegacyNewDecFromInt(amt.Amount).QuoInt(bond)
+		if err := k.slashCollateral(ctx, *seq, frac, rewardMul, rewardee); err != nil {
+			return errorsmod.Wrap(err, "slash collateral")
+		}
+	}
 	rewardCoin := ucoin.MulDec(rewardMul, amt)[0]
 	if !rewardCoin.IsZero() {
 		err := k.sendFromModule(ctx, seq, rewardCoin, rewardee)
@@ -46,6 +46,10 @@ func (k Keeper) sendFromModule(ctx sdk.Context, seq *types.Sequencer, amt sdk.Co
 }
 
 func (k Keeper) sendToModule(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) error {
+	return k.sendToModuleFrom(ctx, seq, amt, seq.AccAddr())
+}
+
+func (k Keeper) sendToModuleFrom(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin, sender sdk.AccAddress) error {
 	seq.SetTokensCoin(seq.TokensCoin().Add(amt))
-	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, seq.AccAddr(), types.ModuleName, sdk.NewCoins(amt))
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(amt))
 }
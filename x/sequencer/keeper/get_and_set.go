@@ -38,10 +38,12 @@ func (k Keeper) SetSequencerByDymintAddr(ctx sdk.Context, dymint cryptotypes.Add
 }
 
 // SetProposer : passing sentinel is allowed
+// Also keeps track of the time spent as proposer.
 func (k Keeper) SetProposer(ctx sdk.Context, rollapp, seqAddr string) {
 	store := ctx.KVStore(k.storeKey)
 	addressBytes := []byte(seqAddr)
 	activeKey := types.ProposerByRollappKey(rollapp)
+	k.recordProposerChange(ctx, string(store.Get(activeKey)), seqAddr)
 	store.Set(activeKey, addressBytes)
 }
 
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) BondAssets(c context.Context, req *types.QueryBondAssetsRequest) (*types.QueryBondAssetsResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBondAssetsResponse{
+		Assets: k.GetBondAssets(ctx, req.RollappId).Assets,
+	}, nil
+}
+
+func (k Keeper) SequencerBond(c context.Context, req *types.QuerySequencerBondRequest) (*types.QuerySequencerBondResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	seq, err := k.RealSequencer(ctx, req.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySequencerBondResponse{
+		Tokens:     seq.Tokens,
+		Collateral: k.GetCollateral(ctx, seq.Address),
+		Value:      sdk.NewCoin(seq.TokensCoin().Denom, k.BondValue(ctx, seq)),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) Delegations(c context.Context, req *types.QueryDelegationsRequest) (*types.QueryDelegationsResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool := k.GetDelegationPool(ctx, req.Sequencer)
+	delegations, pageResp, err := query.CollectionPaginate(ctx, k.delegations, req.Pagination,
+		func(_ collections.Pair[string, string], d types.Delegation) (types.DelegationBalance, error) {
+			return types.DelegationBalance{
+				Delegation: d,
+				Balance:    sdk.NewCoin(commontypes.DYMCoin.Denom, pool.TokensFor(d.Shares)),
+			}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Sequencer),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationsResponse{
+		Pool:        pool,
+		Delegations: delegations,
+		Pagination:  pageResp,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) Handover(c context.Context, req *types.QueryHandoverRequest) (*types.QueryHandoverResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryHandoverResponse{}
+	if h, ok := k.GetHandover(ctx, req.RollappId); ok {
+		res.Handover = &h
+	}
+	return res, nil
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// SequencersPerformance returns the track record of every sequencer of the rollapp, so they can be compared
+func (k Keeper) SequencersPerformance(c context.Context, req *types.QuerySequencersPerformanceRequest) (*types.QuerySequencersPerformanceResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var perfs []types.SequencerPerformance
+	for _, seq := range k.RollappSequencers(ctx, req.RollappId) {
+		m, _ := k.GetSequencerMetrics(ctx, seq.Address)
+		perfs = append(perfs, m.Performance(ctx.BlockTime()))
+	}
+
+	return &types.QuerySequencersPerformanceResponse{Performances: perfs}, nil
+}
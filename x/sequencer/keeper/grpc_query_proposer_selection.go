@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) ProposerSelection(c context.Context, req *types.QueryProposerSelectionRequest) (*types.QueryProposerSelectionResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryProposerSelectionResponse{
+		Selection: k.GetProposerSelection(ctx, req.RollappId),
+	}, nil
+}
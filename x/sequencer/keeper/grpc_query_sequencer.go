@@ -47,5 +47,10 @@ func (k Keeper) Sequencer(c context.Context, req *types.QueryGetSequencerRequest
 		return nil, err
 	}
 
-	return &types.QueryGetSequencerResponse{Sequencer: seq}, nil
+	res := &types.QueryGetSequencerResponse{Sequencer: seq}
+	if m, ok := k.GetSequencerMetrics(ctx, seq.Address); ok {
+		perf := m.Performance(ctx.BlockTime())
+		res.Performance = &perf
+	}
+	return res, nil
 }
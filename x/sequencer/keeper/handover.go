@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// GetHandover returns the handover in progress of the rollapp, if any
+func (k Keeper) GetHandover(ctx sdk.Context, rollapp string) (types.Handover, bool) {
+	h, err := k.handovers.Get(ctx, rollapp)
+	if err != nil {
+		return types.Handover{}, false
+	}
+	return h, true
+}
+
+func (k Keeper) SetHandover(ctx sdk.Context, h types.Handover) error {
+	return k.handovers.Set(ctx, h.RollappId, h)
+}
+
+func (k Keeper) removeHandover(ctx sdk.Context, rollapp string) error {
+	err := k.handovers.Remove(ctx, rollapp)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (k Keeper) AllHandovers(ctx sdk.Context) ([]types.Handover, error) {
+	iter, err := k.handovers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (k Keeper) HandoverInProgress(ctx sdk.Context, rollapp string) bool {
+	_, ok := k.GetHandover(ctx, rollapp)
+	return ok
+}
+
+// startHandover awaits the successor to accept proposership until the deadline. The rollapp has no proposer
+// meanwhile, and the successor stays successor so it cannot unbond.
+func (k Keeper) startHandover(ctx sdk.Context, rollapp string, successor types.Sequencer) error {
+	h := types.Handover{
+		RollappId: rollapp,
+		Successor: successor.Address,
+		Deadline:  ctx.BlockTime().Add(k.GetParams(ctx).HandoverPeriod),
+	}
+	if err := k.SetHandover(ctx, h); err != nil {
+		return errorsmod.Wrap(err, "set handover")
+	}
+	k.SetSuccessor(ctx, rollapp, successor.Address)
+	return uevent.EmitTypedEvent(ctx, &types.EventHandoverStarted{
+		Rollapp:   rollapp,
+		Successor: successor.Address,
+		Deadline:  h.Deadline,
+	})
+}
+
+// AcceptProposership makes the awaited successor the proposer of its rollapp, if the deadline has not passed
+func (k Keeper) AcceptProposership(ctx sdk.Context, seq types.Sequencer, firstStateRoot []byte) error {
+	h, ok := k.GetHandover(ctx, seq.RollappId)
+	if !ok || h.Successor != seq.Address {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "not the awaited successor")
+	}
+	if !ctx.BlockTime().Before(h.Deadline) {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "handover deadline passed")
+	}
+	if err := k.removeHandover(ctx, seq.RollappId); err != nil {
+		return errorsmod.Wrap(err, "remove handover")
+	}
+	if err := k.completeRotation(ctx, seq.RollappId, seq); err != nil {
+		return errorsmod.Wrap(err, "complete rotation")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventProposershipAccepted{
+		Rollapp:        seq.RollappId,
+		Proposer:       seq.Address,
+		FirstStateRoot: firstStateRoot,
+	})
+}
+
+// ExpireHandovers penalizes the successors who missed their handover deadline, as for a liveness failure, and opts
+// them out. The next candidate is awaited instead, or the rollapp forks if there is none.
+func (k Keeper) ExpireHandovers(ctx sdk.Context) error {
+	hs, err := k.AllHandovers(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "all handovers")
+	}
+	for _, h := range hs {
+		if ctx.BlockTime().Before(h.Deadline) {
+			continue
+		}
+		if err := k.removeHandover(ctx, h.RollappId); err != nil {
+			return errorsmod.Wrap(err, "remove handover")
+		}
+
+		absent, err := k.RealSequencer(ctx, h.Successor)
+		if err != nil {
+			return errorsmod.Wrap(err, "get successor")
+		}
+		if !k.IsSuccessor(ctx, absent) {
+			// the rotation was interrupted
+			continue
+		}
+		if err := k.penalizeAbsentSuccessor(ctx, &absent); err != nil {
+			return errorsmod.Wrap(err, "penalize absent successor")
+		}
+
+		next, err := k.chooseProposer(ctx, h.RollappId, absent.Address)
+		if err != nil {
+			return errorsmod.Wrap(err, "choose proposer")
+		}
+		if err := uevent.EmitTypedEvent(ctx, &types.EventHandoverMissed{
+			Rollapp: h.RollappId,
+			Absent:  absent.Address,
+			Next:    next.Address,
+		}); err != nil {
+			return err
+		}
+
+		if next.Sentinel() {
+			err = k.completeRotation(ctx, h.RollappId, next)
+		} else {
+			err = k.startHandover(ctx, h.RollappId, next)
+		}
+		if err != nil {
+			return errorsmod.Wrap(err, "next successor")
+		}
+	}
+	return nil
+}
+
+func (k Keeper) penalizeAbsentSuccessor(ctx sdk.Context, seq *types.Sequencer) error {
+	if err := k.livenessSlash(ctx, seq); err != nil {
+		return errorsmod.Wrap(err, "slash")
+	}
+	k.increasePenaltyDowntime(ctx, seq)
+	if err := seq.SetOptedIn(ctx, false); err != nil {
+		return errorsmod.Wrap(err, "set opted in")
+	}
+	k.SetSequencer(ctx, *seq)
+	return errorsmod.Wrap(k.recordLivenessSlash(ctx, *seq), "record liveness slash")
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// rotates the proposer of the rollapp up to its last block, returns the handover
+func (s *SequencerTestSuite) rotateToHandover(rollapp string, proposer string) *types.Handover {
+	res, err := s.msgServer.Unbond(s.Ctx, &types.MsgUnbond{Creator: proposer})
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockTime(*res.GetNoticePeriodCompletionTime())
+	s.Require().NoError(s.k().ChooseSuccessorForFinishedNotices(s.Ctx, s.Ctx.BlockTime()))
+	s.Require().NoError(s.k().OnProposerLastBlock(s.Ctx, s.k().GetProposer(s.Ctx, rollapp)))
+
+	h, err := s.k().Handover(s.Ctx, &types.QueryHandoverRequest{RollappId: rollapp})
+	s.Require().NoError(err)
+	return h.Handover
+}
+
+func (s *SequencerTestSuite) TestHandoverAccepted() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond.AddAmount(bond.Amount))
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, charlie, bond)
+	s.submitAFewRollappStates(ra.RollappId)
+
+	h := s.rotateToHandover(ra.RollappId, pkAddr(alice))
+	s.Require().NotNil(h)
+	s.Require().Equal(pkAddr(bob), h.Successor)
+	s.Require().Equal(s.Ctx.BlockTime().Add(types.DefaultHandoverPeriod), h.Deadline)
+	s.Require().True(s.k().IsProposer(s.Ctx, s.k().SentinelSequencer(s.Ctx)))
+	s.Require().True(s.k().IsSuccessor(s.Ctx, s.seq(bob)))
+
+	// the successor cannot leave during the handover
+	_, err := s.msgServer.Unbond(s.Ctx, &types.MsgUnbond{Creator: pkAddr(bob)})
+	s.Require().Error(err)
+
+	// a new sequencer does not take over meanwhile
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, david, bond)
+	s.Require().True(s.k().IsProposer(s.Ctx, s.k().SentinelSequencer(s.Ctx)))
+
+	// only the successor can accept
+	_, err = s.msgServer.AcceptProposership(s.Ctx, &types.MsgAcceptProposership{Creator: pkAddr(charlie)})
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+
+	// nothing expires before the deadline
+	s.Ctx = s.Ctx.WithBlockTime(h.Deadline.Add(-time.Second))
+	s.Require().NoError(s.k().ExpireHandovers(s.Ctx))
+
+	_, err = s.msgServer.AcceptProposership(s.Ctx, &types.MsgAcceptProposership{Creator: pkAddr(bob), FirstStateRoot: []byte("root")})
+	s.Require().NoError(err)
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(bob)))
+	s.Require().True(s.k().IsSuccessor(s.Ctx, s.k().SentinelSequencer(s.Ctx)))
+	s.Require().False(s.k().HandoverInProgress(s.Ctx, ra.RollappId))
+	s.Require().True(s.seq(bob).OptedIn)
+}
+
+func (s *SequencerTestSuite) TestHandoverMissed() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond.AddAmount(bond.Amount))
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, charlie, bond)
+	s.submitAFewRollappStates(ra.RollappId)
+
+	h := s.rotateToHandover(ra.RollappId, pkAddr(alice))
+	s.Require().Equal(pkAddr(bob), h.Successor)
+
+	// bob misses the deadline and is penalized, charlie is awaited instead
+	expected := s.seq(bob).TokensCoin().Sub(s.k().LivenessSlashAmount(s.Ctx, s.seq(bob)))
+	s.Ctx = s.Ctx.WithBlockTime(h.Deadline)
+	s.Require().NoError(s.k().ExpireHandovers(s.Ctx))
+	s.Require().True(s.seq(bob).TokensCoin().IsEqual(expected))
+	s.Require().Equal(types.DefaultDishonorLiveness, s.seq(bob).Dishonor)
+	s.Require().False(s.seq(bob).OptedIn)
+	s.Require().True(s.k().IsSuccessor(s.Ctx, s.seq(charlie)))
+
+	_, err := s.msgServer.AcceptProposership(s.Ctx, &types.MsgAcceptProposership{Creator: pkAddr(bob)})
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+
+	h2, ok := s.k().GetHandover(s.Ctx, ra.RollappId)
+	s.Require().True(ok)
+	s.Require().Equal(pkAddr(charlie), h2.Successor)
+	s.Require().Equal(s.Ctx.BlockTime().Add(types.DefaultHandoverPeriod), h2.Deadline)
+
+	// charlie misses it too: there is no candidate left, so the rollapp forks
+	s.Ctx = s.Ctx.WithBlockTime(h2.Deadline)
+	s.Require().NoError(s.k().ExpireHandovers(s.Ctx))
+	s.Require().False(s.seq(charlie).OptedIn)
+	s.Require().False(s.k().HandoverInProgress(s.Ctx, ra.RollappId))
+	s.Require().True(s.k().IsProposer(s.Ctx, s.k().SentinelSequencer(s.Ctx)))
+	s.Require().True(s.k().IsSuccessor(s.Ctx, s.k().SentinelSequencer(s.Ctx)))
+}
+
+func (s *SequencerTestSuite) TestHandoverDisabled() {
+	params := s.k().GetParams(s.Ctx)
+	params.HandoverPeriod = 0
+	s.k().SetParams(s.Ctx, params)
+
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	s.submitAFewRollappStates(ra.RollappId)
+
+	h := s.rotateToHandover(ra.RollappId, pkAddr(alice))
+	s.Require().Nil(h)
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(bob)))
+}
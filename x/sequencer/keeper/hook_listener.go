@@ -6,6 +6,7 @@ import (
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	epochstypes "github.com/osmosis-labs/osmosis/v15/x/epochs/types"
 )
 
 var _ rollapptypes.RollappHooks = rollappHook{}
@@ -38,7 +39,7 @@ func (hook rollappHook) BeforeUpdateState(ctx sdk.Context, seqAddr, rollappId st
 // AfterUpdateState checks if rotation is completed and the nextProposer is changed
 func (hook rollappHook) AfterUpdateState(ctx sdk.Context, stateInfo *rollapptypes.StateInfoMeta) error {
 	proposer := hook.k.GetProposer(ctx, stateInfo.Rollapp)
-	return hook.k.afterStateUpdate(ctx, proposer, stateInfo.Sequencer != stateInfo.NextProposer)
+	return hook.k.afterStateUpdate(ctx, proposer, stateInfo.NumBlocks, stateInfo.Sequencer != stateInfo.NextProposer)
 }
 
 // OnHardFork implements the RollappHooks interface
@@ -50,9 +51,45 @@ func (hook rollappHook) OnHardFork(ctx sdk.Context, rollappID string, _ uint64)
 		return errorsmod.Wrap(err, "opt out all sequencers")
 	}
 
-	// clear current proposer and successor
+	// clear current proposer, successor and handover
 	hook.k.abruptRemoveProposer(ctx, rollappID)
 	hook.k.SetSuccessor(ctx, rollappID, types.SentinelSeqAddr)
+	if err := hook.k.removeHandover(ctx, rollappID); err != nil {
+		return errorsmod.Wrap(err, "remove handover")
+	}
+
+	return nil
+}
+
+// AfterLifecycleStateChanged implements the RollappHooks interface
+// unbonds all rollapp sequencers once the rollapp is shut down
+func (hook rollappHook) AfterLifecycleStateChanged(ctx sdk.Context, rollappID string, _, to rollapptypes.Rollapp_LifecycleState) error {
+	if to != rollapptypes.Rollapp_SHUT_DOWN {
+		return nil
+	}
+	return errorsmod.Wrap(hook.k.unbondAllSequencers(ctx, rollappID), "unbond all sequencers")
+}
+
+var _ epochstypes.EpochHooks = epochHooks{}
 
+type epochHooks struct {
+	k Keeper
+}
+
+func (k Keeper) EpochHooks() epochstypes.EpochHooks {
+	return epochHooks{k: k}
+}
+
+// BeforeEpochStart implements the EpochHooks interface
+func (hook epochHooks) BeforeEpochStart(sdk.Context, string, int64) error {
 	return nil
 }
+
+// AfterEpochEnd implements the EpochHooks interface
+// updates the reference prices of the bond assets at the end of the price epoch
+func (hook epochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) error {
+	if epochIdentifier != hook.k.GetParams(ctx).PriceEpochIdentifier {
+		return nil
+	}
+	return errorsmod.Wrap(hook.k.UpdateBondAssetPrices(ctx), "update bond asset prices")
+}
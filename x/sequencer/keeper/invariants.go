@@ -138,21 +138,25 @@ func InvariantTokens(k Keeper) uinv.Func {
 			return err
 		}
 
-		total := sdk.NewCoin(commontypes.DYMCoin.Denom, math.ZeroInt())
+		total := sdk.NewCoins(sdk.NewCoin(commontypes.DYMCoin.Denom, math.ZeroInt()))
 		for _, seq := range k.AllSequencers(ctx) {
 			total = total.Add(seq.TokensCoin())
 		}
+		collaterals, err := k.AllCollaterals(ctx)
+		if err != nil {
+			return errorsmod.Wrap(err, "collaterals")
+		}
+		for _, c := range collaterals {
+			total = total.Add(c.Coins...)
+		}
 		// check module balance is equal
 		moduleAcc := k.accountK.GetModuleAccount(ctx, types.ModuleName)
 		balances := k.bankKeeper.GetAllBalances(ctx, moduleAcc.GetAddress())
-		if 1 < len(balances) {
-			return errors.New("module account has more than one coin")
-		}
-		if !total.IsZero() && len(balances) == 0 {
+		if !total.IsZero() && balances.IsZero() {
 			return errors.New("module account has no balance")
 		}
-		if !total.IsZero() && !balances[0].IsEqual(total) {
-			return errors.New("module account balance not equal to sum of sequencer tokens")
+		if !total.IsZero() && !balances.Equal(total) {
+			return errors.New("module account balance not equal to sum of sequencer tokens and collateral")
 		}
 		return nil
 	})
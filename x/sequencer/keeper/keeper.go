@@ -22,10 +22,26 @@ type Keeper struct {
 	bankKeeper     types.BankKeeper
 	accountK       types.AccountKeeper
 	rollappKeeper  types.RollappKeeper
+	priceSource    types.PriceSource
 	unbondBlockers []UnbondBlocker
 	hooks          types.Hooks
 
 	dymintProposerAddrToAccAddr collections.Map[[]byte, string]
+
+	delegationPools collections.Map[string, types.DelegationPool]
+	// seq, delegator
+	delegations collections.Map[collections.Pair[string, string], types.Delegation]
+	// completion time (unix nanos), seq, delegator
+	unbondingDelegations *collections.IndexedMap[unbondingDelegationKey, types.UnbondingDelegation, unbondingDelegationIndexes]
+
+	proposerSelections collections.Map[string, types.ProposerSelection]
+
+	bondAssets  collections.Map[string, types.RollappBondAssets]
+	collaterals collections.Map[string, types.Collateral]
+	// pool id, denom
+	bondAssetPrices collections.Map[collections.Pair[uint64, string], types.BondAssetPrice]
+
+	handovers collections.Map[string, types.Handover]
 }
 
 func NewKeeper(
@@ -59,6 +75,56 @@ func NewKeeper(
 			collections.BytesKey,
 			collections.StringValue,
 		),
+		delegationPools: collections.NewMap(
+			sb,
+			types.DelegationPoolsKeyPrefix,
+			"delegationPools",
+			collections.StringKey,
+			collcompat.ProtoValue[types.DelegationPool](cdc),
+		),
+		delegations: collections.NewMap(
+			sb,
+			types.DelegationsKeyPrefix,
+			"delegations",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collcompat.ProtoValue[types.Delegation](cdc),
+		),
+		unbondingDelegations: makeUnbondingDelegations(sb, cdc),
+		proposerSelections: collections.NewMap(
+			sb,
+			types.ProposerSelectionsKeyPrefix,
+			"proposerSelections",
+			collections.StringKey,
+			collcompat.ProtoValue[types.ProposerSelection](cdc),
+		),
+		bondAssets: collections.NewMap(
+			sb,
+			types.BondAssetsKeyPrefix,
+			"bondAssets",
+			collections.StringKey,
+			collcompat.ProtoValue[types.RollappBondAssets](cdc),
+		),
+		collaterals: collections.NewMap(
+			sb,
+			types.CollateralsKeyPrefix,
+			"collaterals",
+			collections.StringKey,
+			collcompat.ProtoValue[types.Collateral](cdc),
+		),
+		bondAssetPrices: collections.NewMap(
+			sb,
+			types.BondAssetPricesKeyPrefix,
+			"bondAssetPrices",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			collcompat.ProtoValue[types.BondAssetPrice](cdc),
+		),
+		handovers: collections.NewMap(
+			sb,
+			types.HandoversKeyPrefix,
+			"handovers",
+			collections.StringKey,
+			collcompat.ProtoValue[types.Handover](cdc),
+		),
 	}
 }
 
@@ -70,6 +136,11 @@ func (k *Keeper) SetUnbondBlockers(ubs ...UnbondBlocker) {
 	k.unbondBlockers = ubs
 }
 
+// SetPriceSource sets the source of the prices of the bond assets. Without it, collateral has no value.
+func (k *Keeper) SetPriceSource(p types.PriceSource) {
+	k.priceSource = p
+}
+
 func (k *Keeper) SetHooks(h types.Hooks) {
 	k.hooks = h
 }
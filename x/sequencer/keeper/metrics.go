@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// GetSequencerMetrics returns the track record of the sequencer, false if it has none
+func (k Keeper) GetSequencerMetrics(ctx sdk.Context, seqAddr string) (types.SequencerMetrics, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SequencerMetricsKey(seqAddr))
+	if bz == nil {
+		return types.NewSequencerMetrics(seqAddr), false
+	}
+	var m types.SequencerMetrics
+	k.cdc.MustUnmarshal(bz, &m)
+	return m, true
+}
+
+func (k Keeper) SetSequencerMetrics(ctx sdk.Context, m types.SequencerMetrics) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SequencerMetricsKey(m.Sequencer), k.cdc.MustMarshal(&m))
+}
+
+func (k Keeper) AllSequencerMetrics(ctx sdk.Context) []types.SequencerMetrics {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.SequencerMetricsKeyPrefix)
+	defer it.Close() // nolint: errcheck
+
+	var ret []types.SequencerMetrics
+	for ; it.Valid(); it.Next() {
+		var m types.SequencerMetrics
+		k.cdc.MustUnmarshal(it.Value(), &m)
+		ret = append(ret, m)
+	}
+	return ret
+}
+
+// updateSequencerMetrics applies f to the track record of the sequencer, and emits the result
+func (k Keeper) updateSequencerMetrics(ctx sdk.Context, seq types.Sequencer, f func(*types.SequencerMetrics)) error {
+	if seq.Sentinel() {
+		return nil
+	}
+	m, _ := k.GetSequencerMetrics(ctx, seq.Address)
+	f(&m)
+	k.SetSequencerMetrics(ctx, m)
+	return errorsmod.Wrap(uevent.EmitTypedEvent(ctx, &types.EventSequencerMetrics{
+		Rollapp:     seq.RollappId,
+		Performance: m.Performance(ctx.BlockTime()),
+	}), "emit event")
+}
+
+func (k Keeper) recordStateUpdate(ctx sdk.Context, seq types.Sequencer, numBlocks uint64) error {
+	return k.updateSequencerMetrics(ctx, seq, func(m *types.SequencerMetrics) {
+		m.RecordStateUpdate(ctx.BlockHeight(), numBlocks)
+	})
+}
+
+func (k Keeper) recordLivenessSlash(ctx sdk.Context, seq types.Sequencer) error {
+	return k.updateSequencerMetrics(ctx, seq, func(m *types.SequencerMetrics) {
+		m.LivenessSlashes++
+	})
+}
+
+func (k Keeper) recordKick(ctx sdk.Context, seq types.Sequencer) error {
+	return k.updateSequencerMetrics(ctx, seq, func(m *types.SequencerMetrics) {
+		m.Kicks++
+	})
+}
+
+// recordProposerChange closes the term of the previous proposer and opens the term of the new one
+func (k Keeper) recordProposerChange(ctx sdk.Context, before, after string) {
+	if before == after {
+		return
+	}
+	now := ctx.BlockTime()
+	if before != "" && before != types.SentinelSeqAddr {
+		m, _ := k.GetSequencerMetrics(ctx, before)
+		m.StopProposing(now)
+		k.SetSequencerMetrics(ctx, m)
+	}
+	if after != types.SentinelSeqAddr {
+		m, _ := k.GetSequencerMetrics(ctx, after)
+		m.StartProposing(now)
+		k.SetSequencerMetrics(ctx, m)
+	}
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) TestSequencerMetrics() {
+	ra := s.createRollapp()
+	seqAlice := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.Require().True(s.k().IsProposer(s.Ctx, seqAlice))
+	start := s.Ctx.BlockTime()
+
+	// two state updates, 5 hub blocks apart
+	h, _ := s.App.RollappKeeper.GetLatestHeight(s.Ctx, ra.RollappId)
+	h, err := s.PostStateUpdate(s.Ctx, ra.RollappId, seqAlice.Address, h+1, 10)
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 5).WithBlockTime(start.Add(time.Hour))
+	_, err = s.PostStateUpdate(s.Ctx, ra.RollappId, seqAlice.Address, h, 20)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.k().SlashLiveness(s.Ctx, ra.RollappId))
+
+	res, err := s.k().Sequencer(s.Ctx, &types.QueryGetSequencerRequest{SequencerAddress: seqAlice.Address})
+	s.Require().NoError(err)
+	s.Require().NotNil(res.Performance)
+	s.Require().Equal(uint64(2), res.Performance.Metrics.StateUpdates)
+	s.Require().Equal(uint64(30), res.Performance.Metrics.BlocksCovered)
+	s.Require().Equal(uint64(5), res.Performance.AverageUpdateGap)
+	s.Require().Equal(uint64(1), res.Performance.Metrics.LivenessSlashes)
+	s.Require().Equal(time.Hour, res.Performance.TimeAsProposer)
+
+	// bob has no track record yet
+	seqBob := s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	res, err = s.k().Sequencer(s.Ctx, &types.QueryGetSequencerRequest{SequencerAddress: seqBob.Address})
+	s.Require().NoError(err)
+	s.Require().Nil(res.Performance)
+
+	// bob kicks alice an hour later
+	seqAlice = s.seq(alice)
+	seqAlice.SetPenalty(types.DefaultDishonorKickThreshold)
+	s.k().SetSequencer(s.Ctx, seqAlice)
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(2 * time.Hour))
+	s.Require().NoError(s.k().TryKickProposer(s.Ctx, s.seq(bob)))
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(bob)))
+
+	perfs, err := s.k().SequencersPerformance(s.Ctx, &types.QuerySequencersPerformanceRequest{RollappId: ra.RollappId})
+	s.Require().NoError(err)
+	s.Require().Len(perfs.Performances, 2)
+	for _, p := range perfs.Performances {
+		switch p.Metrics.Sequencer {
+		case seqAlice.Address:
+			s.Require().Equal(uint64(1), p.Metrics.Kicks)
+			s.Require().Nil(p.Metrics.ProposerSince)
+			s.Require().Equal(2*time.Hour, p.TimeAsProposer)
+		case seqBob.Address:
+			s.Require().Zero(p.Metrics.Kicks)
+			s.Require().NotNil(p.Metrics.ProposerSince)
+			s.Require().Zero(p.TimeAsProposer)
+		default:
+			s.Fail("unexpected sequencer", p.Metrics.Sequencer)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	k Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{k: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It sets the handover period, the max commission change and the price epoch.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.k.GetParams(ctx)
+	params.HandoverPeriod = types.DefaultHandoverPeriod
+	params.MaxCommissionChange = types.DefaultMaxCommissionChange
+	params.PriceEpochIdentifier = types.DefaultPriceEpochIdentifier
+	if err := params.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "validate params")
+	}
+	m.k.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) TestMigrate2to3() {
+	// the params of version 2 have no handover period, no max commission change and no price epoch
+	v2 := types.DefaultParams()
+	v2.HandoverPeriod = 0
+	v2.MaxCommissionChange = math.LegacyZeroDec()
+	v2.PriceEpochIdentifier = ""
+	s.k().SetParams(s.Ctx, v2)
+
+	s.Require().NoError(keeper.NewMigrator(*s.k()).Migrate2to3(s.Ctx))
+
+	params := s.k().GetParams(s.Ctx)
+	s.Require().Equal(types.DefaultHandoverPeriod, params.HandoverPeriod)
+	s.Require().True(types.DefaultMaxCommissionChange.Equal(params.MaxCommissionChange))
+	v2.HandoverPeriod = types.DefaultHandoverPeriod
+	v2.MaxCommissionChange = types.DefaultMaxCommissionChange
+	v2.PriceEpochIdentifier = types.DefaultPriceEpochIdentifier
+	s.Require().Equal(v2, params)
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k msgServer) AcceptProposership(goCtx context.Context, msg *types.MsgAcceptProposership) (*types.MsgAcceptProposershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.GetCreator())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.AcceptProposership(ctx, seq, msg.FirstStateRoot); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptProposershipResponse{}, nil
+}
@@ -18,21 +18,25 @@ func (k msgServer) IncreaseBond(goCtx context.Context, msg *types.MsgIncreaseBon
 		return nil, err
 	}
 
-	if err := validBondDenom(msg.AddAmount); err != nil {
-		return nil, err
-	}
-
-	// charge the user and modify the sequencer object
-	if err := k.sendToModule(ctx, &seq, msg.AddAmount); err != nil {
+	// charge the user and modify the sequencer object, or its collateral for other assets
+	if validBondDenom(msg.AddAmount) == nil {
+		// the rewards paid so far are shared according to the bond they were paid for
+		if err := k.accrueRewards(ctx, seq); err != nil {
+			return nil, errorsmod.Wrap(err, "accrue rewards")
+		}
+		if err := k.sendToModule(ctx, &seq, msg.AddAmount); err != nil {
+			return nil, err
+		}
+		k.SetSequencer(ctx, seq)
+	} else if err := k.addCollateral(ctx, seq, msg.AddAmount); err != nil {
 		return nil, err
 	}
-	k.SetSequencer(ctx, seq)
 
 	// emit a typed event which includes the added amount and the active bond amount
 	return &types.MsgIncreaseBondResponse{}, uevent.EmitTypedEvent(ctx,
 		&types.EventIncreasedBond{
 			Sequencer:   msg.Creator,
-			Bond:        seq.Tokens,
+			Bond:        seq.Tokens.Add(k.GetCollateral(ctx, seq.Address)...),
 			AddedAmount: msg.AddAmount,
 		},
 	)
@@ -23,6 +23,10 @@ func (k msgServer) CreateSequencer(goCtx context.Context, msg *types.MsgCreateSe
 		return nil, rollapptypes.ErrRollappNotFound
 	}
 
+	if rollapp.IsSunset() {
+		return nil, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "rollapp is retired: %s", rollapp.LifecycleState)
+	}
+
 	// check to see if the seq has been registered before
 	if _, err := k.RealSequencer(ctx, msg.Creator); err == nil {
 		return nil, types.ErrSequencerAlreadyExists
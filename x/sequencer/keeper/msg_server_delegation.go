@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddr, err.Error())
+	}
+
+	shares, err := k.Keeper.Delegate(ctx, delegator, &seq, msg.Amount)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "delegate")
+	}
+	k.SetSequencer(ctx, seq)
+
+	return &types.MsgDelegateResponse{}, uevent.EmitTypedEvent(ctx, &types.EventDelegated{
+		Delegator: msg.Delegator,
+		Sequencer: msg.Sequencer,
+		Amount:    msg.Amount,
+		Shares:    shares,
+	})
+}
+
+func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+
+	completion, err := k.Keeper.Undelegate(ctx, msg.Delegator, &seq, msg.Amount)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "undelegate")
+	}
+	k.SetSequencer(ctx, seq)
+
+	return &types.MsgUndelegateResponse{CompletionTime: completion}, nil
+}
+
+func (k msgServer) UpdateCommission(goCtx context.Context, msg *types.MsgUpdateCommission) (*types.MsgUpdateCommissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// the rewards paid so far are shared at the current rate
+	if err := k.accrueRewards(ctx, seq); err != nil {
+		return nil, errorsmod.Wrap(err, "accrue rewards")
+	}
+
+	pool := k.GetDelegationPool(ctx, seq.Address)
+	// the delegators must have time to undelegate before the next change
+	if pool.Shares.IsPositive() {
+		params := k.GetParams(ctx)
+		next := pool.CommissionUpdateTime.Add(params.NoticePeriod)
+		if !pool.CommissionUpdateTime.IsZero() && ctx.BlockTime().Before(next) {
+			return nil, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "commission rate can be updated again at: %s", next)
+		}
+		if change := msg.CommissionRate.Sub(pool.CommissionRate).Abs(); change.GT(params.MaxCommissionChange) {
+			return nil, errorsmod.Wrapf(gerrc.ErrOutOfRange, "commission rate change: %s: max: %s", change, params.MaxCommissionChange)
+		}
+		pool.CommissionUpdateTime = ctx.BlockTime()
+	}
+	pool.CommissionRate = msg.CommissionRate
+	if err := k.SetDelegationPool(ctx, pool); err != nil {
+		return nil, errorsmod.Wrap(err, "set delegation pool")
+	}
+
+	return &types.MsgUpdateCommissionResponse{}, uevent.EmitTypedEvent(ctx, msg)
+}
+
+func (k msgServer) DistributeRewards(goCtx context.Context, msg *types.MsgDistributeRewards) (*types.MsgDistributeRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.DistributeRewards(ctx, sdk.MustAccAddressFromBech32(msg.Sender), seq, msg.Amount)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "distribute rewards")
+	}
+
+	return &types.MsgDistributeRewardsResponse{}, nil
+}
+
+func (k msgServer) WithdrawDelegatorRewards(goCtx context.Context, msg *types.MsgWithdrawDelegatorRewards) (*types.MsgWithdrawDelegatorRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := k.Keeper.WithdrawDelegatorRewards(ctx, msg.Delegator, seq)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "withdraw delegator rewards")
+	}
+
+	return &types.MsgWithdrawDelegatorRewardsResponse{Amount: rewards}, nil
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// UpdateBondAssets sets the non-DYM denoms accepted in the bonds of the sequencers of a rollapp. Every asset must be
+// priced against DYM by its pool. A new asset starts with its spot price as reference price.
+func (k msgServer) UpdateBondAssets(goCtx context.Context, msg *types.MsgUpdateBondAssets) (*types.MsgUpdateBondAssetsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if _, ok := k.rollappKeeper.GetRollapp(ctx, msg.RollappId); !ok {
+		return nil, errorsmod.Wrap(gerrc.ErrNotFound, "rollapp")
+	}
+
+	for _, a := range msg.Assets {
+		if k.priceSource == nil {
+			return nil, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "no price source")
+		}
+		price, err := k.priceSource.CalculateSpotPrice(ctx, a.PoolId, commontypes.DYMCoin.Denom, a.Denom)
+		if err != nil {
+			return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument, "price of %s in pool %d: %s", a.Denom, a.PoolId, err)
+		}
+		if _, ok := k.GetBondAssetPrice(ctx, a.PoolId, a.Denom); !ok {
+			err = k.SetBondAssetPrice(ctx, types.BondAssetPrice{PoolId: a.PoolId, Denom: a.Denom, Price: price})
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "set bond asset price")
+			}
+		}
+	}
+
+	if err := k.SetBondAssets(ctx, msg.BondAssets()); err != nil {
+		return nil, errorsmod.Wrap(err, "set bond assets")
+	}
+
+	return &types.MsgUpdateBondAssetsResponse{}, uevent.EmitTypedEvent(ctx, msg)
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// UpdateProposerSelection lets the rollapp owner, or its sequencer whitelister, choose how proposers are chosen
+func (k msgServer) UpdateProposerSelection(goCtx context.Context, msg *types.MsgUpdateProposerSelection) (*types.MsgUpdateProposerSelectionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ra, ok := k.rollappKeeper.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, errorsmod.Wrap(gerrc.ErrNotFound, "rollapp")
+	}
+	if !ra.HasRole(msg.Owner, rollapptypes.RollappRole_ROLLAPP_ROLE_SEQUENCER_WHITELISTER) {
+		return nil, errorsmod.Wrap(gerrc.ErrPermissionDenied, "not the owner or the sequencer whitelister of the rollapp")
+	}
+
+	sel := msg.Selection()
+	sel.LastProposer = k.GetProposerSelection(ctx, msg.RollappId).LastProposer
+	if err := k.SetProposerSelection(ctx, sel); err != nil {
+		return nil, errorsmod.Wrap(err, "set proposer selection")
+	}
+
+	return &types.MsgUpdateProposerSelectionResponse{}, uevent.EmitTypedEvent(ctx, msg)
+}
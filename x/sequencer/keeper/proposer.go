@@ -11,9 +11,12 @@ import (
 )
 
 // when the proposer did a state update
-func (k Keeper) afterStateUpdate(ctx sdk.Context, prop types.Sequencer, last bool) error {
+func (k Keeper) afterStateUpdate(ctx sdk.Context, prop types.Sequencer, numBlocks uint64, last bool) error {
 	k.reducePenaltyUptime(ctx, &prop)
 	k.SetSequencer(ctx, prop)
+	if err := k.recordStateUpdate(ctx, prop, numBlocks); err != nil {
+		return errorsmod.Wrap(err, "record state update")
+	}
 	if last {
 		return k.OnProposerLastBlock(ctx, prop)
 	}
@@ -44,6 +47,30 @@ func (k Keeper) optOutAllSequencers(ctx sdk.Context, rollapp string) error {
 	return nil
 }
 
+// unbondAllSequencers clears the proposer and successor of the rollapp, then opts out, refunds and unbonds
+// all its sequencers. The unbond blockers are not consulted: the rollapp will not need them anymore.
+func (k Keeper) unbondAllSequencers(ctx sdk.Context, rollapp string) error {
+	k.abruptRemoveProposer(ctx, rollapp)
+	k.SetSuccessor(ctx, rollapp, types.SentinelSeqAddr)
+	if err := k.removeHandover(ctx, rollapp); err != nil {
+		return errorsmod.Wrap(err, "remove handover")
+	}
+
+	for _, seq := range k.RollappSequencers(ctx, rollapp) {
+		if err := seq.SetOptedIn(ctx, false); err != nil {
+			return errorsmod.Wrap(err, "set opted in")
+		}
+		if err := k.refundAll(ctx, &seq); err != nil {
+			return errorsmod.Wrap(err, "refund")
+		}
+		if seq.Bonded() {
+			k.unbond(ctx, &seq)
+		}
+		k.SetSequencer(ctx, seq)
+	}
+	return nil
+}
+
 func (k Keeper) RollappPotentialProposers(ctx sdk.Context, rollappId string) []types.Sequencer {
 	seqs := k.RollappBondedSequencers(ctx, rollappId)
 	seqs = slices.DeleteFunc(seqs, func(seq types.Sequencer) bool {
@@ -54,15 +81,19 @@ func (k Keeper) RollappPotentialProposers(ctx sdk.Context, rollappId string) []t
 
 // ChooseProposerAfterSentinel will assign a new proposer to the rollapp.
 // It will choose a new proposer from the list of potential proposers.
-// A proposer must be available.
+// A proposer must be available. Does nothing while a handover is in progress: the successor is awaited.
 func (k Keeper) ChooseProposerAfterSentinel(ctx sdk.Context, rollapp string) error {
+	if k.HandoverInProgress(ctx, rollapp) {
+		return nil
+	}
+
 	proposer := k.GetProposer(ctx, rollapp)
 
 	if !proposer.Sentinel() {
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "proposer is not sentinel")
 	}
 
-	successor, err := ProposerChoiceAlgo(k.RollappPotentialProposers(ctx, rollapp))
+	successor, err := k.chooseProposer(ctx, rollapp, "")
 	if err != nil {
 		return err
 	}
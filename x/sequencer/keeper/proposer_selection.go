@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// GetProposerSelection returns how the proposers of the rollapp are chosen. The default is the highest bond.
+func (k Keeper) GetProposerSelection(ctx sdk.Context, rollapp string) types.ProposerSelection {
+	sel, err := k.proposerSelections.Get(ctx, rollapp)
+	if err != nil {
+		return types.ProposerSelection{RollappId: rollapp}
+	}
+	return sel
+}
+
+func (k Keeper) SetProposerSelection(ctx sdk.Context, sel types.ProposerSelection) error {
+	return k.proposerSelections.Set(ctx, sel.RollappId, sel)
+}
+
+func (k Keeper) AllProposerSelections(ctx sdk.Context) ([]types.ProposerSelection, error) {
+	iter, err := k.proposerSelections.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// chooseProposer chooses among the potential proposers of the rollapp, except the excluded address, with the
+// selection algorithm of the rollapp. Returns the sentinel if there is no candidate.
+func (k Keeper) chooseProposer(ctx sdk.Context, rollapp string, exclude string) (types.Sequencer, error) {
+	seqs := slices.DeleteFunc(k.RollappPotentialProposers(ctx, rollapp), func(seq types.Sequencer) bool {
+		return seq.Address == exclude && !seq.Sentinel()
+	})
+	value := func(seq types.Sequencer) math.Int {
+		return k.BondValue(ctx, seq)
+	}
+	if len(realCandidates(seqs)) == 0 {
+		return proposerChoiceByValue(seqs, value)
+	}
+
+	sel := k.GetProposerSelection(ctx, rollapp)
+	switch sel.Algo {
+	case types.ProposerSelectionAlgo_PROPOSER_SELECTION_ALGO_HIGHEST_BOND:
+		return proposerChoiceByValue(seqs, value)
+	case types.ProposerSelectionAlgo_PROPOSER_SELECTION_ALGO_ROUND_ROBIN:
+		chosen := roundRobinChoice(seqs, sel.LastProposer)
+		sel.LastProposer = chosen.Address
+		if err := k.SetProposerSelection(ctx, sel); err != nil {
+			return types.Sequencer{}, errorsmod.Wrap(err, "set proposer selection")
+		}
+		return chosen, nil
+	case types.ProposerSelectionAlgo_PROPOSER_SELECTION_ALGO_STAKE_WEIGHTED_RANDOM:
+		return stakeWeightedChoice(seqs, proposerSeed(ctx, rollapp), value), nil
+	case types.ProposerSelectionAlgo_PROPOSER_SELECTION_ALGO_OWNER_LIST:
+		for _, addr := range sel.OwnerList {
+			if i := slices.IndexFunc(seqs, func(seq types.Sequencer) bool { return seq.Address == addr }); i != -1 {
+				return seqs[i], nil
+			}
+		}
+		return proposerChoiceByValue(seqs, value)
+	default:
+		return types.Sequencer{}, errorsmod.Wrapf(gerrc.ErrInternal, "unknown proposer selection algo: %s", sel.Algo)
+	}
+}
+
+// realCandidates returns the non sentinel sequencers, sorted by address
+func realCandidates(seqs []types.Sequencer) []types.Sequencer {
+	ret := slices.DeleteFunc(slices.Clone(seqs), func(seq types.Sequencer) bool {
+		return seq.Sentinel()
+	})
+	slices.SortFunc(ret, func(a, b types.Sequencer) int {
+		return strings.Compare(a.Address, b.Address)
+	})
+	return ret
+}
+
+// roundRobinChoice returns the first candidate after the last one in address order, wrapping around
+func roundRobinChoice(seqs []types.Sequencer, last string) types.Sequencer {
+	cands := realCandidates(seqs)
+	for _, seq := range cands {
+		if last < seq.Address {
+			return seq
+		}
+	}
+	return cands[0]
+}
+
+// stakeWeightedChoice returns a candidate with a probability proportional to its bond value
+func stakeWeightedChoice(seqs []types.Sequencer, seed []byte, value func(types.Sequencer) math.Int) types.Sequencer {
+	cands := realCandidates(seqs)
+	values := make([]math.Int, len(cands))
+	total := math.ZeroInt()
+	for i, seq := range cands {
+		values[i] = value(seq)
+		total = total.Add(values[i])
+	}
+	if !total.IsPositive() {
+		return cands[0]
+	}
+
+	r := math.NewIntFromBigInt(new(big.Int).Mod(new(big.Int).SetBytes(seed), total.BigInt()))
+	for i, seq := range cands {
+		r = r.Sub(values[i])
+		if r.IsNegative() {
+			return seq
+		}
+	}
+	return cands[len(cands)-1]
+}
+
+// proposerSeed derives a deterministic seed for the rollapp from the block hash. The hub block proposer has some
+// control over the header hash, so can grind it to bias the choice towards a sequencer; rollapps that can't accept
+// this should use another selection algo.
+func proposerSeed(ctx sdk.Context, rollapp string) []byte {
+	h := sha256.New()
+	h.Write(ctx.HeaderHash())
+	h.Write([]byte(rollapp))
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(ctx.BlockHeight()))) //nolint:gosec
+	return h.Sum(nil)
+}
+
+// StartScheduledRotations starts the notice period of the proposers of rollapps with a rotation interval, once
+// every interval blocks, so that another sequencer takes over. Rollapps with a rotation in progress, or without
+// another potential proposer, are skipped.
+func (k Keeper) StartScheduledRotations(ctx sdk.Context) error {
+	sels, err := k.AllProposerSelections(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "proposer selections")
+	}
+	for _, sel := range sels {
+		if sel.RotationIntervalBlocks == 0 || uint64(ctx.BlockHeight())%sel.RotationIntervalBlocks != 0 { //nolint:gosec
+			continue
+		}
+		prop := k.GetProposer(ctx, sel.RollappId)
+		if prop.Sentinel() || k.RotationInProgress(ctx, sel.RollappId) {
+			continue
+		}
+		others := slices.DeleteFunc(k.RollappPotentialProposers(ctx, sel.RollappId), func(seq types.Sequencer) bool {
+			return seq.Address == prop.Address
+		})
+		if len(realCandidates(others)) == 0 {
+			continue
+		}
+		k.StartNoticePeriod(ctx, &prop)
+		k.SetSequencer(ctx, prop)
+	}
+	return nil
+}
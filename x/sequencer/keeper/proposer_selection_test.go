@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// chooseAfterSentinel clears the proposer of the rollapp, then lets the selection algorithm choose a new one
+func (s *SequencerTestSuite) chooseAfterSentinel(rollapp string) string {
+	s.k().SetProposer(s.Ctx, rollapp, types.SentinelSeqAddr)
+	s.Require().NoError(s.k().ChooseProposerAfterSentinel(s.Ctx, rollapp))
+	return s.k().GetProposer(s.Ctx, rollapp).Address
+}
+
+func (s *SequencerTestSuite) TestProposerSelection() {
+	ra := s.createRollapp()
+	addrs := []string{
+		s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond).Address,
+		s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, ucoin.SimpleMul(bond, 3)).Address,
+		s.createSequencerWithBond(s.Ctx, ra.RollappId, charlie, ucoin.SimpleMul(bond, 2)).Address,
+	}
+	slices.SortFunc(addrs, strings.Compare)
+
+	update := func(algo types.ProposerSelectionAlgo, ownerList ...string) {
+		_, err := s.msgServer.UpdateProposerSelection(s.Ctx, &types.MsgUpdateProposerSelection{
+			Owner:     ra.Owner,
+			RollappId: ra.RollappId,
+			Algo:      algo,
+			OwnerList: ownerList,
+		})
+		s.Require().NoError(err)
+	}
+
+	s.Run("only the owner", func() {
+		_, err := s.msgServer.UpdateProposerSelection(s.Ctx, &types.MsgUpdateProposerSelection{
+			Owner:     pkAddr(david),
+			RollappId: ra.RollappId,
+		})
+		utest.IsErr(s.Require(), err, gerrc.ErrPermissionDenied)
+	})
+	s.Run("highest bond", func() {
+		s.Require().Equal(pkAddr(bob), s.chooseAfterSentinel(ra.RollappId))
+	})
+	s.Run("round robin", func() {
+		update(types.ProposerSelectionAlgo_PROPOSER_SELECTION_ALGO_ROUND_ROBIN)
+		for i := range 2 * len(addrs) {
+			s.Require().Equal(addrs[i%len(addrs)], s.chooseAfterSentinel(ra.RollappId))
+		}
+	})
+	s.Run("stake weighted random", func() {
+		update(types.ProposerSelectionAlgo_PROPOSER_SELECTION_ALGO_STAKE_WEIGHTED_RANDOM)
+		chosen := s.chooseAfterSentinel(ra.RollappId)
+		s.Require().Contains(addrs, chosen)
+		// deterministic for a given block
+		s.Require().Equal(chosen, s.chooseAfterSentinel(ra.RollappId))
+	})
+	s.Run("owner list", func() {
+		update(types.ProposerSelectionAlgo_PROPOSER_SELECTION_ALGO_OWNER_LIST, pkAddr(david), pkAddr(charlie), pkAddr(alice))
+		// david is not a sequencer
+		s.Require().Equal(pkAddr(charlie), s.chooseAfterSentinel(ra.RollappId))
+	})
+}
+
+func (s *SequencerTestSuite) TestScheduledRotation() {
+	ra := s.createRollapp()
+	seqAlice := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, ucoin.SimpleMul(bond, 2))
+	s.Require().True(s.k().IsProposer(s.Ctx, seqAlice))
+
+	_, err := s.msgServer.UpdateProposerSelection(s.Ctx, &types.MsgUpdateProposerSelection{
+		Owner:                  ra.Owner,
+		RollappId:              ra.RollappId,
+		RotationIntervalBlocks: 10,
+	})
+	s.Require().NoError(err)
+
+	// no other sequencer to take over
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	s.Require().NoError(s.k().StartScheduledRotations(s.Ctx))
+	s.Require().False(s.seq(alice).NoticeStarted())
+
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+
+	// not on the interval
+	s.Ctx = s.Ctx.WithBlockHeight(11)
+	s.Require().NoError(s.k().StartScheduledRotations(s.Ctx))
+	s.Require().False(s.seq(alice).NoticeStarted())
+
+	s.Ctx = s.Ctx.WithBlockHeight(20)
+	s.Require().NoError(s.k().StartScheduledRotations(s.Ctx))
+	s.Require().True(s.seq(alice).NoticeStarted())
+
+	// the successor is chosen once the notice elapses. The proposer is still opted in and has the highest bond,
+	// but doesn't succeed itself.
+	s.Ctx = s.Ctx.WithBlockTime(s.seq(alice).NoticePeriodTime)
+	s.Require().NoError(s.k().ChooseSuccessorForFinishedNotices(s.Ctx, s.Ctx.BlockTime()))
+	s.Require().Equal(pkAddr(bob), s.k().GetSuccessor(s.Ctx, ra.RollappId).Address)
+	s.Require().True(s.seq(alice).OptedIn)
+
+	res, err := s.queryClient.ProposerSelection(s.Ctx, &types.QueryProposerSelectionRequest{RollappId: ra.RollappId})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(10), res.Selection.RotationIntervalBlocks)
+}
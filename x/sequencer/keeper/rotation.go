@@ -1,11 +1,11 @@
 package keeper
 
 import (
-	"slices"
 	"strings"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
@@ -50,12 +50,17 @@ func (k Keeper) ChooseSuccessorForFinishedNotices(ctx sdk.Context, now time.Time
 			return errorsmod.Wrap(err, "choose successor")
 		}
 		successor := k.GetSuccessor(ctx, seq.RollappId)
+		// the rollapp pays the rewards to the delegation rewards account, which shares them with the delegators
+		rewardAddr := successor.RewardAddr
+		if !successor.Sentinel() {
+			rewardAddr = types.DelegationRewardsAddress(successor.Address).String()
+		}
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeRotationStarted,
 				sdk.NewAttribute(types.AttributeKeyRollappId, seq.RollappId),
 				sdk.NewAttribute(types.AttributeKeyNextProposer, successor.Address),
-				sdk.NewAttribute(types.AttributeKeyRewardAddr, successor.RewardAddr),
+				sdk.NewAttribute(types.AttributeKeyRewardAddr, rewardAddr),
 				sdk.NewAttribute(types.AttributeKeyWhitelistedRelayers, strings.Join(successor.WhitelistedRelayers, ",")),
 			),
 		)
@@ -73,7 +78,8 @@ func (k Keeper) AwaitingLastProposerBlock(ctx sdk.Context, rollapp string) bool
 	return proposer.NoticeElapsed(ctx.BlockTime())
 }
 
-// OnProposerLastBlock : it will assign the successor to be the proposer.
+// OnProposerLastBlock : it will start the handover to the successor, who must accept proposership before the
+// deadline. Without handover period or successor, the successor becomes the proposer immediately.
 // Contract: must be called after ChooseSuccessorForFinishedNotices for a given block time
 func (k Keeper) OnProposerLastBlock(ctx sdk.Context, proposer types.Sequencer) error {
 	allowLastBlock := proposer.NoticeElapsed(ctx.BlockTime())
@@ -84,6 +90,15 @@ func (k Keeper) OnProposerLastBlock(ctx sdk.Context, proposer types.Sequencer) e
 	rollapp := proposer.RollappId
 
 	successor := k.GetSuccessor(ctx, rollapp)
+	if successor.Sentinel() || k.GetParams(ctx).HandoverPeriod == 0 {
+		return k.completeRotation(ctx, rollapp, successor)
+	}
+	k.SetProposer(ctx, rollapp, types.SentinelSeqAddr)
+	return errorsmod.Wrap(k.startHandover(ctx, rollapp, successor), "start handover")
+}
+
+// completeRotation makes the successor the proposer of the rollapp
+func (k Keeper) completeRotation(ctx sdk.Context, rollapp string, successor types.Sequencer) error {
 	k.SetSuccessor(ctx, rollapp, types.SentinelSeqAddr) // clear successor
 	k.SetProposer(ctx, rollapp, successor.Address)
 
@@ -100,7 +115,7 @@ func (k Keeper) OnProposerLastBlock(ctx sdk.Context, proposer types.Sequencer) e
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposerRotated,
-			sdk.NewAttribute(types.AttributeKeyRollappId, proposer.RollappId),
+			sdk.NewAttribute(types.AttributeKeyRollappId, rollapp),
 			sdk.NewAttribute(types.AttributeKeySequencer, successor.Address),
 		),
 	)
@@ -108,11 +123,10 @@ func (k Keeper) OnProposerLastBlock(ctx sdk.Context, proposer types.Sequencer) e
 }
 
 // setSuccessorForRotatingRollapp will assign a successor to the rollapp.
-// It will prioritize non sentinel
+// It will prioritize non sentinel, and never choose the rotating proposer.
 // called when a proposer has finished their notice period.
 func (k Keeper) setSuccessorForRotatingRollapp(ctx sdk.Context, rollapp string) error {
-	seqs := k.RollappPotentialProposers(ctx, rollapp)
-	successor, err := ProposerChoiceAlgo(seqs)
+	successor, err := k.chooseProposer(ctx, rollapp, k.GetProposer(ctx, rollapp).Address)
 	if err != nil {
 		return err
 	}
@@ -120,25 +134,26 @@ func (k Keeper) setSuccessorForRotatingRollapp(ctx sdk.Context, rollapp string)
 	return nil
 }
 
-// ProposerChoiceAlgo : choose the one with most bond
+// ProposerChoiceAlgo : choose the one with most bond tokens.
 // Requires sentinel to be passed in, as last resort.
 func ProposerChoiceAlgo(seqs []types.Sequencer) (types.Sequencer, error) {
+	return proposerChoiceByValue(seqs, func(seq types.Sequencer) math.Int {
+		return seq.TokensCoin().Amount
+	})
+}
+
+// proposerChoiceByValue : choose the one with the most bond value, the first one on a tie. It is the default proposer
+// selection algo. Requires sentinel to be passed in, as last resort.
+func proposerChoiceByValue(seqs []types.Sequencer, value func(types.Sequencer) math.Int) (types.Sequencer, error) {
 	if len(seqs) == 0 {
 		return types.Sequencer{}, gerrc.ErrInternal.Wrap("seqs must at least include sentinel")
 	}
-	// slices package is recommended over sort package
-	slices.SortStableFunc(seqs, func(a, b types.Sequencer) int {
-		ca := a.TokensCoin()
-		cb := b.TokensCoin()
-		if ca.IsEqual(cb) {
-			return 0
-		}
-
-		// flipped to sort decreasing
-		if ca.IsLT(cb) {
-			return 1
+	// the value is computed once per sequencer: a bond value prices all the collateral
+	best, bestValue := seqs[0], value(seqs[0])
+	for _, seq := range seqs[1:] {
+		if v := value(seq); v.GT(bestValue) {
+			best, bestValue = seq, v
 		}
-		return -1
-	})
-	return seqs[0], nil
+	}
+	return best, nil
 }
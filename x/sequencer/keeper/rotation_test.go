@@ -44,6 +44,10 @@ func (s *SequencerTestSuite) TestRotationHappyFlow() {
 	err = s.k().OnProposerLastBlock(s.Ctx, s.seq(alice))
 	s.Require().NoError(err)
 	s.Require().False(s.k().IsProposer(s.Ctx, s.seq(alice)))
+
+	// successor accepts
+	_, err = s.msgServer.AcceptProposership(s.Ctx, &types.MsgAcceptProposership{Creator: pkAddr(bob)})
+	s.Require().NoError(err)
 	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(bob)))
 	s.Require().False(s.k().IsSuccessor(s.Ctx, s.seq(bob)))
 }
@@ -89,6 +93,10 @@ func (s *SequencerTestSuite) TestRotationReOptInFlow() {
 		err = s.k().OnProposerLastBlock(s.Ctx, s.seq(prop))
 		s.Require().NoError(err)
 		s.Require().False(s.k().IsProposer(s.Ctx, s.seq(prop)))
+		if s.k().IsSuccessor(s.Ctx, s.seq(succ)) {
+			_, err = s.msgServer.AcceptProposership(s.Ctx, &types.MsgAcceptProposership{Creator: pkAddr(succ)})
+			s.Require().NoError(err)
+		}
 		s.Require().False(s.k().IsSuccessor(s.Ctx, s.seq(succ)))
 
 		// We can rotate Alice -> Bob but not Bob -> Alice
@@ -163,6 +171,8 @@ func (s *SequencerTestSuite) TestRotationProposerAndSuccessorBothUnbond() {
 	err = s.k().OnProposerLastBlock(s.Ctx, s.seq(alice))
 	s.Require().NoError(err)
 	s.Require().False(s.k().IsProposer(s.Ctx, s.seq(alice)))
+	_, err = s.msgServer.AcceptProposership(s.Ctx, &types.MsgAcceptProposership{Creator: pkAddr(bob)})
+	s.Require().NoError(err)
 	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(bob)))
 	s.Require().False(s.k().IsSuccessor(s.Ctx, s.seq(bob)))
 
@@ -133,6 +133,11 @@ func (am AppModule) Name() string {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(*am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/sequencer from version 2 to 3: %v", err))
+	}
 }
 
 // RegisterInvariants registers the module's invariants.
@@ -155,7 +160,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(goCtx context.Context) error {
@@ -167,5 +172,23 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 		return err
 	}
 
+	err = am.keeper.StartScheduledRotations(ctx)
+	if err != nil {
+		ctx.Logger().Error("StartScheduledRotations", "err", err)
+		return err
+	}
+
+	err = am.keeper.ExpireHandovers(ctx)
+	if err != nil {
+		ctx.Logger().Error("ExpireHandovers", "err", err)
+		return err
+	}
+
+	err = am.keeper.CompleteUnbondingDelegations(ctx, ctx.BlockTime())
+	if err != nil {
+		ctx.Logger().Error("CompleteUnbondingDelegations", "err", err)
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,67 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+)
+
+func (a BondAsset) ValidateBasic() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+	if a.Denom == commontypes.DYMCoin.Denom {
+		return errorsmod.Wrap(ErrInvalidDenom, "DYM is always accepted")
+	}
+	if a.Haircut.IsNil() || a.Haircut.IsNegative() || a.Haircut.GTE(math.LegacyOneDec()) {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "haircut must be in [0, 1)")
+	}
+	return nil
+}
+
+func (a RollappBondAssets) ValidateBasic() error {
+	seen := make(map[string]struct{})
+	for _, asset := range a.Assets {
+		if _, ok := seen[asset.Denom]; ok {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "duplicate asset: %s", asset.Denom)
+		}
+		seen[asset.Denom] = struct{}{}
+		if err := asset.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "asset: %s", asset.Denom)
+		}
+	}
+	return nil
+}
+
+// Get returns the asset of the denom, false if the rollapp doesn't accept it
+func (a RollappBondAssets) Get(denom string) (BondAsset, bool) {
+	for _, asset := range a.Assets {
+		if asset.Denom == denom {
+			return asset, true
+		}
+	}
+	return BondAsset{}, false
+}
+
+func (p BondAssetPrice) ValidateBasic() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+	if p.Price.IsNil() || p.Price.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "price must not be negative")
+	}
+	return nil
+}
+
+func (c Collateral) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(c.Sequencer); err != nil {
+		return errorsmod.Wrap(ErrInvalidAddr, err.Error())
+	}
+	if err := c.Coins.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidCoins, err.Error())
+	}
+	return nil
+}
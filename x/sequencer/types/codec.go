@@ -21,6 +21,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPunishSequencer{}, "sequencer/PunishSequencer", nil)
 	cdc.RegisterConcrete(&MsgUpdateSequencerInformation{}, "sequencer/UpdateSequencerInformation", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "sequencer/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgDelegate{}, "sequencer/Delegate", nil)
+	cdc.RegisterConcrete(&MsgUndelegate{}, "sequencer/Undelegate", nil)
+	cdc.RegisterConcrete(&MsgUpdateCommission{}, "sequencer/UpdateCommission", nil)
+	cdc.RegisterConcrete(&MsgDistributeRewards{}, "sequencer/DistributeRewards", nil)
+	cdc.RegisterConcrete(&MsgUpdateProposerSelection{}, "sequencer/UpdateProposerSelection", nil)
+	cdc.RegisterConcrete(&MsgUpdateBondAssets{}, "sequencer/UpdateBondAssets", nil)
+	cdc.RegisterConcrete(&MsgAcceptProposership{}, "sequencer/AcceptProposership", nil)
+	cdc.RegisterConcrete(&MsgWithdrawDelegatorRewards{}, "sequencer/WithdrawDelegatorRewards", nil)
 	cdc.RegisterConcrete(Params{}, "sequencer/Params", nil)
 }
 
@@ -37,6 +45,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgPunishSequencer{},
 		&MsgUpdateSequencerInformation{},
+		&MsgDelegate{},
+		&MsgUndelegate{},
+		&MsgUpdateCommission{},
+		&MsgDistributeRewards{},
+		&MsgUpdateProposerSelection{},
+		&MsgUpdateBondAssets{},
+		&MsgAcceptProposership{},
+		&MsgWithdrawDelegatorRewards{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,111 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+// AccruedRewardsAddress holds the rewards accrued to the delegators of every sequencer until they withdraw them
+var AccruedRewardsAddress sdk.AccAddress = address.Module(ModuleName, []byte("accrued_rewards"))
+
+// DelegationRewardsAddress is the account the rollapp pays the rewards of the sequencer to. Its balance is
+// shared between the delegators and the reward address of the sequencer whenever the rewards are accrued, so the
+// delegators get their part whether or not the sequencer cooperates.
+func DelegationRewardsAddress(seq string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("delegation_rewards"), []byte(seq))
+}
+
+// NewDelegationPool returns an empty delegation pool for the sequencer
+func NewDelegationPool(seq string) DelegationPool {
+	return DelegationPool{
+		Sequencer:      seq,
+		Tokens:         math.ZeroInt(),
+		Shares:         math.LegacyZeroDec(),
+		CommissionRate: math.LegacyZeroDec(),
+	}
+}
+
+func NewDelegation(delegator, seq string) Delegation {
+	return Delegation{
+		Delegator: delegator,
+		Sequencer: seq,
+		Shares:    math.LegacyZeroDec(),
+	}
+}
+
+// SharesFor returns the shares issued for delegating the tokens
+func (p DelegationPool) SharesFor(tokens math.Int) (math.LegacyDec, error) {
+	if p.Shares.IsZero() {
+		return math.LegacyNewDecFromInt(tokens), nil
+	}
+	if p.Tokens.IsZero() {
+		return math.LegacyDec{}, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "delegation pool was entirely slashed")
+	}
+	return p.Shares.MulInt(tokens).QuoInt(p.Tokens), nil
+}
+
+// TokensFor returns the tokens the shares are worth
+func (p DelegationPool) TokensFor(shares math.LegacyDec) math.Int {
+	if p.Shares.IsZero() {
+		return math.ZeroInt()
+	}
+	if shares.Equal(p.Shares) {
+		return p.Tokens
+	}
+	return shares.MulInt(p.Tokens).Quo(p.Shares).TruncateInt()
+}
+
+// Add issues shares for the tokens
+func (p *DelegationPool) Add(tokens math.Int, shares math.LegacyDec) {
+	p.Tokens = p.Tokens.Add(tokens)
+	p.Shares = p.Shares.Add(shares)
+}
+
+// Accrue adds the delegators' rewards to the rewards per share. Returns the amount which must be set aside for the
+// delegators: the rewards per share are truncated, so it covers what the shares can withdraw.
+func (p *DelegationPool) Accrue(rewards sdk.DecCoins) sdk.Coins {
+	perShare := rewards.QuoDecTruncate(p.Shares)
+	p.RewardsPerShare = p.RewardsPerShare.Add(perShare...)
+	owed := sdk.NewCoins()
+	for _, c := range perShare.MulDecTruncate(p.Shares) {
+		owed = owed.Add(sdk.NewCoin(c.Denom, c.Amount.Ceil().TruncateInt()))
+	}
+	return owed
+}
+
+// PendingRewards returns the rewards accrued to the shares since the rewards per share were at index
+func (p DelegationPool) PendingRewards(shares math.LegacyDec, index sdk.DecCoins) sdk.Coins {
+	pending, _ := p.RewardsPerShare.Sub(index).MulDecTruncate(shares).TruncateDecimal()
+	return pending
+}
+
+// Remove redeems the shares and returns the tokens they are worth. The last shares take the rounding dust.
+func (p *DelegationPool) Remove(shares math.LegacyDec) math.Int {
+	tokens := p.TokensFor(shares)
+	p.Tokens = p.Tokens.Sub(tokens)
+	p.Shares = p.Shares.Sub(shares)
+	return tokens
+}
+
+func (p DelegationPool) ValidateBasic() error {
+	if p.Tokens.IsNil() || p.Tokens.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "tokens")
+	}
+	if p.Shares.IsNil() || p.Shares.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "shares")
+	}
+	if err := p.RewardsPerShare.Validate(); err != nil {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "rewards per share: %s", err)
+	}
+	return ValidateCommissionRate(p.CommissionRate)
+}
+
+func ValidateCommissionRate(r math.LegacyDec) error {
+	if r.IsNil() || r.IsNegative() || r.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "commission rate must be between 0 and 1")
+	}
+	return nil
+}
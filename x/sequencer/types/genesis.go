@@ -42,6 +42,83 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, p := range gs.DelegationPools {
+		if _, ok := sequencerIndexMap[string(SequencerKey(p.Sequencer))]; !ok {
+			return fmt.Errorf("delegation pool of non-existent sequencer: %s", p.Sequencer)
+		}
+		if err := p.ValidateBasic(); err != nil {
+			return fmt.Errorf("delegation pool: %s: %w", p.Sequencer, err)
+		}
+	}
+
+	for _, sel := range gs.ProposerSelections {
+		if err := sel.ValidateBasic(); err != nil {
+			return fmt.Errorf("proposer selection: %s: %w", sel.RollappId, err)
+		}
+	}
+
+	metricsIndexMap := make(map[string]struct{})
+	for _, m := range gs.SequencerMetrics {
+		if _, ok := metricsIndexMap[m.Sequencer]; ok {
+			return fmt.Errorf("duplicated metrics for sequencer: %s", m.Sequencer)
+		}
+		metricsIndexMap[m.Sequencer] = struct{}{}
+		if err := m.ValidateBasic(); err != nil {
+			return fmt.Errorf("sequencer metrics: %s: %w", m.Sequencer, err)
+		}
+	}
+
+	bondAssetsIndexMap := make(map[string]struct{})
+	for _, a := range gs.BondAssets {
+		if _, ok := bondAssetsIndexMap[a.RollappId]; ok {
+			return fmt.Errorf("duplicated bond assets for rollapp: %s", a.RollappId)
+		}
+		bondAssetsIndexMap[a.RollappId] = struct{}{}
+		if err := a.ValidateBasic(); err != nil {
+			return fmt.Errorf("bond assets: %s: %w", a.RollappId, err)
+		}
+	}
+
+	collateralIndexMap := make(map[string]struct{})
+	for _, c := range gs.Collaterals {
+		if _, ok := sequencerIndexMap[string(SequencerKey(c.Sequencer))]; !ok {
+			return fmt.Errorf("collateral of non-existent sequencer: %s", c.Sequencer)
+		}
+		if _, ok := collateralIndexMap[c.Sequencer]; ok {
+			return fmt.Errorf("duplicated collateral for sequencer: %s", c.Sequencer)
+		}
+		collateralIndexMap[c.Sequencer] = struct{}{}
+		if err := c.ValidateBasic(); err != nil {
+			return fmt.Errorf("collateral: %s: %w", c.Sequencer, err)
+		}
+	}
+
+	bondAssetPriceIndexMap := make(map[string]struct{})
+	for _, p := range gs.BondAssetPrices {
+		key := fmt.Sprintf("%d/%s", p.PoolId, p.Denom)
+		if _, ok := bondAssetPriceIndexMap[key]; ok {
+			return fmt.Errorf("duplicated bond asset price: %s", key)
+		}
+		bondAssetPriceIndexMap[key] = struct{}{}
+		if err := p.ValidateBasic(); err != nil {
+			return fmt.Errorf("bond asset price: %s: %w", key, err)
+		}
+	}
+
+	handoverIndexMap := make(map[string]struct{})
+	for _, h := range gs.Handovers {
+		if _, ok := handoverIndexMap[h.RollappId]; ok {
+			return fmt.Errorf("duplicated handover for rollapp: %s", h.RollappId)
+		}
+		handoverIndexMap[h.RollappId] = struct{}{}
+		if err := h.ValidateBasic(); err != nil {
+			return fmt.Errorf("handover: %s: %w", h.RollappId, err)
+		}
+		if _, ok := sequencerIndexMap[string(SequencerKey(h.Successor))]; !ok {
+			return fmt.Errorf("handover to non-existent sequencer: %s", h.Successor)
+		}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
@@ -33,7 +33,16 @@ type GenesisState struct {
 	// genesisSuccessor is a list of the defined genesis proposers
 	GenesisSuccessors []GenesisProposer `protobuf:"bytes,5,rep,name=genesisSuccessors,proto3" json:"genesisSuccessors"`
 	// list of sequencers in the notice queue
-	NoticeQueue []string `protobuf:"bytes,4,rep,name=noticeQueue,proto3" json:"noticeQueue,omitempty"`
+	NoticeQueue          []string              `protobuf:"bytes,4,rep,name=noticeQueue,proto3" json:"noticeQueue,omitempty"`
+	DelegationPools      []DelegationPool      `protobuf:"bytes,6,rep,name=delegation_pools,json=delegationPools,proto3" json:"delegation_pools"`
+	Delegations          []Delegation          `protobuf:"bytes,7,rep,name=delegations,proto3" json:"delegations"`
+	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,8,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
+	ProposerSelections   []ProposerSelection   `protobuf:"bytes,9,rep,name=proposer_selections,json=proposerSelections,proto3" json:"proposer_selections"`
+	SequencerMetrics     []SequencerMetrics    `protobuf:"bytes,10,rep,name=sequencer_metrics,json=sequencerMetrics,proto3" json:"sequencer_metrics"`
+	BondAssets           []RollappBondAssets   `protobuf:"bytes,11,rep,name=bond_assets,json=bondAssets,proto3" json:"bond_assets"`
+	Collaterals          []Collateral          `protobuf:"bytes,12,rep,name=collaterals,proto3" json:"collaterals"`
+	Handovers            []Handover            `protobuf:"bytes,13,rep,name=handovers,proto3" json:"handovers"`
+	BondAssetPrices      []BondAssetPrice      `protobuf:"bytes,14,rep,name=bond_asset_prices,json=bondAssetPrices,proto3" json:"bond_asset_prices"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -104,6 +113,69 @@ func (m *GenesisState) GetNoticeQueue() []string {
 	return nil
 }
 
+func (m *GenesisState) GetDelegationPools() []DelegationPool {
+	if m != nil {
+		return m.DelegationPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegations() []Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingDelegations() []UnbondingDelegation {
+	if m != nil {
+		return m.UnbondingDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposerSelections() []ProposerSelection {
+	if m != nil {
+		return m.ProposerSelections
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSequencerMetrics() []SequencerMetrics {
+	if m != nil {
+		return m.SequencerMetrics
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBondAssets() []RollappBondAssets {
+	if m != nil {
+		return m.BondAssets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollaterals() []Collateral {
+	if m != nil {
+		return m.Collaterals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHandovers() []Handover {
+	if m != nil {
+		return m.Handovers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBondAssetPrices() []BondAssetPrice {
+	if m != nil {
+		return m.BondAssetPrices
+	}
+	return nil
+}
+
 type GenesisProposer struct {
 	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	RollappId string `protobuf:"bytes,2,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
@@ -166,30 +238,46 @@ func init() {
 }
 
 var fileDescriptor_3115db717b16c2af = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x4e, 0xc2, 0x40,
-	0x10, 0x86, 0x5b, 0x8a, 0x18, 0x16, 0x8d, 0xba, 0xf1, 0xb0, 0x21, 0xa6, 0x36, 0x9c, 0x9a, 0x18,
-	0x5b, 0x85, 0xc4, 0x07, 0xe0, 0x20, 0x21, 0xf1, 0x80, 0x70, 0x30, 0xf1, 0x56, 0xda, 0x49, 0x6d,
-	0x02, 0xdd, 0xba, 0xb3, 0x35, 0xe0, 0x53, 0xf8, 0x02, 0xbe, 0x0f, 0x47, 0x8e, 0x9e, 0x8c, 0x81,
-	0x17, 0x31, 0xb6, 0xa5, 0x80, 0xc4, 0xf4, 0xe0, 0x6d, 0x66, 0xfa, 0xff, 0xdf, 0x3f, 0xe9, 0x0e,
-	0xb1, 0xbc, 0xe9, 0x18, 0x42, 0x0c, 0x78, 0x38, 0x99, 0xbe, 0xda, 0x79, 0x63, 0x23, 0x3c, 0xc7,
-	0x10, 0xba, 0x20, 0x6c, 0x1f, 0x42, 0xc0, 0x00, 0xad, 0x48, 0x70, 0xc9, 0xa9, 0xb1, 0xa9, 0x5f,
-	0x9b, 0xad, 0x5c, 0x5f, 0x3f, 0xf5, 0xb9, 0xcf, 0x13, 0xb1, 0xfd, 0x53, 0xa5, 0xbe, 0xfa, 0x65,
-	0x61, 0x4e, 0xe4, 0x08, 0x67, 0x9c, 0xc5, 0xd4, 0xaf, 0x0a, 0xe5, 0x79, 0x95, 0x3a, 0x1a, 0xef,
-	0x1a, 0x39, 0xe8, 0xa4, 0xab, 0x0e, 0xa4, 0x23, 0x81, 0xde, 0x92, 0x4a, 0x8a, 0x64, 0xaa, 0xa1,
-	0x9a, 0xb5, 0xa6, 0x69, 0x15, 0xad, 0x6e, 0xf5, 0x12, 0x7d, 0xbb, 0x3c, 0xfb, 0x3c, 0x57, 0xfa,
-	0x99, 0x9b, 0x3e, 0x90, 0xc3, 0x5c, 0x71, 0x17, 0xa0, 0x64, 0x25, 0x43, 0x33, 0x6b, 0xcd, 0x8b,
-	0x62, 0xdc, 0x60, 0x55, 0x65, 0xc4, 0x6d, 0x0e, 0x75, 0xc9, 0x71, 0xf6, 0x6f, 0x7b, 0x82, 0x47,
-	0x1c, 0x41, 0x20, 0xd3, 0x12, 0xf6, 0x75, 0x31, 0xbb, 0xb3, 0xed, 0xcc, 0x12, 0x76, 0x80, 0x14,
-	0xc8, 0x49, 0x36, 0x1b, 0xc4, 0xae, 0x0b, 0x88, 0x5c, 0x20, 0xdb, 0xfb, 0x5f, 0xca, 0x2e, 0x91,
-	0x1a, 0xa4, 0x16, 0x72, 0x19, 0xb8, 0x70, 0x1f, 0x43, 0x0c, 0xac, 0x6c, 0x68, 0x66, 0xb5, 0xbf,
-	0x39, 0x6a, 0x74, 0xc9, 0xd1, 0x2f, 0x1a, 0x65, 0x64, 0xdf, 0xf1, 0x3c, 0x01, 0x98, 0x3e, 0x51,
-	0xb5, 0xbf, 0x6a, 0xe9, 0x19, 0xa9, 0x0a, 0x3e, 0x1a, 0x39, 0x51, 0xd4, 0xf5, 0x58, 0x29, 0xf9,
-	0xb6, 0x1e, 0xb4, 0x7b, 0xb3, 0x85, 0xae, 0xce, 0x17, 0xba, 0xfa, 0xb5, 0xd0, 0xd5, 0xb7, 0xa5,
-	0xae, 0xcc, 0x97, 0xba, 0xf2, 0xb1, 0xd4, 0x95, 0xc7, 0x1b, 0x3f, 0x90, 0x4f, 0xf1, 0xd0, 0x72,
-	0xf9, 0xd8, 0xfe, 0xe3, 0x82, 0x5e, 0x5a, 0xf6, 0x64, 0xe3, 0x8c, 0xe4, 0x34, 0x02, 0x1c, 0x56,
-	0x92, 0x1b, 0x6a, 0x7d, 0x07, 0x00, 0x00, 0xff, 0xff, 0x74, 0x53, 0x26, 0xab, 0x0e, 0x03, 0x00,
-	0x00,
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x5d, 0x6f, 0xd3, 0x3c,
+	0x14, 0xc7, 0xdb, 0x75, 0x4f, 0xf7, 0xd4, 0xd9, 0xd8, 0x66, 0x86, 0x64, 0x55, 0x28, 0x54, 0xbb,
+	0xaa, 0x78, 0x49, 0xb6, 0x56, 0x20, 0x71, 0x49, 0x41, 0x8c, 0x49, 0x80, 0x4a, 0x0b, 0x42, 0xda,
+	0x4d, 0x94, 0x17, 0x2b, 0x0b, 0x4a, 0xe3, 0x90, 0x93, 0x4c, 0x2b, 0x77, 0x7c, 0x03, 0x3e, 0xd6,
+	0x2e, 0x77, 0xc9, 0x15, 0x42, 0xed, 0x17, 0x41, 0x71, 0x9c, 0x97, 0xb6, 0x42, 0x6e, 0xc5, 0x9d,
+	0x73, 0x7c, 0xfe, 0xbf, 0xbf, 0x7d, 0x7c, 0xec, 0x20, 0xcd, 0x99, 0x4e, 0x68, 0x00, 0x1e, 0x0b,
+	0xae, 0xa7, 0xdf, 0xf4, 0xe2, 0x43, 0x07, 0xfa, 0x35, 0xa1, 0x81, 0x4d, 0x23, 0xdd, 0xa5, 0x01,
+	0x05, 0x0f, 0xb4, 0x30, 0x62, 0x31, 0xc3, 0x9d, 0x6a, 0x7e, 0x29, 0xd6, 0x8a, 0xfc, 0xf6, 0x91,
+	0xcb, 0x5c, 0xc6, 0x93, 0xf5, 0x74, 0x94, 0xe9, 0xda, 0x4f, 0xa4, 0x3e, 0xa1, 0x19, 0x99, 0x13,
+	0x61, 0xd3, 0x3e, 0x91, 0xa6, 0x17, 0x23, 0xa1, 0x38, 0x95, 0x2a, 0x1c, 0xea, 0x53, 0xd7, 0x8c,
+	0xd3, 0xd5, 0x66, 0x12, 0x5d, 0x2a, 0xb9, 0x34, 0x03, 0x87, 0x5d, 0x6d, 0xe0, 0x61, 0xb1, 0xc0,
+	0x31, 0x4c, 0x00, 0x1a, 0x0b, 0x89, 0xbc, 0xbe, 0x13, 0x1a, 0x47, 0x9e, 0x9d, 0x6f, 0xfc, 0xb9,
+	0xbc, 0x4e, 0x11, 0x0b, 0x19, 0xd0, 0xc8, 0x00, 0xea, 0x53, 0xbb, 0xdc, 0xce, 0xf1, 0x77, 0x84,
+	0x76, 0xcf, 0xb2, 0xc3, 0x1a, 0xc7, 0x66, 0x4c, 0xf1, 0x6b, 0xd4, 0xcc, 0x8a, 0x4a, 0xea, 0x9d,
+	0x7a, 0x57, 0xe9, 0x75, 0x35, 0xd9, 0xe1, 0x69, 0x43, 0x9e, 0x3f, 0xd8, 0xbe, 0xf9, 0xf5, 0xa0,
+	0x36, 0x12, 0x6a, 0xfc, 0x19, 0xed, 0x15, 0x19, 0x6f, 0x3d, 0x88, 0xc9, 0x56, 0xa7, 0xd1, 0x55,
+	0x7a, 0x8f, 0xe4, 0xb8, 0x71, 0x3e, 0x12, 0xc4, 0x45, 0x0e, 0xb6, 0xd1, 0x81, 0xe8, 0xae, 0xa1,
+	0xd8, 0x14, 0x90, 0x06, 0x67, 0x9f, 0xca, 0xd9, 0x67, 0x8b, 0x4a, 0xe1, 0xb0, 0x02, 0xc4, 0x14,
+	0x1d, 0x8a, 0xd8, 0x38, 0xb1, 0x6d, 0x0a, 0xc0, 0x22, 0x20, 0xff, 0xfd, 0x9b, 0xcb, 0x2a, 0x11,
+	0x77, 0x90, 0x12, 0xb0, 0xd8, 0xb3, 0xe9, 0x87, 0x84, 0x26, 0x94, 0x6c, 0x77, 0x1a, 0xdd, 0xd6,
+	0xa8, 0x1a, 0xc2, 0x26, 0x3a, 0x28, 0x5b, 0xd0, 0x08, 0x19, 0xf3, 0x81, 0x34, 0xf9, 0x3a, 0x4e,
+	0xe4, 0xeb, 0x78, 0x55, 0x28, 0x87, 0x8c, 0xf9, 0x62, 0x19, 0xfb, 0xce, 0x42, 0x14, 0xf0, 0x47,
+	0xa4, 0x94, 0x21, 0x20, 0x3b, 0x9c, 0xfe, 0x78, 0x13, 0xba, 0x20, 0x57, 0x31, 0x38, 0x44, 0xf7,
+	0x92, 0x20, 0xed, 0x6c, 0x2f, 0x70, 0x8d, 0x2a, 0xff, 0x7f, 0xce, 0x7f, 0x2a, 0xe7, 0x7f, 0xca,
+	0xe5, 0x2b, 0x46, 0x47, 0xc9, 0xea, 0x14, 0xe0, 0x2f, 0xe8, 0xee, 0x6a, 0x9b, 0x03, 0x69, 0x71,
+	0xbf, 0xfe, 0x1a, 0x6d, 0x2c, 0xc4, 0xe3, 0x5c, 0x2b, 0xdc, 0x70, 0xb8, 0x3c, 0xc1, 0xfb, 0xa3,
+	0x10, 0x1a, 0xe2, 0x32, 0x12, 0xc4, 0x9d, 0x7a, 0x1b, 0x74, 0xf8, 0xbb, 0x4c, 0x99, 0xb7, 0x21,
+	0x2c, 0xc5, 0xf1, 0x05, 0x52, 0xca, 0xc7, 0x01, 0x88, 0xb2, 0xee, 0x56, 0x46, 0xcc, 0xf7, 0xcd,
+	0x30, 0x1c, 0xb0, 0xc0, 0x79, 0xc1, 0xa5, 0xc2, 0x01, 0x59, 0x45, 0x24, 0x3d, 0x76, 0x3b, 0x4d,
+	0x8b, 0x69, 0x64, 0xfa, 0x40, 0x76, 0xd7, 0x3d, 0xf6, 0x97, 0x85, 0x28, 0x3f, 0xf6, 0x0a, 0x06,
+	0xbf, 0x47, 0xad, 0xfc, 0xfd, 0x03, 0xb2, 0xc7, 0x99, 0x0f, 0xe5, 0xcc, 0x37, 0x42, 0x22, 0x88,
+	0x25, 0x02, 0x5b, 0xe8, 0xb0, 0xac, 0x80, 0x11, 0x46, 0x9e, 0x4d, 0x81, 0xdc, 0x59, 0xf7, 0x02,
+	0x14, 0x05, 0x18, 0xa6, 0xc2, 0xfc, 0x02, 0x58, 0x0b, 0x51, 0x38, 0x3e, 0x47, 0xfb, 0x4b, 0x37,
+	0x16, 0x13, 0xb4, 0x63, 0x3a, 0x4e, 0x44, 0x21, 0x7b, 0x06, 0x5b, 0xa3, 0xfc, 0x13, 0xdf, 0x47,
+	0xad, 0x28, 0xab, 0xee, 0xb9, 0x43, 0xb6, 0xf8, 0x5c, 0x19, 0x18, 0x0c, 0x6f, 0x66, 0x6a, 0xfd,
+	0x76, 0xa6, 0xd6, 0x7f, 0xcf, 0xd4, 0xfa, 0x8f, 0xb9, 0x5a, 0xbb, 0x9d, 0xab, 0xb5, 0x9f, 0x73,
+	0xb5, 0x76, 0xf1, 0xcc, 0xf5, 0xe2, 0xcb, 0xc4, 0xd2, 0x6c, 0x36, 0xf9, 0xdb, 0x2f, 0xe4, 0xaa,
+	0xaf, 0x5f, 0x57, 0xde, 0xec, 0x78, 0x1a, 0x52, 0xb0, 0x9a, 0xfc, 0x9d, 0xee, 0xff, 0x19, 0x00,
+	0x46, 0xad, 0xea, 0x3a, 0x74, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -212,6 +300,132 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BondAssetPrices) > 0 {
+		for iNdEx := len(m.BondAssetPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BondAssetPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Handovers) > 0 {
+		for iNdEx := len(m.Handovers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Handovers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Collaterals) > 0 {
+		for iNdEx := len(m.Collaterals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collaterals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.BondAssets) > 0 {
+		for iNdEx := len(m.BondAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BondAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.SequencerMetrics) > 0 {
+		for iNdEx := len(m.SequencerMetrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SequencerMetrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ProposerSelections) > 0 {
+		for iNdEx := len(m.ProposerSelections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposerSelections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DelegationPools) > 0 {
+		for iNdEx := len(m.DelegationPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.GenesisSuccessors) > 0 {
 		for iNdEx := len(m.GenesisSuccessors) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -356,6 +570,60 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DelegationPools) > 0 {
+		for _, e := range m.DelegationPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for _, e := range m.UnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposerSelections) > 0 {
+		for _, e := range m.ProposerSelections {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SequencerMetrics) > 0 {
+		for _, e := range m.SequencerMetrics {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BondAssets) > 0 {
+		for _, e := range m.BondAssets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Collaterals) > 0 {
+		for _, e := range m.Collaterals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Handovers) > 0 {
+		for _, e := range m.Handovers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BondAssetPrices) > 0 {
+		for _, e := range m.BondAssetPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -578,6 +846,312 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationPools = append(m.DelegationPools, DelegationPool{})
+			if err := m.DelegationPools[len(m.DelegationPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingDelegations = append(m.UnbondingDelegations, UnbondingDelegation{})
+			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSelections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerSelections = append(m.ProposerSelections, ProposerSelection{})
+			if err := m.ProposerSelections[len(m.ProposerSelections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequencerMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SequencerMetrics = append(m.SequencerMetrics, SequencerMetrics{})
+			if err := m.SequencerMetrics[len(m.SequencerMetrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondAssets = append(m.BondAssets, RollappBondAssets{})
+			if err := m.BondAssets[len(m.BondAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collaterals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collaterals = append(m.Collaterals, Collateral{})
+			if err := m.Collaterals[len(m.Collaterals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handovers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handovers = append(m.Handovers, Handover{})
+			if err := m.Handovers[len(m.Handovers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondAssetPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondAssetPrices = append(m.BondAssetPrices, BondAssetPrice{})
+			if err := m.BondAssetPrices[len(m.BondAssetPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,17 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func (h Handover) ValidateBasic() error {
+	if h.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id is required")
+	}
+	if _, err := sdk.AccAddressFromBech32(h.Successor); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid successor address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,436 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/sequencer/handover.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Handover is the last phase of a rotation, after the last block of the
+// proposer. The rollapp has no proposer until the successor accepts
+// proposership.
+type Handover struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// successor is the bech32-encoded address of the awaited successor
+	Successor string `protobuf:"bytes,2,opt,name=successor,proto3" json:"successor,omitempty"`
+	// deadline is the time by which the successor must accept proposership.
+	// After it, the successor is penalized and the next candidate is awaited.
+	Deadline time.Time `protobuf:"bytes,3,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *Handover) Reset()         { *m = Handover{} }
+func (m *Handover) String() string { return proto.CompactTextString(m) }
+func (*Handover) ProtoMessage()    {}
+func (*Handover) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa816931bd358b57, []int{0}
+}
+func (m *Handover) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Handover) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Handover.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Handover) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Handover.Merge(m, src)
+}
+func (m *Handover) XXX_Size() int {
+	return m.Size()
+}
+func (m *Handover) XXX_DiscardUnknown() {
+	xxx_messageInfo_Handover.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Handover proto.InternalMessageInfo
+
+func (m *Handover) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *Handover) GetSuccessor() string {
+	if m != nil {
+		return m.Successor
+	}
+	return ""
+}
+
+func (m *Handover) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*Handover)(nil), "dymensionxyz.dymension.sequencer.Handover")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/handover.proto", fileDescriptor_fa816931bd358b57)
+}
+
+var fileDescriptor_fa816931bd358b57 = []byte{
+	// 265 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x31, 0x4f, 0x83, 0x40,
+	0x18, 0x86, 0x39, 0x4d, 0x0c, 0x9c, 0x1b, 0x71, 0x20, 0x44, 0x0f, 0xe2, 0xd4, 0xe9, 0x2e, 0xb1,
+	0x89, 0xb3, 0xe9, 0xa4, 0x9b, 0x69, 0x9c, 0x5c, 0x0c, 0x70, 0x9f, 0x94, 0x04, 0xf8, 0xf0, 0xee,
+	0x68, 0x8a, 0xff, 0xc0, 0xad, 0x3f, 0xab, 0x63, 0x47, 0x27, 0x35, 0xf0, 0x47, 0x8c, 0xd0, 0x62,
+	0x97, 0x6e, 0xf7, 0x7e, 0xf7, 0x3e, 0x79, 0x92, 0x97, 0x0a, 0xd9, 0x14, 0x50, 0xea, 0x0c, 0xcb,
+	0x55, 0xf3, 0xfe, 0x1f, 0x84, 0x86, 0xb7, 0x1a, 0xca, 0x04, 0x94, 0x58, 0x44, 0xa5, 0xc4, 0x25,
+	0x28, 0x5e, 0x29, 0x34, 0xe8, 0x86, 0x87, 0x00, 0x1f, 0x03, 0x1f, 0x01, 0xff, 0x22, 0xc5, 0x14,
+	0xfb, 0xb2, 0xf8, 0x7b, 0x0d, 0x9c, 0x1f, 0xa4, 0x88, 0x69, 0x0e, 0xa2, 0x4f, 0x71, 0xfd, 0x2a,
+	0x4c, 0x56, 0x80, 0x36, 0x51, 0x51, 0x0d, 0x85, 0xeb, 0x0f, 0x42, 0xed, 0xfb, 0x9d, 0xcb, 0xbd,
+	0xa2, 0x54, 0x61, 0x9e, 0x47, 0x55, 0xf5, 0x92, 0x49, 0x8f, 0x84, 0x64, 0xe2, 0xcc, 0x9d, 0xdd,
+	0xe5, 0x41, 0xba, 0x97, 0xd4, 0xd1, 0x75, 0x92, 0x80, 0xd6, 0xa8, 0xbc, 0x93, 0xe1, 0x77, 0x3c,
+	0xb8, 0x77, 0xd4, 0x96, 0x10, 0xc9, 0x3c, 0x2b, 0xc1, 0x3b, 0x0d, 0xc9, 0xe4, 0xfc, 0xc6, 0xe7,
+	0x83, 0x9d, 0xef, 0xed, 0xfc, 0x69, 0x6f, 0x9f, 0xd9, 0x9b, 0xaf, 0xc0, 0x5a, 0x7f, 0x07, 0x64,
+	0x3e, 0x52, 0xb3, 0xc7, 0x4d, 0xcb, 0xc8, 0xb6, 0x65, 0xe4, 0xa7, 0x65, 0x64, 0xdd, 0x31, 0x6b,
+	0xdb, 0x31, 0xeb, 0xb3, 0x63, 0xd6, 0xf3, 0x6d, 0x9a, 0x99, 0x45, 0x1d, 0xf3, 0x04, 0x8b, 0x63,
+	0xd3, 0x2d, 0xa7, 0x62, 0x75, 0xb0, 0x9f, 0x69, 0x2a, 0xd0, 0xf1, 0x59, 0x6f, 0x9e, 0xfe, 0x0e,
+	0x00, 0xe9, 0x11, 0x59, 0x9e, 0x70, 0x01, 0x00, 0x00,
+}
+
+func (m *Handover) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Handover) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Handover) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintHandover(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Successor) > 0 {
+		i -= len(m.Successor)
+		copy(dAtA[i:], m.Successor)
+		i = encodeVarintHandover(dAtA, i, uint64(len(m.Successor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintHandover(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHandover(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHandover(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Handover) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovHandover(uint64(l))
+	}
+	l = len(m.Successor)
+	if l > 0 {
+		n += 1 + l + sovHandover(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovHandover(uint64(l))
+	return n
+}
+
+func sovHandover(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHandover(x uint64) (n int) {
+	return sovHandover(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Handover) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandover
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Handover: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Handover: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandover
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandover
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandover
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandover
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandover
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandover
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Successor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandover
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandover
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandover
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandover(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandover
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHandover(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHandover
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHandover
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHandover
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHandover
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHandover
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHandover
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHandover        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHandover          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHandover = fmt.Errorf("proto: unexpected end of group")
+)
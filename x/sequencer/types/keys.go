@@ -53,8 +53,26 @@ var (
 
 	NoticePeriodQueueKey = []byte{0x42} // prefix for the timestamps in notice period queue
 
+	// SequencerMetricsKeyPrefix is the prefix to retrieve the track record of a sequencer
+	SequencerMetricsKeyPrefix = []byte{0x48} // prefix/seqAddr
+
 	DymintProposerAddrToAccAddrKeyPrefix = collections.NewPrefix([]byte{0x43})
 
+	DelegationPoolsKeyPrefix      = collections.NewPrefix([]byte{0x44}) // prefix/seqAddr
+	DelegationsKeyPrefix          = collections.NewPrefix([]byte{0x45}) // prefix/seqAddr/delegator
+	UnbondingDelegationsKeyPrefix = collections.NewPrefix([]byte{0x46}) // prefix/completionTime/seqAddr/delegator
+
+	ProposerSelectionsKeyPrefix = collections.NewPrefix([]byte{0x47}) // prefix/rollappId
+
+	BondAssetsKeyPrefix  = collections.NewPrefix([]byte{0x49}) // prefix/rollappId
+	CollateralsKeyPrefix = collections.NewPrefix([]byte{0x4a}) // prefix/seqAddr
+
+	HandoversKeyPrefix = collections.NewPrefix([]byte{0x4b}) // prefix/rollappId
+
+	UnbondingDelegationsBySequencerKeyPrefix = collections.NewPrefix([]byte{0x4c}) // prefix/seqAddr/completionTime/seqAddr/delegator
+
+	BondAssetPricesKeyPrefix = collections.NewPrefix([]byte{0x4d}) // prefix/poolId/denom
+
 	// These keys were already used on mainnet. Don't reuse
 	_ = []byte{0xa3}
 	_ = []byte{0x41}
@@ -73,6 +91,10 @@ func SequencerByRollappByStatusKey(rollappId, seqAddr string, status OperatingSt
 	return append(SequencersByRollappByStatusKey(rollappId, status), []byte(seqAddr)...)
 }
 
+func SequencerMetricsKey(sequencerAddress string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s", SequencerMetricsKeyPrefix, KeySeparator, []byte(sequencerAddress)))
+}
+
 /* ------------------------- multiple sequencers keys ------------------------ */
 
 func SequencersKey() []byte {
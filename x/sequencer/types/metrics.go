@@ -0,0 +1,76 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func NewSequencerMetrics(seq string) SequencerMetrics {
+	return SequencerMetrics{Sequencer: seq}
+}
+
+// RecordStateUpdate accounts for a state update covering numBlocks rollapp blocks, submitted at hub height h
+func (m *SequencerMetrics) RecordStateUpdate(h int64, numBlocks uint64) {
+	if 0 < m.StateUpdates && m.LastUpdateHeight < h {
+		m.TotalUpdateGap += uint64(h - m.LastUpdateHeight)
+	}
+	m.StateUpdates++
+	m.BlocksCovered += numBlocks
+	m.LastUpdateHeight = h
+}
+
+// StartProposing opens a term as proposer, no-op if already open
+func (m *SequencerMetrics) StartProposing(now time.Time) {
+	if m.ProposerSince == nil {
+		m.ProposerSince = &now
+	}
+}
+
+// StopProposing closes the current term as proposer, no-op if not open
+func (m *SequencerMetrics) StopProposing(now time.Time) {
+	if m.ProposerSince == nil {
+		return
+	}
+	if m.ProposerSince.Before(now) {
+		m.TimeAsProposer += now.Sub(*m.ProposerSince)
+	}
+	m.ProposerSince = nil
+}
+
+// AverageUpdateGap is the average number of hub blocks between consecutive state updates
+func (m SequencerMetrics) AverageUpdateGap() uint64 {
+	if m.StateUpdates < 2 {
+		return 0
+	}
+	return m.TotalUpdateGap / (m.StateUpdates - 1)
+}
+
+// TotalTimeAsProposer is the time spent as proposer, including the current term
+func (m SequencerMetrics) TotalTimeAsProposer(now time.Time) time.Duration {
+	ret := m.TimeAsProposer
+	if m.ProposerSince != nil && m.ProposerSince.Before(now) {
+		ret += now.Sub(*m.ProposerSince)
+	}
+	return ret
+}
+
+func (m SequencerMetrics) Performance(now time.Time) SequencerPerformance {
+	return SequencerPerformance{
+		Metrics:          m,
+		AverageUpdateGap: m.AverageUpdateGap(),
+		TimeAsProposer:   m.TotalTimeAsProposer(now),
+	}
+}
+
+func (m SequencerMetrics) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sequencer); err != nil {
+		return errorsmod.Wrap(ErrInvalidAddr, err.Error())
+	}
+	if m.TimeAsProposer < 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "time as proposer")
+	}
+	return nil
+}
@@ -0,0 +1,941 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/sequencer/metrics.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SequencerMetrics is the track record of a sequencer. It is kept after the
+// sequencer unbonds.
+type SequencerMetrics struct {
+	// sequencer is the bech32-encoded address of the sequencer
+	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// state_updates is the number of state updates submitted
+	StateUpdates uint64 `protobuf:"varint,2,opt,name=state_updates,json=stateUpdates,proto3" json:"state_updates,omitempty"`
+	// blocks_covered is the number of rollapp blocks in the submitted state
+	// updates
+	BlocksCovered uint64 `protobuf:"varint,3,opt,name=blocks_covered,json=blocksCovered,proto3" json:"blocks_covered,omitempty"`
+	// last_update_height is the hub height of the last state update
+	LastUpdateHeight int64 `protobuf:"varint,4,opt,name=last_update_height,json=lastUpdateHeight,proto3" json:"last_update_height,omitempty"`
+	// total_update_gap is the sum of the hub blocks between consecutive state
+	// updates
+	TotalUpdateGap uint64 `protobuf:"varint,5,opt,name=total_update_gap,json=totalUpdateGap,proto3" json:"total_update_gap,omitempty"`
+	// liveness_slashes is the number of times the sequencer was slashed for
+	// liveness
+	LivenessSlashes uint64 `protobuf:"varint,6,opt,name=liveness_slashes,json=livenessSlashes,proto3" json:"liveness_slashes,omitempty"`
+	// kicks is the number of times the sequencer was kicked as proposer
+	Kicks uint64 `protobuf:"varint,7,opt,name=kicks,proto3" json:"kicks,omitempty"`
+	// time_as_proposer is the time spent as proposer in the past terms
+	TimeAsProposer time.Duration `protobuf:"bytes,8,opt,name=time_as_proposer,json=timeAsProposer,proto3,stdduration" json:"time_as_proposer"`
+	// proposer_since is the start of the current term as proposer, unset if the
+	// sequencer is not proposer
+	ProposerSince *time.Time `protobuf:"bytes,9,opt,name=proposer_since,json=proposerSince,proto3,stdtime" json:"proposer_since,omitempty"`
+}
+
+func (m *SequencerMetrics) Reset()         { *m = SequencerMetrics{} }
+func (m *SequencerMetrics) String() string { return proto.CompactTextString(m) }
+func (*SequencerMetrics) ProtoMessage()    {}
+func (*SequencerMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee431c62f891d6e1, []int{0}
+}
+func (m *SequencerMetrics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SequencerMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SequencerMetrics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SequencerMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SequencerMetrics.Merge(m, src)
+}
+func (m *SequencerMetrics) XXX_Size() int {
+	return m.Size()
+}
+func (m *SequencerMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_SequencerMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SequencerMetrics proto.InternalMessageInfo
+
+func (m *SequencerMetrics) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *SequencerMetrics) GetStateUpdates() uint64 {
+	if m != nil {
+		return m.StateUpdates
+	}
+	return 0
+}
+
+func (m *SequencerMetrics) GetBlocksCovered() uint64 {
+	if m != nil {
+		return m.BlocksCovered
+	}
+	return 0
+}
+
+func (m *SequencerMetrics) GetLastUpdateHeight() int64 {
+	if m != nil {
+		return m.LastUpdateHeight
+	}
+	return 0
+}
+
+func (m *SequencerMetrics) GetTotalUpdateGap() uint64 {
+	if m != nil {
+		return m.TotalUpdateGap
+	}
+	return 0
+}
+
+func (m *SequencerMetrics) GetLivenessSlashes() uint64 {
+	if m != nil {
+		return m.LivenessSlashes
+	}
+	return 0
+}
+
+func (m *SequencerMetrics) GetKicks() uint64 {
+	if m != nil {
+		return m.Kicks
+	}
+	return 0
+}
+
+func (m *SequencerMetrics) GetTimeAsProposer() time.Duration {
+	if m != nil {
+		return m.TimeAsProposer
+	}
+	return 0
+}
+
+func (m *SequencerMetrics) GetProposerSince() *time.Time {
+	if m != nil {
+		return m.ProposerSince
+	}
+	return nil
+}
+
+// SequencerPerformance is the track record of a sequencer along with the
+// derived values
+type SequencerPerformance struct {
+	Metrics SequencerMetrics `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics"`
+	// average_update_gap is the average number of hub blocks between
+	// consecutive state updates
+	AverageUpdateGap uint64 `protobuf:"varint,2,opt,name=average_update_gap,json=averageUpdateGap,proto3" json:"average_update_gap,omitempty"`
+	// time_as_proposer is the time spent as proposer, including the current term
+	TimeAsProposer time.Duration `protobuf:"bytes,3,opt,name=time_as_proposer,json=timeAsProposer,proto3,stdduration" json:"time_as_proposer"`
+}
+
+func (m *SequencerPerformance) Reset()         { *m = SequencerPerformance{} }
+func (m *SequencerPerformance) String() string { return proto.CompactTextString(m) }
+func (*SequencerPerformance) ProtoMessage()    {}
+func (*SequencerPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee431c62f891d6e1, []int{1}
+}
+func (m *SequencerPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SequencerPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SequencerPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SequencerPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SequencerPerformance.Merge(m, src)
+}
+func (m *SequencerPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *SequencerPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_SequencerPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SequencerPerformance proto.InternalMessageInfo
+
+func (m *SequencerPerformance) GetMetrics() SequencerMetrics {
+	if m != nil {
+		return m.Metrics
+	}
+	return SequencerMetrics{}
+}
+
+func (m *SequencerPerformance) GetAverageUpdateGap() uint64 {
+	if m != nil {
+		return m.AverageUpdateGap
+	}
+	return 0
+}
+
+func (m *SequencerPerformance) GetTimeAsProposer() time.Duration {
+	if m != nil {
+		return m.TimeAsProposer
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SequencerMetrics)(nil), "dymensionxyz.dymension.sequencer.SequencerMetrics")
+	proto.RegisterType((*SequencerPerformance)(nil), "dymensionxyz.dymension.sequencer.SequencerPerformance")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/metrics.proto", fileDescriptor_ee431c62f891d6e1)
+}
+
+var fileDescriptor_ee431c62f891d6e1 = []byte{
+	// 498 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
+	0x1c, 0xed, 0xd8, 0xee, 0x9f, 0xce, 0xda, 0x1a, 0x86, 0x1e, 0x62, 0x91, 0x34, 0xac, 0x08, 0x11,
+	0x24, 0x81, 0x2e, 0x78, 0xb7, 0x0a, 0xeb, 0x65, 0xa1, 0xa4, 0x7a, 0xf1, 0x12, 0xa6, 0xe9, 0x6f,
+	0xd3, 0xd0, 0x24, 0x13, 0x67, 0x26, 0x65, 0xeb, 0xa7, 0xd8, 0xa3, 0x5f, 0xc2, 0xef, 0xb1, 0xc7,
+	0x3d, 0x7a, 0x52, 0x69, 0x0f, 0x7e, 0x0d, 0xc9, 0x4c, 0xd2, 0x96, 0xaa, 0x08, 0xde, 0x3a, 0xef,
+	0xf7, 0xde, 0xfb, 0x35, 0xef, 0xcd, 0x60, 0x77, 0xb6, 0x4a, 0x21, 0x13, 0x31, 0xcb, 0x6e, 0x56,
+	0x9f, 0xbc, 0xed, 0xc1, 0x13, 0xf0, 0xb1, 0x80, 0x2c, 0x04, 0xee, 0xa5, 0x20, 0x79, 0x1c, 0x0a,
+	0x37, 0xe7, 0x4c, 0x32, 0x62, 0xef, 0xf3, 0x77, 0x62, 0x77, 0xcb, 0xef, 0xf7, 0x22, 0x16, 0x31,
+	0x45, 0xf6, 0xca, 0x5f, 0x5a, 0xd7, 0xb7, 0x22, 0xc6, 0xa2, 0x04, 0x3c, 0x75, 0x9a, 0x16, 0xd7,
+	0xde, 0xac, 0xe0, 0x54, 0x96, 0x4a, 0x3d, 0x1f, 0x1c, 0xce, 0x65, 0x9c, 0x82, 0x90, 0x34, 0xcd,
+	0x35, 0xe1, 0xfc, 0x4b, 0x13, 0x1b, 0x93, 0x7a, 0xc9, 0x95, 0xfe, 0x4f, 0xe4, 0x09, 0x6e, 0x6f,
+	0x17, 0x9b, 0xc8, 0x46, 0x4e, 0xdb, 0xdf, 0x01, 0xe4, 0x29, 0xee, 0x08, 0x49, 0x25, 0x04, 0x45,
+	0x3e, 0xa3, 0x12, 0x84, 0xf9, 0xc0, 0x46, 0x4e, 0xcb, 0x7f, 0xa8, 0xc0, 0xf7, 0x1a, 0x23, 0xcf,
+	0x70, 0x77, 0x9a, 0xb0, 0x70, 0x21, 0x82, 0x90, 0x2d, 0x81, 0xc3, 0xcc, 0x6c, 0x2a, 0x56, 0x47,
+	0xa3, 0xaf, 0x35, 0x48, 0x5e, 0x60, 0x92, 0x50, 0x21, 0x2b, 0xab, 0x60, 0x0e, 0x71, 0x34, 0x97,
+	0x66, 0xcb, 0x46, 0x4e, 0xd3, 0x37, 0xca, 0x89, 0xf6, 0x7b, 0xab, 0x70, 0xe2, 0x60, 0x43, 0x32,
+	0x49, 0x93, 0x9a, 0x1e, 0xd1, 0xdc, 0x3c, 0x52, 0xb6, 0x5d, 0x85, 0x6b, 0xf2, 0x25, 0xcd, 0xc9,
+	0x73, 0x6c, 0x24, 0xf1, 0x12, 0x32, 0x10, 0x22, 0x10, 0x09, 0x15, 0x73, 0x10, 0xe6, 0xb1, 0x62,
+	0x3e, 0xaa, 0xf1, 0x89, 0x86, 0x49, 0x0f, 0x1f, 0x2d, 0xe2, 0x70, 0x21, 0xcc, 0x13, 0x35, 0xd7,
+	0x07, 0x72, 0x85, 0x8d, 0x32, 0xaa, 0x80, 0x8a, 0x20, 0xe7, 0x2c, 0x67, 0x02, 0xb8, 0x79, 0x6a,
+	0x23, 0xe7, 0x6c, 0xf8, 0xd8, 0xd5, 0x99, 0xba, 0x75, 0xa6, 0xee, 0x9b, 0x2a, 0xf3, 0xd1, 0xe9,
+	0xdd, 0xb7, 0x41, 0xe3, 0xf3, 0xf7, 0x01, 0xf2, 0xbb, 0xa5, 0xf8, 0x95, 0x18, 0x57, 0x52, 0x72,
+	0x89, 0xbb, 0xb5, 0x4d, 0x20, 0xe2, 0x2c, 0x04, 0xb3, 0xad, 0xcc, 0xfa, 0xbf, 0x99, 0xbd, 0xab,
+	0x0b, 0x1a, 0xb5, 0x6e, 0x4b, 0xa7, 0x4e, 0xad, 0x9b, 0x94, 0xb2, 0xf3, 0x9f, 0x08, 0xf7, 0xb6,
+	0x7d, 0x8d, 0x81, 0x5f, 0x33, 0x9e, 0xd2, 0x2c, 0x04, 0xe2, 0xe3, 0x93, 0xea, 0x4a, 0xa9, 0xc6,
+	0xce, 0x86, 0x43, 0xf7, 0x5f, 0x77, 0xca, 0x3d, 0x2c, 0x7e, 0xd4, 0x2a, 0x3f, 0xc0, 0xaf, 0x8d,
+	0xca, 0x76, 0xe8, 0x12, 0x38, 0x8d, 0x60, 0x3f, 0x71, 0x5d, 0xb7, 0x51, 0x4d, 0x76, 0x99, 0xff,
+	0x29, 0xb2, 0xe6, 0x7f, 0x47, 0x36, 0x1a, 0xdf, 0xad, 0x2d, 0x74, 0xbf, 0xb6, 0xd0, 0x8f, 0xb5,
+	0x85, 0x6e, 0x37, 0x56, 0xe3, 0x7e, 0x63, 0x35, 0xbe, 0x6e, 0xac, 0xc6, 0x87, 0x97, 0x51, 0x2c,
+	0xe7, 0xc5, 0xd4, 0x0d, 0x59, 0xea, 0xfd, 0xe5, 0x9d, 0x2d, 0x2f, 0xbc, 0x9b, 0xbd, 0xc7, 0x26,
+	0x57, 0x39, 0x88, 0xe9, 0xb1, 0x5a, 0x7f, 0xf1, 0x6b, 0x00, 0x50, 0x0d, 0xe3, 0x99, 0x9d, 0x03,
+	0x00, 0x00,
+}
+
+func (m *SequencerMetrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SequencerMetrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SequencerMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposerSince != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ProposerSince, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ProposerSince):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMetrics(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeAsProposer, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeAsProposer):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMetrics(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	if m.Kicks != 0 {
+		i = encodeVarintMetrics(dAtA, i, uint64(m.Kicks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LivenessSlashes != 0 {
+		i = encodeVarintMetrics(dAtA, i, uint64(m.LivenessSlashes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalUpdateGap != 0 {
+		i = encodeVarintMetrics(dAtA, i, uint64(m.TotalUpdateGap))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastUpdateHeight != 0 {
+		i = encodeVarintMetrics(dAtA, i, uint64(m.LastUpdateHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlocksCovered != 0 {
+		i = encodeVarintMetrics(dAtA, i, uint64(m.BlocksCovered))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StateUpdates != 0 {
+		i = encodeVarintMetrics(dAtA, i, uint64(m.StateUpdates))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintMetrics(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SequencerPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SequencerPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SequencerPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeAsProposer, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeAsProposer):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMetrics(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.AverageUpdateGap != 0 {
+		i = encodeVarintMetrics(dAtA, i, uint64(m.AverageUpdateGap))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetrics(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMetrics(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMetrics(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SequencerMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovMetrics(uint64(l))
+	}
+	if m.StateUpdates != 0 {
+		n += 1 + sovMetrics(uint64(m.StateUpdates))
+	}
+	if m.BlocksCovered != 0 {
+		n += 1 + sovMetrics(uint64(m.BlocksCovered))
+	}
+	if m.LastUpdateHeight != 0 {
+		n += 1 + sovMetrics(uint64(m.LastUpdateHeight))
+	}
+	if m.TotalUpdateGap != 0 {
+		n += 1 + sovMetrics(uint64(m.TotalUpdateGap))
+	}
+	if m.LivenessSlashes != 0 {
+		n += 1 + sovMetrics(uint64(m.LivenessSlashes))
+	}
+	if m.Kicks != 0 {
+		n += 1 + sovMetrics(uint64(m.Kicks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeAsProposer)
+	n += 1 + l + sovMetrics(uint64(l))
+	if m.ProposerSince != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ProposerSince)
+		n += 1 + l + sovMetrics(uint64(l))
+	}
+	return n
+}
+
+func (m *SequencerPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metrics.Size()
+	n += 1 + l + sovMetrics(uint64(l))
+	if m.AverageUpdateGap != 0 {
+		n += 1 + sovMetrics(uint64(m.AverageUpdateGap))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeAsProposer)
+	n += 1 + l + sovMetrics(uint64(l))
+	return n
+}
+
+func sovMetrics(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMetrics(x uint64) (n int) {
+	return sovMetrics(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SequencerMetrics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetrics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SequencerMetrics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SequencerMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateUpdates", wireType)
+			}
+			m.StateUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksCovered", wireType)
+			}
+			m.BlocksCovered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksCovered |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateHeight", wireType)
+			}
+			m.LastUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUpdateGap", wireType)
+			}
+			m.TotalUpdateGap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalUpdateGap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessSlashes", wireType)
+			}
+			m.LivenessSlashes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LivenessSlashes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kicks", wireType)
+			}
+			m.Kicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kicks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeAsProposer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeAsProposer, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposerSince == nil {
+				m.ProposerSince = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ProposerSince, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetrics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SequencerPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetrics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SequencerPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SequencerPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageUpdateGap", wireType)
+			}
+			m.AverageUpdateGap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageUpdateGap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeAsProposer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeAsProposer, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetrics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMetrics(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMetrics
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMetrics
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMetrics
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMetrics
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMetrics        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMetrics          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMetrics = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+// MaxFirstStateRootLength is the maximum length of the first state root of the successor
+const MaxFirstStateRootLength = 32
+
+var _ sdk.Msg = &MsgAcceptProposership{}
+
+func (m *MsgAcceptProposership) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Creator)
+	if err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "get creator addr from bech32")
+	}
+	if len(m.FirstStateRoot) > MaxFirstStateRootLength {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "first state root too long: max: %d", MaxFirstStateRootLength)
+	}
+	return nil
+}
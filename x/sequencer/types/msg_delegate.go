@@ -0,0 +1,72 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgDelegate{}
+	_ sdk.Msg = &MsgUndelegate{}
+	_ sdk.Msg = &MsgUpdateCommission{}
+	_ sdk.Msg = &MsgDistributeRewards{}
+	_ sdk.Msg = &MsgWithdrawDelegatorRewards{}
+)
+
+func (msg *MsgDelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Delegator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid delegator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sequencer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid sequencer address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid delegation amount: %s", msg.Amount.String())
+	}
+	return nil
+}
+
+func (msg *MsgUndelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Delegator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid delegator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sequencer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid sequencer address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid undelegation amount: %s", msg.Amount.String())
+	}
+	return nil
+}
+
+func (msg *MsgUpdateCommission) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid creator address (%s)", err)
+	}
+	return ValidateCommissionRate(msg.CommissionRate)
+}
+
+func (msg *MsgDistributeRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sequencer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid sequencer address (%s)", err)
+	}
+	// empty rewards only accrue what was already paid
+	if !msg.Amount.IsValid() {
+		return errorsmod.Wrapf(errors.ErrInvalidCoins, "invalid rewards: %s", msg.Amount.String())
+	}
+	return nil
+}
+
+func (msg *MsgWithdrawDelegatorRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Delegator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid delegator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sequencer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid sequencer address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var _ sdk.Msg = &MsgUpdateBondAssets{}
+
+func (msg *MsgUpdateBondAssets) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority must be a valid bech32 address")
+	}
+	if msg.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id is required")
+	}
+	return msg.BondAssets().ValidateBasic()
+}
+
+// BondAssets returns the bond assets set by the message
+func (msg *MsgUpdateBondAssets) BondAssets() RollappBondAssets {
+	return RollappBondAssets{
+		RollappId: msg.RollappId,
+		Assets:    msg.Assets,
+	}
+}
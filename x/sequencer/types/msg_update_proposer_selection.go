@@ -0,0 +1,29 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var _ sdk.Msg = &MsgUpdateProposerSelection{}
+
+func (msg *MsgUpdateProposerSelection) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid owner address (%s)", err)
+	}
+	if msg.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id is required")
+	}
+	return msg.Selection().ValidateBasic()
+}
+
+// Selection returns the proposer selection set by the message
+func (msg *MsgUpdateProposerSelection) Selection() ProposerSelection {
+	return ProposerSelection{
+		RollappId:              msg.RollappId,
+		Algo:                   msg.Algo,
+		OwnerList:              msg.OwnerList,
+		RotationIntervalBlocks: msg.RotationIntervalBlocks,
+	}
+}
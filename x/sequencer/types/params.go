@@ -22,6 +22,13 @@ var (
 	DefaultDishonorStateUpdate   = uint64(1)
 	DefaultDishonorLiveness      = uint64(300)
 	DefaultDishonorKickThreshold = uint64(900)
+
+	// DefaultHandoverPeriod is the time the successor has to accept proposership. 0 disables the handover phase.
+	DefaultHandoverPeriod = time.Hour
+	// DefaultMaxCommissionChange is the largest change of the commission rate in one update, once per notice period
+	DefaultMaxCommissionChange = math.LegacyMustNewDecFromStr("0.05")
+	// DefaultPriceEpochIdentifier is the epoch at the end of which the reference prices of the bond assets are updated
+	DefaultPriceEpochIdentifier = "hour"
 )
 
 // NewParams creates a new Params instance
@@ -29,6 +36,9 @@ func NewParams(noticePeriod time.Duration, livenessSlashMul math.LegacyDec, live
 	dishonorStateUpdate uint64,
 	dishonorLiveness uint64,
 	dishonorKickThreshold uint64,
+	handoverPeriod time.Duration,
+	maxCommissionChange math.LegacyDec,
+	priceEpochIdentifier string,
 ) Params {
 	return Params{
 		NoticePeriod:               noticePeriod,
@@ -37,12 +47,15 @@ func NewParams(noticePeriod time.Duration, livenessSlashMul math.LegacyDec, live
 		DishonorStateUpdate:        dishonorStateUpdate,
 		DishonorLiveness:           dishonorLiveness,
 		DishonorKickThreshold:      dishonorKickThreshold,
+		HandoverPeriod:             handoverPeriod,
+		MaxCommissionChange:        maxCommissionChange,
+		PriceEpochIdentifier:       priceEpochIdentifier,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultNoticePeriod, DefaultLivenessSlashMultiplier, DefaultLivenessSlashMinAbsolute, DefaultDishonorStateUpdate, DefaultDishonorLiveness, DefaultDishonorKickThreshold)
+	return NewParams(DefaultNoticePeriod, DefaultLivenessSlashMultiplier, DefaultLivenessSlashMinAbsolute, DefaultDishonorStateUpdate, DefaultDishonorLiveness, DefaultDishonorKickThreshold, DefaultHandoverPeriod, DefaultMaxCommissionChange, DefaultPriceEpochIdentifier)
 }
 
 func validateTime(v time.Duration) error {
@@ -81,6 +94,19 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	// 0 disables the handover phase
+	if p.HandoverPeriod < 0 {
+		return fmt.Errorf("handover period must not be negative: %d", p.HandoverPeriod)
+	}
+
+	if err := uparam.ValidateZeroToOneDec(p.MaxCommissionChange); err != nil {
+		return fmt.Errorf("max commission change: %w", err)
+	}
+
+	if len(p.PriceEpochIdentifier) == 0 {
+		return fmt.Errorf("price epoch identifier cannot be empty")
+	}
+
 	return nil
 }
 
@@ -47,6 +47,19 @@ type Params struct {
 	DishonorStateUpdate uint64 `protobuf:"varint,8,opt,name=dishonor_state_update,json=dishonorStateUpdate,proto3" json:"dishonor_state_update,omitempty"`
 	// the minimum dishonor at which a sequencer can be kicked (<=)
 	DishonorKickThreshold uint64 `protobuf:"varint,9,opt,name=dishonor_kick_threshold,json=dishonorKickThreshold,proto3" json:"dishonor_kick_threshold,omitempty"`
+	// handover_period is the time the successor has to accept proposership after
+	// the last block of the proposer. 0 disables the handover phase: the
+	// successor becomes proposer immediately.
+	HandoverPeriod time.Duration `protobuf:"bytes,10,opt,name=handover_period,json=handoverPeriod,proto3,stdduration" json:"handover_period"`
+	// max_commission_change is the largest change of the commission rate of a
+	// sequencer with delegators in one update. The rate can be updated once per
+	// notice period, so the delegators can undelegate before the next change.
+	MaxCommissionChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=max_commission_change,json=maxCommissionChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change" yaml:"max_commission_change"`
+	// price_epoch_identifier is the epoch at the end of which the reference
+	// prices of the bond assets are updated. The collateral is valued at the
+	// lower of the spot price and the reference price, so moving the spot price
+	// within an epoch doesn't inflate the bond value.
+	PriceEpochIdentifier string `protobuf:"bytes,12,opt,name=price_epoch_identifier,json=priceEpochIdentifier,proto3" json:"price_epoch_identifier,omitempty" yaml:"price_epoch_identifier"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -116,6 +129,20 @@ func (m *Params) GetDishonorKickThreshold() uint64 {
 	return 0
 }
 
+func (m *Params) GetHandoverPeriod() time.Duration {
+	if m != nil {
+		return m.HandoverPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetPriceEpochIdentifier() string {
+	if m != nil {
+		return m.PriceEpochIdentifier
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.sequencer.Params")
 }
@@ -125,41 +152,47 @@ func init() {
 }
 
 var fileDescriptor_599b0eefba99ee26 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0xad, 0x09, 0xae, 0x01, 0x29, 0x18, 0x10, 0x6e, 0x2a, 0x6c, 0x2b, 0x12, 0x52,
-	0x24, 0xc8, 0x9d, 0xda, 0x4a, 0x1d, 0xba, 0x11, 0x32, 0xa0, 0xd0, 0x4a, 0x51, 0x4a, 0x17, 0x16,
-	0xeb, 0x6c, 0x1f, 0xf6, 0x29, 0xb6, 0xcf, 0xf8, 0xce, 0x51, 0xcd, 0x17, 0x60, 0x65, 0xcc, 0xd8,
-	0x0f, 0xc0, 0xc8, 0x87, 0xe8, 0x58, 0x31, 0x21, 0x86, 0x80, 0x92, 0x05, 0x31, 0xf2, 0x09, 0x90,
-	0xff, 0xaa, 0xaa, 0xa0, 0x5b, 0x9e, 0x7b, 0x7e, 0xcf, 0x93, 0xf7, 0x5e, 0xf9, 0x94, 0x81, 0x9b,
-	0x85, 0x24, 0xe2, 0x94, 0x45, 0x67, 0xd9, 0x07, 0xd4, 0x08, 0xc4, 0xc9, 0xfb, 0x94, 0x44, 0x0e,
-	0x49, 0x50, 0x8c, 0x13, 0x1c, 0x72, 0x18, 0x27, 0x4c, 0x30, 0xd5, 0xbc, 0x8a, 0xc3, 0x46, 0xc0,
-	0x06, 0xef, 0x3e, 0xf4, 0x98, 0xc7, 0x0a, 0x18, 0xe5, 0xbf, 0xca, 0x5c, 0x77, 0xdb, 0x61, 0x3c,
-	0x64, 0xdc, 0x2a, 0x8d, 0x52, 0x54, 0x96, 0x5e, 0x2a, 0x64, 0x63, 0x4e, 0xd0, 0x7c, 0xd7, 0x26,
-	0x02, 0xef, 0x22, 0x87, 0xd1, 0xa8, 0xf6, 0x3d, 0xc6, 0xbc, 0x80, 0xa0, 0x42, 0xd9, 0xe9, 0x3b,
-	0xe4, 0xa6, 0x09, 0x16, 0xf9, 0x9f, 0x16, 0x27, 0xbd, 0xcf, 0x92, 0xd2, 0x9e, 0x14, 0x33, 0xaa,
-	0xaf, 0x94, 0x7b, 0x11, 0x13, 0xd4, 0x21, 0x56, 0x4c, 0x12, 0xca, 0x5c, 0x6d, 0xd3, 0x04, 0xfd,
-	0x3b, 0x7b, 0xdb, 0xb0, 0xac, 0x80, 0x75, 0x05, 0x1c, 0x55, 0x15, 0x43, 0xf9, 0x62, 0x69, 0xb4,
-	0x16, 0x3f, 0x0c, 0x30, 0xbd, 0x5b, 0x26, 0x27, 0x45, 0x50, 0x5d, 0x00, 0xe5, 0x49, 0x40, 0xe7,
-	0x24, 0x22, 0x9c, 0x5b, 0x3c, 0xc0, 0xdc, 0xb7, 0x42, 0x1a, 0x59, 0x61, 0x1a, 0x08, 0x1a, 0x07,
-	0x94, 0x24, 0x9a, 0x64, 0x82, 0xfe, 0xd6, 0xf0, 0x34, 0xcf, 0x7f, 0x5f, 0x1a, 0x3b, 0xe5, 0x25,
-	0xb8, 0x3b, 0x83, 0x94, 0xa1, 0x10, 0x0b, 0x1f, 0x1e, 0x11, 0x0f, 0x3b, 0xd9, 0x88, 0x38, 0x7f,
-	0x96, 0x86, 0x99, 0xe1, 0x30, 0x38, 0xec, 0x5d, 0x6f, 0x6c, 0xda, 0x7a, 0x5f, 0xbf, 0x0c, 0x94,
-	0x6a, 0x2b, 0x23, 0xe2, 0x4c, 0xbb, 0x35, 0x79, 0x92, 0x83, 0xc7, 0x34, 0x3a, 0x6e, 0x50, 0xf5,
-	0x23, 0x50, 0x76, 0xfe, 0x31, 0x1a, 0xb6, 0x39, 0x0b, 0x52, 0x41, 0xb4, 0x76, 0x75, 0xe7, 0xaa,
-	0x2e, 0x5f, 0x2b, 0xac, 0xd6, 0x0a, 0x5f, 0x32, 0x1a, 0x0d, 0x07, 0xf9, 0xcc, 0xbf, 0x97, 0xc6,
-	0xd3, 0x1b, 0x5a, 0x9e, 0xb3, 0x90, 0x0a, 0x12, 0xc6, 0x22, 0x9b, 0x6a, 0xd7, 0x67, 0x79, 0x51,
-	0x31, 0xea, 0x33, 0xe5, 0xbe, 0x4b, 0xb9, 0xcf, 0x22, 0x96, 0x58, 0x35, 0xa4, 0xdd, 0x36, 0x41,
-	0x5f, 0x9a, 0x76, 0x6a, 0xe3, 0xa8, 0x3a, 0x57, 0xf7, 0x94, 0x47, 0x0d, 0xcc, 0x05, 0x16, 0xc4,
-	0x4a, 0x63, 0x17, 0x0b, 0xa2, 0xc9, 0x45, 0xe0, 0x41, 0x6d, 0x9e, 0xe4, 0xde, 0x69, 0x61, 0xa9,
-	0x07, 0xca, 0xe3, 0x26, 0x33, 0xa3, 0xce, 0xcc, 0x12, 0x7e, 0x42, 0xb8, 0xcf, 0x02, 0x57, 0xdb,
-	0x2a, 0x52, 0x4d, 0xe5, 0x6b, 0xea, 0xcc, 0xde, 0xd4, 0xe6, 0xa1, 0xbc, 0x38, 0x37, 0x5a, 0xbf,
-	0xce, 0x0d, 0x30, 0x96, 0x64, 0xd0, 0xd9, 0x18, 0x4b, 0xf2, 0xad, 0x4e, 0x7b, 0x2c, 0xc9, 0x1b,
-	0x9d, 0xcd, 0xe1, 0xe4, 0x62, 0xa5, 0x83, 0xcb, 0x95, 0x0e, 0x7e, 0xae, 0x74, 0xf0, 0x69, 0xad,
-	0xb7, 0x2e, 0xd7, 0x7a, 0xeb, 0xdb, 0x5a, 0x6f, 0xbd, 0x3d, 0xf0, 0xa8, 0xf0, 0x53, 0x1b, 0x3a,
-	0x2c, 0x44, 0xff, 0x79, 0x15, 0xf3, 0x7d, 0x74, 0x76, 0xe5, 0x69, 0x88, 0x2c, 0x26, 0xdc, 0x6e,
-	0x17, 0x9f, 0xd5, 0xfe, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xe4, 0xe4, 0x9d, 0x4b, 0x03,
-	0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x4f, 0xd4, 0x4e,
+	0x18, 0xdf, 0xc2, 0xfe, 0xf7, 0xbf, 0x0c, 0xa8, 0x6b, 0x01, 0x2d, 0x20, 0xed, 0xba, 0x89, 0x09,
+	0x89, 0xd2, 0x06, 0x48, 0x38, 0x70, 0x73, 0xc1, 0x44, 0x11, 0x12, 0x5c, 0x24, 0x26, 0x5e, 0x9a,
+	0xd9, 0xe9, 0xd0, 0x4e, 0xb6, 0x33, 0x53, 0x3b, 0xd3, 0xcd, 0xae, 0x77, 0xe3, 0xd5, 0x23, 0x47,
+	0x3e, 0x84, 0x1f, 0x82, 0x23, 0xf1, 0x64, 0x34, 0x59, 0x0d, 0x5c, 0x8c, 0x47, 0x3e, 0x81, 0xe9,
+	0xf4, 0x25, 0x84, 0xa0, 0xe1, 0xd6, 0xe7, 0xf9, 0xbd, 0xcc, 0x33, 0x4f, 0x7f, 0x19, 0xb0, 0xec,
+	0x0d, 0x29, 0x66, 0x82, 0x70, 0x36, 0x18, 0xbe, 0x77, 0xca, 0xc2, 0x11, 0xf8, 0x5d, 0x82, 0x19,
+	0xc2, 0xb1, 0x13, 0xc1, 0x18, 0x52, 0x61, 0x47, 0x31, 0x97, 0x5c, 0x6f, 0x5e, 0xa6, 0xdb, 0x65,
+	0x61, 0x97, 0xf4, 0xf9, 0x19, 0x9f, 0xfb, 0x5c, 0x91, 0x9d, 0xf4, 0x2b, 0xd3, 0xcd, 0xcf, 0x21,
+	0x2e, 0x28, 0x17, 0x6e, 0x06, 0x64, 0x45, 0x0e, 0x99, 0x59, 0xe5, 0x74, 0xa1, 0xc0, 0x4e, 0x7f,
+	0xa5, 0x8b, 0x25, 0x5c, 0x71, 0x10, 0x27, 0xac, 0xc0, 0x7d, 0xce, 0xfd, 0x10, 0x3b, 0xaa, 0xea,
+	0x26, 0x87, 0x8e, 0x97, 0xc4, 0x50, 0xa6, 0x87, 0xaa, 0x4e, 0xeb, 0x7b, 0x0d, 0xd4, 0xf6, 0xd4,
+	0x8c, 0xfa, 0x73, 0x70, 0x8b, 0x71, 0x49, 0x10, 0x76, 0x23, 0x1c, 0x13, 0xee, 0x19, 0xe3, 0x4d,
+	0x6d, 0x69, 0x72, 0x75, 0xce, 0xce, 0x2c, 0xec, 0xc2, 0xc2, 0xde, 0xca, 0x2d, 0xda, 0xf5, 0x93,
+	0x91, 0x55, 0x39, 0xfa, 0x61, 0x69, 0x9d, 0xa9, 0x4c, 0xb9, 0xa7, 0x84, 0xfa, 0x91, 0x06, 0x16,
+	0x43, 0xd2, 0xc7, 0x0c, 0x0b, 0xe1, 0x8a, 0x10, 0x8a, 0xc0, 0xa5, 0x84, 0xb9, 0x34, 0x09, 0x25,
+	0x89, 0x42, 0x82, 0x63, 0xa3, 0xda, 0xd4, 0x96, 0x26, 0xda, 0x07, 0xa9, 0xfe, 0xdb, 0xc8, 0x5a,
+	0xc8, 0x2e, 0x21, 0xbc, 0x9e, 0x4d, 0xb8, 0x43, 0xa1, 0x0c, 0xec, 0x1d, 0xec, 0x43, 0x34, 0xdc,
+	0xc2, 0xe8, 0x62, 0x64, 0x35, 0x87, 0x90, 0x86, 0x1b, 0xad, 0xab, 0x8e, 0xa5, 0x5b, 0xeb, 0xcb,
+	0xe7, 0x65, 0x90, 0x6f, 0x65, 0x0b, 0xa3, 0xce, 0x7c, 0xc1, 0xdc, 0x4f, 0x89, 0xbb, 0x84, 0xed,
+	0x96, 0x54, 0xfd, 0xa3, 0x06, 0x16, 0xae, 0x19, 0x0d, 0x76, 0x05, 0x0f, 0x13, 0x89, 0x8d, 0x5a,
+	0x7e, 0xe7, 0xdc, 0x2e, 0x5d, 0xab, 0x9d, 0xaf, 0xd5, 0xde, 0xe4, 0x84, 0xb5, 0x97, 0xd3, 0x99,
+	0x7f, 0x8f, 0xac, 0x47, 0xff, 0x70, 0x79, 0xc2, 0x29, 0x91, 0x98, 0x46, 0x72, 0xd8, 0x31, 0xae,
+	0xce, 0xf2, 0x34, 0xe7, 0xe8, 0x8f, 0xc1, 0x5d, 0x8f, 0x88, 0x80, 0x33, 0x1e, 0xbb, 0x05, 0xc9,
+	0xf8, 0xbf, 0xa9, 0x2d, 0x55, 0x3b, 0x8d, 0x02, 0xd8, 0xc9, 0xfb, 0xfa, 0x2a, 0x98, 0x2d, 0xc9,
+	0x42, 0x42, 0x89, 0xdd, 0x24, 0xf2, 0xa0, 0xc4, 0x46, 0x5d, 0x09, 0xa6, 0x0b, 0x70, 0x3f, 0xc5,
+	0x0e, 0x14, 0xa4, 0xaf, 0x83, 0xfb, 0xa5, 0xa6, 0x47, 0x50, 0xcf, 0x95, 0x41, 0x8c, 0x45, 0xc0,
+	0x43, 0xcf, 0x98, 0x50, 0xaa, 0xd2, 0xf2, 0x25, 0x41, 0xbd, 0xd7, 0x05, 0xa8, 0xef, 0x80, 0x3b,
+	0x01, 0x64, 0x1e, 0xef, 0xe3, 0xb8, 0x48, 0x02, 0xb8, 0x79, 0x12, 0x6e, 0x17, 0xda, 0x3c, 0x0b,
+	0x1f, 0x34, 0x30, 0x4b, 0xe1, 0xc0, 0x45, 0x9c, 0x52, 0x22, 0xd2, 0xb8, 0xbb, 0x28, 0x80, 0xcc,
+	0xc7, 0xc6, 0xa4, 0xca, 0xc0, 0xab, 0x9b, 0x65, 0xe0, 0x41, 0x96, 0x81, 0x6b, 0x9d, 0xae, 0xfe,
+	0xff, 0x69, 0x0a, 0x07, 0x9b, 0x25, 0x69, 0x53, 0x71, 0xf4, 0x37, 0xe0, 0x5e, 0x14, 0xa7, 0xe1,
+	0xc6, 0x11, 0x47, 0x81, 0x4b, 0x3c, 0xcc, 0x24, 0x39, 0x4c, 0xb3, 0x38, 0xa5, 0xe6, 0x78, 0x78,
+	0x31, 0xb2, 0x16, 0xb3, 0x43, 0xae, 0xe7, 0xb5, 0x3a, 0x33, 0x0a, 0x78, 0x96, 0xf6, 0x5f, 0x94,
+	0xed, 0x8d, 0xfa, 0xd1, 0xb1, 0x55, 0xf9, 0x75, 0x6c, 0x69, 0xdb, 0xd5, 0xba, 0xd6, 0x18, 0xdb,
+	0xae, 0xd6, 0xff, 0x6b, 0xd4, 0xb6, 0xab, 0xf5, 0xb1, 0xc6, 0x78, 0x7b, 0xef, 0xe4, 0xcc, 0xd4,
+	0x4e, 0xcf, 0x4c, 0xed, 0xe7, 0x99, 0xa9, 0x7d, 0x3a, 0x37, 0x2b, 0xa7, 0xe7, 0x66, 0xe5, 0xeb,
+	0xb9, 0x59, 0x79, 0xbb, 0xee, 0x13, 0x19, 0x24, 0x5d, 0x1b, 0x71, 0xea, 0xfc, 0xe5, 0x11, 0xe9,
+	0xaf, 0x39, 0x83, 0x4b, 0x2f, 0x89, 0x1c, 0x46, 0x58, 0x74, 0x6b, 0x6a, 0xf7, 0x6b, 0x7f, 0x06,
+	0x00, 0xef, 0x1a, 0x34, 0x22, 0x7a, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -199,6 +232,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DishonorKickThreshold != that1.DishonorKickThreshold {
 		return false
 	}
+	if this.HandoverPeriod != that1.HandoverPeriod {
+		return false
+	}
+	if !this.MaxCommissionChange.Equal(that1.MaxCommissionChange) {
+		return false
+	}
+	if this.PriceEpochIdentifier != that1.PriceEpochIdentifier {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -221,6 +263,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceEpochIdentifier) > 0 {
+		i -= len(m.PriceEpochIdentifier)
+		copy(dAtA[i:], m.PriceEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PriceEpochIdentifier)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size := m.MaxCommissionChange.Size()
+		i -= size
+		if _, err := m.MaxCommissionChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.HandoverPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HandoverPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
 	if m.DishonorKickThreshold != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DishonorKickThreshold))
 		i--
@@ -256,12 +323,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	return len(dAtA) - i, nil
@@ -299,6 +366,14 @@ func (m *Params) Size() (n int) {
 	if m.DishonorKickThreshold != 0 {
 		n += 1 + sovParams(uint64(m.DishonorKickThreshold))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HandoverPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxCommissionChange.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.PriceEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -494,6 +569,105 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandoverPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.HandoverPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -38,6 +38,42 @@ func TestValidateBasic(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"disabled handover period",
+			func() Params {
+				p := DefaultParams()
+				p.HandoverPeriod = 0
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid handover period",
+			func() Params {
+				p := DefaultParams()
+				p.HandoverPeriod = -1
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid max commission change",
+			func() Params {
+				p := DefaultParams()
+				p.MaxCommissionChange = math.LegacyNewDec(2)
+				return p
+			}(),
+			true,
+		},
+		{
+			"empty price epoch identifier",
+			func() Params {
+				p := DefaultParams()
+				p.PriceEpochIdentifier = ""
+				return p
+			}(),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func (sel ProposerSelection) ValidateBasic() error {
+	if _, ok := ProposerSelectionAlgo_name[int32(sel.Algo)]; !ok {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "unknown algo: %d", sel.Algo)
+	}
+	if sel.Algo == ProposerSelectionAlgo_PROPOSER_SELECTION_ALGO_OWNER_LIST && len(sel.OwnerList) == 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner list algo requires a list")
+	}
+	return validateOwnerList(sel.OwnerList)
+}
+
+func validateOwnerList(list []string) error {
+	for i, addr := range list {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAddr, "owner list: %s", addr)
+		}
+		if slices.Contains(list[:i], addr) {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "owner list: duplicate: %s", addr)
+		}
+	}
+	return nil
+}
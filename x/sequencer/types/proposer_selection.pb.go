@@ -0,0 +1,555 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/sequencer/proposer_selection.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProposerSelectionAlgo is the strategy used to choose the next proposer of a
+// rollapp among its bonded and opted in sequencers
+type ProposerSelectionAlgo int32
+
+const (
+	// the sequencer with the highest bond
+	ProposerSelectionAlgo_PROPOSER_SELECTION_ALGO_HIGHEST_BOND ProposerSelectionAlgo = 0
+	// the sequencers take turns, in address order
+	ProposerSelectionAlgo_PROPOSER_SELECTION_ALGO_ROUND_ROBIN ProposerSelectionAlgo = 1
+	// a random sequencer, weighted by bond, seeded by the block hash. The hub
+	// block proposer can grind the block hash to bias the choice.
+	ProposerSelectionAlgo_PROPOSER_SELECTION_ALGO_STAKE_WEIGHTED_RANDOM ProposerSelectionAlgo = 2
+	// the first available sequencer of a list curated by the rollapp owner,
+	// falling back to the highest bond
+	ProposerSelectionAlgo_PROPOSER_SELECTION_ALGO_OWNER_LIST ProposerSelectionAlgo = 3
+)
+
+var ProposerSelectionAlgo_name = map[int32]string{
+	0: "PROPOSER_SELECTION_ALGO_HIGHEST_BOND",
+	1: "PROPOSER_SELECTION_ALGO_ROUND_ROBIN",
+	2: "PROPOSER_SELECTION_ALGO_STAKE_WEIGHTED_RANDOM",
+	3: "PROPOSER_SELECTION_ALGO_OWNER_LIST",
+}
+
+var ProposerSelectionAlgo_value = map[string]int32{
+	"PROPOSER_SELECTION_ALGO_HIGHEST_BOND":          0,
+	"PROPOSER_SELECTION_ALGO_ROUND_ROBIN":           1,
+	"PROPOSER_SELECTION_ALGO_STAKE_WEIGHTED_RANDOM": 2,
+	"PROPOSER_SELECTION_ALGO_OWNER_LIST":            3,
+}
+
+func (x ProposerSelectionAlgo) String() string {
+	return proto.EnumName(ProposerSelectionAlgo_name, int32(x))
+}
+
+func (ProposerSelectionAlgo) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9ad50eab91cd6076, []int{0}
+}
+
+// ProposerSelection is how the proposers of a rollapp are chosen
+type ProposerSelection struct {
+	RollappId string                `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Algo      ProposerSelectionAlgo `protobuf:"varint,2,opt,name=algo,proto3,enum=dymensionxyz.dymension.sequencer.ProposerSelectionAlgo" json:"algo,omitempty"`
+	// owner_list is the ordered list of preferred sequencer addresses, used by
+	// the owner list algorithm
+	OwnerList []string `protobuf:"bytes,3,rep,name=owner_list,json=ownerList,proto3" json:"owner_list,omitempty"`
+	// rotation_interval_blocks makes the proposer start its notice period every
+	// interval blocks, so that another sequencer takes over. Zero disables
+	// scheduled rotations.
+	RotationIntervalBlocks uint64 `protobuf:"varint,4,opt,name=rotation_interval_blocks,json=rotationIntervalBlocks,proto3" json:"rotation_interval_blocks,omitempty"`
+	// last_proposer is the last proposer chosen by the round robin algorithm
+	LastProposer string `protobuf:"bytes,5,opt,name=last_proposer,json=lastProposer,proto3" json:"last_proposer,omitempty"`
+}
+
+func (m *ProposerSelection) Reset()         { *m = ProposerSelection{} }
+func (m *ProposerSelection) String() string { return proto.CompactTextString(m) }
+func (*ProposerSelection) ProtoMessage()    {}
+func (*ProposerSelection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ad50eab91cd6076, []int{0}
+}
+func (m *ProposerSelection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerSelection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerSelection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerSelection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerSelection.Merge(m, src)
+}
+func (m *ProposerSelection) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerSelection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerSelection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerSelection proto.InternalMessageInfo
+
+func (m *ProposerSelection) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *ProposerSelection) GetAlgo() ProposerSelectionAlgo {
+	if m != nil {
+		return m.Algo
+	}
+	return ProposerSelectionAlgo_PROPOSER_SELECTION_ALGO_HIGHEST_BOND
+}
+
+func (m *ProposerSelection) GetOwnerList() []string {
+	if m != nil {
+		return m.OwnerList
+	}
+	return nil
+}
+
+func (m *ProposerSelection) GetRotationIntervalBlocks() uint64 {
+	if m != nil {
+		return m.RotationIntervalBlocks
+	}
+	return 0
+}
+
+func (m *ProposerSelection) GetLastProposer() string {
+	if m != nil {
+		return m.LastProposer
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.sequencer.ProposerSelectionAlgo", ProposerSelectionAlgo_name, ProposerSelectionAlgo_value)
+	proto.RegisterType((*ProposerSelection)(nil), "dymensionxyz.dymension.sequencer.ProposerSelection")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/proposer_selection.proto", fileDescriptor_9ad50eab91cd6076)
+}
+
+var fileDescriptor_9ad50eab91cd6076 = []byte{
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x3b, 0xdb, 0x2a, 0xec, 0xa0, 0x52, 0x07, 0x94, 0x5c, 0x0c, 0x61, 0x57, 0xb4, 0x08,
+	0x26, 0xe8, 0x82, 0x7f, 0x8e, 0xad, 0x19, 0xda, 0xb0, 0x31, 0x13, 0x26, 0x91, 0x05, 0x2f, 0x43,
+	0x9a, 0x0e, 0x35, 0x38, 0x9b, 0x89, 0x33, 0xb3, 0xeb, 0xd6, 0x4f, 0xe1, 0x97, 0x12, 0x3c, 0xee,
+	0xd1, 0xa3, 0xb4, 0x9f, 0x43, 0x90, 0x84, 0xa6, 0x14, 0x34, 0xec, 0xf1, 0x7d, 0x9e, 0xf7, 0x97,
+	0xe7, 0xcd, 0xf0, 0xc0, 0xb7, 0x8b, 0xd5, 0x39, 0x2f, 0x75, 0x21, 0xcb, 0xab, 0xd5, 0x37, 0x6f,
+	0x37, 0x78, 0x9a, 0x7f, 0xb9, 0xe0, 0x65, 0xce, 0x95, 0x57, 0x29, 0x59, 0x49, 0xcd, 0x15, 0xd3,
+	0x5c, 0xf0, 0xdc, 0x14, 0xb2, 0x74, 0x2b, 0x25, 0x8d, 0x44, 0xce, 0x3e, 0xea, 0xee, 0x06, 0x77,
+	0x87, 0x1e, 0xfd, 0x01, 0xf0, 0x7e, 0xbc, 0xc5, 0x93, 0x96, 0x46, 0x8f, 0x20, 0x54, 0x52, 0x88,
+	0xac, 0xaa, 0x58, 0xb1, 0xb0, 0x80, 0x03, 0x46, 0x87, 0xf4, 0x70, 0xab, 0x04, 0x0b, 0x74, 0x0a,
+	0x07, 0x99, 0x58, 0x4a, 0xeb, 0xc0, 0x01, 0xa3, 0x7b, 0x2f, 0x5f, 0xbb, 0x37, 0xa5, 0xb8, 0xff,
+	0x24, 0x8c, 0xc5, 0x52, 0xd2, 0xe6, 0x23, 0x75, 0x96, 0xfc, 0x5a, 0x72, 0xc5, 0x44, 0xa1, 0x8d,
+	0xd5, 0x77, 0xfa, 0x75, 0x56, 0xa3, 0x84, 0x85, 0x36, 0xe8, 0x0d, 0xb4, 0x94, 0x34, 0x59, 0x0d,
+	0xb1, 0xa2, 0x34, 0x5c, 0x5d, 0x66, 0x82, 0xcd, 0x85, 0xcc, 0x3f, 0x6b, 0x6b, 0xe0, 0x80, 0xd1,
+	0x80, 0x3e, 0x6c, 0xfd, 0x60, 0x6b, 0x4f, 0x1a, 0x17, 0x1d, 0xc3, 0xbb, 0x22, 0xd3, 0x86, 0xb5,
+	0xaf, 0x63, 0xdd, 0x6a, 0xfe, 0xe3, 0x4e, 0x2d, 0xb6, 0x07, 0x3d, 0xfb, 0x01, 0xe0, 0x83, 0xff,
+	0x5e, 0x87, 0x46, 0xf0, 0x71, 0x4c, 0x49, 0x4c, 0x12, 0x4c, 0x59, 0x82, 0x43, 0xfc, 0x2e, 0x0d,
+	0x48, 0xc4, 0xc6, 0xe1, 0x94, 0xb0, 0x59, 0x30, 0x9d, 0xe1, 0x24, 0x65, 0x13, 0x12, 0xf9, 0xc3,
+	0x1e, 0x7a, 0x0a, 0x8f, 0xbb, 0x36, 0x29, 0xf9, 0x10, 0xf9, 0x8c, 0x92, 0x49, 0x10, 0x0d, 0x01,
+	0x7a, 0x01, 0x9f, 0x77, 0x2d, 0x26, 0xe9, 0xf8, 0x14, 0xb3, 0x33, 0x1c, 0x4c, 0x67, 0x29, 0xf6,
+	0x19, 0x1d, 0x47, 0x3e, 0x79, 0x3f, 0x3c, 0x40, 0x4f, 0xe0, 0x51, 0x17, 0x42, 0xce, 0x22, 0x4c,
+	0x59, 0x18, 0x24, 0xe9, 0xb0, 0x3f, 0x89, 0x7f, 0xae, 0x6d, 0x70, 0xbd, 0xb6, 0xc1, 0xef, 0xb5,
+	0x0d, 0xbe, 0x6f, 0xec, 0xde, 0xf5, 0xc6, 0xee, 0xfd, 0xda, 0xd8, 0xbd, 0x8f, 0xaf, 0x96, 0x85,
+	0xf9, 0x74, 0x31, 0x77, 0x73, 0x79, 0xee, 0x75, 0x34, 0xe9, 0xf2, 0xc4, 0xbb, 0xda, 0xab, 0x93,
+	0x59, 0x55, 0x5c, 0xcf, 0x6f, 0x37, 0x15, 0x3a, 0xf9, 0x3b, 0x00, 0xd4, 0xeb, 0xa6, 0xb9, 0x7f,
+	0x02, 0x00, 0x00,
+}
+
+func (m *ProposerSelection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerSelection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerSelection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastProposer) > 0 {
+		i -= len(m.LastProposer)
+		copy(dAtA[i:], m.LastProposer)
+		i = encodeVarintProposerSelection(dAtA, i, uint64(len(m.LastProposer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RotationIntervalBlocks != 0 {
+		i = encodeVarintProposerSelection(dAtA, i, uint64(m.RotationIntervalBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OwnerList) > 0 {
+		for iNdEx := len(m.OwnerList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OwnerList[iNdEx])
+			copy(dAtA[i:], m.OwnerList[iNdEx])
+			i = encodeVarintProposerSelection(dAtA, i, uint64(len(m.OwnerList[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Algo != 0 {
+		i = encodeVarintProposerSelection(dAtA, i, uint64(m.Algo))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintProposerSelection(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposerSelection(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposerSelection(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProposerSelection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovProposerSelection(uint64(l))
+	}
+	if m.Algo != 0 {
+		n += 1 + sovProposerSelection(uint64(m.Algo))
+	}
+	if len(m.OwnerList) > 0 {
+		for _, s := range m.OwnerList {
+			l = len(s)
+			n += 1 + l + sovProposerSelection(uint64(l))
+		}
+	}
+	if m.RotationIntervalBlocks != 0 {
+		n += 1 + sovProposerSelection(uint64(m.RotationIntervalBlocks))
+	}
+	l = len(m.LastProposer)
+	if l > 0 {
+		n += 1 + l + sovProposerSelection(uint64(l))
+	}
+	return n
+}
+
+func sovProposerSelection(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposerSelection(x uint64) (n int) {
+	return sovProposerSelection(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProposerSelection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposerSelection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerSelection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerSelection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposerSelection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposerSelection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposerSelection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algo", wireType)
+			}
+			m.Algo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposerSelection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algo |= ProposerSelectionAlgo(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposerSelection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposerSelection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposerSelection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerList = append(m.OwnerList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationIntervalBlocks", wireType)
+			}
+			m.RotationIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposerSelection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RotationIntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposerSelection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposerSelection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposerSelection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastProposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposerSelection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposerSelection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposerSelection(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposerSelection
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposerSelection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposerSelection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposerSelection
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposerSelection
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposerSelection
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposerSelection        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposerSelection          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposerSelection = fmt.Errorf("proto: unexpected end of group")
+)